@@ -8,15 +8,22 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
+	"slices"
 	"syscall"
+	"time"
 
 	"github.com/alecthomas/kingpin/v2"
+	prom "github.com/prometheus/client_golang/prometheus"
 
 	"github.com/sustainable-computing-io/kepler/config"
 	"github.com/sustainable-computing-io/kepler/internal/device"
 	"github.com/sustainable-computing-io/kepler/internal/device/gpu"
+	"github.com/sustainable-computing-io/kepler/internal/device/gpu/intel"
 	_ "github.com/sustainable-computing-io/kepler/internal/device/gpu/nvidia" // Register NVIDIA backend
+	"github.com/sustainable-computing-io/kepler/internal/device/gpu/rocm"
 	"github.com/sustainable-computing-io/kepler/internal/exporter/prometheus"
+	"github.com/sustainable-computing-io/kepler/internal/exporter/pushgateway"
+	"github.com/sustainable-computing-io/kepler/internal/exporter/statsd"
 	"github.com/sustainable-computing-io/kepler/internal/exporter/stdout"
 	"github.com/sustainable-computing-io/kepler/internal/k8s/pod"
 	"github.com/sustainable-computing-io/kepler/internal/logger"
@@ -26,6 +33,8 @@ import (
 	"github.com/sustainable-computing-io/kepler/internal/server"
 	"github.com/sustainable-computing-io/kepler/internal/service"
 	"github.com/sustainable-computing-io/kepler/internal/version"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
 )
 
 func main() {
@@ -34,6 +43,7 @@ func main() {
 	if err != nil {
 		os.Exit(1)
 	}
+	configLoadedAt := time.Now()
 
 	// Configure logger - use stderr if stdout exporter is enabled to prevent output interleaving
 	logOut := os.Stdout
@@ -44,8 +54,9 @@ func main() {
 
 	logVersionInfo(logger)
 	printConfigInfo(logger, cfg)
+	logExperimentalFeatures(logger, cfg)
 
-	services, err := createServices(logger, cfg)
+	services, err := createServices(logger, cfg, configLoadedAt)
 	if err != nil {
 		logger.Error("failed to create services", "error", err)
 		os.Exit(1)
@@ -58,6 +69,7 @@ func main() {
 		logger.Error("failed to initialize services", "error", err)
 		os.Exit(1)
 	}
+	logStartupSummary(logger, cfg, services)
 
 	logger.Info("Starting Kepler")
 
@@ -81,6 +93,75 @@ func logVersionInfo(logger *slog.Logger) {
 	)
 }
 
+// logExperimentalFeatures warns once for each enabled experimental feature so
+// that operators are aware they are running code without stability guarantees
+func logExperimentalFeatures(logger *slog.Logger, cfg *config.Config) {
+	for _, f := range cfg.EnabledExperimentalFeatures() {
+		logger.Warn("EXPERIMENTAL: no stability guarantees", "feature", string(f))
+	}
+}
+
+// logStartupSummary logs what Kepler decided to do after initialization; unlike
+// the configuration dump, it reflects runtime discovery of meters and devices
+func logStartupSummary(logger *slog.Logger, cfg *config.Config, services []service.Service) {
+	cpuMeter := "none"
+	cpuZones := []string{}
+	gpuBackends := []string{}
+	gpuDevices := []string{}
+	platform := "none"
+
+	for _, s := range services {
+		switch svc := s.(type) {
+		case device.CPUPowerMeter:
+			cpuMeter = svc.Name()
+			zones, err := svc.Zones()
+			if err != nil {
+				logger.Warn("failed to list CPU zones for startup summary", "error", err)
+				continue
+			}
+			for _, z := range zones {
+				cpuZones = append(cpuZones, z.Name())
+			}
+		case gpu.GPUPowerMeter:
+			gpuBackends = append(gpuBackends, string(svc.Vendor()))
+			for _, d := range svc.Devices() {
+				gpuDevices = append(gpuDevices, fmt.Sprintf("%d:%s", d.Index, d.Name))
+			}
+		case *redfish.Service:
+			platform = "redfish"
+		}
+	}
+	slices.Sort(cpuZones)
+	cpuZones = slices.Compact(cpuZones)
+
+	exporters := []string{}
+	if cfg.IsFeatureEnabled(config.PrometheusFeature) {
+		exporters = append(exporters, "prometheus")
+	}
+	if cfg.IsFeatureEnabled(config.StdoutFeature) {
+		exporters = append(exporters, "stdout")
+	}
+	if cfg.IsFeatureEnabled(config.PushgatewayFeature) {
+		exporters = append(exporters, "pushgateway")
+	}
+	if cfg.IsFeatureEnabled(config.StatsdFeature) {
+		exporters = append(exporters, "statsd")
+	}
+
+	logger.Info("Kepler startup summary",
+		"cpu.meter", cpuMeter,
+		"cpu.zones", cpuZones,
+		"gpu.backends", gpuBackends,
+		"gpu.devices", gpuDevices,
+		"platform", platform,
+		"exporters", exporters,
+		"metrics.level", metricsLevel(cfg).String(),
+		"monitor.mode", cfg.Monitor.Mode,
+		"monitor.interval", cfg.Monitor.Interval,
+		"monitor.staleness", cfg.Monitor.Staleness,
+	)
+}
+
 func parseArgsAndConfig() (*config.Config, error) {
 	const appName = "kepler"
 	app := kingpin.New(appName, "Power consumption monitoring exporter for Prometheus.")
@@ -122,18 +203,21 @@ Configuration
 ━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━
 %s
 ━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━
-`, cfg)
+`, cfg.Redacted())
 }
 
-func createServices(logger *slog.Logger, cfg *config.Config) ([]service.Service, error) {
+func createServices(logger *slog.Logger, cfg *config.Config, configLoadedAt time.Time) ([]service.Service, error) {
 	logger.Debug("Creating all services")
 	cpuPowerMeter, err := createCPUMeter(logger, cfg)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create CPU power meter: %w", err)
 	}
 
-	// GPU meters are optional - returns empty slice if not available
-	gpuMeters := createGPUMeters(logger, cfg)
+	// GPU meters are optional unless experimental.gpu.required is set
+	gpuMeters, err := createGPUMeters(logger, cfg)
+	if err != nil {
+		return nil, err
+	}
 
 	// Inject configured idle power into GPU meters that support it
 	if cfg.Experimental != nil && cfg.Experimental.GPU.IdlePower > 0 {
@@ -146,10 +230,81 @@ func createServices(logger *slog.Logger, cfg *config.Config) ([]service.Service,
 		}
 	}
 
+	// Weight encoder/decoder utilization into GPU process attribution
+	if cfg.Experimental != nil && cfg.Experimental.GPU.EncDecWeight > 0 {
+		for _, m := range gpuMeters {
+			if c, ok := m.(gpu.EncDecWeightConfigurable); ok {
+				c.SetEncDecWeight(cfg.Experimental.GPU.EncDecWeight)
+				logger.Info("configured GPU encoder/decoder attribution weight",
+					"weight", cfg.Experimental.GPU.EncDecWeight)
+			}
+		}
+	}
+
+	// Attribute GPU power to graphics processes as well as compute processes
+	if cfg.Experimental != nil && !ptr.Deref(cfg.Experimental.GPU.ComputeOnly, true) {
+		for _, m := range gpuMeters {
+			if c, ok := m.(gpu.ComputeOnlyConfigurable); ok {
+				c.SetComputeOnly(false)
+				logger.Info("configured GPU attribution to include graphics processes",
+					"meter", m.Name())
+			}
+		}
+	}
+
+	// Attribute the idle GPU power to processes as well as the active power
+	if cfg.Experimental != nil && cfg.Experimental.GPU.ProcessEnergyBasis == config.ProcessEnergyBasisTotal {
+		for _, m := range gpuMeters {
+			if c, ok := m.(gpu.IdleAttributionConfigurable); ok {
+				c.SetAttributeIdle(true)
+				logger.Info("configured GPU attribution to include idle power",
+					"meter", m.Name())
+			}
+		}
+	}
+
+	// Restrict GPU monitoring to the first N discovered devices
+	if cfg.Experimental != nil && cfg.Experimental.GPU.MaxDevices > 0 {
+		for _, m := range gpuMeters {
+			if l, ok := m.(gpu.DeviceLimitable); ok {
+				for _, dev := range l.LimitDevices(cfg.Experimental.GPU.MaxDevices) {
+					logger.Info("GPU device skipped; exceeds configured maxDevices",
+						"vendor", m.Vendor(),
+						"device", dev.Index,
+						"uuid", dev.UUID,
+						"maxDevices", cfg.Experimental.GPU.MaxDevices)
+				}
+			}
+		}
+	}
+
+	// Restrict GPU monitoring to the devices selected by UUID
+	if cfg.Experimental != nil && len(cfg.Experimental.GPU.DeviceUUIDs) > 0 {
+		selectGPUDevices(logger, gpuMeters, cfg.Experimental.GPU.DeviceUUIDs)
+	}
+
+	// Exclude configured processes from per-process GPU attribution
+	if cfg.Experimental != nil && len(cfg.Experimental.GPU.ExcludeProcesses) > 0 {
+		exclude, err := gpu.NewProcessExcluder(cfg.Host.ProcFS, cfg.Experimental.GPU.ExcludeProcesses)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create GPU process excluder: %w", err)
+		}
+		for _, m := range gpuMeters {
+			if e, ok := m.(gpu.ProcessExcludable); ok {
+				e.SetProcessExcluder(exclude)
+				logger.Info("configured GPU process exclusion",
+					"patterns", cfg.Experimental.GPU.ExcludeProcesses)
+			}
+		}
+	}
+
 	var services []service.Service
 
+	nodeOnly := cfg.Monitor.Mode == config.MonitorModeNodeOnly
+
+	// pods are never looked up in node-only mode
 	var podInformer pod.Informer
-	if *cfg.Kube.Enabled {
+	if *cfg.Kube.Enabled && !nodeOnly {
 		podInformer = createPodInformer(cfg, logger)
 		services = append(services, podInformer)
 	}
@@ -157,6 +312,9 @@ func createServices(logger *slog.Logger, cfg *config.Config) ([]service.Service,
 		resource.WithLogger(logger),
 		resource.WithProcFSPath(cfg.Host.ProcFS),
 		resource.WithPodInformer(podInformer),
+		resource.WithProcessScanInterval(cfg.Monitor.ProcessScanInterval),
+		resource.WithNodeOnly(nodeOnly),
+		resource.WithContainerIDFormat(resource.ContainerIDFormat(cfg.Monitor.ContainerIDFormat)),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create resource informer: %w", err)
@@ -169,10 +327,32 @@ func createServices(logger *slog.Logger, cfg *config.Config) ([]service.Service,
 		monitor.WithInterval(cfg.Monitor.Interval),
 		monitor.WithMaxStaleness(cfg.Monitor.Staleness),
 		monitor.WithMaxTerminated(cfg.Monitor.MaxTerminated),
-		monitor.WithMinTerminatedEnergyThreshold(monitor.Energy(cfg.Monitor.MinTerminatedEnergyThreshold) * monitor.Joule),
+		monitor.WithMinTerminatedEnergyThreshold(monitor.Energy(cfg.Monitor.MinTerminatedEnergyThreshold.Joules() * float64(monitor.Joule))),
+		monitor.WithPIDMode(monitor.PIDMode(cfg.Monitor.PIDMode)),
+		monitor.WithMode(monitor.Mode(cfg.Monitor.Mode)),
+		monitor.WithMaxBackoff(cfg.Monitor.MaxBackoff),
+		monitor.WithCollectionTimeout(cfg.Monitor.CollectionTimeout),
+		monitor.WithResolveUsernames(ptr.Deref(cfg.Monitor.ResolveUsernames, false)),
+		monitor.WithProcessEnergyBasis(monitor.EnergyBasis(cfg.Monitor.ProcessEnergyBasis)),
+		monitor.WithKernelAsProcess(ptr.Deref(cfg.Monitor.KernelAsProcess, true)),
+		monitor.WithMaxProcessAge(cfg.Monitor.MaxProcessAge),
+		monitor.WithPowerSmoothingWindow(cfg.Monitor.PowerSmoothingWindow),
 	}
 	if len(gpuMeters) > 0 {
-		pmOpts = append(pmOpts, monitor.WithGPUPowerMeters(gpuMeters))
+		pmOpts = append(pmOpts,
+			monitor.WithGPUPowerMeters(gpuMeters),
+			monitor.WithGPUReliabilityMetrics(cfg.Experimental != nil && cfg.Experimental.GPU.ReliabilityMetrics),
+		)
+		if cfg.Experimental != nil && cfg.Experimental.GPU.ProcessEnergyBasis == config.ProcessEnergyBasisTotal {
+			pmOpts = append(pmOpts, monitor.WithGPUProcessEnergyBasis(monitor.EnergyBasisTotal))
+		}
+		if cfg.Experimental != nil && cfg.Experimental.GPU.EnergyJitterTolerance > 0 {
+			pmOpts = append(pmOpts, monitor.WithGPUEnergyJitterTolerance(
+				device.Energy(cfg.Experimental.GPU.EnergyJitterTolerance*float64(device.Joule))))
+		}
+	}
+	if coreZones := createCoreZones(logger, cfg); len(coreZones) > 0 {
+		pmOpts = append(pmOpts, monitor.WithCoreZones(coreZones))
 	}
 
 	pm := monitor.NewPowerMonitor(cpuPowerMeter, pmOpts...)
@@ -210,24 +390,76 @@ func createServices(logger *slog.Logger, cfg *config.Config) ([]service.Service,
 		}
 	}
 
+	// the metrics exporters share the collectors: the power collector is the
+	// only consumer of the monitor data channel
+	var collectors map[string]prom.Collector
+	if cfg.IsFeatureEnabled(config.PrometheusFeature) || cfg.IsFeatureEnabled(config.PushgatewayFeature) {
+		collectors, err = createCollectors(logger, cfg, pm, redfishService, len(gpuMeters) > 0, configLoadedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create collectors: %w", err)
+		}
+	}
+
 	// Add Prometheus exporter if enabled
 	if cfg.IsFeatureEnabled(config.PrometheusFeature) {
-		promExporter, err := createPrometheusExporter(logger, cfg, apiServer, pm, redfishService)
+		promExporter, err := createPrometheusExporter(logger, cfg, apiServer, pm, collectors)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create Prometheus exporter: %w", err)
 		}
 		services = append(services, promExporter)
 	}
 
+	// Add Pushgateway exporter if enabled
+	if cfg.IsFeatureEnabled(config.PushgatewayFeature) {
+		services = append(services, createPushgatewayExporter(logger, cfg, collectors))
+	}
+
+	// Add statsd exporter if enabled
+	if cfg.IsFeatureEnabled(config.StatsdFeature) {
+		sd := cfg.Exporter.Statsd
+		services = append(services, statsd.NewExporter(pm, sd.Address,
+			statsd.WithLogger(logger),
+			statsd.WithInterval(sd.Interval),
+			statsd.WithMaxPacketSize(sd.MaxPacketSize),
+			statsd.WithMetricsLevel(metricsLevel(cfg)),
+			statsd.WithNodeName(nodeName(logger, cfg)),
+			statsd.WithTags(sd.Tags),
+		))
+	}
+
 	// Add pprof if enabled
 	if cfg.IsFeatureEnabled(config.PprofFeature) {
-		pprof := server.NewPprof(apiServer)
+		var pprofAPI server.APIService = apiServer
+		if addr := cfg.Debug.Pprof.ListenAddress; addr != "" {
+			// serve pprof on a dedicated server so that profiling stays off the metrics port
+			pprofServer := server.NewAPIServer(
+				server.WithLogger(logger),
+				server.WithName("pprof-server"),
+				server.WithListenAddress([]string{addr}),
+			)
+			services = append(services, pprofServer)
+			pprofAPI = pprofServer
+		}
+		pprof := server.NewPprof(pprofAPI)
 		services = append(services, pprof)
 	}
 
+	// Add config inspect endpoint if enabled
+	if cfg.IsFeatureEnabled(config.DebugConfigFeature) {
+		services = append(services, server.NewConfigInspector(apiServer, cfg.Redacted()))
+	}
+
+	// Add raw zone readings endpoint if enabled
+	if cfg.IsFeatureEnabled(config.DebugZonesFeature) {
+		services = append(services, server.NewZoneInspector(apiServer, pm))
+	}
+
 	// Add stdout exporter if enabled
 	if cfg.IsFeatureEnabled(config.StdoutFeature) {
-		stdoutExporter := stdout.NewExporter(pm, stdout.WithLogger(logger))
+		stdoutExporter := stdout.NewExporter(pm,
+			stdout.WithLogger(logger),
+			stdout.WithFormat(stdout.Format(cfg.Exporter.Stdout.Format)),
+		)
 		services = append(services, stdoutExporter)
 	}
 
@@ -240,7 +472,7 @@ func createPodInformer(cfg *config.Config, logger *slog.Logger) pod.Informer {
 		return pod.NewInformer(
 			pod.WithLogger(logger),
 			pod.WithKubeConfig(cfg.Kube.Config),
-			pod.WithNodeName(cfg.Kube.Node),
+			pod.WithNodeName(nodeName(logger, cfg)),
 		)
 	}
 
@@ -249,7 +481,7 @@ func createPodInformer(cfg *config.Config, logger *slog.Logger) pod.Informer {
 		"pollInterval", cfg.Kube.PodInformer.PollInterval)
 	return pod.NewKubeletInformer(
 		pod.WithLogger(logger),
-		pod.WithNodeName(cfg.Kube.Node),
+		pod.WithNodeName(nodeName(logger, cfg)),
 		pod.WithKubeConfig(cfg.Kube.Config),
 		pod.WithPollInterval(cfg.Kube.PodInformer.PollInterval),
 	)
@@ -262,21 +494,138 @@ func createRedfishService(logger *slog.Logger, cfg *config.Config) (*redfish.Ser
 func createPrometheusExporter(
 	logger *slog.Logger, cfg *config.Config,
 	apiServer *server.APIServer, pm *monitor.PowerMonitor,
-	rs *redfish.Service,
+	collectors map[string]prom.Collector,
 ) (*prometheus.Exporter, error) {
 	logger.Debug("Creating Prometheus exporter")
 
-	// Use metrics level from configuration (already parsed)
-	metricsLevel := cfg.Exporter.Prometheus.MetricsLevel
+	debugCollectors := cfg.Exporter.Prometheus.DebugCollectors
+
+	var authToken string
+	if cfg.Web.AuthTokenFile != "" {
+		var err error
+		if authToken, err = config.ReadAuthToken(cfg.Web.AuthTokenFile); err != nil {
+			return nil, fmt.Errorf("failed to read web auth token: %w", err)
+		}
+	}
 
+	promExporter := prometheus.NewExporter(
+		pm,
+		apiServer,
+		prometheus.WithLogger(logger),
+		prometheus.WithCollectors(collectors),
+		prometheus.WithDebugCollectors(debugCollectors),
+		prometheus.WithAuthToken(authToken),
+		prometheus.WithMetricsPath(cfg.Web.MetricsPath),
+		prometheus.WithDisabledMetrics(cfg.Exporter.Prometheus.DisabledMetrics),
+	)
+
+	return promExporter, nil
+}
+
+func createPushgatewayExporter(logger *slog.Logger, cfg *config.Config, collectors map[string]prom.Collector) *pushgateway.Exporter {
+	logger.Debug("Creating Pushgateway exporter")
+
+	return pushgateway.NewExporter(
+		cfg.Exporter.Pushgateway.URL,
+		pushgateway.WithLogger(logger),
+		pushgateway.WithNodeName(nodeName(logger, cfg)),
+		pushgateway.WithInterval(cfg.Exporter.Pushgateway.Interval),
+		pushgateway.WithCollectors(collectors),
+		pushgateway.WithDisabledMetrics(cfg.Exporter.Prometheus.DisabledMetrics),
+	)
+}
+
+// nodeName returns the node name resolved from kube.nodeNameSources, falling
+// back to kube.nodeName if it cannot be resolved
+func nodeName(logger *slog.Logger, cfg *config.Config) string {
+	name, err := cfg.ResolveNodeName()
+	if err != nil {
+		logger.Warn("failed to resolve node name; using kube.nodeName", "error", err)
+		return cfg.Kube.Node
+	}
+	return name
+}
+
+// metricsLevel returns the metrics levels exported; workload metrics are
+// never exported in node-only mode since no workload power is computed
+func metricsLevel(cfg *config.Config) config.Level {
+	if cfg.Monitor.Mode == config.MonitorModeNodeOnly {
+		return config.MetricsLevelNode
+	}
+	return cfg.Exporter.Prometheus.MetricsLevel
+}
+
+// attributionMethods returns the methods used to attribute CPU and GPU power
+// to workloads, as reported by kepler_attribution_info. CPU power is split by
+// CPU time of either the active or the total node power; GPU power by the
+// utilization of the processes, optionally weighting encoder/decoder usage.
+func attributionMethods(cfg *config.Config) (cpuMethod, gpuMethod string) {
+	if cfg.Monitor.Mode == config.MonitorModeNodeOnly {
+		return "none", "none"
+	}
+
+	cpuMethod = "cputime"
+	if cfg.Monitor.ProcessEnergyBasis == config.ProcessEnergyBasisTotal {
+		cpuMethod = "cputime-total"
+	}
+
+	gpuMethod = "none"
+	if cfg.IsFeatureEnabled(config.ExperimentalGPUFeature) {
+		gpuMethod = "utilization"
+		if cfg.Experimental.GPU.EncDecWeight > 0 {
+			gpuMethod = "utilization-encdec"
+		}
+	}
+	return cpuMethod, gpuMethod
+}
+
+// createCollectors creates the Prometheus collectors shared by the metrics exporters
+func createCollectors(
+	logger *slog.Logger, cfg *config.Config, pm *monitor.PowerMonitor,
+	rs *redfish.Service, gpuMeterUp bool, configLoadedAt time.Time,
+) (map[string]prom.Collector, error) {
 	var collectorOpts []prometheus.OptionFn
 	collectorOpts = append(collectorOpts,
 		prometheus.WithLogger(logger),
 		prometheus.WithProcFSPath(cfg.Host.ProcFS),
-		prometheus.WithNodeName(cfg.Kube.Node),
-		prometheus.WithMetricsLevel(metricsLevel),
+		prometheus.WithNodeName(nodeName(logger, cfg)),
+		prometheus.WithMetricsLevel(metricsLevel(cfg)),
+		prometheus.WithTotalPowerSources(cfg.Monitor.TotalPowerSources),
+		prometheus.WithGPUInPlatformTotal(ptr.Deref(cfg.Monitor.GPUInPlatformTotal, true)),
+		prometheus.WithZoneNameMap(cfg.Rapl.ZoneNameMap),
+		prometheus.WithKWh(ptr.Deref(cfg.Exporter.Prometheus.EmitKwh, false)),
+		// per-socket zones share their name and are told apart by their path
+		prometheus.WithZonePathLabel(ptr.Deref(cfg.Exporter.Prometheus.IncludeZonePath, false) ||
+			ptr.Deref(cfg.Rapl.PerSocket, false)),
+		prometheus.WithDisabledMetrics(cfg.Exporter.Prometheus.DisabledMetrics),
+		prometheus.WithMaxTotalSeries(cfg.Exporter.Prometheus.MaxTotalSeries),
+		prometheus.WithAttributionMethods(attributionMethods(cfg)),
+		prometheus.WithWarmup(cfg.Monitor.WarmupInterval),
+		prometheus.WithConfigLoadTime(configLoadedAt),
 	)
 
+	if p := cfg.Exporter.Prometheus.GPUPowerPrecision; p != nil {
+		collectorOpts = append(collectorOpts, prometheus.WithGPUPowerPrecision(*p))
+	}
+
+	if pi := cfg.Exporter.Prometheus.PlatformInfo; ptr.Deref(pi.Enabled, false) {
+		collectorOpts = append(collectorOpts, prometheus.WithPlatformInfo(cfg.Host.SysFS, pi.Cloud, pi.InstanceType))
+	}
+
+	if ptr.Deref(cfg.Exporter.Prometheus.UseStaleMarkers, false) {
+		// the latest snapshot is exported without refreshing it, so a healthy
+		// snapshot may be up to an interval old
+		if cfg.Monitor.Interval > 0 {
+			collectorOpts = append(collectorOpts, prometheus.WithStaleMarkers(cfg.Monitor.Interval+cfg.Monitor.Staleness))
+		} else {
+			logger.Warn("ignoring exporter.prometheus.useStaleMarkers; it requires a monitor interval")
+		}
+	}
+
+	if cfg.IsFeatureEnabled(config.ExperimentalGPUFeature) {
+		collectorOpts = append(collectorOpts, prometheus.WithGPUMeterUp(gpuMeterUp))
+	}
+
 	// Add platform data provider if Redfish service is available
 	if rs != nil {
 		collectorOpts = append(collectorOpts, prometheus.WithPlatformDataProvider(rs))
@@ -286,18 +635,28 @@ func createPrometheusExporter(
 	if err != nil {
 		return nil, fmt.Errorf("failed to create Prometheus collectors: %w", err)
 	}
+	return collectors, nil
+}
 
-	debugCollectors := cfg.Exporter.Prometheus.DebugCollectors
+// createCoreZones discovers per-core energy zones if enabled. Per-core power is
+// optional, so an empty slice is returned if it is disabled or unavailable.
+func createCoreZones(logger *slog.Logger, cfg *config.Config) []device.EnergyZone {
+	if !*cfg.Rapl.PerCore {
+		return nil
+	}
 
-	promExporter := prometheus.NewExporter(
-		pm,
-		apiServer,
-		prometheus.WithLogger(logger),
-		prometheus.WithCollectors(collectors),
-		prometheus.WithDebugCollectors(debugCollectors),
-	)
+	zones, err := device.CoreEnergyZones(cfg.Host.SysFS)
+	if err != nil {
+		logger.Warn("failed to discover per-core energy zones", "error", err)
+		return nil
+	}
+	if len(zones) == 0 {
+		logger.Info("per-core energy counters not available; only package level power is reported")
+		return nil
+	}
 
-	return promExporter, nil
+	logger.Info("per-core energy zones discovered", "cores", len(zones))
+	return zones
 }
 
 func createCPUMeter(logger *slog.Logger, cfg *config.Config) (device.CPUPowerMeter, error) {
@@ -341,27 +700,149 @@ func createCPUMeter(logger *slog.Logger, cfg *config.Config) (device.CPUPowerMet
 		logger.Info("rapl zones are filtered", "zones-enabled", cfg.Rapl.Zones)
 	}
 
-	return device.NewCPUPowerMeter(
-		cfg.Host.SysFS,
+	opts := []device.OptionFn{
 		device.WithRaplLogger(logger),
 		device.WithZoneFilter(cfg.Rapl.Zones),
-	)
+		device.WithPerSocketZones(ptr.Deref(cfg.Rapl.PerSocket, false)),
+	}
+	if cfg.Rapl.Path != "" {
+		logger.Info("rapl zones are discovered in custom path", "path", cfg.Rapl.Path)
+		opts = append(opts, device.WithPowercapPath(cfg.Rapl.Path))
+	}
+
+	raplMeter, err := device.NewCPUPowerMeter(cfg.Host.SysFS, opts...)
+	if err != nil || !cfg.IsFeatureEnabled(config.ExperimentalPowerSupplyFeature) {
+		return raplMeter, err
+	}
+
+	// Fall back to the batteries and UPSes of edge devices without RAPL
+	if err := raplMeter.Init(); err != nil {
+		logger.Info("RAPL not available; reading node power from the power supply", "reason", err)
+		return device.NewPowerSupplyPowerMeter(cfg.Host.SysFS, device.WithPowerSupplyLogger(logger))
+	}
+	return raplMeter, nil
+}
+
+// selectGPUDevices restricts the GPU meters to the devices with the given
+// UUIDs and warns about UUIDs that match no discovered device
+func selectGPUDevices(logger *slog.Logger, meters []gpu.GPUPowerMeter, uuids []string) {
+	resolved := make(map[string]bool, len(uuids))
+	for _, m := range meters {
+		s, ok := m.(gpu.DeviceSelectable)
+		if !ok {
+			logger.Warn("GPU meter does not support selecting devices by UUID; monitoring all devices",
+				"meter", m.Name())
+			continue
+		}
+		for _, dev := range s.SelectDevices(uuids) {
+			logger.Info("GPU device skipped; not in configured deviceUUIDs",
+				"vendor", m.Vendor(),
+				"device", dev.Index,
+				"uuid", dev.UUID)
+		}
+		for _, dev := range m.Devices() {
+			resolved[dev.UUID] = true
+			logger.Info("GPU device selected by UUID",
+				"vendor", m.Vendor(),
+				"device", dev.Index,
+				"uuid", dev.UUID)
+		}
+	}
+
+	for _, uuid := range uuids {
+		if !resolved[uuid] {
+			logger.Warn("configured GPU device UUID not found", "uuid", uuid)
+		}
+	}
+}
+
+// gpuBackendVendors maps the backends of experimental.gpu.type to the vendor
+// their meter is registered for
+var gpuBackendVendors = map[string]gpu.Vendor{
+	config.GPUTypeNVML:  gpu.VendorNVIDIA,
+	config.GPUTypeROCM:  gpu.VendorAMD,
+	config.GPUTypeIntel: gpu.VendorIntel,
+}
+
+// createFakeGPUMeter creates a fake GPU meter reporting the configured power
+// for each device, shared by the configured processes or by Kepler itself
+func createFakeGPUMeter(cfg *config.Config) *gpu.FakeGPUMeter {
+	fake := cfg.Dev.FakeGpuMeter
+
+	devices := make([]gpu.GPUDevice, 0, fake.Devices)
+	for i := range fake.Devices {
+		devices = append(devices, gpu.GPUDevice{
+			Index:  i,
+			UUID:   fmt.Sprintf("GPU-fake-%d", i),
+			Name:   "Fake GPU",
+			Vendor: gpu.VendorUnknown,
+		})
+	}
+
+	meter := gpu.NewFakeGPUMeter(devices)
+	// report energy consistent with the power whatever the monitor interval
+	meter.SetEnergyIntegration(clock.RealClock{})
+	for _, dev := range devices {
+		meter.SetPower(dev.Index, device.Power(fake.Power*float64(device.Watt)))
+	}
+
+	pids := make([]uint32, 0, len(fake.PIDs))
+	for _, pid := range fake.PIDs {
+		pids = append(pids, uint32(pid))
+	}
+	if len(pids) == 0 {
+		pids = append(pids, uint32(os.Getpid()))
+	}
+	meter.SetProcesses(pids)
+
+	return meter
 }
 
 // createGPUMeters discovers and initializes GPU power meters for all vendors.
 // Uses the registry pattern to support multiple GPU vendors (NVIDIA, AMD, Intel).
-// Returns empty slice if GPU is not enabled or no GPUs are available (soft-fail).
-func createGPUMeters(logger *slog.Logger, cfg *config.Config) []gpu.GPUPowerMeter {
+// Backends that discover no devices do not start a meter. Returns empty slice
+// if GPU is not enabled or no GPU meter starts (soft-fail), which disables GPU
+// attribution for the session and exports kepler_gpu_meter_up 0, or an error
+// in the latter case when experimental.gpu.required is set.
+func createGPUMeters(logger *slog.Logger, cfg *config.Config) ([]gpu.GPUPowerMeter, error) {
+	if fake := cfg.Dev.FakeGpuMeter; ptr.Deref(fake.Enabled, false) {
+		logger.Warn("using fake GPU meter; do not use in production",
+			"devices", fake.Devices, "power", fake.Power, "pids", fake.PIDs)
+		return []gpu.GPUPowerMeter{createFakeGPUMeter(cfg)}, nil
+	}
+
 	if !cfg.IsFeatureEnabled(config.ExperimentalGPUFeature) {
 		logger.Info("GPU feature disabled")
-		return nil
+		return nil, nil
 	}
 
-	// DiscoverAll probes all registered GPU backends and returns initialized meters
-	meters := gpu.DiscoverAll(logger)
+	// the AMD and Intel backends read sysfs and procfs, which may be mounted
+	// elsewhere
+	gpu.Register(gpu.VendorAMD, rocm.Factory(cfg.Host.SysFS, cfg.Host.ProcFS))
+	gpu.Register(gpu.VendorIntel, intel.Factory(cfg.Host.SysFS, cfg.Host.ProcFS))
+
+	var meters []gpu.GPUPowerMeter
+	if backends := cfg.Experimental.GPU.Backends(); len(backends) > 0 {
+		// probe the configured backends in order and use the first available
+		vendors := make([]gpu.Vendor, 0, len(backends))
+		for _, b := range backends {
+			vendors = append(vendors, gpuBackendVendors[b])
+		}
+		if m := gpu.DiscoverFirst(vendors, logger); m != nil {
+			meters = append(meters, m)
+		}
+	} else {
+		// DiscoverAll probes all registered GPU backends and returns initialized meters
+		meters = gpu.DiscoverAll(logger)
+	}
 	if len(meters) == 0 {
-		logger.Info("no GPUs discovered on this node")
-		return nil
+		if cfg.Experimental.GPU.Required {
+			return nil, fmt.Errorf("GPU monitoring is required but no GPU meter started with any devices")
+		}
+		logger.Warn("GPU monitoring enabled but no GPU meter started; continuing with CPU-only monitoring",
+			"reason", "GPU backends unavailable or discovered no devices",
+			"metric", "kepler_gpu_meter_up")
+		return nil, nil
 	}
 
 	// Log all discovered GPUs
@@ -371,5 +852,5 @@ func createGPUMeters(logger *slog.Logger, cfg *config.Config) []gpu.GPUPowerMete
 			"devices", len(m.Devices()))
 	}
 
-	return meters
+	return meters, nil
 }
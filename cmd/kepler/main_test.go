@@ -0,0 +1,364 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/sustainable-computing-io/kepler/config"
+	"github.com/sustainable-computing-io/kepler/internal/device"
+	"github.com/sustainable-computing-io/kepler/internal/device/gpu"
+	"github.com/sustainable-computing-io/kepler/internal/platform/redfish"
+	"github.com/sustainable-computing-io/kepler/internal/service"
+	"k8s.io/utils/ptr"
+)
+
+// stubGPUMeter is a minimal gpu.GPUPowerMeter reporting a fixed set of devices
+type stubGPUMeter struct {
+	devices []gpu.GPUDevice
+	initErr error
+}
+
+func (m *stubGPUMeter) Name() string {
+	return "stub-gpu"
+}
+
+func (m *stubGPUMeter) Init() error {
+	return m.initErr
+}
+
+func (m *stubGPUMeter) Shutdown() error {
+	return nil
+}
+
+func (m *stubGPUMeter) Vendor() gpu.Vendor {
+	return gpu.VendorNVIDIA
+}
+
+func (m *stubGPUMeter) Devices() []gpu.GPUDevice {
+	return m.devices
+}
+
+func (m *stubGPUMeter) GetPowerUsage(int) (device.Power, error) {
+	return 0, nil
+}
+
+func (m *stubGPUMeter) GetTotalEnergy(int) (device.Energy, error) {
+	return 0, nil
+}
+
+func (m *stubGPUMeter) GetDevicePowerStats(int) (gpu.GPUPowerStats, error) {
+	return gpu.GPUPowerStats{}, nil
+}
+
+func (m *stubGPUMeter) GetProcessPower() (map[uint32]float64, error) {
+	return nil, nil
+}
+
+func (m *stubGPUMeter) GetProcessInfo() ([]gpu.ProcessGPUInfo, error) {
+	return nil, nil
+}
+
+var _ gpu.GPUPowerMeter = (*stubGPUMeter)(nil)
+
+func TestLogStartupSummary(t *testing.T) {
+	cpuMeter, err := device.NewFakeCPUMeter([]string{"package", "dram"})
+	require.NoError(t, err)
+
+	gpuMeter := &stubGPUMeter{devices: []gpu.GPUDevice{
+		{Index: 0, Name: "NVIDIA A100", Vendor: gpu.VendorNVIDIA},
+		{Index: 1, Name: "NVIDIA A100", Vendor: gpu.VendorNVIDIA},
+	}}
+
+	cfg := config.DefaultConfig()
+	cfg.Monitor.Interval = 10 * time.Second
+	cfg.Monitor.Staleness = time.Second
+	cfg.Exporter.Stdout.Enabled = ptr.To(true)
+	cfg.Exporter.Prometheus.MetricsLevel = config.MetricsLevelNode | config.MetricsLevelPod
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+
+	logStartupSummary(logger, cfg, []service.Service{cpuMeter, gpuMeter, &redfish.Service{}})
+
+	var entry map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+
+	assert.Equal(t, "Kepler startup summary", entry["msg"])
+	assert.Equal(t, "fake-cpu-meter", entry["cpu.meter"])
+	assert.ElementsMatch(t, []any{"dram", "package"}, entry["cpu.zones"])
+	assert.Equal(t, []any{"nvidia"}, entry["gpu.backends"])
+	assert.Equal(t, []any{"0:NVIDIA A100", "1:NVIDIA A100"}, entry["gpu.devices"])
+	assert.Equal(t, "redfish", entry["platform"])
+	assert.Equal(t, []any{"prometheus", "stdout"}, entry["exporters"])
+	assert.Equal(t, cfg.Exporter.Prometheus.MetricsLevel.String(), entry["metrics.level"])
+	assert.Equal(t, float64(10*time.Second), entry["monitor.interval"])
+	assert.Equal(t, float64(time.Second), entry["monitor.staleness"])
+}
+
+func TestLogStartupSummary_NoDevices(t *testing.T) {
+	cfg := config.DefaultConfig()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+
+	logStartupSummary(logger, cfg, nil)
+
+	var entry map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+
+	assert.Equal(t, "none", entry["cpu.meter"])
+	assert.Empty(t, entry["cpu.zones"])
+	assert.Empty(t, entry["gpu.backends"])
+	assert.Empty(t, entry["gpu.devices"])
+	assert.Equal(t, "none", entry["platform"])
+	assert.Equal(t, []any{"prometheus"}, entry["exporters"])
+}
+
+func TestMetricsLevel(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Exporter.Prometheus.MetricsLevel = config.MetricsLevelNode | config.MetricsLevelPod
+	assert.Equal(t, config.MetricsLevelNode|config.MetricsLevelPod, metricsLevel(cfg))
+
+	cfg.Monitor.Mode = config.MonitorModeNodeOnly
+	assert.Equal(t, config.MetricsLevelNode, metricsLevel(cfg), "node-only mode must only export node metrics")
+}
+
+func TestAttributionMethods(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cpuMethod, gpuMethod := attributionMethods(cfg)
+	assert.Equal(t, "cputime", cpuMethod)
+	assert.Equal(t, "none", gpuMethod, "GPU power is not attributed unless GPU monitoring is enabled")
+
+	cfg.Monitor.ProcessEnergyBasis = config.ProcessEnergyBasisTotal
+	cfg.Experimental = &config.Experimental{}
+	cfg.Experimental.GPU.Enabled = ptr.To(true)
+	cpuMethod, gpuMethod = attributionMethods(cfg)
+	assert.Equal(t, "cputime-total", cpuMethod)
+	assert.Equal(t, "utilization", gpuMethod)
+
+	cfg.Experimental.GPU.EncDecWeight = 0.5
+	_, gpuMethod = attributionMethods(cfg)
+	assert.Equal(t, "utilization-encdec", gpuMethod)
+
+	cfg.Monitor.Mode = config.MonitorModeNodeOnly
+	cpuMethod, gpuMethod = attributionMethods(cfg)
+	assert.Equal(t, "none", cpuMethod, "node-only mode attributes no power")
+	assert.Equal(t, "none", gpuMethod)
+}
+
+func TestGPUBackendVendors(t *testing.T) {
+	for _, backend := range config.GPUBackends {
+		vendor, ok := gpuBackendVendors[backend]
+		assert.True(t, ok, "GPU backend %q has no vendor", backend)
+		assert.NotEmpty(t, vendor)
+	}
+}
+
+// experimentalWarnings counts the experimental warnings logged per feature
+func experimentalWarnings(t *testing.T, buf *bytes.Buffer) map[string]int {
+	t.Helper()
+	counts := map[string]int{}
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var entry map[string]any
+		require.NoError(t, json.Unmarshal([]byte(line), &entry))
+		if entry["msg"] != "EXPERIMENTAL: no stability guarantees" {
+			continue
+		}
+		assert.Equal(t, "WARN", entry["level"])
+		feature, _ := entry["feature"].(string)
+		counts[feature]++
+	}
+	return counts
+}
+
+func TestLogExperimentalFeatures(t *testing.T) {
+	t.Run("enabled features warn once each", func(t *testing.T) {
+		cfg := config.DefaultConfig()
+		cfg.Experimental = &config.Experimental{}
+		cfg.Experimental.Platform.Redfish.Enabled = ptr.To(true)
+		cfg.Experimental.GPU.Enabled = ptr.To(true)
+
+		var buf bytes.Buffer
+		logger := slog.New(slog.NewJSONHandler(&buf, nil))
+		logExperimentalFeatures(logger, cfg)
+
+		assert.Equal(t, map[string]int{
+			string(config.ExperimentalRedfishFeature): 1,
+			string(config.ExperimentalGPUFeature):     1,
+		}, experimentalWarnings(t, &buf))
+	})
+
+	t.Run("no experimental features", func(t *testing.T) {
+		var buf bytes.Buffer
+		logger := slog.New(slog.NewJSONHandler(&buf, nil))
+		logExperimentalFeatures(logger, config.DefaultConfig())
+
+		assert.Empty(t, experimentalWarnings(t, &buf))
+	})
+}
+
+func TestCreateGPUMeters_StartFailure(t *testing.T) {
+	gpu.ClearRegistry()
+	t.Cleanup(gpu.ClearRegistry)
+	gpu.Register(gpu.VendorNVIDIA, func(*slog.Logger) (gpu.GPUPowerMeter, error) {
+		return &stubGPUMeter{
+			devices: []gpu.GPUDevice{{Index: 0, Name: "NVIDIA A100", Vendor: gpu.VendorNVIDIA}},
+			initErr: errors.New("nvml: driver not loaded"),
+		}, nil
+	})
+
+	newConfig := func(required bool) *config.Config {
+		cfg := config.DefaultConfig()
+		cfg.Experimental = &config.Experimental{}
+		cfg.Experimental.GPU.Enabled = ptr.To(true)
+		cfg.Experimental.GPU.Required = required
+		return cfg
+	}
+
+	t.Run("required", func(t *testing.T) {
+		meters, err := createGPUMeters(slog.New(slog.DiscardHandler), newConfig(true))
+		assert.Error(t, err)
+		assert.Empty(t, meters)
+	})
+
+	t.Run("optional", func(t *testing.T) {
+		var buf bytes.Buffer
+		logger := slog.New(slog.NewJSONHandler(&buf, nil))
+
+		meters, err := createGPUMeters(logger, newConfig(false))
+		require.NoError(t, err)
+		assert.Empty(t, meters)
+		assert.Contains(t, buf.String(), `"level":"WARN","msg":"GPU monitoring enabled but no GPU meter started`)
+	})
+}
+
+func TestCreateGPUMeters_NoDevices(t *testing.T) {
+	gpu.ClearRegistry()
+	t.Cleanup(gpu.ClearRegistry)
+	gpu.Register(gpu.VendorNVIDIA, func(*slog.Logger) (gpu.GPUPowerMeter, error) {
+		return &stubGPUMeter{}, nil
+	})
+
+	newConfig := func(required bool) *config.Config {
+		cfg := config.DefaultConfig()
+		cfg.Experimental = &config.Experimental{}
+		cfg.Experimental.GPU.Enabled = ptr.To(true)
+		cfg.Experimental.GPU.Required = required
+		return cfg
+	}
+
+	t.Run("required", func(t *testing.T) {
+		meters, err := createGPUMeters(slog.New(slog.DiscardHandler), newConfig(true))
+		assert.ErrorContains(t, err, "no GPU meter started with any devices")
+		assert.Empty(t, meters)
+	})
+
+	t.Run("optional", func(t *testing.T) {
+		var buf bytes.Buffer
+		logger := slog.New(slog.NewJSONHandler(&buf, nil))
+
+		meters, err := createGPUMeters(logger, newConfig(false))
+		require.NoError(t, err)
+		assert.Empty(t, meters, "GPU attribution must be disabled")
+		assert.Contains(t, buf.String(), `"level":"WARN","msg":"GPU vendor initialized but discovered no devices"`)
+		assert.Contains(t, buf.String(), `"level":"WARN","msg":"GPU monitoring enabled but no GPU meter started`)
+	})
+}
+
+func TestCreateGPUMeters_Fake(t *testing.T) {
+	newConfig := func(pids ...int) *config.Config {
+		cfg := config.DefaultConfig()
+		cfg.Dev.FakeGpuMeter.Enabled = ptr.To(true)
+		cfg.Dev.FakeGpuMeter.Devices = 2
+		cfg.Dev.FakeGpuMeter.Power = 30
+		cfg.Dev.FakeGpuMeter.PIDs = pids
+		return cfg
+	}
+
+	t.Run("configured pids", func(t *testing.T) {
+		meters, err := createGPUMeters(slog.New(slog.DiscardHandler), newConfig(100, 200))
+		require.NoError(t, err)
+		require.Len(t, meters, 1, "fake GPU meter must not require the experimental GPU feature")
+		assert.Len(t, meters[0].Devices(), 2)
+
+		power, err := meters[0].GetProcessPower()
+		require.NoError(t, err)
+		assert.Equal(t, map[uint32]float64{100: 30, 200: 30}, power)
+	})
+
+	t.Run("defaults to kepler's pid", func(t *testing.T) {
+		meters, err := createGPUMeters(slog.New(slog.DiscardHandler), newConfig())
+		require.NoError(t, err)
+		require.Len(t, meters, 1)
+
+		power, err := meters[0].GetProcessPower()
+		require.NoError(t, err)
+		assert.Equal(t, map[uint32]float64{uint32(os.Getpid()): 60}, power)
+	})
+}
+
+func TestCreateCPUMeter_PowerSupplyFallback(t *testing.T) {
+	// a sysfs with a battery but without RAPL zones
+	sysfs := t.TempDir()
+	bat := filepath.Join(sysfs, "class", "power_supply", "BAT0")
+	require.NoError(t, os.MkdirAll(bat, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(bat, "type"), []byte("Battery\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(bat, "power_now"), []byte("8000000\n"), 0o644))
+
+	newConfig := func(enabled bool) *config.Config {
+		cfg := config.DefaultConfig()
+		cfg.Host.SysFS = sysfs
+		cfg.Experimental = &config.Experimental{}
+		cfg.Experimental.PowerSupply.Enabled = ptr.To(enabled)
+		return cfg
+	}
+
+	t.Run("enabled", func(t *testing.T) {
+		meter, err := createCPUMeter(slog.New(slog.DiscardHandler), newConfig(true))
+		require.NoError(t, err)
+		assert.Equal(t, "power-supply", meter.Name())
+
+		zone, err := meter.PrimaryEnergyZone()
+		require.NoError(t, err)
+		power, err := zone.Power()
+		require.NoError(t, err)
+		assert.Equal(t, 8*device.Watt, power)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		meter, err := createCPUMeter(slog.New(slog.DiscardHandler), newConfig(false))
+		require.NoError(t, err)
+		assert.Equal(t, "rapl", meter.Name())
+	})
+}
+
+func TestNodeName(t *testing.T) {
+	t.Setenv("KEPLER_TEST_NODE_NAME", "env-node")
+
+	cfg := config.DefaultConfig()
+	cfg.Kube.Node = "kube-node"
+	assert.Equal(t, "kube-node", nodeName(slog.New(slog.DiscardHandler), cfg))
+
+	cfg.Kube.NodeNameSources = []string{config.NodeNameSourceEnv, config.NodeNameSourceConfig}
+	cfg.Kube.NodeNameEnv = "KEPLER_TEST_NODE_NAME"
+	assert.Equal(t, "env-node", nodeName(slog.New(slog.DiscardHandler), cfg))
+
+	cfg.Kube.Node = ""
+	cfg.Kube.NodeNameEnv = "KEPLER_TEST_UNSET_NODE_NAME"
+	assert.Empty(t, nodeName(slog.New(slog.DiscardHandler), cfg), "unresolvable node name falls back to kube.nodeName")
+}
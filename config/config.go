@@ -6,8 +6,14 @@ package config
 import (
 	"fmt"
 	"io"
+	"maps"
 	"net"
+	"net/url"
 	"os"
+	"path"
+	"reflect"
+	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -34,13 +40,86 @@ const (
 	// StdoutFeature represents the stdout exporter feature
 	StdoutFeature Feature = "stdout"
 
+	// PushgatewayFeature represents the Prometheus Pushgateway exporter feature
+	PushgatewayFeature Feature = "pushgateway"
+
+	// StatsdFeature represents the statsd exporter feature
+	StatsdFeature Feature = "statsd"
+
 	// PprofFeature represents the pprof debug endpoints feature
 	PprofFeature Feature = "pprof"
 
+	// DebugConfigFeature represents the /debug/config inspect endpoint feature
+	DebugConfigFeature Feature = "debug-config"
+
+	// DebugZonesFeature represents the /debug/zones raw zone readings endpoint feature
+	DebugZonesFeature Feature = "debug-zones"
+
 	// ExperimentalGPUFeature represents GPU power monitoring (experimental)
 	ExperimentalGPUFeature Feature = "gpu"
+
+	// ExperimentalPowerSupplyFeature represents the battery/UPS power fallback
+	ExperimentalPowerSupplyFeature Feature = "power-supply"
+)
+
+// Power sources that can be summed into the node total power
+const (
+	TotalPowerSourceCPU      = "cpu"
+	TotalPowerSourceGPU      = "gpu"
+	TotalPowerSourcePlatform = "platform"
+)
+
+// PID modes that can be selected with monitor.pidMode
+const (
+	PIDModeHost       = "host"
+	PIDModeNamespaced = "namespaced"
+)
+
+// Container ID formats that can be selected with monitor.containerIDFormat
+const (
+	ContainerIDFormatFull  = "full"
+	ContainerIDFormatShort = "short"
+)
+
+// Monitor modes that can be selected with monitor.mode
+const (
+	MonitorModeFull     = "full"
+	MonitorModeNodeOnly = "node-only"
 )
 
+// Energy bases that can be selected with monitor.processEnergyBasis
+const (
+	ProcessEnergyBasisActive = "active"
+	ProcessEnergyBasisTotal  = "total"
+)
+
+// Formats that can be selected with exporter.stdout.format
+const (
+	StdoutFormatText = "text"
+	StdoutFormatJSON = "json"
+)
+
+// GPU backends that can be selected with experimental.gpu.type
+const (
+	GPUTypeAuto  = "auto"
+	GPUTypeNVML  = "nvml"
+	GPUTypeROCM  = "rocm"
+	GPUTypeIntel = "intel"
+)
+
+// sources the node name can be resolved from
+const (
+	NodeNameSourceConfig   = "config"   // kube.nodeName or --kube.node-name
+	NodeNameSourceEnv      = "env"      // the environment variable kube.nodeNameEnv
+	NodeNameSourceHostname = "hostname" // the hostname of the host
+)
+
+// NodeNameSources lists the valid node name sources
+var NodeNameSources = []string{NodeNameSourceConfig, NodeNameSourceEnv, NodeNameSourceHostname}
+
+// GPUBackends lists the GPU backends that can be used in a fallback chain
+var GPUBackends = []string{GPUTypeNVML, GPUTypeROCM, GPUTypeIntel}
+
 // Config represents the complete application configuration
 type (
 	Log struct {
@@ -55,6 +134,28 @@ type (
 	// Rapl configuration
 	Rapl struct {
 		Zones []string `yaml:"zones"`
+
+		// PerCore enables per-core CPU power where the driver exposes per-core
+		// energy counters (e.g. amd_energy). Disabled by default due to the
+		// cardinality of the resulting metrics.
+		PerCore *bool `yaml:"perCore"`
+
+		// ZoneNameMap aliases zone names in exported metric labels. Keys are
+		// zone names (e.g. package -> cpu-package) or zone names suffixed
+		// with the zone index (e.g. package-0 -> cpu-socket-0), which take
+		// precedence. Zone identity used internally is unchanged.
+		ZoneNameMap map[string]string `yaml:"zoneNameMap"`
+
+		// PerSocket reports the zones of each socket (e.g. the package zones
+		// of a dual-socket node) separately instead of aggregating zones
+		// sharing a name. The zones share their name and are told apart by
+		// the path label, which is then added to the workload metrics too.
+		PerSocket *bool `yaml:"perSocket"`
+
+		// Path overrides the directory RAPL zones are discovered in, which
+		// defaults to <host.sysfs>/class/powercap. Useful when only the
+		// powercap subtree is mounted into the container.
+		Path string `yaml:"path"`
 	}
 
 	// ChipPairingRule defines how voltage and current sensors should be paired for a specific chip.
@@ -84,16 +185,36 @@ type (
 		ChipRules []ChipPairingRule `yaml:"chipRules,omitempty"`
 	}
 
+	// PowerSupply configuration (Set in Experimental)
+	PowerSupply struct {
+		// Enabled reads the node power from the batteries and UPSes under
+		// /sys/class/power_supply when RAPL is not available, e.g. on edge
+		// devices and laptops
+		Enabled *bool `yaml:"enabled"`
+	}
+
 	// Development mode settings; disabled by default
 	Dev struct {
 		FakeCpuMeter struct {
 			Enabled *bool    `yaml:"enabled"`
 			Zones   []string `yaml:"zones"`
 		} `yaml:"fake-cpu-meter"`
+		FakeGpuMeter struct {
+			Enabled *bool   `yaml:"enabled"`
+			Devices int     `yaml:"devices"` // number of fake devices
+			Power   float64 `yaml:"power"`   // power of each device in watts
+			// PIDs reported as using the GPUs; empty reports Kepler's own PID
+			PIDs []int `yaml:"pids"`
+		} `yaml:"fake-gpu-meter"`
 	}
 	Web struct {
 		Config          string   `yaml:"configFile"`
 		ListenAddresses []string `yaml:"listenAddresses"`
+		// AuthTokenFile holds a static bearer token required to scrape the
+		// metrics endpoint; empty disables token authentication
+		AuthTokenFile string `yaml:"authTokenFile" redact:"true"`
+		// MetricsPath is the path the Prometheus metrics are served at
+		MetricsPath string `yaml:"metricsPath"`
 	}
 
 	Monitor struct {
@@ -108,34 +229,200 @@ type (
 
 		// MinTerminatedEnergyThreshold sets the minimum energy consumption threshold for terminated workloads
 		// Only terminated workloads with energy consumption above this threshold will be tracked
-		// Value is in joules (e.g., 10 = 10 joules)
-		// TODO: Add support for parsing energy units like "10J", "500mJ", "2kJ"
-		MinTerminatedEnergyThreshold int64 `yaml:"minTerminatedEnergyThreshold"`
+		// Value is in joules (e.g., 10 = 10 joules) or has a unit suffix
+		// (e.g., "500mJ", "2kJ")
+		MinTerminatedEnergyThreshold EnergyQuantity `yaml:"minTerminatedEnergyThreshold"`
+
+		// TotalPowerSources lists the power sources summed into the node total power
+		// metric. Supported values: cpu, gpu, platform. Since platform power already
+		// includes CPU and GPU, it takes precedence over them when available, unless
+		// GPUInPlatformTotal is false.
+		TotalPowerSources []string `yaml:"totalPowerSources"`
+
+		// GPUInPlatformTotal declares whether the platform (e.g. Redfish BMC)
+		// power includes the GPU power. If so (the default), the measured GPU
+		// power is subtracted from the platform power in the node other power and
+		// not added to it in the node total power, so GPUs are not counted twice.
+		GPUInPlatformTotal *bool `yaml:"gpuInPlatformTotal"`
+
+		// ProcessScanInterval controls how often /proc is enumerated to discover
+		// the set of running processes. In between scans, power is still computed
+		// every monitor interval against the previously discovered processes.
+		// 0 scans on every monitor refresh.
+		ProcessScanInterval time.Duration `yaml:"processScanInterval"`
+
+		// PIDMode selects the PID used as process metric label and identity:
+		// host uses the PID in the host PID namespace, namespaced uses the PID
+		// inside the container's PID namespace when available.
+		PIDMode string `yaml:"pidMode"`
+
+		// Mode selects what is computed on each refresh: full attributes node
+		// power to processes, containers, VMs and pods; node-only skips workload
+		// attribution and only computes node zone and node GPU power.
+		Mode string `yaml:"mode"`
+
+		// MaxBackoff caps the interval between collections while consecutive
+		// collections fail. The interval doubles from Interval on each failure
+		// and resets on success. 0 disables the backoff.
+		MaxBackoff time.Duration `yaml:"maxBackoff"`
+
+		// CollectionTimeout bounds the runtime of a single collection. A
+		// collection still reading the node power after it is abandoned and
+		// the previous snapshot is kept; a later one is reported as timed out,
+		// its node power is published right away and its workloads once
+		// complete. 0 disables the timeout.
+		CollectionTimeout time.Duration `yaml:"collectionTimeout"`
+
+		// ResolveUsernames resolves the UIDs of the user metrics level to user
+		// names using the user database visible to Kepler
+		ResolveUsernames *bool `yaml:"resolveUsernames"`
+
+		// ProcessEnergyBasis selects the node power attributed to processes:
+		// active only attributes active power, total also distributes idle
+		// power by the same CPU time ratio.
+		ProcessEnergyBasis string `yaml:"processEnergyBasis"`
+
+		// KernelAsProcess reports the kernel pseudo-process (PID 0), which some
+		// accounting lumps kernel and idle time into, as a process named
+		// kernel. When false its CPU time is folded into node idle power.
+		KernelAsProcess *bool `yaml:"kernelAsProcess"`
+
+		// WarmupInterval withholds the power metrics after startup so that
+		// the transients of the first readings are not exported. Build and
+		// configuration info is exported right away. 0 disables the warm-up.
+		WarmupInterval time.Duration `yaml:"warmupInterval"`
+
+		// ContainerIDFormat selects the canonical container ID used as
+		// container identity and metric label: full uses the 64 character ID,
+		// short the 12 character ID shown by docker, crictl and podman.
+		ContainerIDFormat string `yaml:"containerIDFormat"`
+
+		// MaxProcessAge stops reporting processes individually once they are
+		// older than this age; their energy is only reported in an aggregate
+		// per zone. 0 reports all processes.
+		MaxProcessAge time.Duration `yaml:"maxProcessAge"`
+
+		// PowerSmoothingWindow smooths node zone power by spreading the energy
+		// of each interval evenly over this window. The integral of the
+		// smoothed power still equals the measured energy; energy counters are
+		// not affected. 0 disables smoothing.
+		PowerSmoothingWindow time.Duration `yaml:"powerSmoothingWindow"`
 	}
 
 	// Exporter configuration
 	StdoutExporter struct {
 		Enabled *bool `yaml:"enabled"`
+
+		// Format selects how snapshots are written: "text" writes the node
+		// zones as a table, "json" writes each snapshot as a single line
+		// JSON object for log ingestion pipelines
+		Format string `yaml:"format"`
 	}
 
 	PrometheusExporter struct {
 		Enabled         *bool    `yaml:"enabled"`
 		DebugCollectors []string `yaml:"debugCollectors"`
 		MetricsLevel    Level    `yaml:"metricsLevel"`
+
+		// EmitKwh additionally exports the energy counters in kilowatt-hours
+		// for billing integrations
+		EmitKwh *bool `yaml:"emitKwh"`
+
+		// UseStaleMarkers withholds the power metrics while the latest
+		// snapshot is older than monitor.interval plus monitor.staleness so
+		// that Prometheus marks the series stale instead of ingesting old
+		// values. Scrapes export the latest snapshot without refreshing it.
+		UseStaleMarkers *bool `yaml:"useStaleMarkers"`
+
+		// GPUPowerPrecision rounds the GPU power gauges to the given number
+		// of decimal places at export time. Energy counters and attribution
+		// are unaffected. Unset exports full precision.
+		GPUPowerPrecision *int `yaml:"gpuPowerPrecision"`
+
+		// IncludeZonePath adds the zone path as a label to the process,
+		// container, vm and pod CPU metrics for debugging
+		IncludeZonePath *bool `yaml:"includeZonePath"`
+
+		// DisabledMetrics lists names or glob patterns (e.g.
+		// "kepler_*_idle_watts") of metrics that are never exported
+		DisabledMetrics []string `yaml:"disabledMetrics"`
+
+		// PlatformInfo exports kepler_node_platform_info with the cloud
+		// provider and instance type of the node
+		PlatformInfo PlatformInfo `yaml:"platformInfo"`
+
+		// MaxTotalSeries caps the estimated number of series exported per
+		// scrape. Above it, the process, container, vm and pod levels are
+		// dropped in that order. 0 disables the cap.
+		MaxTotalSeries int `yaml:"maxTotalSeries"`
+	}
+
+	// PlatformInfo configures the cloud metadata exported with
+	// kepler_node_platform_info. The cloud and instance type are detected
+	// from the read-only DMI data in sysfs; configured values override the
+	// detected ones, e.g. where DMI does not expose the instance type.
+	PlatformInfo struct {
+		Enabled      *bool  `yaml:"enabled"`
+		Cloud        string `yaml:"cloud"`
+		InstanceType string `yaml:"instanceType"`
+	}
+
+	// PushgatewayExporter periodically pushes the metrics to a Prometheus
+	// Pushgateway, for nodes that are too short-lived to be scraped
+	PushgatewayExporter struct {
+		// URL of the Pushgateway, which may hold basic auth credentials;
+		// empty disables the exporter
+		URL string `yaml:"url" redact:"true"`
+
+		// Interval between pushes
+		Interval time.Duration `yaml:"interval"`
+	}
+
+	// StatsdExporter periodically sends the node, process and container
+	// power as statsd gauges over UDP, with tags in the DogStatsD format
+	StatsdExporter struct {
+		// Address (host:port) of the statsd server; empty disables the exporter
+		Address string `yaml:"address"`
+
+		// Interval between sends
+		Interval time.Duration `yaml:"interval"`
+
+		// Tags added to every metric
+		Tags map[string]string `yaml:"tags"`
+
+		// MaxPacketSize is the maximum size in bytes of a UDP packet
+		MaxPacketSize int `yaml:"maxPacketSize"`
 	}
 
 	Exporter struct {
-		Stdout     StdoutExporter     `yaml:"stdout"`
-		Prometheus PrometheusExporter `yaml:"prometheus"`
+		Stdout      StdoutExporter      `yaml:"stdout"`
+		Prometheus  PrometheusExporter  `yaml:"prometheus"`
+		Pushgateway PushgatewayExporter `yaml:"pushgateway"`
+		Statsd      StatsdExporter      `yaml:"statsd"`
 	}
 
 	// Debug configuration
 	PprofDebug struct {
 		Enabled *bool `yaml:"enabled"`
+		// ListenAddress serves the pprof endpoints on a dedicated server;
+		// empty serves them on the web listen addresses
+		ListenAddress string `yaml:"listenAddress"`
+	}
+
+	// ConfigDebug exposes the effective (redacted) configuration over HTTP
+	ConfigDebug struct {
+		Enabled *bool `yaml:"enabled"`
+	}
+
+	// ZonesDebug exposes the raw zone energy readings over HTTP
+	ZonesDebug struct {
+		Enabled *bool `yaml:"enabled"`
 	}
 
 	Debug struct {
-		Pprof PprofDebug `yaml:"pprof"`
+		Pprof  PprofDebug  `yaml:"pprof"`
+		Config ConfigDebug `yaml:"config"`
+		Zones  ZonesDebug  `yaml:"zones"`
 	}
 
 	PodInformer struct {
@@ -145,9 +432,15 @@ type (
 
 	Kube struct {
 		Enabled     *bool       `yaml:"enabled"`
-		Config      string      `yaml:"config"`
+		Config      string      `yaml:"config" redact:"true"`
 		Node        string      `yaml:"nodeName"`
 		PodInformer PodInformer `yaml:"podInformer"`
+
+		// NodeNameSources is the order in which the node name labelling the
+		// metrics, and used by the pod informer and Redfish, is resolved
+		NodeNameSources []string `yaml:"nodeNameSources"`
+		// NodeNameEnv is the environment variable read by the env source
+		NodeNameEnv string `yaml:"nodeNameEnv"`
 	}
 
 	// Platform contains settings for platform power monitoring
@@ -159,8 +452,12 @@ type (
 	Redfish struct {
 		Enabled     *bool         `yaml:"enabled"`
 		NodeName    string        `yaml:"nodeName"`
-		ConfigFile  string        `yaml:"configFile"`
-		HTTPTimeout time.Duration `yaml:"httpTimeout"` // HTTP client timeout for BMC requests
+		ConfigFile  string        `yaml:"configFile" redact:"true"` // holds BMC credentials
+		HTTPTimeout time.Duration `yaml:"httpTimeout"`              // HTTP client timeout for BMC requests
+
+		// MaxConcurrentRequests caps the number of in-flight HTTP requests to
+		// the BMC to avoid overwhelming slow or fragile BMCs
+		MaxConcurrentRequests int `yaml:"maxConcurrentRequests"`
 	}
 
 	// ExperimentalGPU contains GPU power monitoring settings
@@ -173,13 +470,73 @@ type (
 		// observe true idle (e.g. GPUs always under load).
 		// 0 means auto-detect (track minimum power when no compute processes are running).
 		IdlePower float64 `yaml:"idlePower"`
+
+		// ExcludeProcesses lists regular expressions matched against the comm and
+		// executable path of GPU processes. Matching processes (e.g. Xorg,
+		// nvidia-persistenced) are excluded from per-process GPU attribution so
+		// that the remaining processes split the active power.
+		ExcludeProcesses []string `yaml:"excludeProcesses"`
+
+		// MaxDevices caps the number of GPUs monitored to the first N discovered
+		// devices (by index) to bound monitoring overhead on large nodes.
+		// 0 means all discovered devices are monitored.
+		MaxDevices int `yaml:"maxDevices"`
+
+		// DeviceUUIDs restricts GPU monitoring to the devices with these
+		// UUIDs, resolved against the discovered devices when the meters
+		// start. Unlike device indices, UUIDs are stable across reboots.
+		// Mutually exclusive with MaxDevices; empty monitors all devices.
+		DeviceUUIDs []string `yaml:"deviceUUIDs"`
+
+		// Type selects the GPU backends to probe as a comma separated list in
+		// order of preference (e.g. "nvml"). Backends are tried in order and
+		// the first one that initializes with at least one device is used.
+		// Empty or "auto" probes all available backends.
+		Type string `yaml:"type"`
+
+		// EncDecWeight weights the encoder and decoder (NVENC/NVDEC)
+		// utilization of a process relative to its SM utilization when
+		// attributing GPU power, so media workloads are not under-attributed.
+		// 0 attributes power by SM utilization only.
+		EncDecWeight float64 `yaml:"encDecWeight"`
+
+		// Required aborts startup when GPU monitoring is enabled but no GPU
+		// meter starts. When false, Kepler continues with CPU-only monitoring
+		// and reports kepler_gpu_meter_up 0.
+		Required bool `yaml:"required"`
+
+		// ReliabilityMetrics exports the fan speed and performance state of
+		// GPU devices that report them. Disabled by default to keep the
+		// default set of device readings lean.
+		ReliabilityMetrics bool `yaml:"reliabilityMetrics"`
+
+		// ComputeOnly restricts GPU power attribution, and the idle detection
+		// of devices, to processes running compute work (e.g. CUDA). Set it to
+		// false to include graphics processes (e.g. OpenGL, Vulkan) on
+		// workstation-class nodes. Defaults to true when unset.
+		ComputeOnly *bool `yaml:"computeOnly"`
+
+		// ProcessEnergyBasis selects the GPU power attributed to processes,
+		// like monitor.processEnergyBasis does for CPU power: "active" (the
+		// default when unset) splits only the power above the idle baseline,
+		// which is reported at node level only; "total" splits the total
+		// device power including the idle baseline.
+		ProcessEnergyBasis string `yaml:"processEnergyBasis"`
+
+		// EnergyJitterTolerance is the largest backward move, in Joules, of a
+		// GPU energy counter treated as sampling jitter: the energy of the
+		// device is held instead of following the counter backwards, so the
+		// delta is zero. Larger backward moves are handled as counter resets.
+		// 0 disables the tolerance.
+		EnergyJitterTolerance float64 `yaml:"energyJitterTolerance"`
 	}
 
 	// Experimental contains experimental features (no stability guarantees)
 	Experimental struct {
-		Platform Platform        `yaml:"platform"`
-		Hwmon    Hwmon           `yaml:"hwmon"`
-		GPU      ExperimentalGPU `yaml:"gpu"`
+		Platform    Platform        `yaml:"platform"`
+		Hwmon       Hwmon           `yaml:"hwmon"`
+		GPU         ExperimentalGPU `yaml:"gpu"`
+		PowerSupply PowerSupply     `yaml:"powerSupply"`
 	}
 
 	Config struct {
@@ -197,6 +554,11 @@ type (
 		// use omitempty to suppress printing (String) Experimental configuration
 		// when it is empty
 		Experimental *Experimental `yaml:"experimental,omitempty"`
+
+		// hiddenExperimental holds the experimental section hidden by sanitize
+		// because no feature is enabled, so that a feature enabled later by a
+		// flag keeps the settings of the config file
+		hiddenExperimental *Experimental
 	}
 )
 
@@ -254,35 +616,80 @@ const (
 	HostSysFSFlag  = "host.sysfs"
 	HostProcFSFlag = "host.procfs"
 
-	MonitorIntervalFlag      = "monitor.interval"
-	MonitorStaleness         = "monitor.staleness" // not a flag
-	MonitorMaxTerminatedFlag = "monitor.max-terminated"
+	MonitorIntervalFlag         = "monitor.interval"
+	MonitorStalenessFlag        = "monitor.staleness"
+	MonitorMaxTerminatedFlag    = "monitor.max-terminated"
+	MonitorTotalPowerSources    = "monitor.total-power-sources"    // not a flag
+	MonitorGPUInPlatformTotal   = "monitor.gpu-in-platform-total"  // not a flag
+	MonitorProcessScanInterval  = "monitor.process-scan-interval"  // not a flag
+	MonitorPIDMode              = "monitor.pid-mode"               // not a flag
+	MonitorMode                 = "monitor.mode"                   // not a flag
+	MonitorMaxBackoff           = "monitor.max-backoff"            // not a flag
+	MonitorResolveUsernames     = "monitor.resolve-usernames"      // not a flag
+	MonitorCollectionTimeout    = "monitor.collection-timeout"     // not a flag
+	MonitorProcessEnergyBasis   = "monitor.process-energy-basis"   // not a flag
+	MonitorKernelAsProcess      = "monitor.kernel-as-process"      // not a flag
+	MonitorWarmupInterval       = "monitor.warmup-interval"        // not a flag
+	MonitorContainerIDFormat    = "monitor.container-id-format"    // not a flag
+	MonitorMaxProcessAge        = "monitor.max-process-age"        // not a flag
+	MonitorPowerSmoothingWindow = "monitor.power-smoothing-window" // not a flag
 
 	// RAPL
-	RaplZones = "rapl.zones" // not a flag
+	RaplZonesFlag   = "rapl.zones"
+	RaplZoneNameMap = "rapl.zone-name-map" // not a flag
+	RaplPerCoreFlag = "rapl.per-core"
+	RaplPerSocket   = "rapl.per-socket" // not a flag
+	RaplPath        = "rapl.path"       // not a flag
 
-	pprofEnabledFlag = "debug.pprof"
+	pprofEnabledFlag       = "debug.pprof"
+	pprofListenAddressFlag = "debug.pprof.listen-address"
+	debugConfigEnabledFlag = "debug.config"
+	debugZonesEnabledFlag  = "debug.zones"
 
 	WebConfigFlag        = "web.config-file"
 	WebListenAddressFlag = "web.listen-address"
+	WebAuthTokenFileFlag = "web.auth-token-file"
+	WebTelemetryPathFlag = "web.telemetry-path"
 
 	// Exporters
 	ExporterStdoutEnabledFlag = "exporter.stdout"
+	ExporterStdoutFormatFlag  = "exporter.stdout.format"
 
 	ExporterPrometheusEnabledFlag = "exporter.prometheus"
 	// NOTE: not a flag
 	ExporterPrometheusDebugCollectors = "exporter.prometheus.debug-collectors"
 	ExporterPrometheusMetricsFlag     = "metrics"
+	ExporterPrometheusEmitKwh         = "exporter.prometheus.emit-kwh"            // not a flag
+	ExporterPrometheusUseStaleMarkers = "exporter.prometheus.use-stale-markers"   // not a flag
+	ExporterPrometheusGPUPrecision    = "exporter.prometheus.gpu-power-precision" // not a flag
+	ExporterPrometheusIncludeZonePath = "exporter.prometheus.include-zone-path"   // not a flag
+	ExporterPrometheusDisabledMetrics = "exporter.prometheus.disabled-metrics"    // not a flag
+	ExporterPrometheusMaxTotalSeries  = "exporter.prometheus.max-total-series"    // not a flag
+
+	ExporterPrometheusPlatformInfoEnabled      = "exporter.prometheus.platform-info.enabled"       // not a flag
+	ExporterPrometheusPlatformInfoCloud        = "exporter.prometheus.platform-info.cloud"         // not a flag
+	ExporterPrometheusPlatformInfoInstanceType = "exporter.prometheus.platform-info.instance-type" // not a flag
+
+	ExporterPushgatewayURL      = "exporter.pushgateway.url"      // not a flag
+	ExporterPushgatewayInterval = "exporter.pushgateway.interval" // not a flag
+
+	ExporterStatsdAddress       = "exporter.statsd.address"         // not a flag
+	ExporterStatsdInterval      = "exporter.statsd.interval"        // not a flag
+	ExporterStatsdTags          = "exporter.statsd.tags"            // not a flag
+	ExporterStatsdMaxPacketSize = "exporter.statsd.max-packet-size" // not a flag
 
 	// kubernetes flags
-	KubernetesFlag   = "kube.enable"
-	KubeConfigFlag   = "kube.config"
-	KubeNodeNameFlag = "kube.node-name"
+	KubernetesFlag      = "kube.enable"
+	KubeConfigFlag      = "kube.config"
+	KubeNodeNameFlag    = "kube.node-name"
+	KubeNodeNameSources = "kube.node-name-sources" // not a flag
+	KubeNodeNameEnv     = "kube.node-name-env"     // not a flag
 
 	// Experimental Platform flags
 	ExperimentalPlatformRedfishEnabledFlag  = "experimental.platform.redfish.enabled"
 	ExperimentalPlatformRedfishNodeNameFlag = "experimental.platform.redfish.node-name"
 	ExperimentalPlatformRedfishConfigFlag   = "experimental.platform.redfish.config-file"
+	ExperimentalPlatformRedfishMaxRequests  = "experimental.platform.redfish.max-concurrent-requests" // not a flag
 
 	// Experimental Hwmon flags
 	ExperimentalHwmonEnabledFlag = "experimental.hwmon.enabled"
@@ -307,7 +714,9 @@ func DefaultConfig() *Config {
 			ProcFS: "/proc",
 		},
 		Rapl: Rapl{
-			Zones: []string{},
+			Zones:     []string{},
+			PerCore:   ptr.To(false),
+			PerSocket: ptr.To(false),
 		},
 		Monitor: Monitor{
 			Interval:  5 * time.Second,
@@ -315,24 +724,54 @@ func DefaultConfig() *Config {
 
 			MaxTerminated:                500,
 			MinTerminatedEnergyThreshold: 10, // 10 Joules
+			TotalPowerSources:            []string{TotalPowerSourceCPU, TotalPowerSourceGPU, TotalPowerSourcePlatform},
+			GPUInPlatformTotal:           ptr.To(true),
+			PIDMode:                      PIDModeHost,
+			Mode:                         MonitorModeFull,
+			MaxBackoff:                   5 * time.Minute,
+			ResolveUsernames:             ptr.To(false),
+			ProcessEnergyBasis:           ProcessEnergyBasisActive,
+			KernelAsProcess:              ptr.To(true),
+			ContainerIDFormat:            ContainerIDFormatFull,
 		},
 		Exporter: Exporter{
 			Stdout: StdoutExporter{
 				Enabled: ptr.To(false),
+				Format:  StdoutFormatText,
 			},
 			Prometheus: PrometheusExporter{
 				Enabled:         ptr.To(true),
 				DebugCollectors: []string{"go"},
 				MetricsLevel:    MetricsLevelAll,
+				EmitKwh:         ptr.To(false),
+				UseStaleMarkers: ptr.To(false),
+				IncludeZonePath: ptr.To(false),
+				PlatformInfo: PlatformInfo{
+					Enabled: ptr.To(false),
+				},
+			},
+			Pushgateway: PushgatewayExporter{
+				Interval: 30 * time.Second,
+			},
+			Statsd: StatsdExporter{
+				Interval:      10 * time.Second,
+				MaxPacketSize: 1432,
 			},
 		},
 		Debug: Debug{
 			Pprof: PprofDebug{
 				Enabled: ptr.To(false),
 			},
+			Config: ConfigDebug{
+				Enabled: ptr.To(false),
+			},
+			Zones: ZonesDebug{
+				Enabled: ptr.To(false),
+			},
 		},
 		Web: Web{
 			ListenAddresses: []string{":28282"},
+			MetricsPath:     "/metrics",
 		},
 		Kube: Kube{
 			Enabled: ptr.To(false),
@@ -340,6 +779,8 @@ func DefaultConfig() *Config {
 				Mode:         "kubelet",
 				PollInterval: 15 * time.Second,
 			},
+			NodeNameSources: []string{NodeNameSourceConfig, NodeNameSourceHostname},
+			NodeNameEnv:     "NODE_NAME",
 		},
 
 		// NOTE: Experimental config will be nil by default and only allocated when needed
@@ -348,6 +789,9 @@ func DefaultConfig() *Config {
 	}
 
 	cfg.Dev.FakeCpuMeter.Enabled = ptr.To(false)
+	cfg.Dev.FakeGpuMeter.Enabled = ptr.To(false)
+	cfg.Dev.FakeGpuMeter.Devices = 1
+	cfg.Dev.FakeGpuMeter.Power = 50
 	return cfg
 }
 
@@ -422,20 +866,35 @@ func RegisterFlags(app *kingpin.Application) ConfigUpdaterFn {
 	// monitor
 	monitorInterval := app.Flag(MonitorIntervalFlag,
 		"Interval for monitoring resources (processes, container, vm, etc...); 0 to disable").Default("5s").Duration()
+	monitorStaleness := app.Flag(MonitorStalenessFlag,
+		"Duration after which the monitor data is considered stale and recomputed on request").Default("500ms").Duration()
 	monitorMaxTerminated := app.Flag(MonitorMaxTerminatedFlag,
 		"Maximum number of terminated workloads to track; 0 to disable, -1 for unlimited").Default("500").Int()
 
+	// rapl
+	raplZones := app.Flag(RaplZonesFlag,
+		"RAPL zones to monitor, comma separated or repeated (e.g. package,dram); empty monitors all zones").Strings()
+	raplPerCore := app.Flag(RaplPerCoreFlag, "Enable per-core CPU power where per-core energy counters are available").Default("false").Bool()
+
 	enablePprof := app.Flag(pprofEnabledFlag, "Enable pprof debug endpoints").Default("false").Bool()
+	pprofListenAddress := app.Flag(pprofListenAddressFlag,
+		"Listen address of a dedicated pprof server (e.g. localhost:6060); empty serves pprof on the web listen addresses").Default("").String()
+	enableDebugConfig := app.Flag(debugConfigEnabledFlag, "Enable /debug/config endpoint exposing the effective (redacted) configuration").Default("false").Bool()
+	enableDebugZones := app.Flag(debugZonesEnabledFlag, "Enable /debug/zones endpoint exposing raw CPU and GPU zone energy readings").Default("false").Bool()
 	webConfig := app.Flag(WebConfigFlag, "Web config file path").Default("").String()
 	webListenAddresses := app.Flag(WebListenAddressFlag, "Web server listen addresses").Default(":28282").Strings()
+	webAuthTokenFile := app.Flag(WebAuthTokenFileFlag,
+		"Path to a file holding the bearer token required to scrape the metrics endpoint").Default("").String()
+	webTelemetryPath := app.Flag(WebTelemetryPathFlag, "Path under which to expose metrics").Default("/metrics").String()
 
 	// exporters
 	stdoutExporterEnabled := app.Flag(ExporterStdoutEnabledFlag, "Enable stdout exporter").Default("false").Bool()
+	stdoutExporterFormat := app.Flag(ExporterStdoutFormatFlag, "Stdout exporter format: text or json").Default(StdoutFormatText).Enum(StdoutFormatText, StdoutFormatJSON)
 
 	prometheusExporterEnabled := app.Flag(ExporterPrometheusEnabledFlag, "Enable Prometheus exporter").Default("true").Bool()
 
 	metricsLevel := MetricsLevelAll
-	app.Flag(ExporterPrometheusMetricsFlag, "Metrics levels to export (node,process,container,vm,pod)").SetValue(NewMetricsLevelValue(&metricsLevel))
+	app.Flag(ExporterPrometheusMetricsFlag, "Metrics levels to export (node,process,container,vm,pod,user)").SetValue(NewMetricsLevelValue(&metricsLevel))
 
 	kubernetes := app.Flag(KubernetesFlag, "Monitor kubernetes").Default("false").Bool()
 	kubeconfig := app.Flag(KubeConfigFlag, "Path to a kubeconfig. Only required if out-of-cluster.").ExistingFile()
@@ -476,13 +935,34 @@ func RegisterFlags(app *kingpin.Application) ConfigUpdaterFn {
 		if flagsSet[MonitorIntervalFlag] {
 			cfg.Monitor.Interval = *monitorInterval
 		}
+		if flagsSet[MonitorStalenessFlag] {
+			cfg.Monitor.Staleness = *monitorStaleness
+		}
 		if flagsSet[MonitorMaxTerminatedFlag] {
 			cfg.Monitor.MaxTerminated = *monitorMaxTerminated
 		}
 
+		if flagsSet[RaplZonesFlag] {
+			cfg.Rapl.Zones = splitList(*raplZones)
+		}
+		if flagsSet[RaplPerCoreFlag] {
+			cfg.Rapl.PerCore = raplPerCore
+		}
+
 		if flagsSet[pprofEnabledFlag] {
 			cfg.Debug.Pprof.Enabled = enablePprof
 		}
+		if flagsSet[pprofListenAddressFlag] {
+			cfg.Debug.Pprof.ListenAddress = *pprofListenAddress
+		}
+
+		if flagsSet[debugConfigEnabledFlag] {
+			cfg.Debug.Config.Enabled = enableDebugConfig
+		}
+
+		if flagsSet[debugZonesEnabledFlag] {
+			cfg.Debug.Zones.Enabled = enableDebugZones
+		}
 
 		if flagsSet[WebConfigFlag] {
 			cfg.Web.Config = *webConfig
@@ -492,10 +972,22 @@ func RegisterFlags(app *kingpin.Application) ConfigUpdaterFn {
 			cfg.Web.ListenAddresses = *webListenAddresses
 		}
 
+		if flagsSet[WebAuthTokenFileFlag] {
+			cfg.Web.AuthTokenFile = *webAuthTokenFile
+		}
+
+		if flagsSet[WebTelemetryPathFlag] {
+			cfg.Web.MetricsPath = *webTelemetryPath
+		}
+
 		if flagsSet[ExporterStdoutEnabledFlag] {
 			cfg.Exporter.Stdout.Enabled = stdoutExporterEnabled
 		}
 
+		if flagsSet[ExporterStdoutFormatFlag] {
+			cfg.Exporter.Stdout.Format = *stdoutExporterFormat
+		}
+
 		if flagsSet[ExporterPrometheusEnabledFlag] {
 			cfg.Exporter.Prometheus.Enabled = prometheusExporterEnabled
 		}
@@ -534,6 +1026,20 @@ func RegisterFlags(app *kingpin.Application) ConfigUpdaterFn {
 	}
 }
 
+// splitList splits comma separated flag values into a list, dropping empty
+// entries so that an empty value yields an empty list
+func splitList(values []string) []string {
+	list := []string{}
+	for _, v := range values {
+		for _, item := range strings.Split(v, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				list = append(list, item)
+			}
+		}
+	}
+	return list
+}
+
 // applyRedfishConfig applies Redfish configuration flags and resolves NodeName if enabled
 func applyRedfishConfig(cfg *Config, flagsSet map[string]bool, enabled *bool, nodeName *string, cfgFile *string) error {
 	// Early exit if no redfish flags are set and config file does not have experimental
@@ -544,13 +1050,7 @@ func applyRedfishConfig(cfg *Config, flagsSet map[string]bool, enabled *bool, no
 
 	// At this point, either redfish flags are set or config file has experimental section
 	// so ensure experimental section exists
-	if cfg.Experimental == nil {
-		cfg.Experimental = &Experimental{
-			Platform: Platform{
-				Redfish: defaultRedfishConfig(),
-			},
-		}
-	}
+	cfg.ensureExperimental()
 
 	redfish := &cfg.Experimental.Platform.Redfish
 
@@ -563,7 +1063,7 @@ func applyRedfishConfig(cfg *Config, flagsSet map[string]bool, enabled *bool, no
 	}
 
 	// Resolve NodeName since Redfish is enabled
-	return resolveRedfishNodeName(redfish, cfg.Kube.Node)
+	return resolveRedfishNodeName(redfish, cfg.Kube)
 }
 
 // hasRedfishFlags returns true if any experimental flags are set
@@ -573,10 +1073,26 @@ func hasRedfishFlags(flagsSet map[string]bool) bool {
 		flagsSet[ExperimentalPlatformRedfishConfigFlag]
 }
 
+// maxGPUPowerPrecision is the finest GPU power precision, in decimal places;
+// GPU power is tracked in microwatts
+const maxGPUPowerPrecision = 6
+
+// gpuPowerPrecisionString returns the GPU power precision, or full when unset
+func gpuPowerPrecisionString(p *int) string {
+	if p == nil {
+		return "full"
+	}
+	return strconv.Itoa(*p)
+}
+
+// defaultRedfishMaxConcurrentRequests is the default cap on in-flight BMC requests
+const defaultRedfishMaxConcurrentRequests = 2
+
 func defaultRedfishConfig() Redfish {
 	return Redfish{
-		Enabled:     ptr.To(false),
-		HTTPTimeout: 5 * time.Second,
+		Enabled:               ptr.To(false),
+		HTTPTimeout:           5 * time.Second,
+		MaxConcurrentRequests: defaultRedfishMaxConcurrentRequests,
 	}
 }
 
@@ -596,8 +1112,8 @@ func applyRedfishFlags(redfish *Redfish, flagsSet map[string]bool, enabled *bool
 }
 
 // resolveRedfishNodeName resolves the Redfish node name
-func resolveRedfishNodeName(redfish *Redfish, kubeNodeName string) error {
-	resolvedNodeName, err := resolveNodeName(redfish.NodeName, kubeNodeName)
+func resolveRedfishNodeName(redfish *Redfish, kube Kube) error {
+	resolvedNodeName, err := resolveNodeName(redfish.NodeName, kube)
 	if err != nil {
 		return fmt.Errorf("failed to resolve Redfish node name: %w", err)
 	}
@@ -614,11 +1130,7 @@ func applyHwmonConfig(cfg *Config, flagsSet map[string]bool, enabled *bool, zone
 
 	// At this point, either hwmon flags are set or config file has experimental section
 	// so ensure experimental section exists
-	if cfg.Experimental == nil {
-		cfg.Experimental = &Experimental{
-			Hwmon: defaultHwmonConfig(),
-		}
-	}
+	cfg.ensureExperimental()
 
 	hwmon := &cfg.Experimental.Hwmon
 
@@ -661,9 +1173,7 @@ func applyGPUConfig(cfg *Config, flagsSet map[string]bool, enabled *bool, idlePo
 	}
 
 	// Initialize experimental section if needed
-	if cfg.Experimental == nil {
-		cfg.Experimental = &Experimental{}
-	}
+	cfg.ensureExperimental()
 
 	if flagsSet[ExperimentalGPUEnabledFlag] {
 		cfg.Experimental.GPU.Enabled = enabled
@@ -676,27 +1186,47 @@ func applyGPUConfig(cfg *Config, flagsSet map[string]bool, enabled *bool, idlePo
 }
 
 // resolveNodeName resolves the node name using the following precedence:
-// 1. CLI flag / config.yaml (--experimental.platform.redfish.node-name)
-// 2. Kubernetes node name
-// 3. Hostname fallback
-func resolveNodeName(redfishNodeName, kubeNodeName string) (string, error) {
-	// Priority 1: CLI flag
-	if strings.TrimSpace(redfishNodeName) != "" {
-		return strings.TrimSpace(redfishNodeName), nil
+//  1. explicitNodeName, e.g. CLI flag / config.yaml (--experimental.platform.redfish.node-name)
+//  2. the first of kube.NodeNameSources yielding a name; config and hostname
+//     when no sources are set
+func resolveNodeName(explicitNodeName string, kube Kube) (string, error) {
+	if name := strings.TrimSpace(explicitNodeName); name != "" {
+		return name, nil
 	}
 
-	// Priority 2: Kubernetes node name
-	if strings.TrimSpace(kubeNodeName) != "" {
-		return strings.TrimSpace(kubeNodeName), nil
+	sources := kube.NodeNameSources
+	if len(sources) == 0 {
+		sources = []string{NodeNameSourceConfig, NodeNameSourceHostname}
 	}
 
-	// Priority 3: Hostname fallback
-	hostname, err := os.Hostname()
-	if err != nil {
-		return "", fmt.Errorf("failed to determine node name: %w", err)
+	for _, source := range sources {
+		switch source {
+		case NodeNameSourceConfig:
+			if name := strings.TrimSpace(kube.Node); name != "" {
+				return name, nil
+			}
+		case NodeNameSourceEnv:
+			if kube.NodeNameEnv == "" {
+				continue
+			}
+			if name := strings.TrimSpace(os.Getenv(kube.NodeNameEnv)); name != "" {
+				return name, nil
+			}
+		case NodeNameSourceHostname:
+			hostname, err := os.Hostname()
+			if err != nil {
+				return "", fmt.Errorf("failed to determine node name: %w", err)
+			}
+			return hostname, nil
+		}
 	}
 
-	return hostname, nil
+	return "", fmt.Errorf("failed to determine node name from sources %v", sources)
+}
+
+// ResolveNodeName returns the node name resolved from kube.nodeNameSources
+func (c *Config) ResolveNodeName() (string, error) {
+	return resolveNodeName("", c.Kube)
 }
 
 // IsFeatureEnabled returns true if the specified feature is enabled
@@ -716,19 +1246,51 @@ func (c *Config) IsFeatureEnabled(feature Feature) bool {
 		return ptr.Deref(c.Exporter.Prometheus.Enabled, false)
 	case StdoutFeature:
 		return ptr.Deref(c.Exporter.Stdout.Enabled, false)
+	case PushgatewayFeature:
+		return c.Exporter.Pushgateway.URL != ""
+	case StatsdFeature:
+		return c.Exporter.Statsd.Address != ""
 	case PprofFeature:
 		return ptr.Deref(c.Debug.Pprof.Enabled, false)
+	case DebugConfigFeature:
+		return ptr.Deref(c.Debug.Config.Enabled, false)
+	case DebugZonesFeature:
+		return ptr.Deref(c.Debug.Zones.Enabled, false)
 	case ExperimentalGPUFeature:
 		if c.Experimental == nil {
 			return false
 		}
 		return ptr.Deref(c.Experimental.GPU.Enabled, false)
+	case ExperimentalPowerSupplyFeature:
+		if c.Experimental == nil {
+			return false
+		}
+		return ptr.Deref(c.Experimental.PowerSupply.Enabled, false)
 	default:
 		return false
 	}
 }
 
 // experimentalFeatureEnabled returns true if any experimental feature is enabled
+// ExperimentalFeatures lists the features that have no stability guarantees
+var ExperimentalFeatures = []Feature{
+	ExperimentalRedfishFeature,
+	ExperimentalHwmonFeature,
+	ExperimentalGPUFeature,
+	ExperimentalPowerSupplyFeature,
+}
+
+// EnabledExperimentalFeatures returns the experimental features that are enabled
+func (c *Config) EnabledExperimentalFeatures() []Feature {
+	var enabled []Feature
+	for _, f := range ExperimentalFeatures {
+		if c.IsFeatureEnabled(f) {
+			enabled = append(enabled, f)
+		}
+	}
+	return enabled
+}
+
 func (c *Config) experimentalFeatureEnabled() bool {
 	if c.Experimental == nil {
 		return false
@@ -748,6 +1310,11 @@ func (c *Config) experimentalFeatureEnabled() bool {
 	if ptr.Deref(c.Experimental.GPU.Enabled, false) {
 		return true
 	}
+
+	// Check if the power supply fallback is enabled
+	if ptr.Deref(c.Experimental.PowerSupply.Enabled, false) {
+		return true
+	}
 	// Add checks for future experimental features here
 
 	return false
@@ -756,9 +1323,18 @@ func (c *Config) experimentalFeatureEnabled() bool {
 func (c *Config) sanitize() {
 	c.Log.Level = strings.TrimSpace(c.Log.Level)
 	c.Log.Format = strings.TrimSpace(c.Log.Format)
+	c.Exporter.Stdout.Format = strings.TrimSpace(c.Exporter.Stdout.Format)
 	c.Host.SysFS = strings.TrimSpace(c.Host.SysFS)
 	c.Host.ProcFS = strings.TrimSpace(c.Host.ProcFS)
 	c.Web.Config = strings.TrimSpace(c.Web.Config)
+	c.Web.AuthTokenFile = strings.TrimSpace(c.Web.AuthTokenFile)
+	c.Web.MetricsPath = strings.TrimSpace(c.Web.MetricsPath)
+	c.Debug.Pprof.ListenAddress = strings.TrimSpace(c.Debug.Pprof.ListenAddress)
+	c.Exporter.Pushgateway.URL = strings.TrimSpace(c.Exporter.Pushgateway.URL)
+	c.Exporter.Statsd.Address = strings.TrimSpace(c.Exporter.Statsd.Address)
+	c.Exporter.Prometheus.PlatformInfo.Cloud = strings.TrimSpace(c.Exporter.Prometheus.PlatformInfo.Cloud)
+	c.Exporter.Prometheus.PlatformInfo.InstanceType = strings.TrimSpace(c.Exporter.Prometheus.PlatformInfo.InstanceType)
+	c.Rapl.Path = strings.TrimSpace(c.Rapl.Path)
 	for i := range c.Web.ListenAddresses {
 		c.Web.ListenAddresses[i] = strings.TrimSpace(c.Web.ListenAddresses[i])
 	}
@@ -766,18 +1342,43 @@ func (c *Config) sanitize() {
 	for i := range c.Rapl.Zones {
 		c.Rapl.Zones[i] = strings.TrimSpace(c.Rapl.Zones[i])
 	}
+	if len(c.Rapl.ZoneNameMap) > 0 {
+		zoneNameMap := make(map[string]string, len(c.Rapl.ZoneNameMap))
+		for from, to := range c.Rapl.ZoneNameMap {
+			zoneNameMap[strings.TrimSpace(from)] = strings.TrimSpace(to)
+		}
+		c.Rapl.ZoneNameMap = zoneNameMap
+	}
 
 	for i := range c.Exporter.Prometheus.DebugCollectors {
 		c.Exporter.Prometheus.DebugCollectors[i] = strings.TrimSpace(c.Exporter.Prometheus.DebugCollectors[i])
 	}
+	for i := range c.Exporter.Prometheus.DisabledMetrics {
+		c.Exporter.Prometheus.DisabledMetrics[i] = strings.TrimSpace(c.Exporter.Prometheus.DisabledMetrics[i])
+	}
 	c.Kube.Config = strings.TrimSpace(c.Kube.Config)
+	for i := range c.Kube.NodeNameSources {
+		c.Kube.NodeNameSources[i] = strings.TrimSpace(c.Kube.NodeNameSources[i])
+	}
+	c.Kube.NodeNameEnv = strings.TrimSpace(c.Kube.NodeNameEnv)
+	c.Monitor.PIDMode = strings.TrimSpace(c.Monitor.PIDMode)
+	c.Monitor.Mode = strings.TrimSpace(c.Monitor.Mode)
+	c.Monitor.ProcessEnergyBasis = strings.TrimSpace(c.Monitor.ProcessEnergyBasis)
+	c.Monitor.ContainerIDFormat = strings.TrimSpace(c.Monitor.ContainerIDFormat)
 
 	if c.Experimental == nil {
 		return
 	}
 
+	c.Experimental.GPU.Type = strings.TrimSpace(c.Experimental.GPU.Type)
+	c.Experimental.GPU.ProcessEnergyBasis = strings.TrimSpace(c.Experimental.GPU.ProcessEnergyBasis)
+
 	c.Experimental.Platform.Redfish.NodeName = strings.TrimSpace(c.Experimental.Platform.Redfish.NodeName)
 	c.Experimental.Platform.Redfish.ConfigFile = strings.TrimSpace(c.Experimental.Platform.Redfish.ConfigFile)
+	// experimental section from config file may omit maxConcurrentRequests
+	if c.Experimental.Platform.Redfish.MaxConcurrentRequests == 0 {
+		c.Experimental.Platform.Redfish.MaxConcurrentRequests = defaultRedfishMaxConcurrentRequests
+	}
 
 	// Sanitize Hwmon fields
 	for i := range c.Experimental.Hwmon.Zones {
@@ -786,10 +1387,52 @@ func (c *Config) sanitize() {
 
 	// If all experimental features are disabled, set experimental to nil to hide it
 	if !c.experimentalFeatureEnabled() {
+		c.hiddenExperimental = c.Experimental
 		c.Experimental = nil
 	}
 }
 
+// ensureExperimental allocates the experimental section, restoring the
+// section hidden by sanitize if any. Features the hidden section does not
+// configure get their defaults.
+func (c *Config) ensureExperimental() {
+	if c.Experimental != nil {
+		return
+	}
+
+	hidden := c.hiddenExperimental
+	c.hiddenExperimental = nil
+	if hidden == nil {
+		c.Experimental = &Experimental{
+			Platform: Platform{Redfish: defaultRedfishConfig()},
+			Hwmon:    defaultHwmonConfig(),
+		}
+		return
+	}
+
+	if hidden.Platform.Redfish.Enabled == nil {
+		hidden.Platform.Redfish = defaultRedfishConfig()
+	}
+	if hidden.Hwmon.Enabled == nil {
+		hidden.Hwmon = defaultHwmonConfig()
+	}
+	c.Experimental = hidden
+}
+
+// Backends returns the GPU backends to probe in order of preference, or nil
+// when all available backends should be probed
+func (g ExperimentalGPU) Backends() []string {
+	if g.Type == "" || g.Type == GPUTypeAuto {
+		return nil
+	}
+
+	var backends []string
+	for backend := range strings.SplitSeq(g.Type, ",") {
+		backends = append(backends, strings.TrimSpace(backend))
+	}
+	return backends
+}
+
 // Validate checks for configuration errors
 func (c *Config) Validate(skips ...SkipValidation) error {
 	validationSkipped := make(map[SkipValidation]bool, len(skips))
@@ -820,6 +1463,14 @@ func (c *Config) Validate(skips ...SkipValidation) error {
 			errs = append(errs, fmt.Sprintf("invalid log format: %s", c.Log.Format))
 		}
 	}
+	{ // stdout exporter format
+		switch c.Exporter.Stdout.Format {
+		case StdoutFormatText, StdoutFormatJSON:
+		default:
+			errs = append(errs, fmt.Sprintf("invalid %s %q: must be %s or %s",
+				ExporterStdoutFormatFlag, c.Exporter.Stdout.Format, StdoutFormatText, StdoutFormatJSON))
+		}
+	}
 
 	{ // Validate host settings
 		if _, skip := validationSkipped[SkipHostValidation]; !skip {
@@ -838,6 +1489,21 @@ func (c *Config) Validate(skips ...SkipValidation) error {
 			}
 		}
 	}
+	{ // Web auth token file
+		if c.Web.AuthTokenFile != "" {
+			if _, err := ReadAuthToken(c.Web.AuthTokenFile); err != nil {
+				errs = append(errs, fmt.Sprintf("invalid web auth token file. path: %q: %s", c.Web.AuthTokenFile, err.Error()))
+			}
+		}
+	}
+	{ // Web metrics path
+		switch {
+		case !strings.HasPrefix(c.Web.MetricsPath, "/"):
+			errs = append(errs, fmt.Sprintf("invalid web metrics path %q: must start with /", c.Web.MetricsPath))
+		case c.Web.MetricsPath == "/":
+			errs = append(errs, "invalid web metrics path \"/\": reserved for the landing page")
+		}
+	}
 	{ // Web listen addresses
 		if len(c.Web.ListenAddresses) == 0 {
 			errs = append(errs, "at least one web listen address must be specified")
@@ -852,6 +1518,35 @@ func (c *Config) Validate(skips ...SkipValidation) error {
 			}
 		}
 	}
+	{ // pprof listen address
+		if addr := c.Debug.Pprof.ListenAddress; addr != "" {
+			if err := validateListenAddress(addr); err != nil {
+				errs = append(errs, fmt.Sprintf("invalid debug pprof listen address %q: %s", addr, err.Error()))
+			}
+		}
+	}
+	{ // RAPL path
+		if _, skip := validationSkipped[SkipHostValidation]; !skip && c.Rapl.Path != "" && c.raplInUse() {
+			if err := canReadDir(c.Rapl.Path); err != nil {
+				errs = append(errs, fmt.Sprintf("invalid %s: %s: %s", RaplPath, c.Rapl.Path, err.Error()))
+			}
+		}
+	}
+	{ // RAPL zone name map
+		targets := make(map[string]string, len(c.Rapl.ZoneNameMap))
+		for _, from := range slices.Sorted(maps.Keys(c.Rapl.ZoneNameMap)) {
+			to := c.Rapl.ZoneNameMap[from]
+			if from == "" || to == "" {
+				errs = append(errs, fmt.Sprintf("invalid rapl zone name map entry %q: %q; zone names can't be empty", from, to))
+				continue
+			}
+			if other, dup := targets[to]; dup {
+				errs = append(errs, fmt.Sprintf("invalid rapl zone name map: zones %q and %q are both mapped to %q", other, from, to))
+				continue
+			}
+			targets[to] = from
+		}
+	}
 	{ // Monitor
 		if c.Monitor.Interval < 0 {
 			errs = append(errs, fmt.Sprintf("invalid monitor interval: %s can't be negative", c.Monitor.Interval))
@@ -860,8 +1555,104 @@ func (c *Config) Validate(skips ...SkipValidation) error {
 			errs = append(errs, fmt.Sprintf("invalid monitor staleness: %s can't be negative", c.Monitor.Staleness))
 		}
 
+		if c.Monitor.ProcessScanInterval < 0 {
+			errs = append(errs, fmt.Sprintf("invalid monitor process scan interval: %s can't be negative", c.Monitor.ProcessScanInterval))
+		}
+
 		if c.Monitor.MinTerminatedEnergyThreshold < 0 {
-			errs = append(errs, fmt.Sprintf("invalid monitor min terminated energy threshold: %d can't be negative", c.Monitor.MinTerminatedEnergyThreshold))
+			errs = append(errs, fmt.Sprintf("invalid monitor min terminated energy threshold: %s can't be negative", c.Monitor.MinTerminatedEnergyThreshold))
+		}
+
+		for _, src := range c.Monitor.TotalPowerSources {
+			switch src {
+			case TotalPowerSourceCPU, TotalPowerSourceGPU, TotalPowerSourcePlatform:
+			default:
+				errs = append(errs, fmt.Sprintf("invalid monitor total power source: %q; must be one of cpu, gpu, platform", src))
+			}
+		}
+
+		switch c.Monitor.PIDMode {
+		case PIDModeHost, PIDModeNamespaced:
+		default:
+			errs = append(errs, fmt.Sprintf("invalid monitor pid mode: %q; must be one of host, namespaced", c.Monitor.PIDMode))
+		}
+
+		switch c.Monitor.ContainerIDFormat {
+		case ContainerIDFormatFull, ContainerIDFormatShort:
+		default:
+			errs = append(errs, fmt.Sprintf("invalid monitor container id format: %q; must be one of full, short", c.Monitor.ContainerIDFormat))
+		}
+
+		switch c.Monitor.Mode {
+		case MonitorModeFull, MonitorModeNodeOnly:
+		default:
+			errs = append(errs, fmt.Sprintf("invalid monitor mode: %q; must be one of full, node-only", c.Monitor.Mode))
+		}
+
+		if c.Monitor.MaxBackoff < 0 {
+			errs = append(errs, fmt.Sprintf("invalid monitor max backoff: %s can't be negative", c.Monitor.MaxBackoff))
+		}
+		if c.Monitor.CollectionTimeout < 0 {
+			errs = append(errs, fmt.Sprintf("invalid monitor collection timeout: %s can't be negative", c.Monitor.CollectionTimeout))
+		}
+		if c.Monitor.WarmupInterval < 0 {
+			errs = append(errs, fmt.Sprintf("invalid monitor warmup interval: %s can't be negative", c.Monitor.WarmupInterval))
+		}
+
+		if c.Monitor.MaxProcessAge < 0 {
+			errs = append(errs, fmt.Sprintf("invalid monitor max process age: %s can't be negative", c.Monitor.MaxProcessAge))
+		}
+		if c.Monitor.PowerSmoothingWindow < 0 {
+			errs = append(errs, fmt.Sprintf("invalid monitor power smoothing window: %s can't be negative", c.Monitor.PowerSmoothingWindow))
+		}
+
+		switch c.Monitor.ProcessEnergyBasis {
+		case ProcessEnergyBasisActive, ProcessEnergyBasisTotal:
+		default:
+			errs = append(errs, fmt.Sprintf("invalid monitor process energy basis: %q; must be one of active, total", c.Monitor.ProcessEnergyBasis))
+		}
+	}
+	{ // Prometheus exporter
+		if p := c.Exporter.Prometheus.GPUPowerPrecision; p != nil && (*p < 0 || *p > maxGPUPowerPrecision) {
+			errs = append(errs, fmt.Sprintf("invalid %s: %d must be between 0 and %d", ExporterPrometheusGPUPrecision, *p, maxGPUPowerPrecision))
+		}
+		for _, pattern := range c.Exporter.Prometheus.DisabledMetrics {
+			if pattern == "" {
+				errs = append(errs, fmt.Sprintf("invalid %s: pattern can't be empty", ExporterPrometheusDisabledMetrics))
+			} else if _, err := path.Match(pattern, ""); err != nil {
+				errs = append(errs, fmt.Sprintf("invalid %s pattern %q: %s", ExporterPrometheusDisabledMetrics, pattern, err))
+			}
+		}
+		if c.Exporter.Prometheus.MaxTotalSeries < 0 {
+			errs = append(errs, fmt.Sprintf("invalid %s: %d can't be negative", ExporterPrometheusMaxTotalSeries, c.Exporter.Prometheus.MaxTotalSeries))
+		}
+	}
+	{ // Pushgateway exporter
+		if pg := c.Exporter.Pushgateway; pg.URL != "" {
+			if u, err := url.Parse(pg.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				errs = append(errs, fmt.Sprintf("invalid %s: %q must be an http(s) URL", ExporterPushgatewayURL, pg.URL))
+			}
+			if pg.Interval <= 0 {
+				errs = append(errs, fmt.Sprintf("invalid %s: %s must be positive", ExporterPushgatewayInterval, pg.Interval))
+			}
+		}
+	}
+	{ // statsd exporter
+		if sd := c.Exporter.Statsd; sd.Address != "" {
+			if _, _, err := net.SplitHostPort(sd.Address); err != nil {
+				errs = append(errs, fmt.Sprintf("invalid %s: %q must be host:port", ExporterStatsdAddress, sd.Address))
+			}
+			if sd.Interval <= 0 {
+				errs = append(errs, fmt.Sprintf("invalid %s: %s must be positive", ExporterStatsdInterval, sd.Interval))
+			}
+			if sd.MaxPacketSize <= 0 {
+				errs = append(errs, fmt.Sprintf("invalid %s: %d must be positive", ExporterStatsdMaxPacketSize, sd.MaxPacketSize))
+			}
+			for k := range sd.Tags {
+				if k == "" || strings.ContainsAny(k, ":,|#") {
+					errs = append(errs, fmt.Sprintf("invalid %s: tag %q must be non-empty and not contain any of ':,|#'", ExporterStatsdTags, k))
+				}
+			}
 		}
 	}
 	{ // Kubernetes
@@ -883,6 +1674,20 @@ func (c *Config) Validate(skips ...SkipValidation) error {
 				errs = append(errs, fmt.Sprintf("invalid kube.podInformer.mode: %q, must be \"kubelet\" or \"apiserver\"", c.Kube.PodInformer.Mode))
 			}
 		}
+
+		seen := make(map[string]bool, len(c.Kube.NodeNameSources))
+		for _, source := range c.Kube.NodeNameSources {
+			if !slices.Contains(NodeNameSources, source) {
+				errs = append(errs, fmt.Sprintf("invalid kube node name source: %q; must be one of %s",
+					source, strings.Join(NodeNameSources, ", ")))
+			} else if seen[source] {
+				errs = append(errs, fmt.Sprintf("duplicate kube node name source: %q", source))
+			}
+			seen[source] = true
+		}
+		if seen[NodeNameSourceEnv] && c.Kube.NodeNameEnv == "" {
+			errs = append(errs, fmt.Sprintf("%s must be set when the %q node name source is used", KubeNodeNameEnv, NodeNameSourceEnv))
+		}
 	}
 	// Experimental Platform validation
 	if experimentalErrs := c.validateExperimentalConfig(validationSkipped); len(experimentalErrs) > 0 {
@@ -913,12 +1718,104 @@ func (c *Config) validateExperimentalConfig(validationSkipped map[SkipValidation
 					errs = append(errs, fmt.Sprintf("unreadable Redfish config file: %s: %s", c.Experimental.Platform.Redfish.ConfigFile, err.Error()))
 				}
 			}
+			if c.Experimental.Platform.Redfish.MaxConcurrentRequests <= 0 {
+				errs = append(errs, fmt.Sprintf("invalid %s: %d must be positive", ExperimentalPlatformRedfishMaxRequests, c.Experimental.Platform.Redfish.MaxConcurrentRequests))
+			}
+		}
+
+		if c.IsFeatureEnabled(ExperimentalGPUFeature) {
+			for _, p := range c.Experimental.GPU.ExcludeProcesses {
+				if _, err := regexp.Compile(p); err != nil {
+					errs = append(errs, fmt.Sprintf("invalid experimental gpu exclude process pattern %q: %s", p, err.Error()))
+				}
+			}
+			if c.Experimental.GPU.EncDecWeight < 0 {
+				errs = append(errs, fmt.Sprintf("invalid experimental gpu encDecWeight: %v can't be negative", c.Experimental.GPU.EncDecWeight))
+			}
+			if c.Experimental.GPU.MaxDevices < 0 {
+				errs = append(errs, fmt.Sprintf("invalid experimental gpu maxDevices: %d can't be negative", c.Experimental.GPU.MaxDevices))
+			}
+			if c.Experimental.GPU.EnergyJitterTolerance < 0 {
+				errs = append(errs, fmt.Sprintf("invalid experimental gpu energyJitterTolerance: %v can't be negative", c.Experimental.GPU.EnergyJitterTolerance))
+			}
+			seenUUIDs := make(map[string]bool, len(c.Experimental.GPU.DeviceUUIDs))
+			for _, uuid := range c.Experimental.GPU.DeviceUUIDs {
+				switch {
+				case strings.TrimSpace(uuid) == "":
+					errs = append(errs, "invalid experimental gpu deviceUUIDs: UUID can't be empty")
+				case seenUUIDs[uuid]:
+					errs = append(errs, fmt.Sprintf("invalid experimental gpu deviceUUIDs: duplicate UUID %q", uuid))
+				}
+				seenUUIDs[uuid] = true
+			}
+			errs = append(errs, c.validateGPUOptionConflicts()...)
+			switch c.Experimental.GPU.ProcessEnergyBasis {
+			case "", ProcessEnergyBasisActive, ProcessEnergyBasisTotal:
+			default:
+				errs = append(errs, fmt.Sprintf("invalid experimental gpu processEnergyBasis %q: must be %s or %s",
+					c.Experimental.GPU.ProcessEnergyBasis, ProcessEnergyBasisActive, ProcessEnergyBasisTotal))
+			}
+			for _, backend := range c.Experimental.GPU.Backends() {
+				if !slices.Contains(GPUBackends, backend) {
+					errs = append(errs, fmt.Sprintf("invalid experimental gpu type %q: unknown backend %q; supported backends: %s",
+						c.Experimental.GPU.Type, backend, strings.Join(GPUBackends, ", ")))
+				}
+			}
 		}
 	}
 
 	return errs
 }
 
+// validateGPUOptionConflicts checks the GPU options that are valid on their
+// own but conflict with each other:
+//   - deviceUUIDs and maxDevices both select the monitored devices
+//   - the fake GPU meter replaces all backends, so a type selecting backends
+//     would be ignored
+//   - encDecWeight, computeOnly, processEnergyBasis, maxDevices and
+//     excludeProcesses are only supported by the nvml backend, so they would
+//     be ignored by a type that does not include it
+func (c *Config) validateGPUOptionConflicts() []string {
+	var errs []string
+	g := c.Experimental.GPU
+
+	if len(g.DeviceUUIDs) > 0 && g.MaxDevices > 0 {
+		errs = append(errs, "invalid experimental gpu deviceUUIDs: can't be set together with maxDevices")
+	}
+
+	backends := g.Backends()
+	if ptr.Deref(c.Dev.FakeGpuMeter.Enabled, false) && len(backends) > 0 {
+		errs = append(errs, fmt.Sprintf("invalid experimental gpu type %q: can't be set together with dev.fake-gpu-meter, which replaces all GPU backends", g.Type))
+	}
+
+	if len(backends) == 0 || slices.Contains(backends, GPUTypeNVML) {
+		return errs
+	}
+	nvmlOnly := []struct {
+		name string
+		set  bool
+	}{
+		{"encDecWeight", g.EncDecWeight > 0},
+		{"computeOnly", !ptr.Deref(g.ComputeOnly, true)},
+		{"processEnergyBasis", g.ProcessEnergyBasis == ProcessEnergyBasisTotal},
+		{"maxDevices", g.MaxDevices > 0},
+		{"excludeProcesses", len(g.ExcludeProcesses) > 0},
+	}
+	for _, opt := range nvmlOnly {
+		if opt.set {
+			errs = append(errs, fmt.Sprintf("invalid experimental gpu %s: only supported by the %s backend, which type %q does not include",
+				opt.name, GPUTypeNVML, g.Type))
+		}
+	}
+	return errs
+}
+
+// raplInUse returns true if the CPU power meter reads RAPL, i.e. neither the
+// fake meter nor hwmon replace it
+func (c *Config) raplInUse() bool {
+	return !ptr.Deref(c.Dev.FakeCpuMeter.Enabled, false) && !c.IsFeatureEnabled(ExperimentalHwmonFeature)
+}
+
 func canReadDir(path string) error {
 	f, err := os.Open(path)
 	if err != nil {
@@ -938,6 +1835,20 @@ func canReadDir(path string) error {
 	return nil
 }
 
+// ReadAuthToken reads the bearer token held by path, ignoring surrounding
+// whitespace; an empty token is an error
+func ReadAuthToken(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", fmt.Errorf("token file is empty")
+	}
+	return token, nil
+}
+
 func canReadFile(path string) error {
 	f, err := os.Open(path)
 	if err != nil {
@@ -998,6 +1909,73 @@ func (c *Config) String() string {
 	return c.manualString()
 }
 
+// zoneNameMapString renders the zone name map as sorted from=to pairs
+func zoneNameMapString(m map[string]string) string {
+	pairs := make([]string, 0, len(m))
+	for _, from := range slices.Sorted(maps.Keys(m)) {
+		pairs = append(pairs, from+"="+m[from])
+	}
+	return strings.Join(pairs, ", ")
+}
+
+// statsdTagsString formats statsd tags sorted by key as key:value pairs
+func statsdTagsString(tags map[string]string) string {
+	pairs := make([]string, 0, len(tags))
+	for _, k := range slices.Sorted(maps.Keys(tags)) {
+		pairs = append(pairs, k+":"+tags[k])
+	}
+	return strings.Join(pairs, ", ")
+}
+
+// redactedValue replaces the value of fields tagged with `redact:"true"`
+const redactedValue = "<redacted>"
+
+// Redacted returns a copy of the config with every field tagged
+// `redact:"true"` masked, making it safe to expose (e.g. over /debug/config).
+// New secret fields only need the tag to be covered.
+func (c *Config) Redacted() *Config {
+	redacted := *c
+	redactFields(reflect.ValueOf(&redacted).Elem())
+	return &redacted
+}
+
+// redactFields masks tagged fields of the struct v in place; nested struct
+// pointers are copied before being modified so the source config is untouched
+func redactFields(v reflect.Value) {
+	t := v.Type()
+	for i := range v.NumField() {
+		field := v.Field(i)
+		if !field.CanSet() {
+			continue
+		}
+
+		if t.Field(i).Tag.Get("redact") == "true" {
+			switch {
+			case field.IsZero():
+				// nothing to hide
+			case field.Kind() == reflect.String:
+				field.SetString(redactedValue)
+			default:
+				field.Set(reflect.Zero(field.Type()))
+			}
+			continue
+		}
+
+		switch field.Kind() {
+		case reflect.Struct:
+			redactFields(field)
+		case reflect.Pointer:
+			if field.IsNil() || field.Elem().Kind() != reflect.Struct {
+				continue
+			}
+			cp := reflect.New(field.Elem().Type())
+			cp.Elem().Set(field.Elem())
+			redactFields(cp.Elem())
+			field.Set(cp)
+		}
+	}
+}
+
 func (c *Config) manualString() string {
 	cfgs := []struct {
 		Name  string
@@ -1008,15 +1986,54 @@ func (c *Config) manualString() string {
 		{HostSysFSFlag, c.Host.SysFS},
 		{HostProcFSFlag, c.Host.ProcFS},
 		{MonitorIntervalFlag, c.Monitor.Interval.String()},
-		{MonitorStaleness, c.Monitor.Staleness.String()},
+		{MonitorStalenessFlag, c.Monitor.Staleness.String()},
 		{MonitorMaxTerminatedFlag, fmt.Sprintf("%d", c.Monitor.MaxTerminated)},
-		{RaplZones, strings.Join(c.Rapl.Zones, ", ")},
+		{MonitorTotalPowerSources, strings.Join(c.Monitor.TotalPowerSources, ", ")},
+		{MonitorGPUInPlatformTotal, fmt.Sprintf("%v", ptr.Deref(c.Monitor.GPUInPlatformTotal, true))},
+		{MonitorProcessScanInterval, c.Monitor.ProcessScanInterval.String()},
+		{MonitorPIDMode, c.Monitor.PIDMode},
+		{MonitorMode, c.Monitor.Mode},
+		{MonitorMaxBackoff, c.Monitor.MaxBackoff.String()},
+		{MonitorCollectionTimeout, c.Monitor.CollectionTimeout.String()},
+		{MonitorResolveUsernames, fmt.Sprintf("%v", ptr.Deref(c.Monitor.ResolveUsernames, false))},
+		{MonitorProcessEnergyBasis, c.Monitor.ProcessEnergyBasis},
+		{MonitorKernelAsProcess, fmt.Sprintf("%v", ptr.Deref(c.Monitor.KernelAsProcess, true))},
+		{MonitorWarmupInterval, c.Monitor.WarmupInterval.String()},
+		{MonitorContainerIDFormat, c.Monitor.ContainerIDFormat},
+		{MonitorMaxProcessAge, c.Monitor.MaxProcessAge.String()},
+		{MonitorPowerSmoothingWindow, c.Monitor.PowerSmoothingWindow.String()},
+		{RaplZonesFlag, strings.Join(c.Rapl.Zones, ", ")},
+		{RaplPerCoreFlag, fmt.Sprintf("%v", ptr.Deref(c.Rapl.PerCore, false))},
+		{RaplPerSocket, fmt.Sprintf("%v", ptr.Deref(c.Rapl.PerSocket, false))},
+		{RaplPath, c.Rapl.Path},
+		{RaplZoneNameMap, zoneNameMapString(c.Rapl.ZoneNameMap)},
 		{ExporterStdoutEnabledFlag, fmt.Sprintf("%v", c.Exporter.Stdout.Enabled)},
+		{ExporterStdoutFormatFlag, c.Exporter.Stdout.Format},
 		{ExporterPrometheusEnabledFlag, fmt.Sprintf("%v", c.Exporter.Prometheus.Enabled)},
 		{ExporterPrometheusDebugCollectors, strings.Join(c.Exporter.Prometheus.DebugCollectors, ", ")},
 		{ExporterPrometheusMetricsFlag, c.Exporter.Prometheus.MetricsLevel.String()},
+		{ExporterPrometheusEmitKwh, fmt.Sprintf("%v", ptr.Deref(c.Exporter.Prometheus.EmitKwh, false))},
+		{ExporterPrometheusUseStaleMarkers, fmt.Sprintf("%v", ptr.Deref(c.Exporter.Prometheus.UseStaleMarkers, false))},
+		{ExporterPrometheusGPUPrecision, gpuPowerPrecisionString(c.Exporter.Prometheus.GPUPowerPrecision)},
+		{ExporterPrometheusIncludeZonePath, fmt.Sprintf("%v", ptr.Deref(c.Exporter.Prometheus.IncludeZonePath, false))},
+		{ExporterPrometheusDisabledMetrics, strings.Join(c.Exporter.Prometheus.DisabledMetrics, ", ")},
+		{ExporterPrometheusMaxTotalSeries, fmt.Sprintf("%d", c.Exporter.Prometheus.MaxTotalSeries)},
+		{ExporterPrometheusPlatformInfoEnabled, fmt.Sprintf("%v", ptr.Deref(c.Exporter.Prometheus.PlatformInfo.Enabled, false))},
+		{ExporterPrometheusPlatformInfoCloud, c.Exporter.Prometheus.PlatformInfo.Cloud},
+		{ExporterPrometheusPlatformInfoInstanceType, c.Exporter.Prometheus.PlatformInfo.InstanceType},
+		{ExporterPushgatewayURL, c.Exporter.Pushgateway.URL},
+		{ExporterPushgatewayInterval, c.Exporter.Pushgateway.Interval.String()},
+		{ExporterStatsdAddress, c.Exporter.Statsd.Address},
+		{ExporterStatsdInterval, c.Exporter.Statsd.Interval.String()},
+		{ExporterStatsdTags, statsdTagsString(c.Exporter.Statsd.Tags)},
+		{ExporterStatsdMaxPacketSize, fmt.Sprintf("%d", c.Exporter.Statsd.MaxPacketSize)},
 		{pprofEnabledFlag, fmt.Sprintf("%v", c.Debug.Pprof.Enabled)},
+		{pprofListenAddressFlag, c.Debug.Pprof.ListenAddress},
+		{debugConfigEnabledFlag, fmt.Sprintf("%v", ptr.Deref(c.Debug.Config.Enabled, false))},
+		{debugZonesEnabledFlag, fmt.Sprintf("%v", ptr.Deref(c.Debug.Zones.Enabled, false))},
 		{KubeConfigFlag, fmt.Sprintf("%v", c.Kube.Config)},
+		{KubeNodeNameSources, strings.Join(c.Kube.NodeNameSources, ", ")},
+		{KubeNodeNameEnv, c.Kube.NodeNameEnv},
 	}
 	sb := strings.Builder{}
 
@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// EnergyQuantity is an amount of energy in joules. In YAML it is either a
+// number of joules (e.g. 10) or a string with a unit suffix of mJ, J, kJ or MJ
+// (e.g. "500mJ", "2kJ").
+type EnergyQuantity float64
+
+// energyUnits maps the unit suffixes to joules
+var energyUnits = map[string]float64{
+	"mJ": 1e-3,
+	"J":  1,
+	"kJ": 1e3,
+	"MJ": 1e6,
+}
+
+var energyQuantityRegex = regexp.MustCompile(`^([0-9]*\.?[0-9]+)\s*(mJ|J|kJ|MJ)?$`)
+
+// ParseEnergyQuantity parses a non-negative quantity like "500mJ" or "2kJ";
+// a number without unit is in joules
+func ParseEnergyQuantity(s string) (EnergyQuantity, error) {
+	match := energyQuantityRegex.FindStringSubmatch(strings.TrimSpace(s))
+	if match == nil {
+		return 0, fmt.Errorf("invalid energy quantity %q: must be a non-negative number with an optional unit of mJ, J, kJ or MJ", s)
+	}
+	value, err := strconv.ParseFloat(match[1], 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid energy quantity %q: %w", s, err)
+	}
+	unit := match[2]
+	if unit == "" {
+		unit = "J"
+	}
+	return EnergyQuantity(value * energyUnits[unit]), nil
+}
+
+// Joules returns the quantity in joules
+func (q EnergyQuantity) Joules() float64 {
+	return float64(q)
+}
+
+// String returns the quantity in joules, e.g. "0.5J"
+func (q EnergyQuantity) String() string {
+	return strconv.FormatFloat(float64(q), 'f', -1, 64) + "J"
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler interface. Plain numbers are kept
+// as is so that negative values are reported by Validate.
+func (q *EnergyQuantity) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var joules float64
+	if err := unmarshal(&joules); err == nil {
+		*q = EnergyQuantity(joules)
+		return nil
+	}
+
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return fmt.Errorf("cannot unmarshal energy quantity: must be a number of joules or a string like \"500mJ\"")
+	}
+	parsed, err := ParseEnergyQuantity(s)
+	if err != nil {
+		return err
+	}
+	*q = parsed
+	return nil
+}
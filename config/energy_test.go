@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestEnergyQuantity_UnmarshalYAML(t *testing.T) {
+	tests := []struct {
+		name        string
+		yamlData    string
+		expected    EnergyQuantity
+		expectError bool
+	}{
+		{name: "Integer joules", yamlData: "10", expected: 10},
+		{name: "Fractional joules", yamlData: "0.5", expected: 0.5},
+		{name: "Millijoules", yamlData: `"500mJ"`, expected: 0.5},
+		{name: "Joules", yamlData: "10J", expected: 10},
+		{name: "Kilojoules", yamlData: "2kJ", expected: 2000},
+		{name: "Megajoules", yamlData: "1.5MJ", expected: 1.5e6},
+		{name: "Space before unit", yamlData: `"3 kJ"`, expected: 3000},
+		{name: "String without unit", yamlData: `"7"`, expected: 7},
+		{name: "Negative number is left to Validate", yamlData: "-10", expected: -10},
+		{name: "Unknown unit", yamlData: "10X", expectError: true},
+		{name: "Wrong unit case", yamlData: "10kj", expectError: true},
+		{name: "Negative quantity", yamlData: `"-5J"`, expectError: true},
+		{name: "Unit only", yamlData: "kJ", expectError: true},
+		{name: "List", yamlData: "- 10J", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var q EnergyQuantity
+			err := yaml.Unmarshal([]byte(tt.yamlData), &q)
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.InDelta(t, float64(tt.expected), q.Joules(), 1e-9)
+		})
+	}
+}
+
+func TestMinTerminatedEnergyThresholdUnits(t *testing.T) {
+	cfg, err := Load(strings.NewReader(`
+monitor:
+  minTerminatedEnergyThreshold: "500mJ"
+`))
+	require.NoError(t, err)
+	assert.InDelta(t, 0.5, cfg.Monitor.MinTerminatedEnergyThreshold.Joules(), 1e-9)
+
+	_, err = Load(strings.NewReader(`
+monitor:
+  minTerminatedEnergyThreshold: 10X
+`))
+	assert.ErrorContains(t, err, "invalid energy quantity")
+
+	// the canonical joule value round-trips through the YAML output
+	roundTrip, err := Load(strings.NewReader(cfg.String()))
+	require.NoError(t, err)
+	assert.Equal(t, cfg.Monitor.MinTerminatedEnergyThreshold, roundTrip.Monitor.MinTerminatedEnergyThreshold)
+}
@@ -18,8 +18,10 @@ const (
 	MetricsLevelContainer                   // 4
 	MetricsLevelVM                          // 8
 	MetricsLevelPod                         // 16
+	MetricsLevelUser                        // 32
 
-	// MetricsLevelAll represents all metric levels combined
+	// MetricsLevelAll represents all metric levels combined. The user level
+	// is opt-in and has to be enabled explicitly.
 	MetricsLevelAll = MetricsLevelNode | MetricsLevelProcess | MetricsLevelContainer | MetricsLevelVM | MetricsLevelPod
 )
 
@@ -41,6 +43,9 @@ func (l Level) String() string {
 	if l.IsPodEnabled() {
 		levels = append(levels, "pod")
 	}
+	if l.IsUserEnabled() {
+		levels = append(levels, "user")
+	}
 	return strings.Join(levels, ",")
 }
 
@@ -69,6 +74,11 @@ func (l Level) IsPodEnabled() bool {
 	return l&MetricsLevelPod != 0
 }
 
+// IsUserEnabled checks if user metrics are enabled
+func (l Level) IsUserEnabled() bool {
+	return l&MetricsLevelUser != 0
+}
+
 // ParseLevel parses a slice of strings into a Level
 func ParseLevel(levels []string) (Level, error) {
 	if len(levels) == 0 {
@@ -88,6 +98,8 @@ func ParseLevel(levels []string) (Level, error) {
 			result |= MetricsLevelVM
 		case "pod":
 			result |= MetricsLevelPod
+		case "user":
+			result |= MetricsLevelUser
 		default:
 			return 0, fmt.Errorf("unknown metrics level: %s", level)
 		}
@@ -98,7 +110,7 @@ func ParseLevel(levels []string) (Level, error) {
 
 // ValidLevels returns the list of valid metrics levels
 func ValidLevels() []string {
-	return []string{"node", "process", "container", "vm", "pod"}
+	return []string{"node", "process", "container", "vm", "pod", "user"}
 }
 
 // MarshalYAML implements yaml.Marshaler interface
@@ -119,6 +131,9 @@ func (l Level) MarshalYAML() (interface{}, error) {
 	if l.IsPodEnabled() {
 		levels = append(levels, "pod")
 	}
+	if l.IsUserEnabled() {
+		levels = append(levels, "user")
+	}
 
 	// Return as slice for multiple levels, single string for one level
 	if len(levels) == 1 {
@@ -144,6 +144,12 @@ func TestParseLevel(t *testing.T) {
 			expected:    MetricsLevelAll,
 			expectError: false,
 		},
+		{
+			name:        "User level",
+			levels:      []string{"process", "user"},
+			expected:    MetricsLevelProcess | MetricsLevelUser,
+			expectError: false,
+		},
 		{
 			name:        "Case insensitive",
 			levels:      []string{"NODE", "Process", "CONTAINER"},
@@ -184,7 +190,7 @@ func TestParseLevel(t *testing.T) {
 }
 
 func TestValidLevels(t *testing.T) {
-	expected := []string{"node", "process", "container", "vm", "pod"}
+	expected := []string{"node", "process", "container", "vm", "pod", "user"}
 	result := ValidLevels()
 	assert.Equal(t, expected, result)
 }
@@ -196,6 +202,8 @@ func TestBitPatterns(t *testing.T) {
 	assert.Equal(t, Level(4), MetricsLevelContainer) // 1 << 3 = 8
 	assert.Equal(t, Level(8), MetricsLevelVM)        // 1 << 4 = 16
 	assert.Equal(t, Level(16), MetricsLevelPod)      // 1 << 5 = 32
+	assert.Equal(t, Level(32), MetricsLevelUser)
+	assert.False(t, MetricsLevelAll.IsUserEnabled(), "user level must be opt-in")
 
 	// Test that combined levels work correctly
 	expected := MetricsLevelAll
@@ -7,6 +7,7 @@ import (
 	"flag"
 	"fmt"
 	"log/slog"
+	"math"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -102,6 +103,11 @@ func (m *MockRedfishService) BMCID() string {
 	return m.bmcID
 }
 
+// LastReading implements collector.RedfishReadingTracker interface
+func (m *MockRedfishService) LastReading() (time.Time, bool) {
+	return time.Now(), false
+}
+
 // DescCollector is a helper struct to collect metric descriptions
 type DescCollector struct {
 	descs []*prometheus.Desc
@@ -332,16 +338,34 @@ func main() {
 	fmt.Println("Creating collectors...")
 	// Create a logger for the collectors
 	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
-	powerCollector := collector.NewPowerCollector(mockMonitor, "test-node", logger, config.MetricsLevelAll)
+	powerCollector := collector.NewPowerCollector(mockMonitor, "test-node", logger, config.MetricsLevelAll|config.MetricsLevelUser, collector.WithKWh(true), collector.WithMaxTotalSeries(math.MaxInt32))
 	fmt.Println("Created power collector")
-	buildInfoCollector := collector.NewKeplerBuildInfoCollector()
+	buildInfoCollector := collector.NewKeplerBuildInfoCollector("test-node")
 	fmt.Println("Created build info collector")
-	cpuInfoCollector, err := collector.NewCPUInfoCollector("/proc")
+	attributionInfoCollector := collector.NewAttributionInfoCollector("cputime", "none", "test-node")
+	fmt.Println("Created attribution info collector")
+	platformInfoCollector := collector.NewPlatformInfoCollector("/sys", collector.PlatformInfo{}, "test-node")
+	fmt.Println("Created platform info collector")
+	configReloadCollector := collector.NewConfigReloadCollector(time.Now(), "test-node")
+	fmt.Println("Created config reload collector")
+	selfCPUCollector, err := collector.NewSelfCPUCollector("/proc", "test-node")
+	if err != nil {
+		fmt.Printf("Warning: Could not create self CPU collector: %v\n", err)
+	} else {
+		fmt.Println("Created self CPU collector")
+	}
+	cpuInfoCollector, err := collector.NewCPUInfoCollector("/proc", "test-node")
 	if err != nil {
 		fmt.Printf("Warning: Could not create CPU info collector: %v\n", err)
 	} else {
 		fmt.Println("Created CPU info collector")
 	}
+	cpuModelInfoCollector, err := collector.NewCPUModelInfoCollector("/proc", mockMonitor, "test-node")
+	if err != nil {
+		fmt.Printf("Warning: Could not create CPU model info collector: %v\n", err)
+	} else {
+		fmt.Println("Created CPU model info collector")
+	}
 
 	// Extract metrics information from collectors
 	var allMetrics []MetricInfo
@@ -364,6 +388,44 @@ func main() {
 	fmt.Printf("Extracted %d build info metrics\n", len(buildInfoMetrics))
 	allMetrics = append(allMetrics, buildInfoMetrics...)
 
+	fmt.Println("Extracting metrics from attribution info collector...")
+	attributionInfoMetrics, err := extractMetricsInfo(attributionInfoCollector)
+	if err != nil {
+		fmt.Printf("Failed to extract attribution info metrics: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("Extracted %d attribution info metrics\n", len(attributionInfoMetrics))
+	allMetrics = append(allMetrics, attributionInfoMetrics...)
+
+	fmt.Println("Extracting metrics from platform info collector...")
+	platformInfoMetrics, err := extractMetricsInfo(platformInfoCollector)
+	if err != nil {
+		fmt.Printf("Failed to extract platform info metrics: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("Extracted %d platform info metrics\n", len(platformInfoMetrics))
+	allMetrics = append(allMetrics, platformInfoMetrics...)
+
+	fmt.Println("Extracting metrics from config reload collector...")
+	configReloadMetrics, err := extractMetricsInfo(configReloadCollector)
+	if err != nil {
+		fmt.Printf("Failed to extract config reload metrics: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("Extracted %d config reload metrics\n", len(configReloadMetrics))
+	allMetrics = append(allMetrics, configReloadMetrics...)
+
+	if selfCPUCollector != nil {
+		fmt.Println("Extracting metrics from self CPU collector...")
+		selfCPUMetrics, err := extractMetricsInfo(selfCPUCollector)
+		if err != nil {
+			fmt.Printf("Failed to extract self CPU metrics: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("Extracted %d self CPU metrics\n", len(selfCPUMetrics))
+		allMetrics = append(allMetrics, selfCPUMetrics...)
+	}
+
 	if cpuInfoCollector != nil {
 		fmt.Println("Extracting metrics from CPU info collector...")
 		cpuInfoMetrics, err := extractMetricsInfo(cpuInfoCollector)
@@ -375,6 +437,17 @@ func main() {
 		allMetrics = append(allMetrics, cpuInfoMetrics...)
 	}
 
+	if cpuModelInfoCollector != nil {
+		fmt.Println("Extracting metrics from CPU model info collector...")
+		cpuModelInfoMetrics, err := extractMetricsInfo(cpuModelInfoCollector)
+		if err != nil {
+			fmt.Printf("Failed to extract CPU model info metrics: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("Extracted %d CPU model info metrics\n", len(cpuModelInfoMetrics))
+		allMetrics = append(allMetrics, cpuModelInfoMetrics...)
+	}
+
 	// Create mock redfish service for platform collector
 	mockRedfish := &MockRedfishService{
 		nodeName: "test-node",
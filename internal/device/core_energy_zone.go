@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package device
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// coreEnergyLabel matches the per-core energy labels exposed by drivers such
+// as amd_energy, e.g. "Ecore000"
+var coreEnergyLabel = regexp.MustCompile(`^Ecore(\d+)$`)
+
+// CoreEnergyZones discovers per-core energy counters under hwmon.
+// Per-core counters are only exposed by some drivers (e.g. amd_energy on AMD
+// EPYC); powercap only provides package level (and aggregated core) zones.
+// An empty slice is returned when no per-core counters are available.
+func CoreEnergyZones(sysfsPath string) ([]EnergyZone, error) {
+	labels, err := filepath.Glob(filepath.Join(sysfsPath, "class", "hwmon", "hwmon*", "energy*_label"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list hwmon energy labels: %w", err)
+	}
+
+	zones := make([]*coreEnergyZone, 0, len(labels))
+	for _, labelPath := range labels {
+		data, err := os.ReadFile(labelPath)
+		if err != nil {
+			continue
+		}
+
+		m := coreEnergyLabel.FindStringSubmatch(strings.TrimSpace(string(data)))
+		if m == nil {
+			continue
+		}
+
+		core, err := strconv.Atoi(m[1])
+		if err != nil {
+			continue
+		}
+
+		zones = append(zones, &coreEnergyZone{
+			core: core,
+			path: strings.TrimSuffix(labelPath, "_label") + "_input",
+		})
+	}
+
+	sort.Slice(zones, func(i, j int) bool { return zones[i].core < zones[j].core })
+
+	ret := make([]EnergyZone, len(zones))
+	for i, z := range zones {
+		ret[i] = z
+	}
+	return ret, nil
+}
+
+// coreEnergyZone implements EnergyZone for a hwmon per-core energy counter
+type coreEnergyZone struct {
+	core int
+	path string // path to energy*_input (microjoules)
+}
+
+func (z *coreEnergyZone) Name() string {
+	return ZoneCore
+}
+
+// Index returns the core number
+func (z *coreEnergyZone) Index() int {
+	return z.core
+}
+
+func (z *coreEnergyZone) Path() string {
+	return z.path
+}
+
+func (z *coreEnergyZone) Energy() (Energy, error) {
+	data, err := sysReadFile(z.path)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read energy from %s: %w", z.path, err)
+	}
+
+	uj, err := strconv.ParseUint(strings.TrimSpace(string(data)), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse energy value from %s: %w", z.path, err)
+	}
+	return Energy(uj), nil
+}
+
+// MaxEnergy returns the maximum counter value; hwmon energy counters are
+// accumulated by the driver into 64 bits
+func (z *coreEnergyZone) MaxEnergy() Energy {
+	return Energy(math.MaxUint64)
+}
+
+// Power returns an error since per-core zones provide cumulative energy only
+func (z *coreEnergyZone) Power() (Power, error) {
+	return 0, fmt.Errorf("core energy zones do not provide instantaneous power readings")
+}
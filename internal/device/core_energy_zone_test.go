@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package device
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeHwmonFiles creates a synthetic hwmon device with the given files
+func writeHwmonFiles(t *testing.T, sysfs, hwmon string, files map[string]string) {
+	t.Helper()
+	dir := filepath.Join(sysfs, "class", "hwmon", hwmon)
+	require.NoError(t, os.MkdirAll(dir, 0o755))
+	for name, content := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644))
+	}
+}
+
+func TestCoreEnergyZones(t *testing.T) {
+	t.Run("per-core counters", func(t *testing.T) {
+		sysfs := t.TempDir()
+		writeHwmonFiles(t, sysfs, "hwmon2", map[string]string{
+			"name":          "amd_energy\n",
+			"energy1_label": "Ecore000\n",
+			"energy1_input": "1000000\n",
+			"energy2_label": "Ecore001\n",
+			"energy2_input": "2500000\n",
+			"energy3_label": "Esocket0\n",
+			"energy3_input": "9000000\n",
+		})
+		// unrelated hwmon device without energy counters
+		writeHwmonFiles(t, sysfs, "hwmon0", map[string]string{
+			"name":        "k10temp\n",
+			"temp1_label": "Tctl\n",
+			"temp1_input": "45000\n",
+		})
+
+		zones, err := CoreEnergyZones(sysfs)
+		require.NoError(t, err)
+		require.Len(t, zones, 2, "socket level counters must not be reported as cores")
+
+		for i, expected := range []Energy{1_000_000, 2_500_000} {
+			zone := zones[i]
+			assert.Equal(t, ZoneCore, zone.Name())
+			assert.Equal(t, i, zone.Index())
+			assert.Equal(t, Energy(math.MaxUint64), zone.MaxEnergy())
+
+			energy, err := zone.Energy()
+			require.NoError(t, err)
+			assert.Equal(t, expected, energy)
+
+			_, err = zone.Power()
+			assert.Error(t, err)
+		}
+	})
+
+	t.Run("package level only", func(t *testing.T) {
+		sysfs := t.TempDir()
+		writeHwmonFiles(t, sysfs, "hwmon1", map[string]string{
+			"name":          "amd_energy\n",
+			"energy1_label": "Esocket0\n",
+			"energy1_input": "9000000\n",
+		})
+
+		zones, err := CoreEnergyZones(sysfs)
+		require.NoError(t, err)
+		assert.Empty(t, zones)
+	})
+
+	t.Run("no hwmon", func(t *testing.T) {
+		zones, err := CoreEnergyZones(t.TempDir())
+		require.NoError(t, err)
+		assert.Empty(t, zones)
+	})
+
+	t.Run("unreadable counter", func(t *testing.T) {
+		sysfs := t.TempDir()
+		writeHwmonFiles(t, sysfs, "hwmon2", map[string]string{
+			"energy1_label": "Ecore007\n",
+			"energy1_input": "not-a-number\n",
+		})
+
+		zones, err := CoreEnergyZones(sysfs)
+		require.NoError(t, err)
+		require.Len(t, zones, 1)
+		assert.Equal(t, 7, zones[0].Index())
+
+		_, err = zones[0].Energy()
+		assert.Error(t, err)
+	})
+}
@@ -30,6 +30,13 @@ type EnergyZone interface {
 	Power() (Power, error)
 }
 
+// PowerLimiter is implemented by energy zones that expose a power limit,
+// e.g. the long term power limit of a RAPL zone
+type PowerLimiter interface {
+	// PowerLimit() returns the power limit of the zone
+	PowerLimit() (Power, error)
+}
+
 // CPUPowerMeter implements powerMeter
 type CPUPowerMeter interface {
 	powerMeter
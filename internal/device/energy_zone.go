@@ -169,3 +169,21 @@ func (az *AggregatedZone) Power() (Power, error) {
 
 	return totalPower, nil
 }
+
+// PowerLimit returns the sum of the power limits of all aggregated zones
+func (az *AggregatedZone) PowerLimit() (Power, error) {
+	var totalLimit Power
+	for _, zone := range az.zones {
+		limiter, ok := zone.(PowerLimiter)
+		if !ok {
+			return 0, fmt.Errorf("zone %s does not provide a power limit", zone.Name())
+		}
+		limit, err := limiter.PowerLimit()
+		if err != nil {
+			return 0, fmt.Errorf("failed to read power limit from zone %s: %w", zone.Name(), err)
+		}
+		totalLimit += limit
+	}
+
+	return totalLimit, nil
+}
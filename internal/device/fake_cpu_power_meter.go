@@ -105,7 +105,32 @@ func WithFakeMaxEnergy(e Energy) FakeOptFn {
 	}
 }
 
-// WithFakeMaxEnergy sets the maximum energy value before wrap-around
+// WithFakeInitialEnergy sets the counter value the zones start from. Combined
+// with WithFakeMaxEnergy it allows simulating a counter about to wrap around.
+func WithFakeInitialEnergy(e Energy) FakeOptFn {
+	return func(m *fakeRaplMeter) {
+		for _, z := range m.zones {
+			if fz, ok := z.(*fakeEnergyZone); ok {
+				fz.energy = e
+			}
+		}
+	}
+}
+
+// WithFakeIncrement sets a fixed energy increment per read and disables the
+// random component so that the counter grows deterministically
+func WithFakeIncrement(e Energy) FakeOptFn {
+	return func(m *fakeRaplMeter) {
+		for _, z := range m.zones {
+			if fz, ok := z.(*fakeEnergyZone); ok {
+				fz.increment = e
+				fz.randomFactor = 0
+			}
+		}
+	}
+}
+
+// WithFakeLogger sets the logger of the fake meter
 func WithFakeLogger(l *slog.Logger) FakeOptFn {
 	return func(m *fakeRaplMeter) {
 		m.logger = l.With("meter", m.Name())
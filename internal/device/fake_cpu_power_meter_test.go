@@ -140,6 +140,26 @@ func TestWithFakeMaxEnergy(t *testing.T) {
 	}
 }
 
+func TestWithFakeInitialEnergyAndIncrement(t *testing.T) {
+	meter, err := NewFakeCPUMeter([]string{"package"},
+		WithFakeMaxEnergy(1000),
+		WithFakeInitialEnergy(850),
+		WithFakeIncrement(100),
+	)
+	assert.NoError(t, err)
+
+	zones, err := meter.Zones()
+	assert.NoError(t, err)
+	assert.Len(t, zones, 1)
+
+	zone := zones[0]
+	for _, expected := range []Energy{950, 50, 150} {
+		e, err := zone.Energy()
+		assert.NoError(t, err)
+		assert.Equal(t, expected, e)
+	}
+}
+
 func TestWithFakeLogger(t *testing.T) {
 	logger := slog.Default().With("test", "logger")
 	meter, err := NewFakeCPUMeter(nil, WithFakeLogger(logger))
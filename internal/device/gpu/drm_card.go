@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gpu
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// cardRegex matches the DRM cards, e.g. card0, but not their connectors,
+// e.g. card0-DP-1
+var cardRegex = regexp.MustCompile(`^card(\d+)$`)
+
+// DRMCards returns the names of the DRM cards in drmPath, e.g. /sys/class/drm,
+// ordered by card number
+func DRMCards(drmPath string) ([]string, error) {
+	entries, err := os.ReadDir(drmPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read DRM devices: %w", err)
+	}
+
+	type card struct {
+		num  int
+		name string
+	}
+	var cards []card
+	for _, e := range entries {
+		if match := cardRegex.FindStringSubmatch(e.Name()); match != nil {
+			num, _ := strconv.Atoi(match[1])
+			cards = append(cards, card{num: num, name: e.Name()})
+		}
+	}
+	sort.Slice(cards, func(i, j int) bool { return cards[i].num < cards[j].num })
+
+	names := make([]string, len(cards))
+	for i, c := range cards {
+		names[i] = c.name
+	}
+	return names, nil
+}
+
+// PCIAddress returns the PCI address of a device, the name of the directory
+// the device symlink points to, e.g. /sys/class/drm/card0/device
+func PCIAddress(devicePath string) string {
+	resolved, err := filepath.EvalSymlinks(devicePath)
+	if err != nil {
+		return ""
+	}
+	return filepath.Base(resolved)
+}
+
+// ReadAttribute returns the trimmed content of a sysfs attribute, or "" if it
+// can't be read
+func ReadAttribute(path string) string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gpu
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDRMCards(t *testing.T) {
+	drm := t.TempDir()
+	for _, name := range []string{"card10", "card2", "card2-DP-1", "renderD128", "card0"} {
+		require.NoError(t, os.Mkdir(filepath.Join(drm, name), 0o755))
+	}
+
+	cards, err := DRMCards(drm)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"card0", "card2", "card10"}, cards, "cards ordered by number, without connectors")
+
+	_, err = DRMCards(filepath.Join(drm, "missing"))
+	assert.Error(t, err)
+}
+
+func TestPCIAddress(t *testing.T) {
+	sysfs := t.TempDir()
+	pciPath := filepath.Join(sysfs, "devices", "pci0000:00", "0000:4d:00.0")
+	require.NoError(t, os.MkdirAll(pciPath, 0o755))
+	devicePath := filepath.Join(sysfs, "device")
+	require.NoError(t, os.Symlink(pciPath, devicePath))
+
+	assert.Equal(t, "0000:4d:00.0", PCIAddress(devicePath))
+	assert.Empty(t, PCIAddress(filepath.Join(sysfs, "missing")))
+}
+
+func TestReadAttribute(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "vendor")
+	require.NoError(t, os.WriteFile(path, []byte("0x8086\n"), 0o644))
+
+	assert.Equal(t, "0x8086", ReadAttribute(path))
+	assert.Empty(t, ReadAttribute(path+"-missing"))
+}
@@ -0,0 +1,291 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gpu
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DRMDriver selects the DRM clients of a kernel driver in /proc/<pid>/fdinfo
+// and the fdinfo keys reporting their usage, see
+// https://docs.kernel.org/gpu/drm-usage-stats.html
+type DRMDriver struct {
+	// Name is the value of drm-driver, e.g. amdgpu or i915
+	Name string
+
+	// Engines are the names of the drm-engine-<name> keys whose busy time,
+	// in ns, counts towards the utilization of a client
+	Engines []string
+
+	// Cycles are the engine classes of drivers reporting GPU cycles instead
+	// of busy time, e.g. xe: the drm-cycles-<name> cycles a client was busy
+	// relative to the drm-total-cycles-<name> cycles elapsed count towards its
+	// utilization
+	Cycles []string
+
+	// Memory is the key reporting the device memory of a client
+	Memory string
+}
+
+// DRMProcess identifies a process using a GPU by its PCI address
+type DRMProcess struct {
+	PID        uint32
+	PCIAddress string
+}
+
+// DRMCycles are the GPU cycles an engine class was busy and the total GPU
+// cycles elapsed
+type DRMCycles struct {
+	Busy  uint64
+	Total uint64
+}
+
+// DRMUsage is the engine busy time, or cycles, and memory of the processes
+// using the GPUs of a driver at a point in time
+type DRMUsage struct {
+	Timestamp time.Time
+	Busy      map[DRMProcess]uint64               // ns
+	Cycles    map[DRMProcess]map[string]DRMCycles // by engine class
+	Memory    map[DRMProcess]uint64               // bytes
+}
+
+// ReadDRMUsage reads the usage of all DRM clients of a driver from the fdinfo
+// of the processes under procPath. A client shared by several file
+// descriptors or processes is counted once, for the process with the lowest
+// PID.
+func ReadDRMUsage(procPath string, driver DRMDriver, now time.Time) *DRMUsage {
+	usage := &DRMUsage{
+		Timestamp: now,
+		Busy:      make(map[DRMProcess]uint64),
+		Cycles:    make(map[DRMProcess]map[string]DRMCycles),
+		Memory:    make(map[DRMProcess]uint64),
+	}
+
+	entries, err := os.ReadDir(procPath)
+	if err != nil {
+		return usage
+	}
+
+	var pids []uint32
+	for _, e := range entries {
+		if pid, err := strconv.ParseUint(e.Name(), 10, 32); err == nil {
+			pids = append(pids, uint32(pid))
+		}
+	}
+	sort.Slice(pids, func(i, j int) bool { return pids[i] < pids[j] })
+
+	seen := make(map[string]bool)
+	for _, pid := range pids {
+		fdinfoPath := filepath.Join(procPath, strconv.FormatUint(uint64(pid), 10), "fdinfo")
+		fds, err := os.ReadDir(fdinfoPath)
+		if err != nil {
+			// the process exited or isn't accessible
+			continue
+		}
+		for _, fd := range fds {
+			client, ok := readDRMClient(filepath.Join(fdinfoPath, fd.Name()), driver)
+			if !ok {
+				continue
+			}
+			clientKey := client.pciAddress + "/" + client.id
+			if seen[clientKey] {
+				continue
+			}
+			seen[clientKey] = true
+
+			key := DRMProcess{PID: pid, PCIAddress: client.pciAddress}
+			usage.Busy[key] += client.busy
+			usage.Memory[key] += client.memory
+			usage.addCycles(key, client.cycles)
+		}
+	}
+	return usage
+}
+
+// addCycles adds the cycles of a client to those of its process. The clients
+// of a GPU share its cycle counter, so their busy cycles add up while the
+// total cycles don't.
+func (u *DRMUsage) addCycles(key DRMProcess, cycles map[string]DRMCycles) {
+	if len(cycles) == 0 {
+		return
+	}
+	engines, ok := u.Cycles[key]
+	if !ok {
+		engines = make(map[string]DRMCycles, len(cycles))
+		u.Cycles[key] = engines
+	}
+	for name, c := range cycles {
+		total := engines[name]
+		total.Busy += c.Busy
+		total.Total = max(total.Total, c.Total)
+		engines[name] = total
+	}
+}
+
+// Utilization returns the ratio (0.0-1.0) of time the engines were busy for
+// each process since the previous usage, from the busy time or, for drivers
+// reporting cycles, the busy cycles of each engine class relative to the
+// cycles elapsed. Processes not in the previous usage are left out.
+func (u *DRMUsage) Utilization(prev *DRMUsage) map[DRMProcess]float64 {
+	utilization := make(map[DRMProcess]float64)
+	if prev == nil {
+		return utilization
+	}
+
+	if elapsed := u.Timestamp.Sub(prev.Timestamp).Nanoseconds(); elapsed > 0 {
+		for key, busy := range u.Busy {
+			prevBusy, ok := prev.Busy[key]
+			if !ok || busy < prevBusy {
+				continue
+			}
+			utilization[key] = min(float64(busy-prevBusy)/float64(elapsed), 1)
+		}
+	}
+
+	for key, engines := range u.Cycles {
+		prevEngines, ok := prev.Cycles[key]
+		if !ok {
+			continue
+		}
+		util, counted := 0.0, false
+		for name, c := range engines {
+			p, ok := prevEngines[name]
+			if !ok || c.Busy < p.Busy || c.Total <= p.Total {
+				continue
+			}
+			util += float64(c.Busy-p.Busy) / float64(c.Total-p.Total)
+			counted = true
+		}
+		if counted {
+			utilization[key] = min(utilization[key]+util, 1)
+		}
+	}
+	return utilization
+}
+
+// SplitActivePower splits the active power of each device, keyed by device
+// index, among the processes using it in proportion to their compute
+// utilization, so that the power attributed to the processes of a device sums
+// to its active power. The power of a device whose processes report no
+// utilization is split equally among them.
+func SplitActivePower(procs []ProcessGPUInfo, activePower map[int]float64) map[uint32]float64 {
+	totalUtil := make(map[int]float64)
+	count := make(map[int]int)
+	for _, p := range procs {
+		totalUtil[p.DeviceIndex] += p.ComputeUtil
+		count[p.DeviceIndex]++
+	}
+
+	result := make(map[uint32]float64)
+	for _, p := range procs {
+		power, ok := activePower[p.DeviceIndex]
+		if !ok {
+			continue
+		}
+		share := 1 / float64(count[p.DeviceIndex])
+		if total := totalUtil[p.DeviceIndex]; total > 0 {
+			share = p.ComputeUtil / total
+		}
+		result[p.PID] += power * share
+	}
+	return result
+}
+
+// drmClient is a DRM client read from a /proc/<pid>/fdinfo entry
+type drmClient struct {
+	id         string
+	pciAddress string
+	busy       uint64 // ns
+	cycles     map[string]DRMCycles
+	memory     uint64 // bytes
+}
+
+// readDRMClient parses an fdinfo entry, returning false unless it belongs to
+// a DRM client of the driver
+func readDRMClient(path string, driver DRMDriver) (drmClient, bool) {
+	f, err := os.Open(path)
+	if err != nil {
+		return drmClient{}, false
+	}
+	defer func() { _ = f.Close() }()
+
+	var client drmClient
+	matched := false
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		key, value, ok := strings.Cut(scanner.Text(), ":")
+		if !ok {
+			continue
+		}
+		value = strings.TrimSpace(value)
+
+		switch {
+		case key == "drm-driver":
+			matched = value == driver.Name
+		case key == "drm-client-id":
+			client.id = value
+		case key == "drm-pdev":
+			client.pciAddress = value
+		case key == driver.Memory:
+			client.memory = parseDRMMemory(value)
+		case strings.HasPrefix(key, "drm-engine-") && slices.Contains(driver.Engines, strings.TrimPrefix(key, "drm-engine-")):
+			ns, _ := strconv.ParseUint(strings.TrimSuffix(value, " ns"), 10, 64)
+			client.busy += ns
+		case strings.HasPrefix(key, "drm-cycles-") && slices.Contains(driver.Cycles, strings.TrimPrefix(key, "drm-cycles-")):
+			client.setCycles(strings.TrimPrefix(key, "drm-cycles-"), value, false)
+		case strings.HasPrefix(key, "drm-total-cycles-") && slices.Contains(driver.Cycles, strings.TrimPrefix(key, "drm-total-cycles-")):
+			client.setCycles(strings.TrimPrefix(key, "drm-total-cycles-"), value, true)
+		}
+	}
+
+	if !matched || client.id == "" || client.pciAddress == "" {
+		return drmClient{}, false
+	}
+	return client, true
+}
+
+// setCycles sets the busy or total cycles of an engine class of the client
+func (c *drmClient) setCycles(engine, value string, total bool) {
+	n, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return
+	}
+	if c.cycles == nil {
+		c.cycles = make(map[string]DRMCycles)
+	}
+	cycles := c.cycles[engine]
+	if total {
+		cycles.Total = n
+	} else {
+		cycles.Busy = n
+	}
+	c.cycles[engine] = cycles
+}
+
+// parseDRMMemory parses a memory size such as "1024 KiB" into bytes
+func parseDRMMemory(value string) uint64 {
+	number, unit, _ := strings.Cut(value, " ")
+	size, err := strconv.ParseUint(number, 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	switch unit {
+	case "KiB":
+		return size << 10
+	case "MiB":
+		return size << 20
+	case "GiB":
+		return size << 30
+	default:
+		return size
+	}
+}
@@ -0,0 +1,124 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gpu
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadDRMUsage(t *testing.T) {
+	driver := DRMDriver{Name: "i915", Engines: []string{"render", "compute"}, Memory: "drm-total-local0"}
+
+	procfs := t.TempDir()
+	writeFdinfo := func(pid, fd, content string) {
+		t.Helper()
+		dir := filepath.Join(procfs, pid, "fdinfo")
+		require.NoError(t, os.MkdirAll(dir, 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, fd), []byte(content), 0o644))
+	}
+
+	client := "drm-driver:\ti915\ndrm-client-id:\t4\ndrm-pdev:\t0000:4d:00.0\n" +
+		"drm-total-local0:\t64 MiB\ndrm-engine-render:\t1000 ns\ndrm-engine-compute:\t500 ns\n" +
+		"drm-engine-copy:\t700 ns\ndrm-engine-capacity-render:\t2\n"
+	writeFdinfo("42", "5", client)
+	// the same client in a forked process and another file descriptor
+	writeFdinfo("43", "5", client)
+	writeFdinfo("42", "6", client)
+	// a client of another driver, an fd that isn't a DRM client and a
+	// directory that isn't a process
+	writeFdinfo("44", "3", "drm-driver:\tamdgpu\ndrm-client-id:\t1\ndrm-pdev:\t0000:c1:00.0\ndrm-engine-gfx:\t9 ns\n")
+	writeFdinfo("44", "4", "pos:\t0\nflags:\t02\n")
+	require.NoError(t, os.MkdirAll(filepath.Join(procfs, "sys"), 0o755))
+
+	now := time.Now()
+	usage := ReadDRMUsage(procfs, driver, now)
+	key := DRMProcess{PID: 42, PCIAddress: "0000:4d:00.0"}
+	assert.Equal(t, map[DRMProcess]uint64{key: 1500}, usage.Busy, "only the selected engines count")
+	assert.Equal(t, map[DRMProcess]uint64{key: 64 << 20}, usage.Memory)
+	assert.Equal(t, now, usage.Timestamp)
+
+	t.Run("utilization", func(t *testing.T) {
+		assert.Empty(t, usage.Utilization(nil))
+
+		later := &DRMUsage{
+			Timestamp: now.Add(time.Microsecond),
+			Busy: map[DRMProcess]uint64{
+				key:                       1750,
+				{PID: 50, PCIAddress: ""}: 300,
+			},
+		}
+		assert.Equal(t, map[DRMProcess]float64{key: 0.25}, later.Utilization(usage),
+			"new processes are left out until the next read")
+		assert.Empty(t, usage.Utilization(later), "time must advance")
+	})
+}
+
+func TestReadDRMUsage_Cycles(t *testing.T) {
+	driver := DRMDriver{Name: "xe", Cycles: []string{"rcs", "ccs"}, Memory: "drm-total-vram0"}
+
+	procfs := t.TempDir()
+	writeFdinfo := func(pid, fd string, rcs, ccs, total uint64) {
+		t.Helper()
+		dir := filepath.Join(procfs, pid, "fdinfo")
+		require.NoError(t, os.MkdirAll(dir, 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, fd), fmt.Appendf(nil,
+			"drm-driver:\txe\ndrm-client-id:\t%s\ndrm-pdev:\t0000:8a:00.0\n"+
+				"drm-cycles-rcs:\t%d\ndrm-total-cycles-rcs:\t%d\n"+
+				"drm-cycles-ccs:\t%d\ndrm-total-cycles-ccs:\t%d\n"+
+				"drm-cycles-bcs:\t%d\ndrm-total-cycles-bcs:\t%d\n",
+			fd, rcs, total, ccs, total, rcs, total), 0o644))
+	}
+
+	// two clients of one process share the cycle counter of the GPU
+	writeFdinfo("42", "5", 100, 0, 1000)
+	writeFdinfo("42", "6", 50, 0, 1000)
+	now := time.Now()
+	usage := ReadDRMUsage(procfs, driver, now)
+	key := DRMProcess{PID: 42, PCIAddress: "0000:8a:00.0"}
+	assert.Equal(t, map[string]DRMCycles{
+		"rcs": {Busy: 150, Total: 1000},
+		"ccs": {Busy: 0, Total: 1000},
+	}, usage.Cycles[key], "only the selected engine classes count")
+	assert.Equal(t, map[DRMProcess]uint64{key: 0}, usage.Busy)
+
+	writeFdinfo("42", "5", 400, 200, 2000)
+	writeFdinfo("42", "6", 50, 0, 2000)
+	later := ReadDRMUsage(procfs, driver, now)
+	assert.Equal(t, map[DRMProcess]float64{key: 0.5}, later.Utilization(usage),
+		"300 of 1000 rcs and 200 of 1000 ccs cycles, regardless of the timestamps")
+}
+
+func TestParseDRMMemory(t *testing.T) {
+	for value, expected := range map[string]uint64{
+		"512":     512,
+		"2 KiB":   2048,
+		"3 MiB":   3 << 20,
+		"1 GiB":   1 << 30,
+		"invalid": 0,
+	} {
+		assert.Equal(t, expected, parseDRMMemory(value), value)
+	}
+}
+
+func TestSplitActivePower(t *testing.T) {
+	procs := []ProcessGPUInfo{
+		{PID: 1, DeviceIndex: 0, ComputeUtil: 0.75},
+		{PID: 2, DeviceIndex: 0, ComputeUtil: 0.25},
+		{PID: 3, DeviceIndex: 1},
+		{PID: 4, DeviceIndex: 1},
+		{PID: 5, DeviceIndex: 2, ComputeUtil: 0.5},
+	}
+	power := SplitActivePower(procs, map[int]float64{0: 40, 1: 10})
+
+	assert.Equal(t, map[uint32]float64{1: 30, 2: 10, 3: 5, 4: 5}, power,
+		"power is split by utilization, equally without it, and skipped for unknown devices")
+	assert.Empty(t, SplitActivePower(nil, map[int]float64{0: 40}))
+}
@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gpu
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ProcessExcluder reports whether a process should be excluded from GPU
+// power attribution
+type ProcessExcluder func(pid uint32) bool
+
+// NewProcessExcluder returns a ProcessExcluder that matches the comm or
+// executable path of a process, read from procFSPath, against the given
+// regular expressions. A nil excluder is returned if no patterns are given.
+// Processes whose comm and exe cannot be read are never excluded.
+func NewProcessExcluder(procFSPath string, patterns []string) (ProcessExcluder, error) {
+	if len(patterns) == 0 {
+		return nil, nil
+	}
+
+	regexps := make([]*regexp.Regexp, 0, len(patterns))
+	for _, p := range patterns {
+		re, err := regexp.Compile(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid GPU exclude process pattern %q: %w", p, err)
+		}
+		regexps = append(regexps, re)
+	}
+
+	return func(pid uint32) bool {
+		procDir := filepath.Join(procFSPath, strconv.FormatUint(uint64(pid), 10))
+
+		var names []string
+		if comm, err := os.ReadFile(filepath.Join(procDir, "comm")); err == nil {
+			names = append(names, strings.TrimSpace(string(comm)))
+		}
+		if exe, err := os.Readlink(filepath.Join(procDir, "exe")); err == nil {
+			names = append(names, exe)
+		}
+
+		for _, re := range regexps {
+			for _, name := range names {
+				if re.MatchString(name) {
+					return true
+				}
+			}
+		}
+		return false
+	}, nil
+}
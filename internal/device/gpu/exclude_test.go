@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gpu
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeProc(t *testing.T, procFS, pid, comm, exe string) {
+	t.Helper()
+	dir := filepath.Join(procFS, pid)
+	require.NoError(t, os.MkdirAll(dir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "comm"), []byte(comm+"\n"), 0o644))
+	if exe != "" {
+		require.NoError(t, os.Symlink(exe, filepath.Join(dir, "exe")))
+	}
+}
+
+func TestNewProcessExcluder(t *testing.T) {
+	procFS := t.TempDir()
+	writeProc(t, procFS, "100", "Xorg", "/usr/lib/xorg/Xorg")
+	writeProc(t, procFS, "200", "nvidia-persiste", "/usr/bin/nvidia-persistenced")
+	writeProc(t, procFS, "300", "python3", "/usr/bin/python3.11")
+
+	t.Run("no patterns", func(t *testing.T) {
+		exclude, err := NewProcessExcluder(procFS, nil)
+		require.NoError(t, err)
+		assert.Nil(t, exclude)
+	})
+
+	t.Run("invalid pattern", func(t *testing.T) {
+		_, err := NewProcessExcluder(procFS, []string{"Xorg", "("})
+		assert.ErrorContains(t, err, `invalid GPU exclude process pattern "("`)
+	})
+
+	t.Run("match comm and exe", func(t *testing.T) {
+		exclude, err := NewProcessExcluder(procFS, []string{"^Xorg$", "nvidia-persistenced$"})
+		require.NoError(t, err)
+
+		assert.True(t, exclude(100), "comm should match")
+		assert.True(t, exclude(200), "exe should match when comm is truncated")
+		assert.False(t, exclude(300))
+		assert.False(t, exclude(400), "unknown process must not be excluded")
+	})
+}
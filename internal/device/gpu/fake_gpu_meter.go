@@ -0,0 +1,286 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gpu
+
+import (
+	"sync"
+	"time"
+
+	"github.com/sustainable-computing-io/kepler/internal/device"
+	"k8s.io/utils/clock"
+)
+
+// NOTE: This fake meter is not intended to be used in production and is for testing only
+
+// FakeGPUMeter is a GPUPowerMeter reporting settable readings for a fixed set
+// of devices. Readings not set are 0, except the reliability stats which are
+// reported as unavailable (-1). The power of all devices is shared evenly by
+// the processes set with SetProcesses, and by those set with
+// SetGraphicsProcesses unless the meter is compute-only (the default).
+type FakeGPUMeter struct {
+	mu          sync.RWMutex
+	devices     []GPUDevice
+	power       map[int]device.Power
+	energy      map[int]device.Energy
+	reliability map[int]ReliabilityStats
+	pids        []uint32
+	graphics    []uint32
+	// includeGraphics attributes power to graphics processes as well
+	includeGraphics bool
+
+	// clock integrates the energy from the power when set; see
+	// SetEnergyIntegration
+	clock    clock.PassiveClock
+	lastRead map[int]time.Time
+}
+
+var (
+	_ GPUPowerMeter           = (*FakeGPUMeter)(nil)
+	_ ReliabilityReader       = (*FakeGPUMeter)(nil)
+	_ ComputeOnlyConfigurable = (*FakeGPUMeter)(nil)
+	_ DeviceSelectable        = (*FakeGPUMeter)(nil)
+)
+
+// NewFakeGPUMeter creates a fake GPU meter reporting the given devices
+func NewFakeGPUMeter(devices []GPUDevice) *FakeGPUMeter {
+	reliability := make(map[int]ReliabilityStats, len(devices))
+	for _, dev := range devices {
+		reliability[dev.Index] = ReliabilityStats{FanSpeed: -1, PState: -1}
+	}
+
+	return &FakeGPUMeter{
+		devices:     devices,
+		power:       make(map[int]device.Power),
+		energy:      make(map[int]device.Energy),
+		reliability: reliability,
+	}
+}
+
+// Name returns the name of the fake meter
+func (m *FakeGPUMeter) Name() string {
+	return "fake-gpu-meter"
+}
+
+// Init is a no-op for the fake meter
+func (m *FakeGPUMeter) Init() error {
+	return nil
+}
+
+// Shutdown is a no-op for the fake meter
+func (m *FakeGPUMeter) Shutdown() error {
+	return nil
+}
+
+// Vendor returns the vendor of the first device, or VendorUnknown without devices
+func (m *FakeGPUMeter) Vendor() Vendor {
+	if len(m.devices) == 0 {
+		return VendorUnknown
+	}
+	return m.devices[0].Vendor
+}
+
+// Devices returns the devices of the fake meter
+func (m *FakeGPUMeter) Devices() []GPUDevice {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.devices
+}
+
+// SelectDevices keeps only the devices whose UUID is in uuids and returns
+// the devices that are no longer reported
+func (m *FakeGPUMeter) SelectDevices(uuids []string) []GPUDevice {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	selected, skipped := SelectDevicesByUUID(m.devices, uuids)
+	m.devices = selected
+	return skipped
+}
+
+// SetPower sets the power reported for a device
+func (m *FakeGPUMeter) SetPower(deviceIndex int, power device.Power) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.power[deviceIndex] = power
+}
+
+// SetEnergy sets the cumulative energy reported for a device
+func (m *FakeGPUMeter) SetEnergy(deviceIndex int, energy device.Energy) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.energy[deviceIndex] = energy
+}
+
+// SetEnergyIntegration makes GetTotalEnergy integrate the power of each device
+// over the time elapsed since its previous call, as measured by c, so that
+// energy and power stay consistent whatever the monitor interval. The first
+// call of each device only starts the integration. A nil clock disables it.
+func (m *FakeGPUMeter) SetEnergyIntegration(c clock.PassiveClock) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.clock = c
+	m.lastRead = make(map[int]time.Time)
+}
+
+// SetFanSpeed sets the fan speed in percent reported for a device
+func (m *FakeGPUMeter) SetFanSpeed(deviceIndex int, percent float64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	stats := m.reliability[deviceIndex]
+	stats.FanSpeed = percent
+	m.reliability[deviceIndex] = stats
+}
+
+// SetPState sets the performance state reported for a device
+func (m *FakeGPUMeter) SetPState(deviceIndex int, pstate int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	stats := m.reliability[deviceIndex]
+	stats.PState = pstate
+	m.reliability[deviceIndex] = stats
+}
+
+// SetProcesses sets the PIDs reported as using the GPUs. Using the PIDs of
+// real processes allows validating the process GPU attribution end-to-end.
+func (m *FakeGPUMeter) SetProcesses(pids []uint32) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.pids = pids
+}
+
+// SetGraphicsProcesses sets the PIDs reported as running graphics work on the GPUs
+func (m *FakeGPUMeter) SetGraphicsProcesses(pids []uint32) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.graphics = pids
+}
+
+// SetComputeOnly restricts attribution to the compute processes
+func (m *FakeGPUMeter) SetComputeOnly(computeOnly bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.includeGraphics = !computeOnly
+}
+
+// GetPowerUsage returns the power set for a device
+func (m *FakeGPUMeter) GetPowerUsage(deviceIndex int) (device.Power, error) {
+	if err := m.checkDevice(deviceIndex); err != nil {
+		return 0, err
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.power[deviceIndex], nil
+}
+
+// GetTotalEnergy returns the energy set for a device, plus the energy
+// integrated from its power if energy integration is enabled
+func (m *FakeGPUMeter) GetTotalEnergy(deviceIndex int) (device.Energy, error) {
+	if err := m.checkDevice(deviceIndex); err != nil {
+		return 0, err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.clock != nil {
+		now := m.clock.Now()
+		if last, ok := m.lastRead[deviceIndex]; ok {
+			elapsed := now.Sub(last).Seconds()
+			m.energy[deviceIndex] += device.Energy(m.power[deviceIndex].Watts() * elapsed * float64(device.Joule))
+		}
+		m.lastRead[deviceIndex] = now
+	}
+	return m.energy[deviceIndex], nil
+}
+
+// GetDevicePowerStats reports the power set for a device as active power
+func (m *FakeGPUMeter) GetDevicePowerStats(deviceIndex int) (GPUPowerStats, error) {
+	power, err := m.GetPowerUsage(deviceIndex)
+	if err != nil {
+		return GPUPowerStats{}, err
+	}
+	return GPUPowerStats{
+		TotalPower:  power.Watts(),
+		ActivePower: power.Watts(),
+	}, nil
+}
+
+// GetDeviceReliabilityStats returns the fan speed and performance state set for a device
+func (m *FakeGPUMeter) GetDeviceReliabilityStats(deviceIndex int) (ReliabilityStats, error) {
+	if err := m.checkDevice(deviceIndex); err != nil {
+		return ReliabilityStats{}, err
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.reliability[deviceIndex], nil
+}
+
+// GetProcessPower shares the power of all devices evenly among the processes
+func (m *FakeGPUMeter) GetProcessPower() (map[uint32]float64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	procs := m.processes()
+	result := make(map[uint32]float64, len(procs))
+	if len(procs) == 0 {
+		return result, nil
+	}
+
+	var total device.Power
+	for _, dev := range m.devices {
+		total += m.power[dev.Index]
+	}
+	share := total.Watts() / float64(len(procs))
+	for _, p := range procs {
+		result[p.PID] = share
+	}
+	return result, nil
+}
+
+// GetProcessInfo reports the processes on the first device with an even
+// share of its compute utilization
+func (m *FakeGPUMeter) GetProcessInfo() ([]ProcessGPUInfo, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	procs := m.processes()
+	if len(procs) == 0 || len(m.devices) == 0 {
+		return nil, nil
+	}
+
+	dev := m.devices[0]
+	infos := make([]ProcessGPUInfo, 0, len(procs))
+	for _, p := range procs {
+		p.DeviceIndex = dev.Index
+		p.DeviceUUID = dev.UUID
+		p.ComputeUtil = 1 / float64(len(procs))
+		infos = append(infos, p)
+	}
+	return infos, nil
+}
+
+// processes returns the processes power is attributed to; callers must hold mu
+func (m *FakeGPUMeter) processes() []ProcessGPUInfo {
+	compute := make([]ProcessGPUInfo, 0, len(m.pids))
+	for _, pid := range m.pids {
+		compute = append(compute, ProcessGPUInfo{PID: pid, Type: ProcessTypeCompute})
+	}
+	if !m.includeGraphics {
+		return compute
+	}
+
+	graphics := make([]ProcessGPUInfo, 0, len(m.graphics))
+	for _, pid := range m.graphics {
+		graphics = append(graphics, ProcessGPUInfo{PID: pid, Type: ProcessTypeGraphics})
+	}
+	return MergeProcesses(compute, graphics)
+}
+
+func (m *FakeGPUMeter) checkDevice(deviceIndex int) error {
+	for _, dev := range m.devices {
+		if dev.Index == deviceIndex {
+			return nil
+		}
+	}
+	return ErrGPUNotFound{DeviceIndex: deviceIndex}
+}
@@ -0,0 +1,156 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gpu
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/sustainable-computing-io/kepler/internal/device"
+	testingclock "k8s.io/utils/clock/testing"
+)
+
+func TestFakeGPUMeter(t *testing.T) {
+	meter := NewFakeGPUMeter([]GPUDevice{
+		{Index: 0, UUID: "GPU-fake-0", Name: "Fake GPU", Vendor: VendorNVIDIA},
+	})
+	require.NoError(t, meter.Init())
+	assert.Equal(t, VendorNVIDIA, meter.Vendor())
+
+	stats, err := meter.GetDeviceReliabilityStats(0)
+	require.NoError(t, err)
+	assert.Equal(t, ReliabilityStats{FanSpeed: -1, PState: -1}, stats, "readings not set must be unavailable")
+
+	meter.SetPower(0, 120*device.Watt)
+	meter.SetEnergy(0, 5000*device.Joule)
+	meter.SetFanSpeed(0, 40)
+	meter.SetPState(0, 2)
+
+	powerStats, err := meter.GetDevicePowerStats(0)
+	require.NoError(t, err)
+	assert.Equal(t, 120.0, powerStats.TotalPower)
+
+	energy, err := meter.GetTotalEnergy(0)
+	require.NoError(t, err)
+	assert.Equal(t, 5000*device.Joule, energy)
+
+	stats, err = meter.GetDeviceReliabilityStats(0)
+	require.NoError(t, err)
+	assert.Equal(t, ReliabilityStats{FanSpeed: 40, PState: 2}, stats)
+
+	power, err := meter.GetProcessPower()
+	require.NoError(t, err)
+	assert.Empty(t, power, "no processes are reported unless set")
+
+	meter.SetProcesses([]uint32{100, 200})
+	power, err = meter.GetProcessPower()
+	require.NoError(t, err)
+	assert.Equal(t, map[uint32]float64{100: 60, 200: 60}, power)
+
+	infos, err := meter.GetProcessInfo()
+	require.NoError(t, err)
+	require.Len(t, infos, 2)
+	assert.Equal(t, "GPU-fake-0", infos[0].DeviceUUID)
+	assert.InDelta(t, 0.5, infos[0].ComputeUtil, 1e-9)
+
+	_, err = meter.GetPowerUsage(1)
+	assert.ErrorAs(t, err, &ErrGPUNotFound{})
+}
+
+func TestFakeGPUMeter_SelectDevices(t *testing.T) {
+	meter := NewFakeGPUMeter([]GPUDevice{
+		{Index: 0, UUID: "GPU-fake-0", Vendor: VendorNVIDIA},
+		{Index: 1, UUID: "GPU-fake-1", Vendor: VendorNVIDIA},
+		{Index: 2, UUID: "GPU-fake-2", Vendor: VendorNVIDIA},
+	})
+	require.NoError(t, meter.Init())
+
+	skipped := meter.SelectDevices([]string{"GPU-fake-2", "GPU-fake-1", "GPU-missing"})
+	require.Len(t, skipped, 1)
+	assert.Equal(t, 0, skipped[0].Index)
+
+	devices := meter.Devices()
+	require.Len(t, devices, 2)
+	assert.Equal(t, 1, devices[0].Index, "UUIDs resolve to the discovered device indices")
+	assert.Equal(t, 2, devices[1].Index)
+
+	meter.SetPower(2, 50*device.Watt)
+	power, err := meter.GetPowerUsage(2)
+	require.NoError(t, err)
+	assert.Equal(t, 50*device.Watt, power)
+
+	_, err = meter.GetPowerUsage(0)
+	assert.ErrorIs(t, err, ErrGPUNotFound{DeviceIndex: 0}, "unselected devices are not reported")
+}
+
+func TestFakeGPUMeter_ComputeOnly(t *testing.T) {
+	meter := NewFakeGPUMeter([]GPUDevice{
+		{Index: 0, UUID: "GPU-fake-0", Vendor: VendorNVIDIA},
+	})
+	meter.SetPower(0, 90*device.Watt)
+	meter.SetProcesses([]uint32{100})
+	meter.SetGraphicsProcesses([]uint32{200, 300})
+
+	t.Run("graphics processes are excluded by default", func(t *testing.T) {
+		power, err := meter.GetProcessPower()
+		require.NoError(t, err)
+		assert.Equal(t, map[uint32]float64{100: 90}, power)
+
+		infos, err := meter.GetProcessInfo()
+		require.NoError(t, err)
+		require.Len(t, infos, 1)
+		assert.Equal(t, ProcessTypeCompute, infos[0].Type)
+	})
+
+	t.Run("graphics processes are included when not compute-only", func(t *testing.T) {
+		meter.SetComputeOnly(false)
+		defer meter.SetComputeOnly(true)
+
+		power, err := meter.GetProcessPower()
+		require.NoError(t, err)
+		assert.Equal(t, map[uint32]float64{100: 30, 200: 30, 300: 30}, power)
+
+		infos, err := meter.GetProcessInfo()
+		require.NoError(t, err)
+		require.Len(t, infos, 3)
+		assert.Equal(t, ProcessTypeGraphics, infos[2].Type)
+	})
+}
+
+func TestFakeGPUMeter_EnergyIntegration(t *testing.T) {
+	meter := NewFakeGPUMeter([]GPUDevice{
+		{Index: 0, UUID: "GPU-fake-0", Name: "Fake GPU", Vendor: VendorNVIDIA},
+	})
+	fakeClock := testingclock.NewFakeClock(time.Now())
+	meter.SetEnergyIntegration(fakeClock)
+	meter.SetPower(0, 100*device.Watt)
+
+	energy, err := meter.GetTotalEnergy(0)
+	require.NoError(t, err)
+	assert.Zero(t, energy, "the first read only starts the integration")
+
+	expected := device.Energy(0)
+	for _, interval := range []time.Duration{time.Second, 5 * time.Second, 250 * time.Millisecond, 30 * time.Second} {
+		fakeClock.Step(interval)
+		expected += device.Energy(100 * interval.Seconds() * float64(device.Joule))
+
+		energy, err := meter.GetTotalEnergy(0)
+		require.NoError(t, err)
+		assert.InDelta(t, expected.Joules(), energy.Joules(), 1e-6, "energy must be power x elapsed after %v", interval)
+	}
+
+	t.Run("power changes apply from the next interval", func(t *testing.T) {
+		before, err := meter.GetTotalEnergy(0)
+		require.NoError(t, err)
+
+		meter.SetPower(0, 40*device.Watt)
+		fakeClock.Step(2 * time.Second)
+
+		after, err := meter.GetTotalEnergy(0)
+		require.NoError(t, err)
+		assert.InDelta(t, 80.0, (after - before).Joules(), 1e-6)
+	})
+}
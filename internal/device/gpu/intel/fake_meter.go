@@ -0,0 +1,38 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package intel
+
+import (
+	"fmt"
+
+	"k8s.io/utils/clock"
+
+	"github.com/sustainable-computing-io/kepler/internal/device"
+	"github.com/sustainable-computing-io/kepler/internal/device/gpu"
+)
+
+// NOTE: This fake meter is not intended to be used in production and is for testing only
+
+// NewFakeMeter returns a fake meter reporting the given number of Intel GPUs,
+// each drawing the given power. Like the hwmon energy counter of the real
+// cards, the energy is integrated from the power over the time measured by c.
+// The processes sharing the power are set with SetProcesses.
+func NewFakeMeter(cards int, power device.Power, c clock.PassiveClock) *gpu.FakeGPUMeter {
+	devices := make([]gpu.GPUDevice, 0, cards)
+	for i := range cards {
+		devices = append(devices, gpu.GPUDevice{
+			Index:  i,
+			UUID:   fmt.Sprintf("GPU-0000:%02x:00.0", 0x4d+i),
+			Name:   "Intel GPU (fake)",
+			Vendor: gpu.VendorIntel,
+		})
+	}
+
+	meter := gpu.NewFakeGPUMeter(devices)
+	meter.SetEnergyIntegration(c)
+	for _, dev := range devices {
+		meter.SetPower(dev.Index, power)
+	}
+	return meter
+}
@@ -0,0 +1,415 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package intel
+
+import (
+	"fmt"
+	"log/slog"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+
+	"github.com/sustainable-computing-io/kepler/internal/device"
+	"github.com/sustainable-computing-io/kepler/internal/device/gpu"
+)
+
+// intelPCIVendorID is the PCI vendor ID of Intel devices
+const intelPCIVendorID = "0x8086"
+
+// Factory returns a gpu.Factory creating meters that read the Intel GPUs from
+// the given sysfs and the process utilization from the given procfs. Unlike
+// the NVIDIA backend, it is not registered on import since the paths come from
+// the configuration.
+func Factory(sysfsPath, procfsPath string) gpu.Factory {
+	return func(logger *slog.Logger) (gpu.GPUPowerMeter, error) {
+		return NewIntelGPUPowerMeter(logger, sysfsPath, procfsPath), nil
+	}
+}
+
+// drivers are the DRM drivers of Intel discrete GPUs and how their clients
+// report their engine usage in fdinfo: i915 reports the busy time of each
+// engine and xe the busy GPU cycles of each engine class
+var drivers = map[string]gpu.DRMDriver{
+	"i915": {
+		Name:    "i915",
+		Engines: []string{"render", "compute", "video", "video-enhance", "copy"},
+		Memory:  "drm-total-local0",
+	},
+	"xe": {
+		Name:   "xe",
+		Cycles: []string{"rcs", "ccs", "vcs", "vecs", "bcs"},
+		Memory: "drm-total-vram0",
+	},
+}
+
+// intelDevice is an Intel GPU discovered under /sys/class/drm
+type intelDevice struct {
+	gpu.GPUDevice
+
+	driver     string // i915 or xe
+	pciAddress string // e.g. 0000:4d:00.0, matched against drm-pdev in fdinfo
+	energyPath string // hwmon energy counter in microjoules
+}
+
+// energySample is an energy counter reading, used to derive the power since
+type energySample struct {
+	energy    device.Energy
+	timestamp time.Time
+}
+
+// IntelGPUPowerMeter implements gpu.GPUPowerMeter for Intel discrete GPUs,
+// e.g. Data Center GPU Flex and Max, driven by the i915 or xe kernel driver.
+// Energy is read from the RAPL-style hwmon energy counter of each card and
+// power is derived from it. Power is attributed to processes by their share
+// of the engine busy time or cycles reported in /proc/<pid>/fdinfo.
+type IntelGPUPowerMeter struct {
+	logger   *slog.Logger
+	drmPath  string // /sys/class/drm
+	procPath string // /proc
+	clock    clock.PassiveClock
+
+	mu      sync.RWMutex
+	devices []intelDevice
+
+	// samples are the previous energy reading of each device and power the
+	// power derived from the last two readings
+	samples map[int]energySample
+	power   map[int]device.Power
+
+	// idlePower is a user-configured idle power in Watts. 0 reports all power
+	// as active.
+	idlePower float64
+
+	// usage is the engine usage of the processes read by the previous
+	// attribution, per driver
+	usage map[string]*gpu.DRMUsage
+
+	// procs are the processes and their utilization observed by the last
+	// attribution; nil until the first one
+	procs []gpu.ProcessGPUInfo
+}
+
+// NewIntelGPUPowerMeter creates a meter for the Intel GPUs under
+// <sysfs>/class/drm
+func NewIntelGPUPowerMeter(logger *slog.Logger, sysfsPath, procfsPath string) *IntelGPUPowerMeter {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &IntelGPUPowerMeter{
+		logger:   logger.With("component", "intel-gpu-meter"),
+		drmPath:  filepath.Join(sysfsPath, "class", "drm"),
+		procPath: procfsPath,
+		clock:    clock.RealClock{},
+		samples:  make(map[int]energySample),
+		power:    make(map[int]device.Power),
+		usage:    make(map[string]*gpu.DRMUsage),
+	}
+}
+
+// Name returns the service name
+func (m *IntelGPUPowerMeter) Name() string {
+	return "intel-gpu-power-meter"
+}
+
+// Init discovers the Intel GPUs that report their energy
+func (m *IntelGPUPowerMeter) Init() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	devices, err := m.discoverDevices()
+	if err != nil {
+		return err
+	}
+	m.devices = devices
+	return nil
+}
+
+// Shutdown is a no-op; sysfs requires no cleanup
+func (m *IntelGPUPowerMeter) Shutdown() error {
+	return nil
+}
+
+// Vendor returns the GPU vendor
+func (m *IntelGPUPowerMeter) Vendor() gpu.Vendor {
+	return gpu.VendorIntel
+}
+
+// Devices returns all discovered GPU devices
+func (m *IntelGPUPowerMeter) Devices() []gpu.GPUDevice {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	devices := make([]gpu.GPUDevice, len(m.devices))
+	for i, d := range m.devices {
+		devices[i] = d.GPUDevice
+	}
+	return devices
+}
+
+func (m *IntelGPUPowerMeter) discoverDevices() ([]intelDevice, error) {
+	cards, err := gpu.DRMCards(m.drmPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var devices []intelDevice
+	for _, card := range cards {
+		devicePath := filepath.Join(m.drmPath, card, "device")
+		if gpu.ReadAttribute(filepath.Join(devicePath, "vendor")) != intelPCIVendorID {
+			continue
+		}
+
+		driver := driverName(devicePath)
+		if _, ok := drivers[driver]; !ok {
+			m.logger.Debug("skipping Intel GPU with unsupported driver", "card", card, "driver", driver)
+			continue
+		}
+
+		// integrated GPUs have no hwmon energy counter; their energy is
+		// part of the RAPL package zone
+		energyPath, err := findEnergyFile(devicePath)
+		if err != nil {
+			m.logger.Debug("skipping Intel GPU without energy counter", "card", card, "error", err)
+			continue
+		}
+
+		pci := gpu.PCIAddress(devicePath)
+		dev := intelDevice{
+			GPUDevice: gpu.GPUDevice{
+				Index:  len(devices),
+				UUID:   "GPU-" + pci,
+				Name:   "Intel GPU " + gpu.ReadAttribute(filepath.Join(devicePath, "device")),
+				Vendor: gpu.VendorIntel,
+			},
+			driver:     driver,
+			pciAddress: pci,
+			energyPath: energyPath,
+		}
+
+		m.logger.Info("Intel GPU discovered",
+			"card", card, "index", dev.Index, "uuid", dev.UUID, "driver", driver)
+		devices = append(devices, dev)
+	}
+	return devices, nil
+}
+
+// findEnergyFile returns the hwmon energy counter of a card. i915 reports a
+// single energy1_input; xe reports one per label, of which "card" covers the
+// whole card and "pkg" only the GPU package.
+func findEnergyFile(devicePath string) (string, error) {
+	inputs, _ := filepath.Glob(filepath.Join(devicePath, "hwmon", "hwmon*", "energy*_input"))
+	if len(inputs) == 0 {
+		return "", fmt.Errorf("no hwmon energy counter found in %s", devicePath)
+	}
+	sort.Strings(inputs)
+
+	labels := make(map[string]string, len(inputs))
+	for _, input := range inputs {
+		label := gpu.ReadAttribute(strings.TrimSuffix(input, "_input") + "_label")
+		labels[label] = input
+	}
+	for _, label := range []string{"card", "pkg"} {
+		if input, ok := labels[label]; ok {
+			return input, nil
+		}
+	}
+	return inputs[0], nil
+}
+
+// driverName returns the name of the kernel driver bound to a device
+func driverName(devicePath string) string {
+	driver, err := filepath.EvalSymlinks(filepath.Join(devicePath, "driver"))
+	if err != nil {
+		return ""
+	}
+	return filepath.Base(driver)
+}
+
+// device returns the device with the given index
+// NOTE: caller must hold m.mu lock
+func (m *IntelGPUPowerMeter) device(deviceIndex int) (intelDevice, error) {
+	if deviceIndex < 0 || deviceIndex >= len(m.devices) {
+		return intelDevice{}, fmt.Errorf("invalid GPU device index %d", deviceIndex)
+	}
+	return m.devices[deviceIndex], nil
+}
+
+// GetTotalEnergy returns the energy counter of a device
+func (m *IntelGPUPowerMeter) GetTotalEnergy(deviceIndex int) (device.Energy, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	dev, err := m.device(deviceIndex)
+	if err != nil {
+		return 0, err
+	}
+	return readEnergy(dev.energyPath)
+}
+
+func readEnergy(path string) (device.Energy, error) {
+	value := gpu.ReadAttribute(path)
+	microjoules, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse energy from %s: %w", path, err)
+	}
+	return device.Energy(microjoules), nil
+}
+
+// GetPowerUsage returns the power of a device derived by the last call to
+// GetDevicePowerStats
+func (m *IntelGPUPowerMeter) GetPowerUsage(deviceIndex int) (device.Power, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if _, err := m.device(deviceIndex); err != nil {
+		return 0, err
+	}
+	return m.power[deviceIndex], nil
+}
+
+// GetDevicePowerStats returns the power of a device since the previous call,
+// derived from its energy counter, split into idle and active power when an
+// idle power is configured. The first call, and the first after the counter
+// went backwards, e.g. on a driver reload, only record the energy and report
+// no power. Device utilization is not available.
+func (m *IntelGPUPowerMeter) GetDevicePowerStats(deviceIndex int) (gpu.GPUPowerStats, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	dev, err := m.device(deviceIndex)
+	if err != nil {
+		return gpu.GPUPowerStats{}, err
+	}
+
+	energy, err := readEnergy(dev.energyPath)
+	if err != nil {
+		return gpu.GPUPowerStats{}, err
+	}
+
+	now := m.clock.Now()
+	if prev, ok := m.samples[deviceIndex]; ok {
+		if energy < prev.energy {
+			// the counter was reset; the power since the previous reading is
+			// unknown, so start over from this reading
+			m.logger.Debug("GPU energy counter went backwards, resetting baseline",
+				"device", deviceIndex, "previous", prev.energy, "current", energy)
+			delete(m.power, deviceIndex)
+		} else if elapsed := now.Sub(prev.timestamp).Seconds(); elapsed > 0 {
+			m.power[deviceIndex] = device.Power(float64(energy-prev.energy) / elapsed)
+		}
+	}
+	m.samples[deviceIndex] = energySample{energy: energy, timestamp: now}
+
+	return m.powerStats(deviceIndex), nil
+}
+
+// powerStats returns the power of a device derived by the last reading split
+// into idle and active power
+// NOTE: caller must hold m.mu lock
+func (m *IntelGPUPowerMeter) powerStats(deviceIndex int) gpu.GPUPowerStats {
+	total := m.power[deviceIndex].Watts()
+	idle := min(m.idlePower, total)
+	return gpu.GPUPowerStats{
+		TotalPower:  total,
+		IdlePower:   idle,
+		ActivePower: total - idle,
+	}
+}
+
+// SetIdlePower sets the configured idle power in Watts. Negative values are
+// clamped to 0.
+func (m *IntelGPUPowerMeter) SetIdlePower(watts float64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.idlePower = max(watts, 0)
+}
+
+// GetProcessPower splits the active power of each device, as derived by the
+// last call to GetDevicePowerStats, among its processes in proportion to their
+// engine usage since the previous call, so that the power of the processes
+// of a device sums to its active power. The first call only records the usage
+// and returns no power.
+func (m *IntelGPUPowerMeter) GetProcessPower() (map[uint32]float64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.procs = m.processUtilization()
+
+	activePower := make(map[int]float64)
+	for _, p := range m.procs {
+		activePower[p.DeviceIndex] = m.powerStats(p.DeviceIndex).ActivePower
+	}
+	return gpu.SplitActivePower(m.procs, activePower), nil
+}
+
+// GetProcessInfo returns the processes using the GPUs with their engine
+// utilization observed by the last GetProcessPower call, so that both share
+// one scan of /proc. Before the first attribution, the processes are scanned.
+func (m *IntelGPUPowerMeter) GetProcessInfo() ([]gpu.ProcessGPUInfo, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.procs == nil {
+		m.procs = m.processUtilization()
+	}
+	return slices.Clone(m.procs), nil
+}
+
+// processUtilization reads the engine usage of the processes of each
+// driver in use and returns their utilization of each device since the
+// previous read
+// NOTE: caller must hold m.mu lock
+func (m *IntelGPUPowerMeter) processUtilization() []gpu.ProcessGPUInfo {
+	devByPCI := make(map[string]intelDevice, len(m.devices))
+	inUse := make(map[string]bool)
+	for _, d := range m.devices {
+		devByPCI[d.pciAddress] = d
+		if driver := drivers[d.driver]; len(driver.Engines) > 0 || len(driver.Cycles) > 0 {
+			inUse[d.driver] = true
+		}
+	}
+
+	now := m.clock.Now()
+	procs := make([]gpu.ProcessGPUInfo, 0)
+	for driver := range inUse {
+		current := gpu.ReadDRMUsage(m.procPath, drivers[driver], now)
+		utilization := current.Utilization(m.usage[driver])
+		m.usage[driver] = current
+
+		for key, util := range utilization {
+			dev, ok := devByPCI[key.PCIAddress]
+			if !ok {
+				continue
+			}
+			procs = append(procs, gpu.ProcessGPUInfo{
+				PID:         key.PID,
+				DeviceIndex: dev.Index,
+				DeviceUUID:  dev.UUID,
+				Type:        gpu.ProcessTypeCompute,
+				ComputeUtil: util,
+				MemoryUsed:  current.Memory[key],
+				Timestamp:   now,
+			})
+		}
+	}
+	sort.Slice(procs, func(i, j int) bool {
+		if procs[i].DeviceIndex != procs[j].DeviceIndex {
+			return procs[i].DeviceIndex < procs[j].DeviceIndex
+		}
+		return procs[i].PID < procs[j].PID
+	})
+	return procs
+}
+
+// Ensure IntelGPUPowerMeter implements gpu.GPUPowerMeter
+var (
+	_ gpu.GPUPowerMeter         = (*IntelGPUPowerMeter)(nil)
+	_ gpu.IdlePowerConfigurable = (*IntelGPUPowerMeter)(nil)
+)
@@ -0,0 +1,279 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package intel
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	testingclock "k8s.io/utils/clock/testing"
+
+	"github.com/sustainable-computing-io/kepler/internal/device"
+	"github.com/sustainable-computing-io/kepler/internal/device/gpu"
+)
+
+// fakeSysfs builds a sysfs tree with Intel GPUs for tests
+type fakeSysfs struct {
+	t    *testing.T
+	root string
+}
+
+func newFakeSysfs(t *testing.T) *fakeSysfs {
+	return &fakeSysfs{t: t, root: t.TempDir()}
+}
+
+func (fs *fakeSysfs) write(path, content string) {
+	fs.t.Helper()
+	require.NoError(fs.t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(fs.t, os.WriteFile(path, []byte(content+"\n"), 0o644))
+}
+
+// pciPath returns the sysfs directory of a PCI device
+func (fs *fakeSysfs) pciPath(pciAddress string) string {
+	return filepath.Join(fs.root, "devices", "pci0000:00", pciAddress)
+}
+
+// addCard adds a DRM card bound to driver whose device symlink points to a
+// PCI device, like /sys/class/drm/card0/device -> 0000:4d:00.0
+func (fs *fakeSysfs) addCard(card, pciAddress, vendor, driver string) {
+	fs.t.Helper()
+	pciPath := fs.pciPath(pciAddress)
+	fs.write(filepath.Join(pciPath, "vendor"), vendor)
+	fs.write(filepath.Join(pciPath, "device"), "0x56c0")
+
+	driverPath := filepath.Join(fs.root, "bus", "pci", "drivers", driver)
+	require.NoError(fs.t, os.MkdirAll(driverPath, 0o755))
+	require.NoError(fs.t, os.Symlink(driverPath, filepath.Join(pciPath, "driver")))
+
+	cardPath := filepath.Join(fs.root, "class", "drm", card)
+	require.NoError(fs.t, os.MkdirAll(cardPath, 0o755))
+	require.NoError(fs.t, os.Symlink(pciPath, filepath.Join(cardPath, "device")))
+}
+
+// setEnergy sets an hwmon energy counter of a PCI device, with an optional label
+func (fs *fakeSysfs) setEnergy(pciAddress string, n int, label string, energy device.Energy) {
+	fs.t.Helper()
+	hwmon := filepath.Join(fs.pciPath(pciAddress), "hwmon", "hwmon4")
+	fs.write(filepath.Join(hwmon, fmt.Sprintf("energy%d_input", n)), fmt.Sprint(uint64(energy)))
+	if label != "" {
+		fs.write(filepath.Join(hwmon, fmt.Sprintf("energy%d_label", n)), label)
+	}
+}
+
+const (
+	flexPCI = "0000:4d:00.0" // i915
+	xePCI   = "0000:8a:00.0" // xe
+)
+
+// newTestSysfs returns a node with a Flex GPU driven by i915, a GPU driven
+// by xe, an integrated GPU and a GPU of another vendor
+func newTestSysfs(t *testing.T) *fakeSysfs {
+	fs := newFakeSysfs(t)
+
+	fs.addCard("card1", flexPCI, "0x8086", "i915")
+	fs.setEnergy(flexPCI, 1, "", 1000*device.Joule)
+
+	fs.addCard("card2", xePCI, "0x8086", "xe")
+	fs.setEnergy(xePCI, 1, "pkg", 500*device.Joule)
+	fs.setEnergy(xePCI, 2, "card", 800*device.Joule)
+
+	// integrated GPU without energy counter
+	fs.addCard("card0", "0000:00:02.0", "0x8086", "i915")
+	fs.addCard("card3", "0000:c1:00.0", "0x1002", "amdgpu")
+	return fs
+}
+
+func TestIntelGPUPowerMeter_Discovery(t *testing.T) {
+	meter := NewIntelGPUPowerMeter(nil, newTestSysfs(t).root, t.TempDir())
+	require.NoError(t, meter.Init())
+	defer func() { assert.NoError(t, meter.Shutdown()) }()
+
+	assert.Equal(t, gpu.VendorIntel, meter.Vendor())
+
+	devices := meter.Devices()
+	require.Len(t, devices, 2)
+	assert.Equal(t, gpu.GPUDevice{Index: 0, UUID: "GPU-" + flexPCI, Name: "Intel GPU 0x56c0", Vendor: gpu.VendorIntel}, devices[0])
+	assert.Equal(t, "GPU-"+xePCI, devices[1].UUID)
+
+	energy, err := meter.GetTotalEnergy(0)
+	require.NoError(t, err)
+	assert.Equal(t, 1000*device.Joule, energy)
+
+	energy, err = meter.GetTotalEnergy(1)
+	require.NoError(t, err)
+	assert.Equal(t, 800*device.Joule, energy, "xe card energy covers the whole card")
+
+	_, err = meter.GetTotalEnergy(2)
+	assert.Error(t, err)
+}
+
+func TestIntelGPUPowerMeter_NoDRM(t *testing.T) {
+	meter := NewIntelGPUPowerMeter(nil, t.TempDir(), t.TempDir())
+	assert.Error(t, meter.Init())
+}
+
+func TestIntelGPUPowerMeter_Power(t *testing.T) {
+	fs := newTestSysfs(t)
+	meter := NewIntelGPUPowerMeter(nil, fs.root, t.TempDir())
+	fakeClock := testingclock.NewFakePassiveClock(time.Now())
+	meter.clock = fakeClock
+	require.NoError(t, meter.Init())
+
+	stats, err := meter.GetDevicePowerStats(0)
+	require.NoError(t, err)
+	assert.Equal(t, gpu.GPUPowerStats{}, stats, "the first read only records the energy")
+
+	fakeClock.SetTime(fakeClock.Now().Add(2 * time.Second))
+	fs.setEnergy(flexPCI, 1, "", 1300*device.Joule)
+
+	stats, err = meter.GetDevicePowerStats(0)
+	require.NoError(t, err)
+	assert.Equal(t, 150.0, stats.TotalPower)
+	assert.Equal(t, 150.0, stats.ActivePower)
+	assert.Zero(t, stats.Utilization)
+
+	power, err := meter.GetPowerUsage(0)
+	require.NoError(t, err)
+	assert.Equal(t, 150*device.Watt, power)
+
+	meter.SetIdlePower(40)
+	fakeClock.SetTime(fakeClock.Now().Add(time.Second))
+	fs.setEnergy(flexPCI, 1, "", 1400*device.Joule)
+
+	stats, err = meter.GetDevicePowerStats(0)
+	require.NoError(t, err)
+	assert.Equal(t, 100.0, stats.TotalPower)
+	assert.Equal(t, 40.0, stats.IdlePower)
+	assert.Equal(t, 60.0, stats.ActivePower)
+
+	// a counter reset starts over instead of reporting the previous power
+	fakeClock.SetTime(fakeClock.Now().Add(time.Second))
+	fs.setEnergy(flexPCI, 1, "", 10*device.Joule)
+
+	stats, err = meter.GetDevicePowerStats(0)
+	require.NoError(t, err)
+	assert.Equal(t, gpu.GPUPowerStats{}, stats, "the power since the reset is unknown")
+
+	fakeClock.SetTime(fakeClock.Now().Add(time.Second))
+	fs.setEnergy(flexPCI, 1, "", 90*device.Joule)
+
+	stats, err = meter.GetDevicePowerStats(0)
+	require.NoError(t, err)
+	assert.Equal(t, 80.0, stats.TotalPower)
+}
+
+func TestIntelGPUPowerMeter_ProcessAttribution(t *testing.T) {
+	fs := newTestSysfs(t)
+	procfs := t.TempDir()
+	meter := NewIntelGPUPowerMeter(nil, fs.root, procfs)
+	fakeClock := testingclock.NewFakePassiveClock(time.Now())
+	meter.clock = fakeClock
+	require.NoError(t, meter.Init())
+
+	addClient := func(pid int, driver, pci string, renderNs, computeNs uint64) {
+		path := filepath.Join(procfs, fmt.Sprint(pid), "fdinfo", "7")
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, fmt.Appendf(nil,
+			"drm-driver:\t%s\ndrm-client-id:\t%d\ndrm-pdev:\t%s\ndrm-total-local0:\t16 MiB\n"+
+				"drm-engine-render:\t%d ns\ndrm-engine-compute:\t%d ns\n",
+			driver, pid, pci, renderNs, computeNs), 0o644))
+	}
+
+	// xe clients report the busy cycles of each engine class instead
+	addXeClient := func(pid int, rcsCycles, totalCycles uint64) {
+		path := filepath.Join(procfs, fmt.Sprint(pid), "fdinfo", "7")
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, fmt.Appendf(nil,
+			"drm-driver:\txe\ndrm-client-id:\t%d\ndrm-pdev:\t%s\ndrm-total-vram0:\t8 MiB\n"+
+				"drm-cycles-rcs:\t%d\ndrm-total-cycles-rcs:\t%d\n",
+			pid, xePCI, rcsCycles, totalCycles), 0o644))
+	}
+
+	read := func(step time.Duration, flexEnergy, xeEnergy device.Energy) map[uint32]float64 {
+		fakeClock.SetTime(fakeClock.Now().Add(step))
+		fs.setEnergy(flexPCI, 1, "", flexEnergy)
+		fs.setEnergy(xePCI, 2, "card", xeEnergy)
+		_, err := meter.GetDevicePowerStats(0)
+		require.NoError(t, err)
+		_, err = meter.GetDevicePowerStats(1)
+		require.NoError(t, err)
+		power, err := meter.GetProcessPower()
+		require.NoError(t, err)
+		return power
+	}
+
+	addClient(10, "i915", flexPCI, 0, 0)
+	addClient(11, "i915", flexPCI, 0, 0)
+	addXeClient(20, 0, 1_000_000)
+	addXeClient(21, 0, 1_000_000)
+	assert.Empty(t, read(0, 1000*device.Joule, 800*device.Joule), "the first read only records the busy time")
+
+	// 100 W over 1s, pid 10 keeps the engines busy for 600ms and pid 11 for
+	// 200ms; the power is split 3:1 so that it sums to the device power
+	addClient(10, "i915", flexPCI, 400_000_000, 200_000_000)
+	addClient(11, "i915", flexPCI, 200_000_000, 0)
+	// 40 W on the xe GPU, pid 20 is busy for 90% of the cycles and pid 21
+	// for 10%
+	addXeClient(20, 900_000, 2_000_000)
+	addXeClient(21, 100_000, 2_000_000)
+	power := read(time.Second, 1100*device.Joule, 840*device.Joule)
+	require.Len(t, power, 4)
+	assert.InDelta(t, 75.0, power[10], 1e-9)
+	assert.InDelta(t, 25.0, power[11], 1e-9)
+	assert.InDelta(t, 36.0, power[20], 1e-9)
+	assert.InDelta(t, 4.0, power[21], 1e-9)
+
+	// the process info shares the scan of the last attribution
+	fakeClock.SetTime(fakeClock.Now().Add(time.Second))
+	addClient(10, "i915", flexPCI, 600_000_000, 200_000_000)
+	procs, err := meter.GetProcessInfo()
+	require.NoError(t, err)
+	require.Len(t, procs, 4)
+	assert.Equal(t, uint32(10), procs[0].PID)
+	assert.Equal(t, "GPU-"+flexPCI, procs[0].DeviceUUID)
+	assert.InDelta(t, 0.6, procs[0].ComputeUtil, 1e-9)
+	assert.Equal(t, uint64(16<<20), procs[0].MemoryUsed)
+	assert.Equal(t, uint32(11), procs[1].PID)
+	assert.InDelta(t, 0.2, procs[1].ComputeUtil, 1e-9)
+	assert.Equal(t, uint32(20), procs[2].PID)
+	assert.Equal(t, 1, procs[2].DeviceIndex)
+	assert.InDelta(t, 0.9, procs[2].ComputeUtil, 1e-9)
+	assert.Equal(t, uint64(8<<20), procs[2].MemoryUsed)
+}
+
+func TestFactory(t *testing.T) {
+	meter, err := Factory(newTestSysfs(t).root, t.TempDir())(nil)
+	require.NoError(t, err)
+	require.NoError(t, meter.Init())
+	assert.Len(t, meter.Devices(), 2)
+}
+
+func TestNewFakeMeter(t *testing.T) {
+	fakeClock := testingclock.NewFakePassiveClock(time.Now())
+	meter := NewFakeMeter(2, 50*device.Watt, fakeClock)
+	require.NoError(t, meter.Init())
+
+	assert.Equal(t, gpu.VendorIntel, meter.Vendor())
+	devices := meter.Devices()
+	require.Len(t, devices, 2)
+	assert.Equal(t, "GPU-0000:4d:00.0", devices[0].UUID)
+	assert.Equal(t, "GPU-0000:4e:00.0", devices[1].UUID)
+
+	_, err := meter.GetTotalEnergy(0)
+	require.NoError(t, err)
+	fakeClock.SetTime(fakeClock.Now().Add(2 * time.Second))
+	energy, err := meter.GetTotalEnergy(0)
+	require.NoError(t, err)
+	assert.Equal(t, 100*device.Joule, energy, "energy is integrated from the power")
+
+	meter.SetProcesses([]uint32{10, 20})
+	power, err := meter.GetProcessPower()
+	require.NoError(t, err)
+	assert.Equal(t, map[uint32]float64{10: 50, 20: 50}, power)
+}
@@ -39,6 +39,40 @@ type GPUPowerStats struct {
 
 	// ActivePower is the power attributed to workloads (TotalPower - IdlePower) in Watts
 	ActivePower float64
+
+	// Utilization is the device compute (SM) utilization in percent (0-100)
+	// observed during the last process attribution. 0 when not available.
+	Utilization float64
+
+	// UtilizationKnown is set when the device reported its utilization, so
+	// that a Utilization of 0 is an idle device rather than missing data
+	UtilizationKnown bool
+}
+
+// SplitByUtilization splits the power of a device without an idle power
+// baseline into active and idle power by its utilization, like CPU power is
+// split by the CPU usage ratio of the node. Such devices would otherwise
+// report all of their power as active. Stats with an idle power or without
+// a known utilization are returned as is.
+func (s GPUPowerStats) SplitByUtilization() GPUPowerStats {
+	if s.IdlePower > 0 || !s.UtilizationKnown {
+		return s
+	}
+	s.ActivePower = s.TotalPower * min(s.Utilization, MaxUtilization) / MaxUtilization
+	s.IdlePower = s.TotalPower - s.ActivePower
+	return s
+}
+
+// ReliabilityStats contains device readings used by thermal and reliability
+// dashboards. A value is -1 when the device does not report it, e.g. the fan
+// speed of a passively cooled GPU.
+type ReliabilityStats struct {
+	// FanSpeed is the fan speed in percent of the maximum speed (0-100)
+	FanSpeed float64
+
+	// PState is the performance state, from 0 (maximum performance) to 15
+	// (minimum performance)
+	PState int
 }
 
 // GPUPowerMeter is the interface for GPU power measurement and process attribution.
@@ -78,6 +112,68 @@ type IdlePowerConfigurable interface {
 	SetIdlePower(watts float64)
 }
 
+// EncDecWeightConfigurable is an optional interface for GPU meters that support
+// weighting encoder/decoder utilization into per-process power attribution, so
+// that media workloads with little compute utilization are attributed power.
+type EncDecWeightConfigurable interface {
+	SetEncDecWeight(weight float64)
+}
+
+// ComputeOnlyConfigurable is an optional interface for GPU meters that can
+// attribute power to graphics processes in addition to compute processes.
+// Meters implementing it restrict attribution to compute processes by default.
+type ComputeOnlyConfigurable interface {
+	SetComputeOnly(computeOnly bool)
+}
+
+// IdleAttributionConfigurable is an optional interface for GPU meters that
+// can attribute the idle power of a device to its processes in addition to
+// the active power. Meters implementing it attribute only the active power by
+// default and report the idle power at device level only.
+type IdleAttributionConfigurable interface {
+	SetAttributeIdle(attributeIdle bool)
+}
+
+// ProcessExcludable is an optional interface for GPU meters that support
+// excluding processes (e.g. system daemons) from per-process power attribution.
+// Excluded processes are dropped before power is split among processes; device
+// level power is unaffected.
+type ProcessExcludable interface {
+	SetProcessExcluder(exclude ProcessExcluder)
+}
+
+// ReliabilityReader is an optional interface for GPU meters that can report
+// the fan speed and performance state of a device.
+type ReliabilityReader interface {
+	GetDeviceReliabilityStats(deviceIndex int) (ReliabilityStats, error)
+}
+
+// ProcessUtilizationReader is an optional interface for GPU meters that can
+// report the latest utilization of each process, e.g. the encoder and decoder
+// utilization of transcoding workloads.
+type ProcessUtilizationReader interface {
+	// GetProcessUtilization returns the latest utilization per PID; the
+	// utilization of a process using several devices is summed
+	GetProcessUtilization() (map[uint32]ProcessUtilization, error)
+}
+
+// DeviceLimitable is an optional interface for GPU meters that support
+// restricting monitoring to a subset of the discovered devices.
+type DeviceLimitable interface {
+	// LimitDevices keeps only the first max discovered devices and returns
+	// the devices that are no longer monitored
+	LimitDevices(max int) []GPUDevice
+}
+
+// DeviceSelectable is an optional interface for GPU meters that support
+// restricting monitoring to devices selected by UUID. Unlike device indices,
+// UUIDs are stable across reboots.
+type DeviceSelectable interface {
+	// SelectDevices keeps only the discovered devices whose UUID is in uuids
+	// and returns the devices that are no longer monitored
+	SelectDevices(uuids []string) []GPUDevice
+}
+
 // ProcessGPUInfo contains per-process GPU metrics collected from the device.
 // This struct is vendor-agnostic.
 type ProcessGPUInfo struct {
@@ -90,6 +186,9 @@ type ProcessGPUInfo struct {
 	// DeviceUUID is the unique identifier of the GPU device
 	DeviceUUID string
 
+	// Type is the kind of GPU context of the process (compute or graphics)
+	Type ProcessType
+
 	// ComputeUtil is the compute utilization ratio (0.0-1.0)
 	// For NVIDIA: SM (Streaming Multiprocessor) utilization
 	// For AMD: CU (Compute Unit) utilization
@@ -5,6 +5,9 @@ package nvidia
 
 import (
 	"log/slog"
+	"math"
+	"slices"
+	"sort"
 	"sync"
 
 	"golang.org/x/sync/singleflight"
@@ -29,6 +32,11 @@ type GPUPowerCollector struct {
 	devices      []gpu.GPUDevice
 	sharingModes map[int]gpu.SharingMode
 
+	// initialized is set once Init has discovered the devices. The meter is
+	// initialized when it is discovered and again by the service lifecycle;
+	// the second Init must not undo LimitDevices and SelectDevices.
+	initialized bool
+
 	// minObservedPower tracks minimum power per device UUID, updated only when
 	// no compute processes are running (true idle).
 	minObservedPower map[string]float64
@@ -41,6 +49,46 @@ type GPUPowerCollector struct {
 	// When set (> 0), always used instead of observed idle power. 0 means auto-detect.
 	idlePower float64
 
+	// encDecWeight weights the encoder and decoder utilization of a process
+	// relative to its SM utilization when attributing power. 0 attributes
+	// power by SM utilization only.
+	encDecWeight float64
+
+	// excludeProcess reports processes (e.g. system daemons) that are dropped
+	// from per-process attribution. nil excludes nothing.
+	excludeProcess gpu.ProcessExcluder
+
+	// includeGraphics adds graphics processes to the compute processes that
+	// power is attributed to and whose absence marks a device idle
+	includeGraphics bool
+
+	// attributeIdle attributes the total device power, including the idle
+	// power, to processes instead of only the active power
+	attributeIdle bool
+
+	// lastUtilTimestamp tracks, per device index, the newest process utilization
+	// sample timestamp (microseconds) so subsequent calls only fetch new samples.
+	lastUtilTimestamp map[int]uint64
+
+	// lastUtil caches the most recent per-PID utilization per device index.
+	// It is reused when no new samples are available since lastUtilTimestamp.
+	lastUtil map[int]map[uint32]gpu.ProcessUtilization
+
+	// lastMIGUtilTimestamp and lastMIGUtil track the process utilization of
+	// each MIG instance, like lastUtilTimestamp and lastUtil for whole devices.
+	lastMIGUtilTimestamp map[migInstanceID]uint64
+	lastMIGUtil          map[migInstanceID]map[uint32]gpu.ProcessUtilization
+
+	// deviceUtil holds the device SM utilization (percent) per device index,
+	// summed over the running processes during time-slicing attribution.
+	// Devices without an entry have an unknown utilization.
+	deviceUtil map[int]float64
+
+	// migIdleShare holds, per MIG enabled device index, the share of the
+	// device held by idle MIG instances and unallocated slices, whose active
+	// power is reported as idle power since no process can be attributed it.
+	migIdleShare map[int]float64
+
 	mu sync.RWMutex
 
 	// Singleflight to coalesce concurrent GetProcessPower calls.
@@ -49,6 +97,13 @@ type GPUPowerCollector struct {
 	processPowerGroup singleflight.Group
 }
 
+// migInstanceID identifies a MIG instance by its parent device index and its
+// GPU instance ID; GPU instance IDs are only unique within a device
+type migInstanceID struct {
+	device        int
+	gpuInstanceID uint
+}
+
 // NewGPUPowerCollector creates a new NVIDIA GPU power collector.
 func NewGPUPowerCollector(logger *slog.Logger) (*GPUPowerCollector, error) {
 	if logger == nil {
@@ -58,11 +113,13 @@ func NewGPUPowerCollector(logger *slog.Logger) (*GPUPowerCollector, error) {
 	nvmlBackend := NewNVMLBackend(logger)
 
 	return &GPUPowerCollector{
-		logger:           logger.With("component", "nvidia-gpu-collector"),
-		nvml:             nvmlBackend,
-		minObservedPower: make(map[string]float64),
-		idleObserved:     make(map[string]bool),
-		sharingModes:     make(map[int]gpu.SharingMode),
+		logger:            logger.With("component", "nvidia-gpu-collector"),
+		nvml:              nvmlBackend,
+		minObservedPower:  make(map[string]float64),
+		idleObserved:      make(map[string]bool),
+		sharingModes:      make(map[int]gpu.SharingMode),
+		lastUtilTimestamp: make(map[int]uint64),
+		lastUtil:          make(map[int]map[uint32]gpu.ProcessUtilization),
 	}, nil
 }
 
@@ -71,11 +128,16 @@ func (c *GPUPowerCollector) Name() string {
 	return "nvidia-gpu-power-collector"
 }
 
-// Init initializes the NVML backend and discovers devices
+// Init initializes the NVML backend and discovers devices. Init is
+// idempotent: once initialized, further calls keep the discovered devices.
 func (c *GPUPowerCollector) Init() error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
+	if c.initialized {
+		return nil
+	}
+
 	if err := c.nvml.Init(); err != nil {
 		return err
 	}
@@ -101,6 +163,7 @@ func (c *GPUPowerCollector) Init() error {
 			"mode", mode.String())
 	}
 
+	c.initialized = true
 	return nil
 }
 
@@ -109,6 +172,7 @@ func (c *GPUPowerCollector) Shutdown() error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
+	c.initialized = false
 	return c.nvml.Shutdown()
 }
 
@@ -145,6 +209,16 @@ func (c *GPUPowerCollector) GetTotalEnergy(deviceIndex int) (device.Energy, erro
 	return dev.GetTotalEnergy()
 }
 
+// GetDeviceReliabilityStats returns the fan speed and performance state of a device
+func (c *GPUPowerCollector) GetDeviceReliabilityStats(deviceIndex int) (gpu.ReliabilityStats, error) {
+	dev, err := c.nvml.GetDevice(deviceIndex)
+	if err != nil {
+		return gpu.ReliabilityStats{}, err
+	}
+
+	return dev.GetReliabilityStats()
+}
+
 // GetDevicePowerStats returns power statistics including idle power detection
 func (c *GPUPowerCollector) GetDevicePowerStats(deviceIndex int) (gpu.GPUPowerStats, error) {
 	c.mu.Lock()
@@ -170,7 +244,7 @@ func (c *GPUPowerCollector) getDevicePowerStatsLocked(deviceIndex int) (gpu.GPUP
 	uuid := dev.UUID()
 
 	// Check if the GPU is truly idle (no compute processes running)
-	procs, err := dev.GetComputeRunningProcesses()
+	procs, err := c.runningProcesses(dev)
 	if err != nil {
 		// Non-fatal: log and skip idle detection for this reading
 		c.logger.Debug("GetComputeRunningProcesses failed, skipping idle detection",
@@ -204,11 +278,20 @@ func (c *GPUPowerCollector) getDevicePowerStatsLocked(deviceIndex int) (gpu.GPUP
 		activePower = 0
 	}
 
+	// the active power of idle MIG instances and unallocated slices is idle
+	if share := c.migIdleShare[deviceIndex]; share > 0 {
+		idlePower += activePower * share
+		activePower -= activePower * share
+	}
+
+	util, utilKnown := c.deviceUtil[deviceIndex]
 	return gpu.GPUPowerStats{
-		TotalPower:  totalPower,
-		IdlePower:   idlePower,
-		ActivePower: activePower,
-	}, nil
+		TotalPower:       totalPower,
+		IdlePower:        idlePower,
+		ActivePower:      activePower,
+		Utilization:      util,
+		UtilizationKnown: utilKnown,
+	}.SplitByUtilization(), nil
 }
 
 // SetIdlePower sets the configured idle power in Watts.
@@ -223,6 +306,117 @@ func (c *GPUPowerCollector) SetIdlePower(watts float64) {
 	c.idlePower = watts
 }
 
+// SetEncDecWeight sets the weight of encoder and decoder utilization relative
+// to SM utilization in per-process power attribution. Negative values are
+// clamped to 0, which attributes power by SM utilization only.
+func (c *GPUPowerCollector) SetEncDecWeight(weight float64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.encDecWeight = max(weight, 0)
+}
+
+// SetComputeOnly sets whether power is attributed to compute processes only
+// (the default) or to graphics processes as well
+func (c *GPUPowerCollector) SetComputeOnly(computeOnly bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.includeGraphics = !computeOnly
+}
+
+// SetAttributeIdle sets whether the total device power, including the idle
+// power, is attributed to processes instead of only the active power
+func (c *GPUPowerCollector) SetAttributeIdle(attributeIdle bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.attributeIdle = attributeIdle
+}
+
+// attributablePower returns the device power split among its processes
+// NOTE: caller must hold c.mu lock
+func (c *GPUPowerCollector) attributablePower(stats gpu.GPUPowerStats) float64 {
+	if c.attributeIdle {
+		return stats.TotalPower
+	}
+	return stats.ActivePower
+}
+
+// runningProcesses returns the compute processes of a device, and its
+// graphics processes if includeGraphics is set
+// NOTE: caller must hold c.mu lock
+func (c *GPUPowerCollector) runningProcesses(dev NVMLDevice) ([]gpu.ProcessGPUInfo, error) {
+	compute, err := dev.GetComputeRunningProcesses()
+	if err != nil || !c.includeGraphics {
+		return compute, err
+	}
+
+	graphics, err := dev.GetGraphicsRunningProcesses()
+	if err != nil {
+		return nil, err
+	}
+	return gpu.MergeProcesses(compute, graphics), nil
+}
+
+// SetProcessExcluder sets the function used to exclude processes from
+// per-process power attribution. Device power is unaffected.
+func (c *GPUPowerCollector) SetProcessExcluder(exclude gpu.ProcessExcluder) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.excludeProcess = exclude
+}
+
+// LimitDevices keeps only the first max discovered devices (ordered by index)
+// and returns the devices that are no longer monitored. max <= 0 keeps all
+// devices.
+func (c *GPUPowerCollector) LimitDevices(max int) []gpu.GPUDevice {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if max <= 0 || len(c.devices) <= max {
+		return nil
+	}
+
+	sort.Slice(c.devices, func(i, j int) bool { return c.devices[i].Index < c.devices[j].Index })
+	skipped := slices.Clone(c.devices[max:])
+	c.devices = c.devices[:max:max]
+	for _, dev := range skipped {
+		delete(c.sharingModes, dev.Index)
+	}
+	return skipped
+}
+
+// SelectDevices keeps only the discovered devices whose UUID is in uuids and
+// returns the devices that are no longer monitored
+func (c *GPUPowerCollector) SelectDevices(uuids []string) []gpu.GPUDevice {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	selected, skipped := gpu.SelectDevicesByUUID(c.devices, uuids)
+	c.devices = selected
+	for _, dev := range skipped {
+		delete(c.sharingModes, dev.Index)
+	}
+	return skipped
+}
+
+// attributableProcesses drops excluded processes so that the remaining
+// processes split the active power
+// NOTE: caller must hold c.mu lock
+func (c *GPUPowerCollector) attributableProcesses(procs []gpu.ProcessGPUInfo) []gpu.ProcessGPUInfo {
+	if c.excludeProcess == nil {
+		return procs
+	}
+
+	ret := make([]gpu.ProcessGPUInfo, 0, len(procs))
+	for _, p := range procs {
+		if c.excludeProcess(p.PID) {
+			c.logger.Debug("excluding process from GPU attribution", "pid", p.PID)
+			continue
+		}
+		ret = append(ret, p)
+	}
+	return ret
+}
+
 // processPowerResult wraps the result for singleflight (which only returns interface{})
 type processPowerResult struct {
 	power map[uint32]float64
@@ -253,10 +447,10 @@ func (c *GPUPowerCollector) collectProcessPower() processPowerResult {
 
 		switch mode {
 		case gpu.SharingModePartitioned:
-			// Partitioned (MIG) support will be added in PR-3
-			c.logger.Debug("partitioned mode detected, skipping (not yet implemented)",
-				"device", dev.Index)
-			continue
+			if err := c.attributePartitioned(dev.Index, result); err != nil {
+				c.logger.Debug("partitioned attribution failed",
+					"device", dev.Index, "error", err)
+			}
 
 		case gpu.SharingModeExclusive:
 			if err := c.attributeExclusive(dev.Index, result); err != nil {
@@ -290,18 +484,19 @@ func (c *GPUPowerCollector) attributeExclusive(deviceIndex int, result map[uint3
 	}
 
 	// Get running processes
-	procs, err := nvmlDev.GetComputeRunningProcesses()
+	procs, err := c.runningProcesses(nvmlDev)
 	if err != nil {
 		return err
 	}
 
+	procs = c.attributableProcesses(procs)
 	if len(procs) == 0 {
 		return nil
 	}
 
 	// In exclusive mode, attribute all active power to the single process
 	// (or split equally if somehow multiple processes exist)
-	powerPerProc := stats.ActivePower / float64(len(procs))
+	powerPerProc := c.attributablePower(stats) / float64(len(procs))
 	for _, p := range procs {
 		result[p.PID] += powerPerProc
 	}
@@ -309,7 +504,8 @@ func (c *GPUPowerCollector) attributeExclusive(deviceIndex int, result map[uint3
 	return nil
 }
 
-// attributeTimeSlicing distributes power based on SM utilization
+// attributeTimeSlicing distributes power based on SM utilization, and
+// encoder/decoder utilization when encDecWeight is set
 // NOTE: caller must hold c.mu lock
 func (c *GPUPowerCollector) attributeTimeSlicing(deviceIndex int, result map[uint32]float64) error {
 	nvmlDev, err := c.nvml.GetDevice(deviceIndex)
@@ -317,30 +513,31 @@ func (c *GPUPowerCollector) attributeTimeSlicing(deviceIndex int, result map[uin
 		return err
 	}
 
-	// Get active power
-	stats, err := c.getDevicePowerStatsLocked(deviceIndex)
-	if err != nil {
-		return err
-	}
-
 	// Step 1: Get list of running processes (authoritative list)
-	runningProcs, err := nvmlDev.GetComputeRunningProcesses()
+	runningProcs, err := c.runningProcesses(nvmlDev)
 	if err != nil {
 		c.logger.Debug("GetComputeRunningProcesses failed", "device", deviceIndex, "error", err)
 		return err
 	}
 
+	runningProcs = c.attributableProcesses(runningProcs)
 	if len(runningProcs) == 0 {
+		c.setDeviceUtilization(deviceIndex, 0)
 		return nil
 	}
 
-	// Step 2: Get process utilization samples (always pass 0 to get latest samples)
-	utils, err := nvmlDev.GetProcessUtilization(0)
+	// Step 2: Get process utilization samples newer than the last seen sample
+	utils, err := nvmlDev.GetProcessUtilization(c.lastUtilTimestamp[deviceIndex])
 	if err != nil {
 		// Fall back to equal distribution among running processes
 		c.logger.Debug("GetProcessUtilization unavailable, using equal distribution",
 			"device", deviceIndex, "error", err)
-		powerPerProc := stats.ActivePower / float64(len(runningProcs))
+		delete(c.deviceUtil, deviceIndex)
+		stats, err := c.getDevicePowerStatsLocked(deviceIndex)
+		if err != nil {
+			return err
+		}
+		powerPerProc := c.attributablePower(stats) / float64(len(runningProcs))
 		for _, p := range runningProcs {
 			result[p.PID] += powerPerProc
 		}
@@ -348,14 +545,29 @@ func (c *GPUPowerCollector) attributeTimeSlicing(deviceIndex int, result map[uin
 	}
 
 	// Step 3: Build utilization map by PID
-	utilMap := make(map[uint32]uint32) // PID -> ComputeUtil
-	for _, pu := range utils {
-		// Keep the highest utilization for each PID (samples may have duplicates)
-		if existing, ok := utilMap[pu.PID]; !ok || pu.ComputeUtil > existing {
-			utilMap[pu.PID] = pu.ComputeUtil
+	utilMap := c.processUtilization(deviceIndex, utils)
+
+	// Step 4: Calculate total SM utilization and attribution weight across
+	// running processes
+	var totalSmUtil uint32
+	var totalWeight float64
+	for _, proc := range runningProcs {
+		if pu, ok := utilMap[proc.PID]; ok {
+			totalSmUtil += pu.ComputeUtil
+			totalWeight += c.attributionWeight(pu)
 		}
 	}
 
+	// Record the utilization before reading the power, so that the active
+	// power attributed below is split by the same utilization the device
+	// stats report
+	c.setDeviceUtilization(deviceIndex, totalSmUtil)
+
+	stats, err := c.getDevicePowerStatsLocked(deviceIndex)
+	if err != nil {
+		return err
+	}
+
 	c.logger.Debug("GetProcessUtilization result",
 		"device", deviceIndex,
 		"runningProcs", len(runningProcs),
@@ -365,17 +577,9 @@ func (c *GPUPowerCollector) attributeTimeSlicing(deviceIndex int, result map[uin
 		"idlePower", stats.IdlePower,
 		"activePower", stats.ActivePower)
 
-	// Step 4: Calculate total SM utilization across running processes
-	var totalSmUtil uint32
-	for _, proc := range runningProcs {
-		if smUtil, ok := utilMap[proc.PID]; ok {
-			totalSmUtil += smUtil
-		}
-	}
-
 	// If no utilization data, distribute equally among running processes
-	if totalSmUtil == 0 {
-		powerPerProc := stats.ActivePower / float64(len(runningProcs))
+	if totalWeight == 0 {
+		powerPerProc := c.attributablePower(stats) / float64(len(runningProcs))
 		for _, proc := range runningProcs {
 			result[proc.PID] += powerPerProc
 		}
@@ -386,16 +590,335 @@ func (c *GPUPowerCollector) attributeTimeSlicing(deviceIndex int, result map[uin
 		return nil
 	}
 
-	// Step 5: Distribute active power proportionally to SM utilization
+	// Step 5: Distribute active power proportionally to the attribution weight
 	for _, proc := range runningProcs {
-		smUtil := utilMap[proc.PID] // 0 if not in map
-		fraction := float64(smUtil) / float64(totalSmUtil)
-		result[proc.PID] += stats.ActivePower * fraction
+		fraction := c.attributionWeight(utilMap[proc.PID]) / totalWeight // 0 if not in map
+		result[proc.PID] += c.attributablePower(stats) * fraction
 	}
 
 	return nil
 }
 
+// migInstanceProcesses holds the attributable processes of an active MIG instance
+type migInstanceProcesses struct {
+	id     migInstanceID
+	dev    NVMLDevice
+	procs  []gpu.ProcessGPUInfo
+	slices uint
+}
+
+// attributePartitioned attributes each MIG instance running processes the
+// share of the power of the device its slices hold of the whole GPU, and splits
+// the power of each instance among its processes like attributeTimeSlicing.
+// The active power of idle instances and unallocated slices is reported as idle
+// power of the device, so that the processes split its active power. When the
+// number of slices of an instance is unknown, active instances are attributed
+// equal power.
+// NOTE: caller must hold c.mu lock
+func (c *GPUPowerCollector) attributePartitioned(deviceIndex int, result map[uint32]float64) error {
+	nvmlDev, err := c.nvml.GetDevice(deviceIndex)
+	if err != nil {
+		return err
+	}
+
+	instances, err := nvmlDev.GetMIGInstances()
+	if err != nil {
+		return err
+	}
+	c.pruneMIGUtilization(deviceIndex, instances)
+
+	var active []migInstanceProcesses
+	for _, inst := range instances {
+		migDev, err := nvmlDev.GetMIGDeviceByInstanceID(inst.GPUInstanceID)
+		if err != nil {
+			c.logger.Debug("failed to get MIG device",
+				"device", deviceIndex, "gpuInstance", inst.GPUInstanceID, "error", err)
+			continue
+		}
+
+		procs, err := c.runningProcesses(migDev)
+		if err != nil {
+			c.logger.Debug("GetComputeRunningProcesses failed",
+				"device", deviceIndex, "gpuInstance", inst.GPUInstanceID, "error", err)
+			continue
+		}
+
+		procs = c.attributableProcesses(procs)
+		if len(procs) == 0 {
+			continue
+		}
+		active = append(active, migInstanceProcesses{
+			id:     migInstanceID{device: deviceIndex, gpuInstanceID: inst.GPUInstanceID},
+			dev:    migDev,
+			procs:  procs,
+			slices: inst.ProfileSlices,
+		})
+	}
+
+	totalSlices := gpuSlices(nvmlDev, instances)
+	fractions := make([]float64, len(active))
+	activeShare := 0.0
+	for i, inst := range active {
+		fractions[i] = 1 / float64(len(active))
+		if totalSlices > 0 {
+			fractions[i] = float64(inst.slices) / float64(totalSlices)
+		}
+		activeShare += fractions[i]
+	}
+
+	// Record the idle share before reading the power, so that the active
+	// power attributed below is the active power the device stats report
+	c.setMIGIdleShare(deviceIndex, 1-activeShare)
+
+	stats, err := c.getDevicePowerStatsLocked(deviceIndex)
+	if err != nil {
+		return err
+	}
+	if len(active) == 0 {
+		return nil
+	}
+
+	for i, inst := range active {
+		c.attributeMIGInstance(inst, c.attributablePower(stats)*fractions[i]/activeShare, result)
+	}
+
+	return nil
+}
+
+// setMIGIdleShare records the share of a MIG enabled device held by idle MIG
+// instances and unallocated slices
+// NOTE: caller must hold c.mu lock
+func (c *GPUPowerCollector) setMIGIdleShare(deviceIndex int, share float64) {
+	if c.migIdleShare == nil {
+		c.migIdleShare = make(map[int]float64)
+	}
+	c.migIdleShare[deviceIndex] = min(max(share, 0), 1)
+}
+
+// gpuSlices returns the number of slices of a MIG enabled GPU, or 0 when the
+// number of slices of any of its instances is unknown. The GPU has one slice
+// per possible MIG device, e.g. 7 on an A100, or at least as many as its
+// instances hold.
+func gpuSlices(dev NVMLDevice, instances []MIGInstance) uint {
+	var allocated uint
+	for _, inst := range instances {
+		if inst.ProfileSlices == 0 {
+			return 0
+		}
+		allocated += inst.ProfileSlices
+	}
+
+	if count, err := dev.GetMaxMigDeviceCount(); err == nil && count > 0 {
+		return max(uint(count), allocated)
+	}
+	return allocated
+}
+
+// pruneMIGUtilization forgets the utilization of the MIG instances of a device
+// that no longer exist
+// NOTE: caller must hold c.mu lock
+func (c *GPUPowerCollector) pruneMIGUtilization(deviceIndex int, instances []MIGInstance) {
+	exists := make(map[uint]bool, len(instances))
+	for _, inst := range instances {
+		exists[inst.GPUInstanceID] = true
+	}
+
+	for id := range c.lastMIGUtilTimestamp {
+		if id.device == deviceIndex && !exists[id.gpuInstanceID] {
+			delete(c.lastMIGUtilTimestamp, id)
+		}
+	}
+	for id := range c.lastMIGUtil {
+		if id.device == deviceIndex && !exists[id.gpuInstanceID] {
+			delete(c.lastMIGUtil, id)
+		}
+	}
+}
+
+// attributeMIGInstance distributes the power of a MIG instance among its
+// processes by their attribution weight, or equally when no utilization is
+// available
+// NOTE: caller must hold c.mu lock
+func (c *GPUPowerCollector) attributeMIGInstance(inst migInstanceProcesses, power float64, result map[uint32]float64) {
+	var utilMap map[uint32]gpu.ProcessUtilization
+	utils, err := inst.dev.GetProcessUtilization(c.lastMIGUtilTimestamp[inst.id])
+	if err != nil {
+		c.logger.Debug("GetProcessUtilization unavailable for MIG instance, using equal distribution",
+			"device", inst.id.device, "gpuInstance", inst.id.gpuInstanceID, "error", err)
+	} else {
+		utilMap = c.migProcessUtilization(inst.id, utils)
+	}
+
+	var totalWeight float64
+	for _, proc := range inst.procs {
+		if pu, ok := utilMap[proc.PID]; ok {
+			totalWeight += c.attributionWeight(pu)
+		}
+	}
+
+	for _, proc := range inst.procs {
+		fraction := 1 / float64(len(inst.procs))
+		if totalWeight > 0 {
+			fraction = c.attributionWeight(utilMap[proc.PID]) / totalWeight
+		}
+		result[proc.PID] += power * fraction
+	}
+}
+
+// attributionWeight returns the share of the active power a process is
+// attributed relative to the other processes on the device
+// NOTE: caller must hold c.mu lock
+func (c *GPUPowerCollector) attributionWeight(pu gpu.ProcessUtilization) float64 {
+	return float64(pu.ComputeUtil) + c.encDecWeight*float64(pu.EncUtil+pu.DecUtil)
+}
+
+// processUtilization builds the PID -> utilization map from the given samples
+// and advances the last seen sample timestamp for the device. When there are no
+// new samples, the previously observed utilization is reused so that the last
+// value is not dropped between calls.
+// NOTE: caller must hold c.mu lock
+func (c *GPUPowerCollector) processUtilization(deviceIndex int, utils []gpu.ProcessUtilization) map[uint32]gpu.ProcessUtilization {
+	if c.lastUtilTimestamp == nil {
+		c.lastUtilTimestamp = make(map[int]uint64)
+	}
+	if c.lastUtil == nil {
+		c.lastUtil = make(map[int]map[uint32]gpu.ProcessUtilization)
+	}
+
+	if len(utils) == 0 {
+		return c.lastUtil[deviceIndex]
+	}
+
+	utilMap, lastSeen := c.mergeUtilization(deviceIndex, utils, c.lastUtilTimestamp[deviceIndex])
+	c.lastUtilTimestamp[deviceIndex] = lastSeen
+	c.lastUtil[deviceIndex] = utilMap
+	return utilMap
+}
+
+// migProcessUtilization is processUtilization for the samples of a MIG instance
+// NOTE: caller must hold c.mu lock
+func (c *GPUPowerCollector) migProcessUtilization(id migInstanceID, utils []gpu.ProcessUtilization) map[uint32]gpu.ProcessUtilization {
+	if c.lastMIGUtilTimestamp == nil {
+		c.lastMIGUtilTimestamp = make(map[migInstanceID]uint64)
+	}
+	if c.lastMIGUtil == nil {
+		c.lastMIGUtil = make(map[migInstanceID]map[uint32]gpu.ProcessUtilization)
+	}
+
+	if len(utils) == 0 {
+		return c.lastMIGUtil[id]
+	}
+
+	utilMap, lastSeen := c.mergeUtilization(id.device, utils, c.lastMIGUtilTimestamp[id])
+	c.lastMIGUtilTimestamp[id] = lastSeen
+	c.lastMIGUtil[id] = utilMap
+	return utilMap
+}
+
+// mergeUtilization normalizes the utilization samples of a device and builds
+// the PID -> utilization map from them, returning it with the newest sample
+// timestamp seen
+// NOTE: caller must hold c.mu lock
+func (c *GPUPowerCollector) mergeUtilization(deviceIndex int, utils []gpu.ProcessUtilization, lastSeen uint64) (map[uint32]gpu.ProcessUtilization, uint64) {
+	utils = c.normalizeUtilization(deviceIndex, utils)
+
+	utilMap := make(map[uint32]gpu.ProcessUtilization)
+	for _, pu := range utils {
+		// Keep the highest utilization for each PID (samples may have duplicates)
+		if existing, ok := utilMap[pu.PID]; ok {
+			pu.ComputeUtil = max(pu.ComputeUtil, existing.ComputeUtil)
+			pu.MemUtil = max(pu.MemUtil, existing.MemUtil)
+			pu.EncUtil = max(pu.EncUtil, existing.EncUtil)
+			pu.DecUtil = max(pu.DecUtil, existing.DecUtil)
+		}
+		utilMap[pu.PID] = pu
+		lastSeen = max(lastSeen, pu.Timestamp)
+	}
+	return utilMap, lastSeen
+}
+
+// normalizeUtilization brings the utilization samples of a device to the
+// 0-100 scale with gpu.NormalizeUtilization, logging out of range samples.
+// NVML reports integer percentages, so in practice only clamping applies.
+// NOTE: caller must hold c.mu lock
+func (c *GPUPowerCollector) normalizeUtilization(deviceIndex int, utils []gpu.ProcessUtilization) []gpu.ProcessUtilization {
+	fields := []func(*gpu.ProcessUtilization) *uint32{
+		func(pu *gpu.ProcessUtilization) *uint32 { return &pu.ComputeUtil },
+		func(pu *gpu.ProcessUtilization) *uint32 { return &pu.MemUtil },
+		func(pu *gpu.ProcessUtilization) *uint32 { return &pu.EncUtil },
+		func(pu *gpu.ProcessUtilization) *uint32 { return &pu.DecUtil },
+	}
+
+	normalized := slices.Clone(utils)
+	clamped := 0
+	samples := make([]float64, len(utils))
+	for _, field := range fields {
+		for i := range normalized {
+			samples[i] = float64(*field(&normalized[i]))
+		}
+		values, n := gpu.NormalizeUtilization(samples)
+		for i, v := range values {
+			*field(&normalized[i]) = uint32(math.Round(v))
+		}
+		clamped += n
+	}
+
+	if clamped > 0 {
+		c.logger.Warn("GPU utilization samples out of range; clamped to 0-100",
+			"device", deviceIndex, "samples", clamped)
+	}
+	return normalized
+}
+
+// setDeviceUtilization records the device SM utilization, capping the sum of
+// per-process utilization at 100%
+// NOTE: caller must hold c.mu lock
+func (c *GPUPowerCollector) setDeviceUtilization(deviceIndex int, smUtil uint32) {
+	if c.deviceUtil == nil {
+		c.deviceUtil = make(map[int]float64)
+	}
+	c.deviceUtil[deviceIndex] = min(float64(smUtil), gpu.MaxUtilization)
+}
+
+// GetProcessUtilization returns the utilization per PID observed during the
+// last process attribution, summed across devices and MIG instances.
+// Utilization is only sampled on time-sliced devices and MIG instances;
+// processes of other devices are not reported.
+func (c *GPUPowerCollector) GetProcessUtilization() (map[uint32]gpu.ProcessUtilization, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	result := make(map[uint32]gpu.ProcessUtilization)
+	add := func(utils map[uint32]gpu.ProcessUtilization) {
+		for pid, pu := range utils {
+			sum := result[pid]
+			sum.PID = pid
+			sum.ComputeUtil += pu.ComputeUtil
+			sum.MemUtil += pu.MemUtil
+			sum.EncUtil += pu.EncUtil
+			sum.DecUtil += pu.DecUtil
+			sum.Timestamp = max(sum.Timestamp, pu.Timestamp)
+			result[pid] = sum
+		}
+	}
+
+	for _, dev := range c.devices {
+		add(c.lastUtil[dev.Index])
+	}
+	for id, utils := range c.lastMIGUtil {
+		if c.isMonitored(id.device) {
+			add(utils)
+		}
+	}
+	return result, nil
+}
+
+// isMonitored reports whether the device with the given index is monitored
+// NOTE: caller must hold c.mu lock
+func (c *GPUPowerCollector) isMonitored(deviceIndex int) bool {
+	return slices.ContainsFunc(c.devices, func(dev gpu.GPUDevice) bool { return dev.Index == deviceIndex })
+}
+
 // GetProcessInfo returns detailed GPU metrics per process
 func (c *GPUPowerCollector) GetProcessInfo() ([]gpu.ProcessGPUInfo, error) {
 	c.mu.RLock()
@@ -409,7 +932,7 @@ func (c *GPUPowerCollector) GetProcessInfo() ([]gpu.ProcessGPUInfo, error) {
 			continue
 		}
 
-		procs, err := nvmlDev.GetComputeRunningProcesses()
+		procs, err := c.runningProcesses(nvmlDev)
 		if err != nil {
 			continue
 		}
@@ -421,4 +944,12 @@ func (c *GPUPowerCollector) GetProcessInfo() ([]gpu.ProcessGPUInfo, error) {
 }
 
 // Ensure GPUPowerCollector implements gpu.GPUPowerMeter
-var _ gpu.GPUPowerMeter = (*GPUPowerCollector)(nil)
+var (
+	_ gpu.GPUPowerMeter     = (*GPUPowerCollector)(nil)
+	_ gpu.ReliabilityReader = (*GPUPowerCollector)(nil)
+
+	_ gpu.ProcessUtilizationReader = (*GPUPowerCollector)(nil)
+
+	_ gpu.ComputeOnlyConfigurable     = (*GPUPowerCollector)(nil)
+	_ gpu.IdleAttributionConfigurable = (*GPUPowerCollector)(nil)
+)
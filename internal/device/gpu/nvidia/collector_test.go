@@ -10,6 +10,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"github.com/sustainable-computing-io/kepler/internal/device"
 	"github.com/sustainable-computing-io/kepler/internal/device/gpu"
 )
@@ -584,6 +585,201 @@ func TestGPUPowerCollector_GetProcessPower(t *testing.T) {
 		mockDevice.AssertExpectations(t)
 	})
 
+	t.Run("time slicing advances utilization since-time", func(t *testing.T) {
+		mockBackend := new(MockNVMLBackend)
+		mockDevice := new(MockNVMLDevice)
+
+		collector := &GPUPowerCollector{
+			logger: slog.Default(),
+			nvml:   mockBackend,
+			devices: []gpu.GPUDevice{
+				{Index: 0, UUID: "GPU-123"},
+			},
+			sharingModes: map[int]gpu.SharingMode{
+				0: gpu.SharingModeTimeSlicing,
+			},
+			minObservedPower: map[string]float64{
+				"GPU-123": 40.0,
+			},
+			idleObserved: map[string]bool{
+				"GPU-123": true,
+			},
+		}
+
+		mockBackend.On("GetDevice", 0).Return(mockDevice, nil)
+		mockDevice.On("GetPowerUsage").Return(device.Power(100*device.Watt), nil)
+		mockDevice.On("UUID").Return("GPU-123")
+		mockDevice.On("GetComputeRunningProcesses").Return([]gpu.ProcessGPUInfo{
+			{PID: 1001},
+			{PID: 1002},
+		}, nil)
+		mockDevice.On("GetProcessUtilization", uint64(0)).Return([]gpu.ProcessUtilization{
+			{PID: 1001, ComputeUtil: 60, Timestamp: 100},
+			{PID: 1002, ComputeUtil: 40, Timestamp: 150},
+		}, nil).Once()
+		// no new samples since 150; last observed utilization must be kept
+		mockDevice.On("GetProcessUtilization", uint64(150)).Return([]gpu.ProcessUtilization{}, nil).Once()
+		mockDevice.On("GetProcessUtilization", uint64(150)).Return([]gpu.ProcessUtilization{
+			{PID: 1001, ComputeUtil: 20, Timestamp: 200},
+			{PID: 1002, ComputeUtil: 80, Timestamp: 250},
+		}, nil).Once()
+		mockDevice.On("GetProcessUtilization", uint64(250)).Return([]gpu.ProcessUtilization{}, nil).Once()
+
+		result, err := collector.GetProcessPower()
+		assert.NoError(t, err)
+		assert.InDelta(t, 36.0, result[1001], 0.01)
+		assert.InDelta(t, 24.0, result[1002], 0.01)
+		assert.Equal(t, uint64(150), collector.lastUtilTimestamp[0])
+
+		result, err = collector.GetProcessPower()
+		assert.NoError(t, err)
+		assert.InDelta(t, 36.0, result[1001], 0.01)
+		assert.InDelta(t, 24.0, result[1002], 0.01)
+		assert.Equal(t, uint64(150), collector.lastUtilTimestamp[0])
+
+		result, err = collector.GetProcessPower()
+		assert.NoError(t, err)
+		assert.InDelta(t, 12.0, result[1001], 0.01)
+		assert.InDelta(t, 48.0, result[1002], 0.01)
+		assert.Equal(t, uint64(250), collector.lastUtilTimestamp[0])
+
+		result, err = collector.GetProcessPower()
+		assert.NoError(t, err)
+		assert.InDelta(t, 12.0, result[1001], 0.01)
+		assert.InDelta(t, 48.0, result[1002], 0.01)
+
+		mockBackend.AssertExpectations(t)
+		mockDevice.AssertExpectations(t)
+	})
+
+	t.Run("time slicing reports device utilization", func(t *testing.T) {
+		mockBackend := new(MockNVMLBackend)
+		mockDevice := new(MockNVMLDevice)
+
+		collector := &GPUPowerCollector{
+			logger: slog.Default(),
+			nvml:   mockBackend,
+			devices: []gpu.GPUDevice{
+				{Index: 0, UUID: "GPU-123"},
+			},
+			sharingModes: map[int]gpu.SharingMode{
+				0: gpu.SharingModeTimeSlicing,
+			},
+			minObservedPower: map[string]float64{},
+			idleObserved:     map[string]bool{},
+		}
+
+		mockBackend.On("GetDevice", 0).Return(mockDevice, nil)
+		mockDevice.On("GetPowerUsage").Return(device.Power(100*device.Watt), nil)
+		mockDevice.On("UUID").Return("GPU-123")
+		mockDevice.On("GetComputeRunningProcesses").Return([]gpu.ProcessGPUInfo{
+			{PID: 1001},
+			{PID: 1002},
+		}, nil)
+		mockDevice.On("GetProcessUtilization", uint64(0)).Return([]gpu.ProcessUtilization{
+			{PID: 1001, ComputeUtil: 30, Timestamp: 100},
+			{PID: 1002, ComputeUtil: 20, Timestamp: 100},
+		}, nil)
+
+		stats, err := collector.GetDevicePowerStats(0)
+		assert.NoError(t, err)
+		assert.Equal(t, 0.0, stats.Utilization, "no utilization before attribution")
+
+		_, err = collector.GetProcessPower()
+		assert.NoError(t, err)
+
+		stats, err = collector.GetDevicePowerStats(0)
+		assert.NoError(t, err)
+		assert.Equal(t, 50.0, stats.Utilization)
+	})
+
+	t.Run("time slicing excludes processes", func(t *testing.T) {
+		mockBackend := new(MockNVMLBackend)
+		mockDevice := new(MockNVMLDevice)
+
+		collector := &GPUPowerCollector{
+			logger: slog.Default(),
+			nvml:   mockBackend,
+			devices: []gpu.GPUDevice{
+				{Index: 0, UUID: "GPU-123"},
+			},
+			sharingModes: map[int]gpu.SharingMode{
+				0: gpu.SharingModeTimeSlicing,
+			},
+			minObservedPower: map[string]float64{
+				"GPU-123": 40.0,
+			},
+			idleObserved: map[string]bool{
+				"GPU-123": true,
+			},
+		}
+		// 1000 is e.g. Xorg
+		collector.SetProcessExcluder(func(pid uint32) bool { return pid == 1000 })
+
+		mockBackend.On("GetDevice", 0).Return(mockDevice, nil)
+		mockDevice.On("GetPowerUsage").Return(device.Power(100*device.Watt), nil)
+		mockDevice.On("UUID").Return("GPU-123")
+		mockDevice.On("GetComputeRunningProcesses").Return([]gpu.ProcessGPUInfo{
+			{PID: 1000},
+			{PID: 1001},
+			{PID: 1002},
+		}, nil)
+		mockDevice.On("GetProcessUtilization", mock.Anything).Return([]gpu.ProcessUtilization{
+			{PID: 1000, ComputeUtil: 50, Timestamp: 100},
+			{PID: 1001, ComputeUtil: 30, Timestamp: 100},
+			{PID: 1002, ComputeUtil: 10, Timestamp: 100},
+		}, nil)
+
+		result, err := collector.GetProcessPower()
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+		assert.NotContains(t, result, uint32(1000))
+		// excluded utilization is dropped: remaining processes split 100% of 60W
+		assert.InDelta(t, 45.0, result[1001], 0.01) // 30/40 of 60W
+		assert.InDelta(t, 15.0, result[1002], 0.01) // 10/40 of 60W
+		assert.InDelta(t, 60.0, result[1001]+result[1002], 0.01)
+
+		mockBackend.AssertExpectations(t)
+		mockDevice.AssertExpectations(t)
+	})
+
+	t.Run("exclusive mode excludes processes", func(t *testing.T) {
+		mockBackend := new(MockNVMLBackend)
+		mockDevice := new(MockNVMLDevice)
+
+		collector := &GPUPowerCollector{
+			logger: slog.Default(),
+			nvml:   mockBackend,
+			devices: []gpu.GPUDevice{
+				{Index: 0, UUID: "GPU-123"},
+			},
+			sharingModes: map[int]gpu.SharingMode{
+				0: gpu.SharingModeExclusive,
+			},
+			minObservedPower: map[string]float64{
+				"GPU-123": 40.0,
+			},
+			idleObserved: map[string]bool{
+				"GPU-123": true,
+			},
+		}
+		collector.SetProcessExcluder(func(pid uint32) bool { return pid == 1000 })
+
+		mockBackend.On("GetDevice", 0).Return(mockDevice, nil)
+		mockDevice.On("GetPowerUsage").Return(device.Power(100*device.Watt), nil)
+		mockDevice.On("UUID").Return("GPU-123")
+		mockDevice.On("GetComputeRunningProcesses").Return([]gpu.ProcessGPUInfo{
+			{PID: 1000},
+			{PID: 1001},
+		}, nil)
+
+		result, err := collector.GetProcessPower()
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[uint32]float64{1001: 60.0}, result)
+	})
+
 	t.Run("time slicing fallback to equal distribution", func(t *testing.T) {
 		mockBackend := new(MockNVMLBackend)
 		mockDevice := new(MockNVMLDevice)
@@ -650,9 +846,7 @@ func TestGPUPowerCollector_GetProcessPower(t *testing.T) {
 		}
 
 		mockBackend.On("GetDevice", 0).Return(mockDevice, nil)
-		mockDevice.On("GetPowerUsage").Return(device.Power(100*device.Watt), nil)
-		mockDevice.On("UUID").Return("GPU-123")
-		// Even with activePower=0, we still check for running processes
+		// Running processes are checked first; without any, the power is not read
 		mockDevice.On("GetComputeRunningProcesses").Return([]gpu.ProcessGPUInfo{}, nil)
 
 		result, err := collector.GetProcessPower()
@@ -786,8 +980,9 @@ func TestGPUPowerCollector_GetProcessPower(t *testing.T) {
 		mockDevice.AssertExpectations(t)
 	})
 
-	t.Run("partitioned mode skipped", func(t *testing.T) {
+	t.Run("partitioned mode without MIG instances", func(t *testing.T) {
 		mockBackend := new(MockNVMLBackend)
+		mockDevice := new(MockNVMLDevice)
 
 		collector := &GPUPowerCollector{
 			logger: slog.Default(),
@@ -802,12 +997,198 @@ func TestGPUPowerCollector_GetProcessPower(t *testing.T) {
 			idleObserved:     make(map[string]bool),
 		}
 
+		mockBackend.On("GetDevice", 0).Return(mockDevice, nil)
+		mockDevice.On("GetMIGInstances").Return(nil, errors.New("no MIG instances found"))
+
 		result, err := collector.GetProcessPower()
 
 		assert.NoError(t, err)
-		assert.Empty(t, result) // Partitioned mode not yet implemented
+		assert.Empty(t, result)
 
 		mockBackend.AssertExpectations(t)
+		mockDevice.AssertExpectations(t)
+	})
+}
+
+func TestGPUPowerCollector_GetProcessPower_MIG(t *testing.T) {
+	// newMIGCollector returns a collector for an A100 with MIG enabled and two
+	// GPU instances, drawing 170W with a configured idle power of 100W
+	newMIGCollector := func(instances []MIGInstance) (*GPUPowerCollector, *MockNVMLDevice, *MockNVMLDevice, *MockNVMLDevice) {
+		mockBackend := new(MockNVMLBackend)
+		parent := new(MockNVMLDevice)
+		mig1 := new(MockNVMLDevice)
+		mig2 := new(MockNVMLDevice)
+
+		collector := &GPUPowerCollector{
+			logger:  slog.Default(),
+			nvml:    mockBackend,
+			devices: []gpu.GPUDevice{{Index: 0, UUID: "GPU-A100"}},
+			sharingModes: map[int]gpu.SharingMode{
+				0: gpu.SharingModePartitioned,
+			},
+			minObservedPower: make(map[string]float64),
+			idleObserved:     make(map[string]bool),
+			idlePower:        100,
+		}
+
+		mockBackend.On("GetDevice", 0).Return(parent, nil)
+		parent.On("GetPowerUsage").Return(device.Power(170*device.Watt), nil)
+		parent.On("UUID").Return("GPU-A100")
+		parent.On("GetComputeRunningProcesses").Return([]gpu.ProcessGPUInfo{{PID: 1001}, {PID: 1002}, {PID: 2001}}, nil)
+		parent.On("GetMIGInstances").Return(instances, nil)
+		parent.On("GetMaxMigDeviceCount").Return(7, nil).Maybe()
+		parent.On("GetMIGDeviceByInstanceID", uint(1)).Return(mig1, nil)
+		parent.On("GetMIGDeviceByInstanceID", uint(2)).Return(mig2, nil)
+
+		mig1.On("GetComputeRunningProcesses").Return([]gpu.ProcessGPUInfo{{PID: 1001}, {PID: 1002}}, nil)
+		mig2.On("GetComputeRunningProcesses").Return([]gpu.ProcessGPUInfo{{PID: 2001}}, nil)
+		return collector, parent, mig1, mig2
+	}
+
+	t.Run("instances attributed by slices and processes by utilization", func(t *testing.T) {
+		collector, parent, mig1, mig2 := newMIGCollector([]MIGInstance{
+			{GPUInstanceID: 1, EntityID: 0, ProfileSlices: 3},
+			{GPUInstanceID: 2, EntityID: 1, ProfileSlices: 4},
+		})
+		mig1.On("GetProcessUtilization", uint64(0)).Return([]gpu.ProcessUtilization{
+			{PID: 1001, ComputeUtil: 25, Timestamp: 100},
+			{PID: 1002, ComputeUtil: 75, Timestamp: 100},
+		}, nil)
+		mig2.On("GetProcessUtilization", uint64(0)).Return([]gpu.ProcessUtilization{
+			{PID: 2001, ComputeUtil: 40, Timestamp: 200},
+		}, nil)
+
+		result, err := collector.GetProcessPower()
+		require.NoError(t, err)
+
+		// 70W active power: 3/7 (30W) to instance 1 and 4/7 (40W) to instance 2
+		require.Len(t, result, 3)
+		assert.InDelta(t, 7.5, result[1001], 0.001)
+		assert.InDelta(t, 22.5, result[1002], 0.001)
+		assert.InDelta(t, 40.0, result[2001], 0.001)
+
+		// utilization is resolved per MIG instance
+		assert.Equal(t, uint64(100), collector.lastMIGUtilTimestamp[migInstanceID{device: 0, gpuInstanceID: 1}])
+		assert.Equal(t, uint64(200), collector.lastMIGUtilTimestamp[migInstanceID{device: 0, gpuInstanceID: 2}])
+		utils, err := collector.GetProcessUtilization()
+		require.NoError(t, err)
+		assert.Equal(t, uint32(25), utils[1001].ComputeUtil)
+		assert.Equal(t, uint32(75), utils[1002].ComputeUtil)
+		assert.Equal(t, uint32(40), utils[2001].ComputeUtil)
+
+		parent.AssertExpectations(t)
+		mig1.AssertExpectations(t)
+		mig2.AssertExpectations(t)
+	})
+
+	t.Run("unknown slices and unavailable utilization split equally", func(t *testing.T) {
+		collector, _, mig1, mig2 := newMIGCollector([]MIGInstance{
+			{GPUInstanceID: 1, EntityID: 0},
+			{GPUInstanceID: 2, EntityID: 1},
+		})
+		mig1.On("GetProcessUtilization", uint64(0)).Return(nil, errors.New("not supported"))
+		mig2.On("GetProcessUtilization", uint64(0)).Return(nil, errors.New("not supported"))
+
+		result, err := collector.GetProcessPower()
+		require.NoError(t, err)
+
+		require.Len(t, result, 3)
+		assert.InDelta(t, 17.5, result[1001], 0.001)
+		assert.InDelta(t, 17.5, result[1002], 0.001)
+		assert.InDelta(t, 35.0, result[2001], 0.001)
+	})
+
+	t.Run("instances without processes are not attributed power", func(t *testing.T) {
+		collector, _, mig1, mig2 := newMIGCollector([]MIGInstance{
+			{GPUInstanceID: 1, EntityID: 0, ProfileSlices: 3},
+			{GPUInstanceID: 2, EntityID: 1, ProfileSlices: 4},
+		})
+		mig1.ExpectedCalls = nil
+		mig1.On("GetComputeRunningProcesses").Return([]gpu.ProcessGPUInfo{}, nil)
+		mig2.On("GetProcessUtilization", uint64(0)).Return([]gpu.ProcessUtilization{}, nil)
+
+		result, err := collector.GetProcessPower()
+		require.NoError(t, err)
+
+		// the active instance is attributed the share of its 4 of 7 slices
+		require.Len(t, result, 1)
+		assert.InDelta(t, 40.0, result[2001], 0.001)
+
+		// the share of the idle instance is reported as idle power
+		stats, err := collector.GetDevicePowerStats(0)
+		require.NoError(t, err)
+		assert.InDelta(t, 40.0, stats.ActivePower, 0.001)
+		assert.InDelta(t, 130.0, stats.IdlePower, 0.001)
+		assert.InDelta(t, stats.ActivePower, result[2001], 0.001, "processes split the reported active power")
+	})
+
+	t.Run("unallocated slices are not attributed power", func(t *testing.T) {
+		collector, _, mig1, mig2 := newMIGCollector([]MIGInstance{
+			{GPUInstanceID: 1, EntityID: 0, ProfileSlices: 1},
+			{GPUInstanceID: 2, EntityID: 1, ProfileSlices: 1},
+		})
+		mig1.ExpectedCalls = nil
+		mig1.On("GetComputeRunningProcesses").Return([]gpu.ProcessGPUInfo{}, nil)
+		mig2.On("GetProcessUtilization", uint64(0)).Return([]gpu.ProcessUtilization{}, nil)
+
+		result, err := collector.GetProcessPower()
+		require.NoError(t, err)
+
+		// one busy 1g slice of 7 is attributed 1/7 of the 70W active power
+		require.Len(t, result, 1)
+		assert.InDelta(t, 10.0, result[2001], 0.001)
+
+		// the share of the idle instance and the unallocated slices is idle
+		stats, err := collector.GetDevicePowerStats(0)
+		require.NoError(t, err)
+		assert.InDelta(t, 10.0, stats.ActivePower, 0.001)
+		assert.InDelta(t, 160.0, stats.IdlePower, 0.001)
+	})
+
+	t.Run("idle and unallocated share is attributed with the idle power", func(t *testing.T) {
+		collector, _, mig1, mig2 := newMIGCollector([]MIGInstance{
+			{GPUInstanceID: 1, EntityID: 0, ProfileSlices: 1},
+			{GPUInstanceID: 2, EntityID: 1, ProfileSlices: 1},
+		})
+		collector.attributeIdle = true
+		mig1.ExpectedCalls = nil
+		mig1.On("GetComputeRunningProcesses").Return([]gpu.ProcessGPUInfo{}, nil)
+		mig2.On("GetProcessUtilization", uint64(0)).Return([]gpu.ProcessUtilization{}, nil)
+
+		result, err := collector.GetProcessPower()
+		require.NoError(t, err)
+
+		// with the total basis the busy instance is attributed all power
+		require.Len(t, result, 1)
+		assert.InDelta(t, 170.0, result[2001], 0.001)
+	})
+
+	t.Run("utilization of destroyed instances is forgotten", func(t *testing.T) {
+		collector, _, mig1, mig2 := newMIGCollector([]MIGInstance{
+			{GPUInstanceID: 1, EntityID: 0, ProfileSlices: 3},
+			{GPUInstanceID: 2, EntityID: 1, ProfileSlices: 4},
+		})
+		mig1.On("GetProcessUtilization", uint64(0)).Return([]gpu.ProcessUtilization{
+			{PID: 1001, ComputeUtil: 25, Timestamp: 100},
+		}, nil)
+		mig2.On("GetProcessUtilization", uint64(0)).Return([]gpu.ProcessUtilization{
+			{PID: 2001, ComputeUtil: 40, Timestamp: 200},
+		}, nil)
+		destroyed := migInstanceID{device: 0, gpuInstanceID: 9}
+		collector.lastMIGUtilTimestamp = map[migInstanceID]uint64{destroyed: 50}
+		collector.lastMIGUtil = map[migInstanceID]map[uint32]gpu.ProcessUtilization{
+			destroyed: {3001: {PID: 3001, ComputeUtil: 90}},
+		}
+
+		_, err := collector.GetProcessPower()
+		require.NoError(t, err)
+
+		assert.NotContains(t, collector.lastMIGUtilTimestamp, destroyed)
+		assert.NotContains(t, collector.lastMIGUtil, destroyed)
+		utils, err := collector.GetProcessUtilization()
+		require.NoError(t, err)
+		assert.NotContains(t, utils, uint32(3001))
+		assert.Contains(t, utils, uint32(2001))
 	})
 }
 
@@ -919,6 +1300,62 @@ func TestGPUPowerCollector_GetProcessInfo(t *testing.T) {
 	})
 }
 
+func TestGPUPowerCollector_ComputeOnly(t *testing.T) {
+	newCollector := func() (*GPUPowerCollector, *MockNVMLDevice) {
+		mockBackend := new(MockNVMLBackend)
+		mockDevice := new(MockNVMLDevice)
+		mockBackend.On("GetDevice", 0).Return(mockDevice, nil)
+		mockDevice.On("GetComputeRunningProcesses").Return([]gpu.ProcessGPUInfo{
+			{PID: 1001, DeviceIndex: 0, Type: gpu.ProcessTypeCompute},
+			{PID: 1002, DeviceIndex: 0, Type: gpu.ProcessTypeCompute},
+		}, nil)
+		mockDevice.On("GetGraphicsRunningProcesses").Return([]gpu.ProcessGPUInfo{
+			{PID: 1002, DeviceIndex: 0, Type: gpu.ProcessTypeGraphics},
+			{PID: 2001, DeviceIndex: 0, Type: gpu.ProcessTypeGraphics},
+		}, nil).Maybe()
+
+		return &GPUPowerCollector{
+			logger:  slog.Default(),
+			nvml:    mockBackend,
+			devices: []gpu.GPUDevice{{Index: 0, UUID: "GPU-0"}},
+		}, mockDevice
+	}
+
+	pids := func(procs []gpu.ProcessGPUInfo) []uint32 {
+		result := make([]uint32, 0, len(procs))
+		for _, p := range procs {
+			result = append(result, p.PID)
+		}
+		return result
+	}
+
+	t.Run("graphics processes are excluded by default", func(t *testing.T) {
+		collector, mockDevice := newCollector()
+
+		result, err := collector.GetProcessInfo()
+
+		assert.NoError(t, err)
+		assert.Equal(t, []uint32{1001, 1002}, pids(result))
+		for _, p := range result {
+			assert.Equal(t, gpu.ProcessTypeCompute, p.Type)
+		}
+		mockDevice.AssertNotCalled(t, "GetGraphicsRunningProcesses")
+	})
+
+	t.Run("graphics processes are included when not compute-only", func(t *testing.T) {
+		collector, _ := newCollector()
+		collector.SetComputeOnly(false)
+
+		result, err := collector.GetProcessInfo()
+
+		assert.NoError(t, err)
+		assert.Equal(t, []uint32{1001, 1002, 2001}, pids(result),
+			"processes with both contexts are reported once")
+		assert.Equal(t, gpu.ProcessTypeCompute, result[1].Type)
+		assert.Equal(t, gpu.ProcessTypeGraphics, result[2].Type)
+	})
+}
+
 func TestGPUPowerCollector_GetProcessPower_ErrorPaths(t *testing.T) {
 	t.Run("exclusive mode GetDevice error", func(t *testing.T) {
 		mockBackend := new(MockNVMLBackend)
@@ -1058,6 +1495,10 @@ func TestGPUPowerCollector_GetProcessPower_ErrorPaths(t *testing.T) {
 		}
 
 		mockBackend.On("GetDevice", 0).Return(mockDevice, nil)
+		mockDevice.On("GetComputeRunningProcesses").Return([]gpu.ProcessGPUInfo{{PID: 1001}}, nil)
+		mockDevice.On("GetProcessUtilization", uint64(0)).Return([]gpu.ProcessUtilization{
+			{PID: 1001, ComputeUtil: 50, Timestamp: 100},
+		}, nil)
 		mockDevice.On("GetPowerUsage").Return(device.Power(0), errors.New("NVML error"))
 
 		result, err := collector.GetProcessPower()
@@ -1278,6 +1719,339 @@ func TestGPUPowerCollector_SetIdlePower(t *testing.T) {
 // Verify IdlePowerConfigurable interface implementation
 var _ gpu.IdlePowerConfigurable = (*GPUPowerCollector)(nil)
 
+// Verify ProcessExcludable interface implementation
+var _ gpu.ProcessExcludable = (*GPUPowerCollector)(nil)
+
+// Verify EncDecWeightConfigurable interface implementation
+var _ gpu.EncDecWeightConfigurable = (*GPUPowerCollector)(nil)
+
+func TestGPUPowerCollector_EncDecWeight(t *testing.T) {
+	newCollector := func(weight float64) (*GPUPowerCollector, *MockNVMLBackend, *MockNVMLDevice) {
+		mockBackend := new(MockNVMLBackend)
+		mockDevice := new(MockNVMLDevice)
+		collector := &GPUPowerCollector{
+			logger:           slog.Default(),
+			nvml:             mockBackend,
+			devices:          []gpu.GPUDevice{{Index: 0, UUID: "GPU-123"}},
+			sharingModes:     map[int]gpu.SharingMode{0: gpu.SharingModeTimeSlicing},
+			minObservedPower: map[string]float64{"GPU-123": 40.0},
+			idleObserved:     map[string]bool{"GPU-123": true},
+		}
+		collector.SetEncDecWeight(weight)
+
+		mockBackend.On("GetDevice", 0).Return(mockDevice, nil)
+		mockDevice.On("GetPowerUsage").Return(device.Power(100*device.Watt), nil)
+		mockDevice.On("UUID").Return("GPU-123")
+		mockDevice.On("GetComputeRunningProcesses").Return([]gpu.ProcessGPUInfo{
+			{PID: 1001},
+			{PID: 1002},
+		}, nil)
+		// 1001 is a compute workload; 1002 a transcoder leaving the SMs idle
+		mockDevice.On("GetProcessUtilization", uint64(0)).Return([]gpu.ProcessUtilization{
+			{PID: 1001, ComputeUtil: 60, Timestamp: 100},
+			{PID: 1002, ComputeUtil: 0, EncUtil: 30, DecUtil: 10, Timestamp: 100},
+		}, nil)
+		return collector, mockBackend, mockDevice
+	}
+
+	t.Run("encoder busy process is attributed power", func(t *testing.T) {
+		collector, mockBackend, mockDevice := newCollector(1.0)
+
+		result, err := collector.GetProcessPower()
+		require.NoError(t, err)
+
+		// active power 60W split 60:40 by SM + enc + dec utilization
+		assert.InDelta(t, 36.0, result[1001], 0.01)
+		assert.InDelta(t, 24.0, result[1002], 0.01)
+		assert.InDelta(t, 60.0, result[1001]+result[1002], 1e-9, "active power must be conserved")
+
+		// device utilization still reports SM utilization only
+		stats, err := collector.GetDevicePowerStats(0)
+		require.NoError(t, err)
+		assert.Equal(t, 60.0, stats.Utilization)
+
+		mockBackend.AssertExpectations(t)
+		mockDevice.AssertExpectations(t)
+	})
+
+	t.Run("disabled attributes by SM utilization only", func(t *testing.T) {
+		collector, _, _ := newCollector(0)
+
+		result, err := collector.GetProcessPower()
+		require.NoError(t, err)
+		assert.InDelta(t, 60.0, result[1001], 0.01)
+		assert.Zero(t, result[1002])
+	})
+
+	t.Run("negative weight is clamped", func(t *testing.T) {
+		collector := &GPUPowerCollector{}
+		collector.SetEncDecWeight(-1)
+		assert.Zero(t, collector.encDecWeight)
+	})
+}
+
+func TestGPUPowerCollector_AttributeIdle(t *testing.T) {
+	newCollector := func(attributeIdle bool) *GPUPowerCollector {
+		mockBackend := new(MockNVMLBackend)
+		mockDevice := new(MockNVMLDevice)
+		collector := &GPUPowerCollector{
+			logger:           slog.Default(),
+			nvml:             mockBackend,
+			devices:          []gpu.GPUDevice{{Index: 0, UUID: "GPU-123"}},
+			sharingModes:     map[int]gpu.SharingMode{0: gpu.SharingModeTimeSlicing},
+			minObservedPower: make(map[string]float64),
+			idleObserved:     make(map[string]bool),
+		}
+		collector.SetIdlePower(40.0)
+		collector.SetAttributeIdle(attributeIdle)
+
+		mockBackend.On("GetDevice", 0).Return(mockDevice, nil)
+		mockDevice.On("GetPowerUsage").Return(device.Power(100*device.Watt), nil)
+		mockDevice.On("UUID").Return("GPU-123")
+		mockDevice.On("GetComputeRunningProcesses").Return([]gpu.ProcessGPUInfo{
+			{PID: 1001},
+			{PID: 1002},
+		}, nil)
+		mockDevice.On("GetProcessUtilization", uint64(0)).Return([]gpu.ProcessUtilization{
+			{PID: 1001, ComputeUtil: 75, Timestamp: 100},
+			{PID: 1002, ComputeUtil: 25, Timestamp: 100},
+		}, nil)
+		return collector
+	}
+
+	t.Run("idle power is excluded by default", func(t *testing.T) {
+		collector := newCollector(false)
+
+		result, err := collector.GetProcessPower()
+		require.NoError(t, err)
+
+		stats, err := collector.GetDevicePowerStats(0)
+		require.NoError(t, err)
+		assert.Equal(t, 40.0, stats.IdlePower)
+		assert.Equal(t, 60.0, stats.ActivePower)
+		assert.Equal(t, stats.TotalPower, stats.ActivePower+stats.IdlePower, "node power must be conserved")
+
+		// only the 60W above the baseline is split 75:25
+		assert.InDelta(t, 45.0, result[1001], 0.01)
+		assert.InDelta(t, 15.0, result[1002], 0.01)
+		assert.InDelta(t, stats.ActivePower, result[1001]+result[1002], 1e-9)
+	})
+
+	t.Run("idle power is attributed when enabled", func(t *testing.T) {
+		collector := newCollector(true)
+
+		result, err := collector.GetProcessPower()
+		require.NoError(t, err)
+
+		stats, err := collector.GetDevicePowerStats(0)
+		require.NoError(t, err)
+		assert.Equal(t, stats.TotalPower, stats.ActivePower+stats.IdlePower, "node power must be conserved")
+
+		assert.InDelta(t, 75.0, result[1001], 0.01)
+		assert.InDelta(t, 25.0, result[1002], 0.01)
+		assert.InDelta(t, stats.TotalPower, result[1001]+result[1002], 1e-9)
+	})
+}
+
+func TestGPUPowerCollector_UtilizationSplitConservesActivePower(t *testing.T) {
+	mockBackend := new(MockNVMLBackend)
+	mockDevice := new(MockNVMLDevice)
+	// no idle power configured or observed: power is split by utilization
+	collector := &GPUPowerCollector{
+		logger:           slog.Default(),
+		nvml:             mockBackend,
+		devices:          []gpu.GPUDevice{{Index: 0, UUID: "GPU-123"}},
+		sharingModes:     map[int]gpu.SharingMode{0: gpu.SharingModeTimeSlicing},
+		minObservedPower: make(map[string]float64),
+		idleObserved:     make(map[string]bool),
+	}
+
+	mockBackend.On("GetDevice", 0).Return(mockDevice, nil)
+	mockDevice.On("GetPowerUsage").Return(device.Power(100*device.Watt), nil)
+	mockDevice.On("UUID").Return("GPU-123")
+	mockDevice.On("GetComputeRunningProcesses").Return([]gpu.ProcessGPUInfo{
+		{PID: 1001},
+		{PID: 1002},
+	}, nil)
+	mockDevice.On("GetProcessUtilization", uint64(0)).Return([]gpu.ProcessUtilization{
+		{PID: 1001, ComputeUtil: 30, Timestamp: 100},
+		{PID: 1002, ComputeUtil: 20, Timestamp: 100},
+	}, nil)
+
+	result, err := collector.GetProcessPower()
+	require.NoError(t, err)
+
+	stats, err := collector.GetDevicePowerStats(0)
+	require.NoError(t, err)
+	assert.Equal(t, 50.0, stats.Utilization)
+	assert.Equal(t, 50.0, stats.ActivePower)
+	assert.Equal(t, 50.0, stats.IdlePower)
+
+	// the processes split the same active power the device reports, from the
+	// first attribution on
+	assert.InDelta(t, 30.0, result[1001], 0.01)
+	assert.InDelta(t, 20.0, result[1002], 0.01)
+	assert.InDelta(t, stats.ActivePower, result[1001]+result[1002], 1e-9, "active power must be conserved")
+}
+
+func TestGPUPowerCollector_LimitDevices(t *testing.T) {
+	allDevices := func() []gpu.GPUDevice {
+		return []gpu.GPUDevice{
+			{Index: 2, UUID: "GPU-2", Vendor: gpu.VendorNVIDIA},
+			{Index: 0, UUID: "GPU-0", Vendor: gpu.VendorNVIDIA},
+			{Index: 3, UUID: "GPU-3", Vendor: gpu.VendorNVIDIA},
+			{Index: 1, UUID: "GPU-1", Vendor: gpu.VendorNVIDIA},
+		}
+	}
+
+	t.Run("cap below device count", func(t *testing.T) {
+		collector := &GPUPowerCollector{
+			devices: allDevices(),
+			sharingModes: map[int]gpu.SharingMode{
+				0: gpu.SharingModeExclusive,
+				1: gpu.SharingModeExclusive,
+				2: gpu.SharingModeTimeSlicing,
+				3: gpu.SharingModeTimeSlicing,
+			},
+		}
+
+		skipped := collector.LimitDevices(2)
+
+		assert.Equal(t, []string{"GPU-0", "GPU-1"}, deviceUUIDs(collector.Devices()))
+		assert.Equal(t, []string{"GPU-2", "GPU-3"}, deviceUUIDs(skipped))
+		assert.Len(t, collector.sharingModes, 2)
+		assert.NotContains(t, collector.sharingModes, 2)
+		assert.NotContains(t, collector.sharingModes, 3)
+	})
+
+	t.Run("cap at or above device count", func(t *testing.T) {
+		collector := &GPUPowerCollector{devices: allDevices()}
+
+		assert.Empty(t, collector.LimitDevices(4))
+		assert.Empty(t, collector.LimitDevices(8))
+		assert.Len(t, collector.Devices(), 4)
+	})
+
+	t.Run("no cap", func(t *testing.T) {
+		collector := &GPUPowerCollector{devices: allDevices()}
+
+		assert.Empty(t, collector.LimitDevices(0))
+		assert.Len(t, collector.Devices(), 4)
+	})
+}
+
+func TestGPUPowerCollector_LimitDevicesSurvivesInit(t *testing.T) {
+	mockBackend := new(MockNVMLBackend)
+	mockDevice := new(MockNVMLDevice)
+
+	// NVML is initialized and devices discovered only once
+	mockBackend.On("Init").Return(nil).Once()
+	mockBackend.On("DiscoverDevices").Return([]gpu.GPUDevice{
+		{Index: 0, UUID: "GPU-0", Vendor: gpu.VendorNVIDIA},
+		{Index: 1, UUID: "GPU-1", Vendor: gpu.VendorNVIDIA},
+		{Index: 2, UUID: "GPU-2", Vendor: gpu.VendorNVIDIA},
+	}, nil).Once()
+	mockBackend.On("DeviceCount").Return(3)
+	mockBackend.On("GetDevice", mock.Anything).Return(mockDevice, nil)
+	mockDevice.On("IsMIGEnabled").Return(false, nil)
+	mockDevice.On("GetComputeMode").Return(ComputeModeDefault, nil)
+
+	collector := &GPUPowerCollector{
+		logger:           slog.Default(),
+		nvml:             mockBackend,
+		minObservedPower: make(map[string]float64),
+		idleObserved:     make(map[string]bool),
+		sharingModes:     make(map[int]gpu.SharingMode),
+	}
+
+	// gpu.Discover initializes the meter before the device limit is applied
+	require.NoError(t, collector.Init())
+	assert.Len(t, collector.LimitDevices(2), 1)
+
+	// the service lifecycle initializes the meter again
+	require.NoError(t, collector.Init())
+	assert.Equal(t, []string{"GPU-0", "GPU-1"}, deviceUUIDs(collector.Devices()))
+
+	mockBackend.AssertExpectations(t)
+}
+
+func TestGPUPowerCollector_SelectDevicesSurvivesInit(t *testing.T) {
+	mockBackend := new(MockNVMLBackend)
+	mockDevice := new(MockNVMLDevice)
+
+	mockBackend.On("Init").Return(nil).Once()
+	mockBackend.On("DiscoverDevices").Return([]gpu.GPUDevice{
+		{Index: 0, UUID: "GPU-0", Vendor: gpu.VendorNVIDIA},
+		{Index: 1, UUID: "GPU-1", Vendor: gpu.VendorNVIDIA},
+	}, nil).Once()
+	mockBackend.On("DeviceCount").Return(2)
+	mockBackend.On("GetDevice", mock.Anything).Return(mockDevice, nil)
+	mockDevice.On("IsMIGEnabled").Return(false, nil)
+	mockDevice.On("GetComputeMode").Return(ComputeModeDefault, nil)
+
+	collector := &GPUPowerCollector{
+		logger:           slog.Default(),
+		nvml:             mockBackend,
+		minObservedPower: make(map[string]float64),
+		idleObserved:     make(map[string]bool),
+		sharingModes:     make(map[int]gpu.SharingMode),
+	}
+
+	require.NoError(t, collector.Init())
+	assert.Len(t, collector.SelectDevices([]string{"GPU-1"}), 1)
+
+	require.NoError(t, collector.Init())
+	assert.Equal(t, []string{"GPU-1"}, deviceUUIDs(collector.Devices()))
+
+	// a shutdown meter rediscovers its devices
+	mockBackend.On("Shutdown").Return(nil)
+	mockBackend.On("Init").Return(nil).Once()
+	mockBackend.On("DiscoverDevices").Return([]gpu.GPUDevice{
+		{Index: 0, UUID: "GPU-0", Vendor: gpu.VendorNVIDIA},
+		{Index: 1, UUID: "GPU-1", Vendor: gpu.VendorNVIDIA},
+	}, nil).Once()
+	require.NoError(t, collector.Shutdown())
+	require.NoError(t, collector.Init())
+	assert.Len(t, collector.Devices(), 2)
+
+	mockBackend.AssertExpectations(t)
+}
+
+func deviceUUIDs(devices []gpu.GPUDevice) []string {
+	uuids := make([]string, len(devices))
+	for i, d := range devices {
+		uuids[i] = d.UUID
+	}
+	return uuids
+}
+
+// Verify DeviceLimitable interface implementation
+var _ gpu.DeviceLimitable = (*GPUPowerCollector)(nil)
+
+func TestGPUPowerCollector_SelectDevices(t *testing.T) {
+	collector := &GPUPowerCollector{
+		devices: []gpu.GPUDevice{
+			{Index: 0, UUID: "GPU-0", Vendor: gpu.VendorNVIDIA},
+			{Index: 1, UUID: "GPU-1", Vendor: gpu.VendorNVIDIA},
+			{Index: 2, UUID: "GPU-2", Vendor: gpu.VendorNVIDIA},
+		},
+		sharingModes: map[int]gpu.SharingMode{
+			0: gpu.SharingModeExclusive,
+			1: gpu.SharingModeExclusive,
+			2: gpu.SharingModeTimeSlicing,
+		},
+	}
+
+	skipped := collector.SelectDevices([]string{"GPU-2", "GPU-0", "GPU-unknown"})
+
+	assert.Equal(t, []string{"GPU-0", "GPU-2"}, deviceUUIDs(collector.Devices()))
+	assert.Equal(t, []string{"GPU-1"}, deviceUUIDs(skipped))
+	assert.NotContains(t, collector.sharingModes, 1)
+	assert.Len(t, collector.sharingModes, 2)
+}
+
+var _ gpu.DeviceSelectable = (*GPUPowerCollector)(nil)
+
 func TestGPUPowerCollector_GetTotalEnergy_ErrorPaths(t *testing.T) {
 	t.Run("GetTotalEnergy error", func(t *testing.T) {
 		mockBackend := new(MockNVMLBackend)
@@ -1350,3 +2124,128 @@ func TestRegistration(t *testing.T) {
 
 // Ensure interface implementation
 var _ gpu.GPUPowerMeter = (*GPUPowerCollector)(nil)
+
+func TestGPUPowerCollector_UtilizationOutOfRange(t *testing.T) {
+	mockBackend := new(MockNVMLBackend)
+	mockDevice := new(MockNVMLDevice)
+	collector := &GPUPowerCollector{
+		logger:           slog.Default(),
+		nvml:             mockBackend,
+		devices:          []gpu.GPUDevice{{Index: 0, UUID: "GPU-123"}},
+		sharingModes:     map[int]gpu.SharingMode{0: gpu.SharingModeTimeSlicing},
+		minObservedPower: map[string]float64{"GPU-123": 40.0},
+		idleObserved:     map[string]bool{"GPU-123": true},
+	}
+
+	mockBackend.On("GetDevice", 0).Return(mockDevice, nil)
+	mockDevice.On("GetPowerUsage").Return(device.Power(100*device.Watt), nil)
+	mockDevice.On("UUID").Return("GPU-123")
+	mockDevice.On("GetComputeRunningProcesses").Return([]gpu.ProcessGPUInfo{
+		{PID: 1001},
+		{PID: 1002},
+	}, nil)
+	mockDevice.On("GetProcessUtilization", uint64(0)).Return([]gpu.ProcessUtilization{
+		{PID: 1001, ComputeUtil: 150, Timestamp: 100},
+		{PID: 1002, ComputeUtil: 50, Timestamp: 100},
+	}, nil)
+
+	result, err := collector.GetProcessPower()
+	require.NoError(t, err)
+
+	// 150% is clamped to 100%: active power 60W split 100:50
+	assert.InDelta(t, 40.0, result[1001], 0.01)
+	assert.InDelta(t, 20.0, result[1002], 0.01)
+
+	stats, err := collector.GetDevicePowerStats(0)
+	require.NoError(t, err)
+	assert.Equal(t, gpu.MaxUtilization, stats.Utilization)
+}
+
+func TestGPUPowerCollector_GetProcessUtilization(t *testing.T) {
+	mockBackend := new(MockNVMLBackend)
+	dev0 := new(MockNVMLDevice)
+	dev1 := new(MockNVMLDevice)
+	collector := &GPUPowerCollector{
+		logger:  slog.Default(),
+		nvml:    mockBackend,
+		devices: []gpu.GPUDevice{{Index: 0, UUID: "GPU-0"}, {Index: 1, UUID: "GPU-1"}},
+		sharingModes: map[int]gpu.SharingMode{
+			0: gpu.SharingModeTimeSlicing,
+			1: gpu.SharingModeTimeSlicing,
+		},
+		minObservedPower: map[string]float64{"GPU-0": 40.0, "GPU-1": 40.0},
+		idleObserved:     map[string]bool{"GPU-0": true, "GPU-1": true},
+	}
+
+	mockBackend.On("GetDevice", 0).Return(dev0, nil)
+	mockBackend.On("GetDevice", 1).Return(dev1, nil)
+	dev0.On("GetPowerUsage").Return(device.Power(100*device.Watt), nil)
+	dev0.On("UUID").Return("GPU-0")
+	dev1.On("GetPowerUsage").Return(device.Power(100*device.Watt), nil)
+	dev1.On("UUID").Return("GPU-1")
+	dev0.On("GetComputeRunningProcesses").Return([]gpu.ProcessGPUInfo{{PID: 1001}, {PID: 1002}}, nil)
+	dev0.On("GetProcessUtilization", uint64(0)).Return([]gpu.ProcessUtilization{
+		{PID: 1001, ComputeUtil: 10, EncUtil: 60, DecUtil: 30, Timestamp: 100},
+		{PID: 1002, ComputeUtil: 50, Timestamp: 100},
+	}, nil)
+	dev1.On("GetComputeRunningProcesses").Return([]gpu.ProcessGPUInfo{{PID: 1001}}, nil)
+	dev1.On("GetProcessUtilization", uint64(0)).Return([]gpu.ProcessUtilization{
+		{PID: 1001, ComputeUtil: 5, EncUtil: 20, Timestamp: 200},
+	}, nil)
+
+	// no utilization is known before the first attribution
+	utils, err := collector.GetProcessUtilization()
+	require.NoError(t, err)
+	assert.Empty(t, utils)
+
+	_, err = collector.GetProcessPower()
+	require.NoError(t, err)
+
+	utils, err = collector.GetProcessUtilization()
+	require.NoError(t, err)
+	require.Len(t, utils, 2)
+
+	// utilization of a process using both devices is summed
+	assert.Equal(t, gpu.ProcessUtilization{
+		PID: 1001, ComputeUtil: 15, EncUtil: 80, DecUtil: 30, Timestamp: 200,
+	}, utils[1001])
+	assert.Equal(t, uint32(50), utils[1002].ComputeUtil)
+	assert.Zero(t, utils[1002].EncUtil)
+}
+
+func TestGPUPowerCollector_IdleDeviceIsSplitByUtilization(t *testing.T) {
+	mockBackend := new(MockNVMLBackend)
+	mockDevice := new(MockNVMLDevice)
+	collector := &GPUPowerCollector{
+		logger:           slog.Default(),
+		nvml:             mockBackend,
+		devices:          []gpu.GPUDevice{{Index: 0, UUID: "GPU-123"}},
+		sharingModes:     map[int]gpu.SharingMode{0: gpu.SharingModeTimeSlicing},
+		minObservedPower: make(map[string]float64),
+		idleObserved:     make(map[string]bool),
+	}
+
+	mockBackend.On("GetDevice", 0).Return(mockDevice, nil)
+	mockDevice.On("GetPowerUsage").Return(device.Power(100*device.Watt), nil)
+	mockDevice.On("UUID").Return("GPU-123")
+	// a process holds the device without using it, so no idle power is observed
+	mockDevice.On("GetComputeRunningProcesses").Return([]gpu.ProcessGPUInfo{{PID: 1001}}, nil)
+	mockDevice.On("GetProcessUtilization", uint64(0)).Return([]gpu.ProcessUtilization{}, nil)
+
+	// the utilization is unknown before the first attribution
+	stats, err := collector.GetDevicePowerStats(0)
+	require.NoError(t, err)
+	assert.False(t, stats.UtilizationKnown)
+	assert.Equal(t, 100.0, stats.ActivePower)
+
+	result, err := collector.GetProcessPower()
+	require.NoError(t, err)
+	assert.Zero(t, result[1001])
+
+	// without utilization the device is known to be idle
+	stats, err = collector.GetDevicePowerStats(0)
+	require.NoError(t, err)
+	assert.True(t, stats.UtilizationKnown)
+	assert.Equal(t, 0.0, stats.ActivePower)
+	assert.Equal(t, 100.0, stats.IdlePower)
+}
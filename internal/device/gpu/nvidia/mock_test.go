@@ -83,6 +83,14 @@ func (m *MockNVMLDevice) GetComputeRunningProcesses() ([]gpu.ProcessGPUInfo, err
 	return args.Get(0).([]gpu.ProcessGPUInfo), args.Error(1)
 }
 
+func (m *MockNVMLDevice) GetGraphicsRunningProcesses() ([]gpu.ProcessGPUInfo, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]gpu.ProcessGPUInfo), args.Error(1)
+}
+
 func (m *MockNVMLDevice) GetProcessUtilization(lastSeen uint64) ([]gpu.ProcessUtilization, error) {
 	args := m.Called(lastSeen)
 	if args.Get(0) == nil {
@@ -122,6 +130,11 @@ func (m *MockNVMLDevice) GetMaxMigDeviceCount() (int, error) {
 	return args.Int(0), args.Error(1)
 }
 
+func (m *MockNVMLDevice) GetReliabilityStats() (gpu.ReliabilityStats, error) {
+	args := m.Called()
+	return args.Get(0).(gpu.ReliabilityStats), args.Error(1)
+}
+
 // Verify interface implementations
 var _ NVMLBackend = (*MockNVMLBackend)(nil)
 var _ NVMLDevice = (*MockNVMLDevice)(nil)
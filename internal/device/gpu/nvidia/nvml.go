@@ -61,12 +61,14 @@ type NVMLDevice interface {
 	GetPowerUsage() (device.Power, error)
 	GetTotalEnergy() (device.Energy, error)
 	GetComputeRunningProcesses() ([]gpu.ProcessGPUInfo, error)
+	GetGraphicsRunningProcesses() ([]gpu.ProcessGPUInfo, error)
 	GetProcessUtilization(lastSeen uint64) ([]gpu.ProcessUtilization, error)
 	GetComputeMode() (ComputeMode, error)
 	IsMIGEnabled() (bool, error)
 	GetMIGInstances() ([]MIGInstance, error)
 	GetMIGDeviceByInstanceID(gpuInstanceID uint) (NVMLDevice, error)
 	GetMaxMigDeviceCount() (int, error)
+	GetReliabilityStats() (gpu.ReliabilityStats, error)
 }
 
 // nvmlBackend is the concrete implementation of NVMLBackend
@@ -268,7 +270,19 @@ func (d *nvmlDevice) GetComputeRunningProcesses() ([]gpu.ProcessGPUInfo, error)
 	if ret != nvml.SUCCESS {
 		return nil, fmt.Errorf("failed to get running processes: %s", d.lib.ErrorString(ret))
 	}
+	return d.processInfo(procs, gpu.ProcessTypeCompute), nil
+}
+
+// GetGraphicsRunningProcesses returns processes currently using the GPU for graphics
+func (d *nvmlDevice) GetGraphicsRunningProcesses() ([]gpu.ProcessGPUInfo, error) {
+	procs, ret := d.handle.GetGraphicsRunningProcesses()
+	if ret != nvml.SUCCESS {
+		return nil, fmt.Errorf("failed to get running graphics processes: %s", d.lib.ErrorString(ret))
+	}
+	return d.processInfo(procs, gpu.ProcessTypeGraphics), nil
+}
 
+func (d *nvmlDevice) processInfo(procs []nvml.ProcessInfo, typ gpu.ProcessType) []gpu.ProcessGPUInfo {
 	now := time.Now()
 	result := make([]gpu.ProcessGPUInfo, len(procs))
 	for i, p := range procs {
@@ -276,12 +290,12 @@ func (d *nvmlDevice) GetComputeRunningProcesses() ([]gpu.ProcessGPUInfo, error)
 			PID:         p.Pid,
 			DeviceIndex: d.index,
 			DeviceUUID:  d.uuid,
+			Type:        typ,
 			MemoryUsed:  p.UsedGpuMemory,
 			Timestamp:   now,
 		}
 	}
-
-	return result, nil
+	return result
 }
 
 // GetProcessUtilization returns per-process SM and memory utilization.
@@ -308,6 +322,13 @@ func (d *nvmlDevice) GetProcessUtilization(lastSeen uint64) ([]gpu.ProcessUtiliz
 		return result, nil
 	}
 
+	// NVML reports ERROR_NOT_FOUND when no samples are newer than lastSeen,
+	// e.g. when polled faster than the driver samples; callers then reuse the
+	// last samples
+	if ret == nvml.ERROR_NOT_FOUND {
+		return []gpu.ProcessUtilization{}, nil
+	}
+
 	// Check if accounting mode is the issue
 	mode, accRet := d.handle.GetAccountingMode()
 	if accRet == nvml.SUCCESS && mode == nvml.FEATURE_DISABLED {
@@ -390,9 +411,17 @@ func (d *nvmlDevice) enumerateMIGInstances() ([]MIGInstance, error) {
 			continue
 		}
 
+		// the slice count is left unknown (0) when the attributes are not
+		// available
+		var slices uint
+		if attrs, ret := migDevice.GetAttributes(); ret == nvml.SUCCESS {
+			slices = uint(attrs.GpuInstanceSliceCount)
+		}
+
 		instances = append(instances, MIGInstance{
 			GPUInstanceID: uint(giID),
 			EntityID:      uint(i),
+			ProfileSlices: slices,
 		})
 	}
 
@@ -462,3 +491,29 @@ func (d *nvmlDevice) GetMaxMigDeviceCount() (int, error) {
 	}
 	return count, nil
 }
+
+// GetReliabilityStats returns the fan speed and performance state of the
+// device. Readings the device does not support are reported as -1.
+func (d *nvmlDevice) GetReliabilityStats() (gpu.ReliabilityStats, error) {
+	stats := gpu.ReliabilityStats{FanSpeed: -1, PState: -1}
+
+	fanSpeed, ret := d.handle.GetFanSpeed()
+	switch ret {
+	case nvml.SUCCESS:
+		stats.FanSpeed = float64(fanSpeed)
+	case nvml.ERROR_NOT_SUPPORTED:
+	default:
+		return stats, fmt.Errorf("failed to get fan speed: %s", d.lib.ErrorString(ret))
+	}
+
+	pstate, ret := d.handle.GetPerformanceState()
+	switch {
+	case ret == nvml.SUCCESS && pstate != nvml.PSTATE_UNKNOWN:
+		stats.PState = int(pstate)
+	case ret == nvml.SUCCESS, ret == nvml.ERROR_NOT_SUPPORTED:
+	default:
+		return stats, fmt.Errorf("failed to get performance state: %s", d.lib.ErrorString(ret))
+	}
+
+	return stats, nil
+}
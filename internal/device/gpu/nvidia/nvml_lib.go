@@ -24,13 +24,17 @@ type nvmlDeviceHandle interface {
 	GetPowerUsage() (uint32, nvml.Return)
 	GetTotalEnergyConsumption() (uint64, nvml.Return)
 	GetComputeRunningProcesses() ([]nvml.ProcessInfo, nvml.Return)
+	GetGraphicsRunningProcesses() ([]nvml.ProcessInfo, nvml.Return)
 	GetProcessUtilization(lastSeen uint64) ([]nvml.ProcessUtilizationSample, nvml.Return)
 	GetComputeMode() (nvml.ComputeMode, nvml.Return)
 	GetMigMode() (int, int, nvml.Return)
 	GetMigDeviceHandleByIndex(index int) (nvmlDeviceHandle, nvml.Return)
 	GetGpuInstanceId() (int, nvml.Return)
 	GetMaxMigDeviceCount() (int, nvml.Return)
+	GetAttributes() (nvml.DeviceAttributes, nvml.Return)
 	GetAccountingMode() (nvml.EnableState, nvml.Return)
+	GetFanSpeed() (uint32, nvml.Return)
+	GetPerformanceState() (nvml.Pstates, nvml.Return)
 }
 
 // realNvmlLib is the production implementation that calls the actual NVML library.
@@ -90,6 +94,10 @@ func (h *realDeviceHandle) GetComputeRunningProcesses() ([]nvml.ProcessInfo, nvm
 	return h.device.GetComputeRunningProcesses()
 }
 
+func (h *realDeviceHandle) GetGraphicsRunningProcesses() ([]nvml.ProcessInfo, nvml.Return) {
+	return h.device.GetGraphicsRunningProcesses()
+}
+
 func (h *realDeviceHandle) GetProcessUtilization(lastSeen uint64) ([]nvml.ProcessUtilizationSample, nvml.Return) {
 	return h.device.GetProcessUtilization(lastSeen)
 }
@@ -118,6 +126,18 @@ func (h *realDeviceHandle) GetMaxMigDeviceCount() (int, nvml.Return) {
 	return h.device.GetMaxMigDeviceCount()
 }
 
+func (h *realDeviceHandle) GetAttributes() (nvml.DeviceAttributes, nvml.Return) {
+	return h.device.GetAttributes()
+}
+
 func (h *realDeviceHandle) GetAccountingMode() (nvml.EnableState, nvml.Return) {
 	return h.device.GetAccountingMode()
 }
+
+func (h *realDeviceHandle) GetFanSpeed() (uint32, nvml.Return) {
+	return h.device.GetFanSpeed()
+}
+
+func (h *realDeviceHandle) GetPerformanceState() (nvml.Pstates, nvml.Return) {
+	return h.device.GetPerformanceState()
+}
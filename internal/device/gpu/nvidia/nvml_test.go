@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/sustainable-computing-io/kepler/internal/device"
+	"github.com/sustainable-computing-io/kepler/internal/device/gpu"
 )
 
 // mockNvmlLib is a mock implementation of nvmlLib for testing
@@ -81,6 +82,15 @@ func (m *mockDeviceHandle) GetComputeRunningProcesses() ([]nvml.ProcessInfo, nvm
 	return procs.([]nvml.ProcessInfo), args.Get(1).(nvml.Return)
 }
 
+func (m *mockDeviceHandle) GetGraphicsRunningProcesses() ([]nvml.ProcessInfo, nvml.Return) {
+	args := m.Called()
+	procs := args.Get(0)
+	if procs == nil {
+		return nil, args.Get(1).(nvml.Return)
+	}
+	return procs.([]nvml.ProcessInfo), args.Get(1).(nvml.Return)
+}
+
 func (m *mockDeviceHandle) GetProcessUtilization(lastSeen uint64) ([]nvml.ProcessUtilizationSample, nvml.Return) {
 	args := m.Called(lastSeen)
 	samples := args.Get(0)
@@ -119,11 +129,26 @@ func (m *mockDeviceHandle) GetMaxMigDeviceCount() (int, nvml.Return) {
 	return args.Int(0), args.Get(1).(nvml.Return)
 }
 
+func (m *mockDeviceHandle) GetAttributes() (nvml.DeviceAttributes, nvml.Return) {
+	args := m.Called()
+	return args.Get(0).(nvml.DeviceAttributes), args.Get(1).(nvml.Return)
+}
+
 func (m *mockDeviceHandle) GetAccountingMode() (nvml.EnableState, nvml.Return) {
 	args := m.Called()
 	return args.Get(0).(nvml.EnableState), args.Get(1).(nvml.Return)
 }
 
+func (m *mockDeviceHandle) GetFanSpeed() (uint32, nvml.Return) {
+	args := m.Called()
+	return args.Get(0).(uint32), args.Get(1).(nvml.Return)
+}
+
+func (m *mockDeviceHandle) GetPerformanceState() (nvml.Pstates, nvml.Return) {
+	args := m.Called()
+	return args.Get(0).(nvml.Pstates), args.Get(1).(nvml.Return)
+}
+
 func TestNewNVMLBackend(t *testing.T) {
 	t.Run("with logger", func(t *testing.T) {
 		logger := slog.Default()
@@ -398,6 +423,45 @@ func TestNVMLBackend_DiscoverDevices(t *testing.T) {
 	})
 }
 
+func TestNVMLDevice_GetReliabilityStats(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mockHandle := new(mockDeviceHandle)
+		mockHandle.On("GetFanSpeed").Return(uint32(45), nvml.SUCCESS)
+		mockHandle.On("GetPerformanceState").Return(nvml.PSTATE_2, nvml.SUCCESS)
+
+		dev := &nvmlDevice{handle: mockHandle, lib: new(mockNvmlLib)}
+		stats, err := dev.GetReliabilityStats()
+
+		assert.NoError(t, err)
+		assert.Equal(t, gpu.ReliabilityStats{FanSpeed: 45, PState: 2}, stats)
+		mockHandle.AssertExpectations(t)
+	})
+
+	t.Run("not supported", func(t *testing.T) {
+		mockHandle := new(mockDeviceHandle)
+		mockHandle.On("GetFanSpeed").Return(uint32(0), nvml.ERROR_NOT_SUPPORTED)
+		mockHandle.On("GetPerformanceState").Return(nvml.PSTATE_UNKNOWN, nvml.SUCCESS)
+
+		dev := &nvmlDevice{handle: mockHandle, lib: new(mockNvmlLib)}
+		stats, err := dev.GetReliabilityStats()
+
+		assert.NoError(t, err)
+		assert.Equal(t, gpu.ReliabilityStats{FanSpeed: -1, PState: -1}, stats)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		mockLib := new(mockNvmlLib)
+		mockHandle := new(mockDeviceHandle)
+		mockHandle.On("GetFanSpeed").Return(uint32(0), nvml.ERROR_UNKNOWN)
+		mockLib.On("ErrorString", nvml.ERROR_UNKNOWN).Return("Unknown error")
+
+		dev := &nvmlDevice{handle: mockHandle, lib: mockLib}
+		_, err := dev.GetReliabilityStats()
+
+		assert.ErrorContains(t, err, "failed to get fan speed")
+	})
+}
+
 func TestNVMLDevice_GetPowerUsage(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		mockLib := new(mockNvmlLib)
@@ -484,6 +548,7 @@ func TestNVMLDevice_GetComputeRunningProcesses(t *testing.T) {
 		assert.Len(t, result, 2)
 		assert.Equal(t, uint32(1234), result[0].PID)
 		assert.Equal(t, uint64(1024), result[0].MemoryUsed)
+		assert.Equal(t, gpu.ProcessTypeCompute, result[0].Type)
 
 		mockHandle.AssertExpectations(t)
 	})
@@ -505,6 +570,46 @@ func TestNVMLDevice_GetComputeRunningProcesses(t *testing.T) {
 	})
 }
 
+func TestNVMLDevice_GetGraphicsRunningProcesses(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mockLib := new(mockNvmlLib)
+		mockHandle := new(mockDeviceHandle)
+
+		procs := []nvml.ProcessInfo{
+			{Pid: 4321, UsedGpuMemory: 512},
+		}
+		mockHandle.On("GetGraphicsRunningProcesses").Return(procs, nvml.SUCCESS)
+
+		dev := &nvmlDevice{index: 0, handle: mockHandle, lib: mockLib, uuid: "GPU-123"}
+		result, err := dev.GetGraphicsRunningProcesses()
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, uint32(4321), result[0].PID)
+		assert.Equal(t, "GPU-123", result[0].DeviceUUID)
+		assert.Equal(t, gpu.ProcessTypeGraphics, result[0].Type)
+
+		mockHandle.AssertExpectations(t)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		mockLib := new(mockNvmlLib)
+		mockHandle := new(mockDeviceHandle)
+
+		mockHandle.On("GetGraphicsRunningProcesses").Return(nil, nvml.ERROR_UNKNOWN)
+		mockLib.On("ErrorString", nvml.ERROR_UNKNOWN).Return("Unknown error")
+
+		dev := &nvmlDevice{handle: mockHandle, lib: mockLib}
+		_, err := dev.GetGraphicsRunningProcesses()
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to get running graphics processes")
+
+		mockHandle.AssertExpectations(t)
+		mockLib.AssertExpectations(t)
+	})
+}
+
 func TestNVMLDevice_GetProcessUtilization(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		mockLib := new(mockNvmlLib)
@@ -526,6 +631,21 @@ func TestNVMLDevice_GetProcessUtilization(t *testing.T) {
 		mockHandle.AssertExpectations(t)
 	})
 
+	t.Run("no new samples", func(t *testing.T) {
+		mockLib := new(mockNvmlLib)
+		mockHandle := new(mockDeviceHandle)
+
+		mockHandle.On("GetProcessUtilization", uint64(12345)).Return(nil, nvml.ERROR_NOT_FOUND)
+
+		dev := &nvmlDevice{handle: mockHandle, lib: mockLib}
+		result, err := dev.GetProcessUtilization(12345)
+
+		assert.NoError(t, err, "no samples since lastSeen is not a failure")
+		assert.Empty(t, result)
+
+		mockHandle.AssertExpectations(t)
+	})
+
 	t.Run("accounting mode disabled", func(t *testing.T) {
 		mockLib := new(mockNvmlLib)
 		mockHandle := new(mockDeviceHandle)
@@ -747,6 +867,7 @@ func TestNVMLDevice_GetMIGInstances(t *testing.T) {
 		mockHandle.On("GetMigDeviceHandleByIndex", 5).Return(nil, nvml.ERROR_NOT_FOUND)
 		mockHandle.On("GetMigDeviceHandleByIndex", 6).Return(nil, nvml.ERROR_NOT_FOUND)
 		mockMigHandle.On("GetGpuInstanceId").Return(1, nvml.SUCCESS)
+		mockMigHandle.On("GetAttributes").Return(nvml.DeviceAttributes{GpuInstanceSliceCount: 3}, nvml.SUCCESS)
 
 		dev := &nvmlDevice{index: 0, handle: mockHandle, lib: mockLib}
 		instances, err := dev.GetMIGInstances()
@@ -754,6 +875,7 @@ func TestNVMLDevice_GetMIGInstances(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Len(t, instances, 1)
 		assert.Equal(t, uint(1), instances[0].GPUInstanceID)
+		assert.Equal(t, uint(3), instances[0].ProfileSlices)
 
 		mockHandle.AssertExpectations(t)
 		mockMigHandle.AssertExpectations(t)
@@ -27,7 +27,7 @@ func Register(vendor Vendor, factory Factory) {
 
 // DiscoverAll probes all registered GPU backends and returns meters for
 // vendors with available hardware. Backends that fail to initialize or
-// have no devices are silently skipped.
+// have no devices are skipped with a warning.
 //
 // Returns an empty slice if no GPUs are found.
 func DiscoverAll(logger *slog.Logger) []GPUPowerMeter {
@@ -43,6 +43,21 @@ func DiscoverAll(logger *slog.Logger) []GPUPowerMeter {
 	return meters
 }
 
+// DiscoverFirst probes the given GPU backends in order and returns the meter of
+// the first one that initializes with at least one device. Backends that are
+// unavailable are logged and skipped.
+//
+// Returns nil if none of the backends are available.
+func DiscoverFirst(vendors []Vendor, logger *slog.Logger) GPUPowerMeter {
+	for _, vendor := range vendors {
+		if meter := Discover(vendor, logger); meter != nil {
+			return meter
+		}
+		logger.Info("GPU backend unavailable, trying next", "vendor", vendor)
+	}
+	return nil
+}
+
 // Discover returns a GPUPowerMeter for a specific vendor, or nil if
 // the vendor is not registered or has no available hardware.
 func Discover(vendor Vendor, logger *slog.Logger) GPUPowerMeter {
@@ -70,8 +85,10 @@ func Discover(vendor Vendor, logger *slog.Logger) GPUPowerMeter {
 		return nil
 	}
 
+	// an initialized backend without devices (e.g. the driver is installed
+	// but no GPU is visible) would only export empty GPU metrics
 	if len(meter.Devices()) == 0 {
-		logger.Info("GPU vendor has no devices", "vendor", vendor)
+		logger.Warn("GPU vendor initialized but discovered no devices", "vendor", vendor)
 		_ = meter.Shutdown()
 		return nil
 	}
@@ -147,6 +147,47 @@ func TestDiscoverAllSkipsFactoryError(t *testing.T) {
 	assert.Empty(t, meters)
 }
 
+func TestDiscoverFirst(t *testing.T) {
+	ClearRegistry()
+	defer ClearRegistry()
+
+	logger := slog.Default()
+
+	// first backend fails to initialize
+	failing := &mockGPUPowerMeter{vendor: VendorNVIDIA, initErr: errors.New("driver not available")}
+	Register(VendorNVIDIA, func(_ *slog.Logger) (GPUPowerMeter, error) {
+		return failing, nil
+	})
+	// second backend is available
+	Register(VendorAMD, func(_ *slog.Logger) (GPUPowerMeter, error) {
+		return &mockGPUPowerMeter{
+			vendor:  VendorAMD,
+			devices: []GPUDevice{{Index: 0, UUID: "GPU-AMD-0", Name: "MI210", Vendor: VendorAMD}},
+		}, nil
+	})
+	// third backend must not be probed once one is found
+	probed := false
+	Register(VendorIntel, func(_ *slog.Logger) (GPUPowerMeter, error) {
+		probed = true
+		return &mockGPUPowerMeter{vendor: VendorIntel, devices: []GPUDevice{{Index: 0}}}, nil
+	})
+
+	meter := DiscoverFirst([]Vendor{VendorNVIDIA, VendorAMD, VendorIntel}, logger)
+	require.NotNil(t, meter)
+	assert.Equal(t, VendorAMD, meter.Vendor())
+	assert.False(t, failing.initialized)
+	assert.False(t, probed, "backends after the first available one must not be probed")
+
+	// order decides which backend is used
+	meter = DiscoverFirst([]Vendor{VendorIntel, VendorAMD}, logger)
+	require.NotNil(t, meter)
+	assert.Equal(t, VendorIntel, meter.Vendor())
+
+	// no backend available
+	assert.Nil(t, DiscoverFirst([]Vendor{VendorNVIDIA, VendorUnknown}, logger))
+	assert.Nil(t, DiscoverFirst(nil, logger))
+}
+
 func TestDiscover(t *testing.T) {
 	ClearRegistry()
 	defer ClearRegistry()
@@ -0,0 +1,365 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rocm
+
+import (
+	"fmt"
+	"log/slog"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"sync"
+
+	"k8s.io/utils/clock"
+
+	"github.com/sustainable-computing-io/kepler/internal/device"
+	"github.com/sustainable-computing-io/kepler/internal/device/gpu"
+)
+
+// amdPCIVendorID is the PCI vendor ID of AMD devices
+const amdPCIVendorID = "0x1002"
+
+// Factory returns a gpu.Factory creating meters that read the AMD GPUs from
+// the given sysfs and the process utilization from the given procfs. Unlike
+// the NVIDIA backend, it is not registered on import since the paths come from
+// the configuration.
+func Factory(sysfsPath, procfsPath string) gpu.Factory {
+	return func(logger *slog.Logger) (gpu.GPUPowerMeter, error) {
+		return NewROCMGPUPowerMeter(logger, sysfsPath, procfsPath), nil
+	}
+}
+
+// amdgpuDriver selects the amdgpu DRM clients in fdinfo; gfx and compute
+// engine busy time counts towards the utilization of a process
+var amdgpuDriver = gpu.DRMDriver{
+	Name:    "amdgpu",
+	Engines: []string{"gfx", "compute"},
+	Memory:  "drm-memory-vram",
+}
+
+// rocmDevice is an AMD GPU discovered under /sys/class/drm
+type rocmDevice struct {
+	gpu.GPUDevice
+
+	pciAddress string // e.g. 0000:c1:00.0, matched against drm-pdev in fdinfo
+	devicePath string // /sys/class/drm/cardN/device
+	powerPath  string // hwmon power1_average (or power1_input) in microwatts
+}
+
+// ROCMGPUPowerMeter implements gpu.GPUPowerMeter for AMD GPUs driven by the
+// amdgpu kernel driver, the same sysfs interface amd-smi and rocm-smi read.
+// Device power is read from hwmon; the energy is integrated from power by the
+// monitor since amdgpu exposes no energy counter. Power is attributed to
+// processes by their share of the GPU engine busy time reported in
+// /proc/<pid>/fdinfo.
+type ROCMGPUPowerMeter struct {
+	logger   *slog.Logger
+	drmPath  string // /sys/class/drm
+	procPath string // /proc
+	clock    clock.PassiveClock
+
+	mu      sync.RWMutex
+	devices []rocmDevice
+
+	// idlePower is a user-configured idle power in Watts. Without one, power
+	// is split into active and idle power by the device utilization.
+	idlePower float64
+
+	// usage is the engine busy time of the processes read by the previous
+	// attribution, used to compute their utilization since then
+	usage *gpu.DRMUsage
+
+	// procs are the processes and their utilization observed by the last
+	// attribution; nil until the first one
+	procs []gpu.ProcessGPUInfo
+}
+
+// NewROCMGPUPowerMeter creates a meter for the AMD GPUs under <sysfs>/class/drm
+func NewROCMGPUPowerMeter(logger *slog.Logger, sysfsPath, procfsPath string) *ROCMGPUPowerMeter {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &ROCMGPUPowerMeter{
+		logger:   logger.With("component", "rocm-gpu-meter"),
+		drmPath:  filepath.Join(sysfsPath, "class", "drm"),
+		procPath: procfsPath,
+		clock:    clock.RealClock{},
+	}
+}
+
+// Name returns the service name
+func (m *ROCMGPUPowerMeter) Name() string {
+	return "rocm-gpu-power-meter"
+}
+
+// Init discovers the AMD GPUs that report their power
+func (m *ROCMGPUPowerMeter) Init() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	devices, err := m.discoverDevices()
+	if err != nil {
+		return err
+	}
+	m.devices = devices
+	return nil
+}
+
+// Shutdown is a no-op; sysfs requires no cleanup
+func (m *ROCMGPUPowerMeter) Shutdown() error {
+	return nil
+}
+
+// Vendor returns the GPU vendor
+func (m *ROCMGPUPowerMeter) Vendor() gpu.Vendor {
+	return gpu.VendorAMD
+}
+
+// Devices returns all discovered GPU devices
+func (m *ROCMGPUPowerMeter) Devices() []gpu.GPUDevice {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	devices := make([]gpu.GPUDevice, len(m.devices))
+	for i, d := range m.devices {
+		devices[i] = d.GPUDevice
+	}
+	return devices
+}
+
+func (m *ROCMGPUPowerMeter) discoverDevices() ([]rocmDevice, error) {
+	cards, err := gpu.DRMCards(m.drmPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var devices []rocmDevice
+	for _, card := range cards {
+		devicePath := filepath.Join(m.drmPath, card, "device")
+		if gpu.ReadAttribute(filepath.Join(devicePath, "vendor")) != amdPCIVendorID {
+			continue
+		}
+
+		powerPath, err := findPowerFile(devicePath)
+		if err != nil {
+			m.logger.Debug("skipping AMD GPU without power reading", "card", card, "error", err)
+			continue
+		}
+
+		dev := rocmDevice{
+			GPUDevice: gpu.GPUDevice{
+				Index:  len(devices),
+				Vendor: gpu.VendorAMD,
+			},
+			pciAddress: gpu.PCIAddress(devicePath),
+			devicePath: devicePath,
+			powerPath:  powerPath,
+		}
+		dev.UUID = deviceUUID(devicePath, dev.pciAddress)
+		dev.Name = deviceName(devicePath)
+
+		m.logger.Info("AMD GPU discovered",
+			"card", card, "index", dev.Index, "uuid", dev.UUID, "name", dev.Name, "pci", dev.pciAddress)
+		devices = append(devices, dev)
+	}
+	return devices, nil
+}
+
+// findPowerFile returns the hwmon file reporting the power of a device.
+// power1_average is reported by most amdgpu devices (e.g. MI210), newer ones
+// (e.g. MI300) report power1_input instead.
+func findPowerFile(devicePath string) (string, error) {
+	for _, name := range []string{"power1_average", "power1_input"} {
+		matches, _ := filepath.Glob(filepath.Join(devicePath, "hwmon", "hwmon*", name))
+		if len(matches) > 0 {
+			return matches[0], nil
+		}
+	}
+	return "", fmt.Errorf("no hwmon power file found in %s", devicePath)
+}
+
+// deviceUUID returns the unique ID of a device, falling back to its PCI
+// address on devices which do not report one
+func deviceUUID(devicePath, pciAddress string) string {
+	if id := gpu.ReadAttribute(filepath.Join(devicePath, "unique_id")); id != "" {
+		return "GPU-" + id
+	}
+	return "GPU-" + pciAddress
+}
+
+// deviceName returns the product name of a device, or its PCI device ID
+func deviceName(devicePath string) string {
+	if name := gpu.ReadAttribute(filepath.Join(devicePath, "product_name")); name != "" {
+		return name
+	}
+	return "AMD GPU " + gpu.ReadAttribute(filepath.Join(devicePath, "device"))
+}
+
+// device returns the device with the given index
+// NOTE: caller must hold m.mu lock
+func (m *ROCMGPUPowerMeter) device(deviceIndex int) (rocmDevice, error) {
+	if deviceIndex < 0 || deviceIndex >= len(m.devices) {
+		return rocmDevice{}, fmt.Errorf("invalid GPU device index %d", deviceIndex)
+	}
+	return m.devices[deviceIndex], nil
+}
+
+// GetPowerUsage returns the current power consumption of a device
+func (m *ROCMGPUPowerMeter) GetPowerUsage(deviceIndex int) (device.Power, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	dev, err := m.device(deviceIndex)
+	if err != nil {
+		return 0, err
+	}
+	return readPower(dev.powerPath)
+}
+
+func readPower(path string) (device.Power, error) {
+	value := gpu.ReadAttribute(path)
+	microwatts, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse power from %s: %w", path, err)
+	}
+	return device.Power(microwatts), nil
+}
+
+// GetTotalEnergy returns an error; amdgpu exposes no energy counter, so the
+// monitor integrates the energy from the device power
+func (m *ROCMGPUPowerMeter) GetTotalEnergy(deviceIndex int) (device.Energy, error) {
+	return 0, fmt.Errorf("AMD GPU %d does not provide an energy counter", deviceIndex)
+}
+
+// GetDevicePowerStats returns the power of a device and its utilization. The
+// power is split into idle and active power by the configured idle power, or
+// by the utilization without one.
+func (m *ROCMGPUPowerMeter) GetDevicePowerStats(deviceIndex int) (gpu.GPUPowerStats, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	dev, err := m.device(deviceIndex)
+	if err != nil {
+		return gpu.GPUPowerStats{}, err
+	}
+	return m.devicePowerStats(dev)
+}
+
+// devicePowerStats reads the power and utilization of a device
+// NOTE: caller must hold m.mu lock
+func (m *ROCMGPUPowerMeter) devicePowerStats(dev rocmDevice) (gpu.GPUPowerStats, error) {
+	power, err := readPower(dev.powerPath)
+	if err != nil {
+		return gpu.GPUPowerStats{}, err
+	}
+
+	total := power.Watts()
+	idle := min(m.idlePower, total)
+	stats := gpu.GPUPowerStats{
+		TotalPower:  total,
+		IdlePower:   idle,
+		ActivePower: total - idle,
+	}
+
+	if busy, err := strconv.ParseFloat(gpu.ReadAttribute(filepath.Join(dev.devicePath, "gpu_busy_percent")), 64); err == nil {
+		stats.Utilization = min(max(busy, 0), gpu.MaxUtilization)
+		stats.UtilizationKnown = true
+	}
+	return stats.SplitByUtilization(), nil
+}
+
+// SetIdlePower sets the configured idle power in Watts. Negative values are
+// clamped to 0.
+func (m *ROCMGPUPowerMeter) SetIdlePower(watts float64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.idlePower = max(watts, 0)
+}
+
+// GetProcessPower splits the active power of each device among its processes
+// in proportion to their engine busy time since the previous call, so that
+// the power of the processes of a device sums to its active power. The first
+// call only records the busy time and returns no power.
+func (m *ROCMGPUPowerMeter) GetProcessPower() (map[uint32]float64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.procs = m.processUtilization()
+
+	activePower := make(map[int]float64)
+	for _, p := range m.procs {
+		if _, ok := activePower[p.DeviceIndex]; ok {
+			continue
+		}
+		dev, err := m.device(p.DeviceIndex)
+		if err != nil {
+			continue
+		}
+		stats, err := m.devicePowerStats(dev)
+		if err != nil {
+			m.logger.Debug("failed to read GPU power", "device", p.DeviceIndex, "error", err)
+			continue
+		}
+		activePower[p.DeviceIndex] = stats.ActivePower
+	}
+	return gpu.SplitActivePower(m.procs, activePower), nil
+}
+
+// GetProcessInfo returns the processes using the GPUs with their engine
+// utilization observed by the last GetProcessPower call, so that both share
+// one scan of /proc. Before the first attribution, the processes are scanned.
+func (m *ROCMGPUPowerMeter) GetProcessInfo() ([]gpu.ProcessGPUInfo, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.procs == nil {
+		m.procs = m.processUtilization()
+	}
+	return slices.Clone(m.procs), nil
+}
+
+// processUtilization reads the engine busy time of the processes and returns
+// their utilization of each device since the previous read
+// NOTE: caller must hold m.mu lock
+func (m *ROCMGPUPowerMeter) processUtilization() []gpu.ProcessGPUInfo {
+	devByPCI := make(map[string]rocmDevice, len(m.devices))
+	for _, d := range m.devices {
+		devByPCI[d.pciAddress] = d
+	}
+
+	current := gpu.ReadDRMUsage(m.procPath, amdgpuDriver, m.clock.Now())
+	utilization := current.Utilization(m.usage)
+	m.usage = current
+
+	procs := make([]gpu.ProcessGPUInfo, 0, len(utilization))
+	for key, util := range utilization {
+		dev, ok := devByPCI[key.PCIAddress]
+		if !ok {
+			continue
+		}
+		procs = append(procs, gpu.ProcessGPUInfo{
+			PID:         key.PID,
+			DeviceIndex: dev.Index,
+			DeviceUUID:  dev.UUID,
+			Type:        gpu.ProcessTypeCompute,
+			ComputeUtil: util,
+			MemoryUsed:  current.Memory[key],
+			Timestamp:   current.Timestamp,
+		})
+	}
+	sort.Slice(procs, func(i, j int) bool {
+		if procs[i].DeviceIndex != procs[j].DeviceIndex {
+			return procs[i].DeviceIndex < procs[j].DeviceIndex
+		}
+		return procs[i].PID < procs[j].PID
+	})
+	return procs
+}
+
+// Ensure ROCMGPUPowerMeter implements gpu.GPUPowerMeter
+var (
+	_ gpu.GPUPowerMeter         = (*ROCMGPUPowerMeter)(nil)
+	_ gpu.IdlePowerConfigurable = (*ROCMGPUPowerMeter)(nil)
+)
@@ -0,0 +1,228 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package rocm
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	testingclock "k8s.io/utils/clock/testing"
+
+	"github.com/sustainable-computing-io/kepler/internal/device"
+	"github.com/sustainable-computing-io/kepler/internal/device/gpu"
+)
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+}
+
+// addCard adds a DRM card whose device symlink points to a PCI device, like
+// /sys/class/drm/card0/device -> ../../../0000:c1:00.0
+func addCard(t *testing.T, sysfs, card, pciAddress string, attrs map[string]string) {
+	t.Helper()
+	pciPath := filepath.Join(sysfs, "devices", "pci0000:00", pciAddress)
+	for name, value := range attrs {
+		writeFile(t, filepath.Join(pciPath, name), value+"\n")
+	}
+	cardPath := filepath.Join(sysfs, "class", "drm", card)
+	require.NoError(t, os.MkdirAll(cardPath, 0o755))
+	require.NoError(t, os.Symlink(pciPath, filepath.Join(cardPath, "device")))
+}
+
+// addClient adds an amdgpu DRM client to the fdinfo of a process
+func addClient(t *testing.T, procfs string, pid, fd int, clientID, pciAddress string, gfxNs, computeNs uint64) {
+	t.Helper()
+	writeFile(t, filepath.Join(procfs, fmt.Sprint(pid), "fdinfo", fmt.Sprint(fd)), fmt.Sprintf(
+		"pos:\t0\nflags:\t02100002\ndrm-driver:\tamdgpu\ndrm-client-id:\t%s\ndrm-pdev:\t%s\n"+
+			"drm-memory-vram:\t2048 KiB\ndrm-engine-gfx:\t%d ns\ndrm-engine-compute:\t%d ns\n",
+		clientID, pciAddress, gfxNs, computeNs))
+}
+
+func newTestSysfs(t *testing.T) string {
+	t.Helper()
+	sysfs := t.TempDir()
+	addCard(t, sysfs, "card1", "0000:c1:00.0", map[string]string{
+		"vendor":                      "0x1002",
+		"device":                      "0x740f",
+		"unique_id":                   "a1b2c3d4",
+		"product_name":                "AMD Instinct MI210",
+		"gpu_busy_percent":            "40",
+		"hwmon/hwmon3/power1_average": "120000000",
+	})
+	addCard(t, sysfs, "card0", "0000:41:00.0", map[string]string{
+		"vendor":                    "0x1002",
+		"device":                    "0x74a1",
+		"gpu_busy_percent":          "0",
+		"hwmon/hwmon2/power1_input": "90000000",
+	})
+	// a GPU of another vendor and a connector are skipped
+	addCard(t, sysfs, "card2", "0000:81:00.0", map[string]string{
+		"vendor": "0x10de",
+	})
+	require.NoError(t, os.MkdirAll(filepath.Join(sysfs, "class", "drm", "card1-DP-1"), 0o755))
+	return sysfs
+}
+
+func TestROCMGPUPowerMeter_Discovery(t *testing.T) {
+	meter := NewROCMGPUPowerMeter(nil, newTestSysfs(t), t.TempDir())
+	require.NoError(t, meter.Init())
+	defer func() { assert.NoError(t, meter.Shutdown()) }()
+
+	assert.Equal(t, gpu.VendorAMD, meter.Vendor())
+
+	devices := meter.Devices()
+	require.Len(t, devices, 2)
+
+	assert.Equal(t, 0, devices[0].Index, "cards are ordered by number")
+	assert.Equal(t, "GPU-0000:41:00.0", devices[0].UUID, "PCI address without unique_id")
+	assert.Equal(t, "AMD GPU 0x74a1", devices[0].Name)
+
+	assert.Equal(t, 1, devices[1].Index)
+	assert.Equal(t, "GPU-a1b2c3d4", devices[1].UUID)
+	assert.Equal(t, "AMD Instinct MI210", devices[1].Name)
+	assert.Equal(t, gpu.VendorAMD, devices[1].Vendor)
+}
+
+func TestROCMGPUPowerMeter_NoDRM(t *testing.T) {
+	meter := NewROCMGPUPowerMeter(nil, t.TempDir(), t.TempDir())
+	assert.Error(t, meter.Init())
+}
+
+func TestROCMGPUPowerMeter_Power(t *testing.T) {
+	meter := NewROCMGPUPowerMeter(nil, newTestSysfs(t), t.TempDir())
+	require.NoError(t, meter.Init())
+
+	power, err := meter.GetPowerUsage(0)
+	require.NoError(t, err)
+	assert.Equal(t, 90*device.Watt, power, "power1_input fallback")
+
+	power, err = meter.GetPowerUsage(1)
+	require.NoError(t, err)
+	assert.Equal(t, 120*device.Watt, power)
+
+	_, err = meter.GetPowerUsage(2)
+	assert.Error(t, err)
+
+	_, err = meter.GetTotalEnergy(1)
+	assert.Error(t, err, "energy is integrated from power by the monitor")
+
+	stats, err := meter.GetDevicePowerStats(1)
+	require.NoError(t, err)
+	assert.Equal(t, 120.0, stats.TotalPower)
+	assert.Equal(t, 40.0, stats.Utilization)
+	// without an idle power, the power is split by the 40% busy utilization
+	assert.Equal(t, 72.0, stats.IdlePower)
+	assert.Equal(t, 48.0, stats.ActivePower)
+
+	meter.SetIdlePower(30)
+	stats, err = meter.GetDevicePowerStats(1)
+	require.NoError(t, err)
+	assert.Equal(t, 30.0, stats.IdlePower)
+	assert.Equal(t, 90.0, stats.ActivePower)
+}
+
+func TestROCMGPUPowerMeter_ProcessAttribution(t *testing.T) {
+	procfs := t.TempDir()
+	meter := NewROCMGPUPowerMeter(nil, newTestSysfs(t), procfs)
+	fakeClock := testingclock.NewFakePassiveClock(time.Now())
+	meter.clock = fakeClock
+	require.NoError(t, meter.Init())
+	meter.SetIdlePower(20)
+
+	addClient(t, procfs, 100, 5, "7", "0000:c1:00.0", 0, 0)
+	addClient(t, procfs, 200, 4, "9", "0000:c1:00.0", 0, 0)
+	// a file descriptor of another driver is ignored
+	writeFile(t, filepath.Join(procfs, "300", "fdinfo", "3"), "pos:\t0\ndrm-driver:\ti915\n")
+
+	// the first read only records the busy time
+	power, err := meter.GetProcessPower()
+	require.NoError(t, err)
+	assert.Empty(t, power)
+
+	// in 1s, pid 100 keeps the engines busy for 500ms and pid 200 for 250ms;
+	// pid 200 shares its client with a second file descriptor
+	fakeClock.SetTime(fakeClock.Now().Add(time.Second))
+	addClient(t, procfs, 100, 5, "7", "0000:c1:00.0", 300_000_000, 200_000_000)
+	addClient(t, procfs, 200, 4, "9", "0000:c1:00.0", 250_000_000, 0)
+	addClient(t, procfs, 200, 6, "9", "0000:c1:00.0", 250_000_000, 0)
+
+	power, err = meter.GetProcessPower()
+	require.NoError(t, err)
+	require.Len(t, power, 2)
+	// the 120 W - 20 W idle active power is split 2:1 by busy time
+	stats, err := meter.GetDevicePowerStats(1)
+	require.NoError(t, err)
+	assert.Equal(t, 100.0, stats.ActivePower)
+	assert.InDelta(t, 100.0*2/3, power[100], 1e-9)
+	assert.InDelta(t, 100.0/3, power[200], 1e-9)
+	assert.InDelta(t, stats.ActivePower, power[100]+power[200], 1e-9, "active power must be conserved")
+
+	// the process info shares the scan of the last attribution
+	fakeClock.SetTime(fakeClock.Now().Add(time.Second))
+	addClient(t, procfs, 100, 5, "7", "0000:c1:00.0", 400_000_000, 300_000_000)
+
+	procs, err := meter.GetProcessInfo()
+	require.NoError(t, err)
+	require.Len(t, procs, 2)
+
+	assert.Equal(t, uint32(100), procs[0].PID)
+	assert.Equal(t, 1, procs[0].DeviceIndex)
+	assert.Equal(t, "GPU-a1b2c3d4", procs[0].DeviceUUID)
+	assert.Equal(t, gpu.ProcessTypeCompute, procs[0].Type)
+	assert.InDelta(t, 0.5, procs[0].ComputeUtil, 1e-9)
+	assert.Equal(t, uint64(2048*1024), procs[0].MemoryUsed)
+
+	assert.Equal(t, uint32(200), procs[1].PID)
+	assert.InDelta(t, 0.25, procs[1].ComputeUtil, 1e-9)
+
+	// the next attribution sees the busy time since the last one
+	power, err = meter.GetProcessPower()
+	require.NoError(t, err)
+	assert.InDelta(t, 100.0, power[100], 1e-9)
+	assert.Zero(t, power[200])
+}
+
+func TestROCMGPUPowerMeter_ProcessAttributionSplitsByUtilization(t *testing.T) {
+	procfs := t.TempDir()
+	meter := NewROCMGPUPowerMeter(nil, newTestSysfs(t), procfs)
+	fakeClock := testingclock.NewFakePassiveClock(time.Now())
+	meter.clock = fakeClock
+	require.NoError(t, meter.Init())
+
+	addClient(t, procfs, 100, 5, "7", "0000:c1:00.0", 0, 0)
+	addClient(t, procfs, 200, 4, "9", "0000:c1:00.0", 0, 0)
+	_, err := meter.GetProcessPower()
+	require.NoError(t, err)
+
+	// the processes keep the engines busy for 30% of the time in total, while
+	// the device reports 40% busy
+	fakeClock.SetTime(fakeClock.Now().Add(time.Second))
+	addClient(t, procfs, 100, 5, "7", "0000:c1:00.0", 200_000_000, 0)
+	addClient(t, procfs, 200, 4, "9", "0000:c1:00.0", 100_000_000, 0)
+
+	power, err := meter.GetProcessPower()
+	require.NoError(t, err)
+
+	// without idle power, 40% of the 120 W is active
+	stats, err := meter.GetDevicePowerStats(1)
+	require.NoError(t, err)
+	assert.Equal(t, 48.0, stats.ActivePower)
+	assert.InDelta(t, 32.0, power[100], 1e-9)
+	assert.InDelta(t, 16.0, power[200], 1e-9)
+	assert.InDelta(t, stats.ActivePower, power[100]+power[200], 1e-9, "active power must be conserved")
+}
+
+func TestFactory(t *testing.T) {
+	meter, err := Factory(newTestSysfs(t), t.TempDir())(nil)
+	require.NoError(t, err)
+	require.NoError(t, meter.Init())
+	assert.Len(t, meter.Devices(), 2)
+}
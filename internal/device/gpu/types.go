@@ -3,7 +3,11 @@
 
 package gpu
 
-import "fmt"
+import (
+	"fmt"
+	"math"
+	"slices"
+)
 
 // Vendor represents the GPU manufacturer
 type Vendor string
@@ -15,6 +19,54 @@ const (
 	VendorUnknown Vendor = "unknown"
 )
 
+// ProcessType is the kind of GPU context a process runs
+type ProcessType string
+
+const (
+	// ProcessTypeCompute is a process running compute work (e.g. CUDA)
+	ProcessTypeCompute ProcessType = "compute"
+
+	// ProcessTypeGraphics is a process running graphics work (e.g. OpenGL, Vulkan)
+	ProcessTypeGraphics ProcessType = "graphics"
+)
+
+// MergeProcesses returns the compute processes followed by the graphics
+// processes not already listed as compute processes; a process with both
+// contexts is reported once, as a compute process
+func MergeProcesses(compute, graphics []ProcessGPUInfo) []ProcessGPUInfo {
+	if len(graphics) == 0 {
+		return compute
+	}
+
+	seen := make(map[uint32]bool, len(compute))
+	merged := make([]ProcessGPUInfo, 0, len(compute)+len(graphics))
+	for _, p := range compute {
+		seen[p.PID] = true
+		merged = append(merged, p)
+	}
+	for _, p := range graphics {
+		if seen[p.PID] {
+			continue
+		}
+		seen[p.PID] = true
+		merged = append(merged, p)
+	}
+	return merged
+}
+
+// SelectDevicesByUUID splits devices into those whose UUID is in uuids and
+// the others, preserving their order and indices
+func SelectDevicesByUUID(devices []GPUDevice, uuids []string) (selected, skipped []GPUDevice) {
+	for _, dev := range devices {
+		if slices.Contains(uuids, dev.UUID) {
+			selected = append(selected, dev)
+		} else {
+			skipped = append(skipped, dev)
+		}
+	}
+	return selected, skipped
+}
+
 // SharingMode represents how a GPU is shared among processes
 type SharingMode int
 
@@ -71,6 +123,41 @@ type ProcessUtilization struct {
 	Timestamp uint64
 }
 
+// MaxUtilization is the utilization of a fully busy engine. Utilization is
+// always handled as a percentage on a 0-100 scale.
+const MaxUtilization = 100.0
+
+// NormalizeUtilization returns utilization samples read together, e.g. the
+// per-process samples of one device, on the 0-100 scale. Some driver versions
+// report a 0-1 ratio instead of a percentage: when every sample is at most 1
+// and at least one is fractional, the samples are scaled to percent. Samples
+// outside [0, MaxUtilization] are clamped, and counted in clamped.
+func NormalizeUtilization(samples []float64) (normalized []float64, clamped int) {
+	ratio := false
+	for _, s := range samples {
+		if s > 1 {
+			ratio = false
+			break
+		}
+		if s != math.Trunc(s) {
+			ratio = true
+		}
+	}
+
+	normalized = make([]float64, len(samples))
+	for i, s := range samples {
+		if ratio {
+			s *= MaxUtilization
+		}
+		if s < 0 || s > MaxUtilization {
+			s = min(max(s, 0), MaxUtilization)
+			clamped++
+		}
+		normalized[i] = s
+	}
+	return normalized, clamped
+}
+
 // ErrGPUNotFound is returned when a GPU device is not found
 type ErrGPUNotFound struct {
 	DeviceIndex int
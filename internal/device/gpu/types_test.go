@@ -276,6 +276,56 @@ func TestGPUPowerStats(t *testing.T) {
 	})
 }
 
+func TestGPUPowerStats_SplitByUtilization(t *testing.T) {
+	tests := []struct {
+		name           string
+		stats          GPUPowerStats
+		expectedActive float64
+		expectedIdle   float64
+	}{
+		{
+			name:           "no idle baseline is split by utilization",
+			stats:          GPUPowerStats{TotalPower: 200, ActivePower: 200, Utilization: 25, UtilizationKnown: true},
+			expectedActive: 50,
+			expectedIdle:   150,
+		},
+		{
+			name:           "idle device is all idle",
+			stats:          GPUPowerStats{TotalPower: 200, ActivePower: 200, UtilizationKnown: true},
+			expectedActive: 0,
+			expectedIdle:   200,
+		},
+		{
+			name:           "idle baseline is kept",
+			stats:          GPUPowerStats{TotalPower: 200, IdlePower: 50, ActivePower: 150, Utilization: 25, UtilizationKnown: true},
+			expectedActive: 150,
+			expectedIdle:   50,
+		},
+		{
+			name:           "unknown utilization is kept",
+			stats:          GPUPowerStats{TotalPower: 200, ActivePower: 200},
+			expectedActive: 200,
+			expectedIdle:   0,
+		},
+		{
+			name:           "utilization above 100 is clamped",
+			stats:          GPUPowerStats{TotalPower: 200, ActivePower: 200, Utilization: 120, UtilizationKnown: true},
+			expectedActive: 200,
+			expectedIdle:   0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			split := tt.stats.SplitByUtilization()
+			assert.Equal(t, tt.expectedActive, split.ActivePower)
+			assert.Equal(t, tt.expectedIdle, split.IdlePower)
+			assert.Equal(t, tt.stats.TotalPower, split.ActivePower+split.IdlePower)
+			assert.Equal(t, tt.stats.Utilization, split.Utilization)
+		})
+	}
+}
+
 func TestProcessGPUInfo(t *testing.T) {
 	t.Run("zero value", func(t *testing.T) {
 		var info ProcessGPUInfo
@@ -326,3 +376,49 @@ func TestProcessGPUInfo(t *testing.T) {
 		}
 	})
 }
+
+func TestNormalizeUtilization(t *testing.T) {
+	tests := []struct {
+		name     string
+		samples  []float64
+		expected []float64
+		clamped  int
+	}{
+		{"percentages", []float64{0, 25, 100}, []float64{0, 25, 100}, 0},
+		{"ratios", []float64{0, 0.25, 0.5, 1}, []float64{0, 25, 50, 100}, 0},
+		{"integer 0 and 1 are percentages", []float64{0, 1}, []float64{0, 1}, 0},
+		{"fractional percentages", []float64{0.5, 42.5}, []float64{0.5, 42.5}, 0},
+		{"above 100 is clamped", []float64{150, 50}, []float64{100, 50}, 1},
+		{"negative is clamped", []float64{-5, 50}, []float64{0, 50}, 1},
+		{"empty", nil, []float64{}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			normalized, clamped := NormalizeUtilization(tt.samples)
+			assert.InDeltaSlice(t, tt.expected, normalized, 1e-9)
+			assert.Equal(t, tt.clamped, clamped)
+		})
+	}
+}
+
+func TestMergeProcesses(t *testing.T) {
+	compute := []ProcessGPUInfo{
+		{PID: 100, Type: ProcessTypeCompute},
+		{PID: 200, Type: ProcessTypeCompute},
+	}
+	graphics := []ProcessGPUInfo{
+		{PID: 200, Type: ProcessTypeGraphics},
+		{PID: 300, Type: ProcessTypeGraphics},
+	}
+
+	merged := MergeProcesses(compute, graphics)
+	assert.Equal(t, []ProcessGPUInfo{
+		{PID: 100, Type: ProcessTypeCompute},
+		{PID: 200, Type: ProcessTypeCompute},
+		{PID: 300, Type: ProcessTypeGraphics},
+	}, merged, "processes with both contexts are reported once as compute")
+
+	assert.Equal(t, compute, MergeProcesses(compute, nil))
+	assert.Equal(t, graphics, MergeProcesses(nil, graphics))
+}
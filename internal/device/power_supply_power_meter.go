@@ -0,0 +1,263 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package device
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// powerSupplyZoneName is the name of the zone reporting the power drawn from
+// the batteries and UPSes of the node
+const powerSupplyZoneName = "power_supply"
+
+// powerSupplyTypes are the power supply types whose power draw is the node
+// power; mains (AC) and USB supplies expose no power reading and are skipped
+var powerSupplyTypes = map[string]bool{
+	"Battery": true,
+	"UPS":     true,
+}
+
+// powerSupplyPowerMeter implements CPUPowerMeter using the batteries and UPSes
+// under /sys/class/power_supply. It serves as a fallback node power source on
+// edge devices and laptops without RAPL.
+type powerSupplyPowerMeter struct {
+	basePath    string // /sys/class/power_supply
+	logger      *slog.Logger
+	cachedZones []EnergyZone
+}
+
+// PowerSupplyOptionFn is a function that configures powerSupplyPowerMeter options
+type PowerSupplyOptionFn func(*powerSupplyPowerMeter)
+
+// WithPowerSupplyLogger sets the logger for powerSupplyPowerMeter
+func WithPowerSupplyLogger(logger *slog.Logger) PowerSupplyOptionFn {
+	return func(pm *powerSupplyPowerMeter) {
+		pm.logger = logger.With("service", "power-supply")
+	}
+}
+
+// NewPowerSupplyPowerMeter creates a new power meter reading the batteries and
+// UPSes of the node
+func NewPowerSupplyPowerMeter(sysfsPath string, opts ...PowerSupplyOptionFn) (*powerSupplyPowerMeter, error) {
+	ret := &powerSupplyPowerMeter{
+		basePath: filepath.Join(sysfsPath, "class", "power_supply"),
+		logger:   slog.Default().With("service", "power-supply"),
+	}
+
+	for _, opt := range opts {
+		opt(ret)
+	}
+
+	return ret, nil
+}
+
+func (p *powerSupplyPowerMeter) Name() string {
+	return "power-supply"
+}
+
+func (p *powerSupplyPowerMeter) Init() error {
+	// ensure a power supply can be read but don't cache the zones
+	zones, err := p.discoverZones()
+	if err != nil {
+		return err
+	}
+
+	_, err = zones[0].Power()
+	return err
+}
+
+// Zones returns a single zone reporting the sum of the power drawn from all
+// batteries and UPSes
+func (p *powerSupplyPowerMeter) Zones() ([]EnergyZone, error) {
+	if len(p.cachedZones) != 0 {
+		return p.cachedZones, nil
+	}
+
+	zones, err := p.discoverZones()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(zones) == 1 {
+		p.cachedZones = zones
+	} else {
+		p.cachedZones = []EnergyZone{NewAggregatedZone(zones)}
+	}
+	return p.cachedZones, nil
+}
+
+func (p *powerSupplyPowerMeter) PrimaryEnergyZone() (EnergyZone, error) {
+	zones, err := p.Zones()
+	if err != nil {
+		return nil, err
+	}
+	return zones[0], nil
+}
+
+// discoverZones returns a zone for each battery or UPS that reports its power
+// draw, either directly or as current and voltage
+func (p *powerSupplyPowerMeter) discoverZones() ([]EnergyZone, error) {
+	entries, err := os.ReadDir(p.basePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("power supply class not available: %w", err)
+		}
+		return nil, fmt.Errorf("failed to read power supply directory: %w", err)
+	}
+
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	sort.Strings(names)
+
+	var zones []EnergyZone
+	for _, name := range names {
+		supplyPath := filepath.Join(p.basePath, name)
+
+		supplyType := readTrimmed(filepath.Join(supplyPath, "type"))
+		if !powerSupplyTypes[supplyType] {
+			p.logger.Debug("skipping power supply", "supply", name, "type", supplyType)
+			continue
+		}
+		// batteries of peripherals, e.g. a wireless mouse, do not power the node
+		if readTrimmed(filepath.Join(supplyPath, "scope")) == "Device" {
+			p.logger.Debug("skipping device scoped power supply", "supply", name)
+			continue
+		}
+
+		zone := &powerSupplyZone{supply: name, index: len(zones)}
+		if fileExists(filepath.Join(supplyPath, "status")) {
+			zone.statusPath = filepath.Join(supplyPath, "status")
+		}
+		switch {
+		case fileExists(filepath.Join(supplyPath, "power_now")):
+			zone.powerPath = filepath.Join(supplyPath, "power_now")
+		case fileExists(filepath.Join(supplyPath, "current_now")) && fileExists(filepath.Join(supplyPath, "voltage_now")):
+			zone.currentPath = filepath.Join(supplyPath, "current_now")
+			zone.voltagePath = filepath.Join(supplyPath, "voltage_now")
+		default:
+			// energy_now is the energy left in the battery, not the energy
+			// drawn by the node, so it can't be used on its own
+			p.logger.Debug("skipping power supply without power reading", "supply", name)
+			continue
+		}
+		zones = append(zones, zone)
+	}
+
+	if len(zones) == 0 {
+		return nil, fmt.Errorf("no battery or UPS power supply with a power reading found in %s", p.basePath)
+	}
+	return zones, nil
+}
+
+// readTrimmed returns the trimmed content of a sysfs attribute, or an empty
+// string if it can't be read
+func readTrimmed(path string) string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+// powerSupplyZone implements EnergyZone for a battery or UPS. It provides
+// instantaneous power readings without time integration, like hwmon zones.
+type powerSupplyZone struct {
+	supply string
+	index  int
+
+	powerPath   string // power_now in microwatts
+	currentPath string // current_now in microamperes
+	voltagePath string // voltage_now in microvolts
+	statusPath  string // status, e.g. Charging or Discharging; optional
+}
+
+func (z *powerSupplyZone) Name() string {
+	return powerSupplyZoneName
+}
+
+func (z *powerSupplyZone) Index() int {
+	return z.index
+}
+
+func (z *powerSupplyZone) Path() string {
+	if z.powerPath != "" {
+		return z.powerPath
+	}
+	return z.currentPath
+}
+
+func (z *powerSupplyZone) Energy() (Energy, error) {
+	return 0, fmt.Errorf("power supply zones do not provide energy readings")
+}
+
+func (z *powerSupplyZone) MaxEnergy() Energy {
+	return 0
+}
+
+// Power returns the power drawn from the supply. A supply that is not
+// discharging, e.g. a battery charging while the node runs on mains, reports
+// its charge rate, not the node power, so it draws no power from the supply.
+func (z *powerSupplyZone) Power() (Power, error) {
+	if z.statusPath != "" {
+		status, err := sysReadFile(z.statusPath)
+		if err != nil {
+			return 0, fmt.Errorf("failed to read %s: %w", z.statusPath, err)
+		}
+		if strings.TrimSpace(string(status)) != "Discharging" {
+			return 0, nil
+		}
+	}
+
+	if z.powerPath != "" {
+		power, err := readSignedAttribute(z.powerPath)
+		if err != nil {
+			return 0, err
+		}
+		return Power(power), nil
+	}
+
+	current, err := readSignedAttribute(z.currentPath)
+	if err != nil {
+		return 0, err
+	}
+	voltage, err := readSignedAttribute(z.voltagePath)
+	if err != nil {
+		return 0, err
+	}
+
+	// µA × µV = pW; scale down to µW
+	// Example: 1,500,000 µA × 12,000,000 µV = 18,000,000 µW = 18 W
+	return Power(current * voltage / 1e6), nil
+}
+
+// readSignedAttribute reads a power supply attribute as an absolute value;
+// some drivers report the current and power drawn from a battery as negative
+func readSignedAttribute(path string) (float64, error) {
+	data, err := sysReadFile(path)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	value, err := strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse value from %s: %w", path, err)
+	}
+	if value < 0 {
+		value = -value
+	}
+	return float64(value), nil
+}
@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package device
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPowerSupplyPowerMeterInterface(t *testing.T) {
+	var _ CPUPowerMeter = (*powerSupplyPowerMeter)(nil)
+}
+
+func TestPowerSupplyPowerMeter(t *testing.T) {
+	meter, err := NewPowerSupplyPowerMeter(validHwmonPath)
+	require.NoError(t, err)
+	assert.Equal(t, "power-supply", meter.Name())
+	require.NoError(t, meter.Init())
+
+	zones, err := meter.Zones()
+	require.NoError(t, err)
+	require.Len(t, zones, 1, "batteries are reported as a single zone")
+
+	zone := zones[0]
+	assert.Equal(t, "power_supply", zone.Name())
+	assert.Equal(t, Energy(0), zone.MaxEnergy(), "power supply zones are power sensors")
+
+	// BAT0 reports 12.5 W as power_now and BAT1 1.5 A × 12 V, as a negative
+	// current; BAT2 is charging and AC and the battery of the mouse are skipped
+	power, err := zone.Power()
+	require.NoError(t, err)
+	assert.InDelta(t, 30.5, power.Watts(), 1e-9)
+
+	primary, err := meter.PrimaryEnergyZone()
+	require.NoError(t, err)
+	assert.Equal(t, zone, primary)
+}
+
+func TestPowerSupplyPowerMeter_SingleBattery(t *testing.T) {
+	sysfs := t.TempDir()
+	bat := filepath.Join(sysfs, "class", "power_supply", "BAT0")
+	require.NoError(t, os.MkdirAll(bat, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(bat, "type"), []byte("Battery\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(bat, "power_now"), []byte("8000000\n"), 0o644))
+
+	meter, err := NewPowerSupplyPowerMeter(sysfs)
+	require.NoError(t, err)
+
+	zone, err := meter.PrimaryEnergyZone()
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(bat, "power_now"), zone.Path())
+
+	power, err := zone.Power()
+	require.NoError(t, err)
+	assert.Equal(t, 8*Watt, power)
+}
+
+func TestPowerSupplyPowerMeter_Status(t *testing.T) {
+	sysfs := t.TempDir()
+	bat := filepath.Join(sysfs, "class", "power_supply", "BAT0")
+	require.NoError(t, os.MkdirAll(bat, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(bat, "type"), []byte("Battery\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(bat, "power_now"), []byte("8000000\n"), 0o644))
+	status := filepath.Join(bat, "status")
+	require.NoError(t, os.WriteFile(status, []byte("Discharging\n"), 0o644))
+
+	meter, err := NewPowerSupplyPowerMeter(sysfs)
+	require.NoError(t, err)
+	zone, err := meter.PrimaryEnergyZone()
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		status string
+		power  Power
+	}{
+		{"Charging", 0},
+		{"Full", 0},
+		{"Not charging", 0},
+		{"Discharging", 8 * Watt},
+	} {
+		require.NoError(t, os.WriteFile(status, []byte(tc.status+"\n"), 0o644))
+		power, err := zone.Power()
+		require.NoError(t, err)
+		assert.Equal(t, tc.power, power, "status %s", tc.status)
+	}
+}
+
+func TestPowerSupplyPowerMeter_NoBattery(t *testing.T) {
+	t.Run("AC only", func(t *testing.T) {
+		sysfs := t.TempDir()
+		ac := filepath.Join(sysfs, "class", "power_supply", "AC")
+		require.NoError(t, os.MkdirAll(ac, 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(ac, "type"), []byte("Mains\n"), 0o644))
+		require.NoError(t, os.WriteFile(filepath.Join(ac, "online"), []byte("1\n"), 0o644))
+
+		meter, err := NewPowerSupplyPowerMeter(sysfs)
+		require.NoError(t, err)
+		assert.ErrorContains(t, meter.Init(), "no battery or UPS power supply")
+
+		_, err = meter.Zones()
+		assert.Error(t, err)
+	})
+
+	t.Run("no power supply class", func(t *testing.T) {
+		meter, err := NewPowerSupplyPowerMeter(badHwmonPath)
+		require.NoError(t, err)
+		assert.ErrorContains(t, meter.Init(), "power supply class not available")
+	})
+}
@@ -4,8 +4,16 @@
 package device
 
 import (
+	"cmp"
+	"errors"
 	"fmt"
+	"io/fs"
 	"log/slog"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/prometheus/procfs/sysfs"
@@ -18,6 +26,10 @@ type raplPowerMeter struct {
 	logger      *slog.Logger
 	zoneFilter  []string
 	topZone     EnergyZone
+
+	// perSocket keeps the zones of each socket (e.g. package-0 and
+	// package-1) separate instead of aggregating zones sharing a name
+	perSocket bool
 }
 
 type OptionFn func(*raplPowerMeter)
@@ -41,6 +53,14 @@ func WithRaplLogger(logger *slog.Logger) OptionFn {
 	}
 }
 
+// WithPowercapPath discovers RAPL zones under path instead of
+// <sysfs>/class/powercap, e.g. when only the powercap subtree is mounted
+func WithPowercapPath(path string) OptionFn {
+	return func(pm *raplPowerMeter) {
+		pm.reader = powercapRaplReader{path: path}
+	}
+}
+
 // WithZoneFilter sets zone names to include for monitoring
 // If empty, all zones are included
 func WithZoneFilter(zones []string) OptionFn {
@@ -49,6 +69,14 @@ func WithZoneFilter(zones []string) OptionFn {
 	}
 }
 
+// WithPerSocketZones reports the zones of each socket separately, with the
+// socket as their index, instead of aggregating zones sharing a name
+func WithPerSocketZones(enabled bool) OptionFn {
+	return func(pm *raplPowerMeter) {
+		pm.perSocket = enabled
+	}
+}
+
 // NewCPUPowerMeter creates a new CPU power meter
 func NewCPUPowerMeter(sysfsPath string, opts ...OptionFn) (*raplPowerMeter, error) {
 	fs, err := sysfs.NewFS(sysfsPath)
@@ -82,8 +110,15 @@ func (r *raplPowerMeter) Init() error {
 		return fmt.Errorf("no RAPL zones found")
 	}
 
-	// try reading the first zone and return the error
+	// try reading the first zone and return the error. A denied read is not
+	// fatal so that Kepler starts and reports it through the
+	// kepler_node_rapl_permission_denied metric
 	_, err = zones[0].Energy()
+	var denied ErrRaplPermissionDenied
+	if errors.As(err, &denied) {
+		r.logger.Warn("RAPL energy counters are not readable", "error", err)
+		return nil
+	}
 	return err
 }
 
@@ -147,11 +182,31 @@ func (r *raplPowerMeter) Zones() ([]EnergyZone, error) {
 		stdZoneMap[key] = zone
 	}
 
+	if r.perSocket {
+		r.cachedZones = r.socketZones(stdZoneMap)
+		return r.cachedZones, nil
+	}
+
 	// Group zones by name for aggregation
 	r.cachedZones = r.groupZonesByName(stdZoneMap)
 	return r.cachedZones, nil
 }
 
+// socketZones returns the zones of each socket as distinct zones, ordered by
+// name and socket
+func (r *raplPowerMeter) socketZones(stdZoneMap map[zoneKey]EnergyZone) []EnergyZone {
+	keys := slices.SortedFunc(maps.Keys(stdZoneMap), func(a, b zoneKey) int {
+		return cmp.Or(strings.Compare(a.name, b.name), cmp.Compare(a.index, b.index))
+	})
+
+	result := make([]EnergyZone, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, stdZoneMap[key])
+	}
+	r.logger.Debug("Using per-socket zones", "zones", r.zoneNames(result))
+	return result
+}
+
 // groupZonesByName groups zones by their base name and creates AggregatedZone
 // instances when multiple zones share the same name (multi-socket systems)
 func (r *raplPowerMeter) groupZonesByName(stdZoneMap map[zoneKey]EnergyZone) []EnergyZone {
@@ -211,10 +266,15 @@ func (r *raplPowerMeter) PrimaryEnergyZone() (EnergyZone, error) {
 
 	zoneMap := map[string]EnergyZone{}
 	for _, zone := range zones {
-		zoneMap[strings.ToLower(zone.Name())] = zone
+		name := strings.ToLower(zone.Name())
+		if _, exists := zoneMap[name]; !exists {
+			zoneMap[name] = zone
+		}
 	}
 
-	// Priority hierarchy for RAPL zones (highest to lowest priority)
+	// Priority hierarchy for RAPL zones (highest to lowest priority). With
+	// per-socket zones, this is the zone of the first socket; consumers sum
+	// the zones sharing its name for the node total.
 	priorityOrder := []string{"psys", "package", "core", "dram", "uncore"}
 
 	// Find highest priority zone available
@@ -254,6 +314,61 @@ func (r sysfsRaplReader) Zones() ([]EnergyZone, error) {
 	return energyZones, nil
 }
 
+// powercapRaplReader discovers RAPL zones in a powercap directory at an
+// arbitrary path. Zones are named and indexed like sysfs.GetRaplZones does for
+// <sysfs>/class/powercap.
+type powercapRaplReader struct {
+	path string
+}
+
+func (r powercapRaplReader) Zones() ([]EnergyZone, error) {
+	entries, err := os.ReadDir(r.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read rapl zones: %w", err)
+	}
+
+	// zones without an index in their name (e.g. multiple "dram") are
+	// indexed in order of appearance
+	nameUsages := map[string]int{}
+
+	var zones []EnergyZone
+	for _, entry := range entries {
+		dir := filepath.Join(r.path, entry.Name())
+		data, err := os.ReadFile(filepath.Join(dir, "name"))
+		if err != nil {
+			// not a zone, e.g. the intel-rapl control type
+			continue
+		}
+
+		name := strings.TrimSpace(string(data))
+		index := nameUsages[name]
+		if base, suffix, ok := strings.Cut(name, "-"); ok {
+			if i, err := strconv.Atoi(suffix); err == nil {
+				name, index = base, i
+			}
+		}
+		nameUsages[name] = index + 1
+
+		data, err = os.ReadFile(filepath.Join(dir, "max_energy_range_uj"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read rapl zone %s: %w", dir, err)
+		}
+		maxUJ, err := strconv.ParseUint(strings.TrimSpace(string(data)), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse max energy of rapl zone %s: %w", dir, err)
+		}
+
+		zones = append(zones, sysfsRaplZone{sysfs.RaplZone{
+			Name:           name,
+			Index:          index,
+			Path:           dir,
+			MaxMicrojoules: maxUJ,
+		}})
+	}
+
+	return zones, nil
+}
+
 // sysfsRaplZone implements EnergyZone using sysfs.RaplZone.
 // It is an adapter for the EnergyZone interface
 type sysfsRaplZone struct {
@@ -278,7 +393,36 @@ func (s sysfsRaplZone) Path() string {
 // Energy returns the current energy value
 func (s sysfsRaplZone) Energy() (Energy, error) {
 	mj, err := s.zone.GetEnergyMicrojoules()
-	return Energy(mj), err
+	if err != nil {
+		return 0, raplReadError(filepath.Join(s.zone.Path, "energy_uj"), err)
+	}
+	return Energy(mj), nil
+}
+
+// ErrRaplPermissionDenied is returned when a RAPL energy counter exists but
+// Kepler is not permitted to read it
+type ErrRaplPermissionDenied struct {
+	Path string
+	Err  error
+}
+
+func (e ErrRaplPermissionDenied) Error() string {
+	return fmt.Sprintf("permission denied reading RAPL energy counter %s: "+
+		"the kernel restricts RAPL energy counters to root; run Kepler as root or "+
+		"grant it the CAP_DAC_READ_SEARCH capability", e.Path)
+}
+
+func (e ErrRaplPermissionDenied) Unwrap() error {
+	return e.Err
+}
+
+// raplReadError distinguishes reads of the RAPL energy counter at path that
+// failed due to missing permissions from other read failures
+func raplReadError(path string, err error) error {
+	if errors.Is(err, fs.ErrPermission) {
+		return ErrRaplPermissionDenied{Path: path, Err: err}
+	}
+	return err
 }
 
 // MaxEnergy returns the maximum energy value before wraparound
@@ -291,3 +435,17 @@ func (s sysfsRaplZone) MaxEnergy() Energy {
 func (s sysfsRaplZone) Power() (Power, error) {
 	return 0, fmt.Errorf("RAPL zones do not provide instantaneous power readings")
 }
+
+// PowerLimit returns the long term power limit (constraint 0) of the zone
+func (s sysfsRaplZone) PowerLimit() (Power, error) {
+	path := filepath.Join(s.zone.Path, "constraint_0_power_limit_uw")
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	uw, err := strconv.ParseUint(strings.TrimSpace(string(data)), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse power limit %s: %w", path, err)
+	}
+	return Power(uw), nil
+}
@@ -5,8 +5,12 @@ package device
 
 import (
 	"errors"
+	"io/fs"
 	"log/slog"
+	"os"
+	"path/filepath"
 	"strings"
+	"syscall"
 	"testing"
 
 	"fmt"
@@ -273,6 +277,45 @@ func TestAggregatedZoneIntegration(t *testing.T) {
 	assert.Equal(t, Energy(3000), packageEnergy) // 1000 + 2000 from both package zones
 }
 
+func TestPerSocketZones(t *testing.T) {
+	mockReader := &mockSysFSReader{
+		response: []EnergyZone{
+			mockZone{name: "package", index: 1, path: "/intel-rapl:1", energy: 2000, maxEnergy: 100000},
+			mockZone{name: "core", index: 0, path: "/intel-rapl:0:0", energy: 500, maxEnergy: 50000},
+			mockZone{name: "package", index: 0, path: "/intel-rapl:0", energy: 1000, maxEnergy: 100000},
+		},
+	}
+
+	rapl := &raplPowerMeter{
+		reader:    mockReader,
+		logger:    slog.Default(),
+		perSocket: true,
+	}
+
+	zones, err := rapl.Zones()
+	require.NoError(t, err)
+	require.Len(t, zones, 3, "package zones of both sockets must not be aggregated")
+
+	for i, expected := range []struct {
+		name  string
+		index int
+		path  string
+	}{
+		{"core", 0, "/intel-rapl:0:0"},
+		{"package", 0, "/intel-rapl:0"},
+		{"package", 1, "/intel-rapl:1"},
+	} {
+		assert.Equal(t, expected.name, zones[i].Name())
+		assert.Equal(t, expected.index, zones[i].Index(), "index must reflect the socket")
+		assert.Equal(t, expected.path, zones[i].Path())
+	}
+
+	primary, err := rapl.PrimaryEnergyZone()
+	require.NoError(t, err)
+	assert.Equal(t, "package", primary.Name())
+	assert.Equal(t, 0, primary.Index())
+}
+
 type mockZone struct {
 	name      string
 	index     int
@@ -531,6 +574,22 @@ func TestCPUPowerMeter_InitNoZones(t *testing.T) {
 	mockReader.AssertExpectations(t)
 }
 
+// TestCPUPowerMeter_InitPermissionDenied tests that a denied read of the
+// energy counters does not fail Init, unlike other read errors
+func TestCPUPowerMeter_InitPermissionDenied(t *testing.T) {
+	zone := NewMockRaplZone("package", 0, "/sys/class/powercap/intel-rapl:0", 1000)
+	mockReader := &mockRaplReader{}
+	mockReader.On("Zones").Return([]EnergyZone{zone}, nil)
+
+	meter := &raplPowerMeter{reader: mockReader, logger: slog.Default()}
+
+	zone.OnEnergy(0, ErrRaplPermissionDenied{Path: "energy_uj", Err: os.ErrPermission})
+	assert.NoError(t, meter.Init())
+
+	zone.OnEnergy(0, assert.AnError)
+	assert.ErrorIs(t, meter.Init(), assert.AnError)
+}
+
 // TestPrimaryEnergyZone tests the PrimaryEnergyZone method
 func TestPrimaryEnergyZone(t *testing.T) {
 	t.Run("Priority hierarchy", func(t *testing.T) {
@@ -670,3 +729,110 @@ func TestPrimaryEnergyZone(t *testing.T) {
 		})
 	})
 }
+
+func TestPowercapPath(t *testing.T) {
+	// sysfs without a powercap tree; only the powercap subtree is "mounted"
+	meter, err := NewCPUPowerMeter(t.TempDir(), WithPowercapPath(filepath.Join(validSysFSPath, "class", "powercap")))
+	require.NoError(t, err)
+	require.NoError(t, meter.Init())
+
+	zones, err := meter.reader.Zones()
+	require.NoError(t, err)
+
+	// zones must be discovered exactly as from <sysfs>/class/powercap
+	expected, err := sysfsRaplReader{fs: validSysFSFixtures(t)}.Zones()
+	require.NoError(t, err)
+	require.NotEmpty(t, expected)
+	require.Len(t, zones, len(expected))
+	for i := range expected {
+		assert.Equal(t, expected[i].Name(), zones[i].Name())
+		assert.Equal(t, expected[i].Index(), zones[i].Index())
+		assert.Equal(t, expected[i].Path(), zones[i].Path())
+		assert.Equal(t, expected[i].MaxEnergy(), zones[i].MaxEnergy())
+
+		want, err := expected[i].Energy()
+		require.NoError(t, err)
+		got, err := zones[i].Energy()
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+	}
+
+	t.Run("missing path", func(t *testing.T) {
+		meter, err := NewCPUPowerMeter(validSysFSPath, WithPowercapPath(filepath.Join(t.TempDir(), "powercap")))
+		require.NoError(t, err)
+		assert.Error(t, meter.Init())
+	})
+}
+
+func TestSysFSRaplZone_PermissionDenied(t *testing.T) {
+	t.Run("permission errors are reported distinctly", func(t *testing.T) {
+		path := "/sys/class/powercap/intel-rapl:0/energy_uj"
+		readErr := &fs.PathError{Op: "open", Path: path, Err: syscall.EACCES}
+
+		err := raplReadError(path, readErr)
+
+		var denied ErrRaplPermissionDenied
+		require.ErrorAs(t, err, &denied)
+		assert.Equal(t, path, denied.Path)
+		assert.ErrorIs(t, err, fs.ErrPermission)
+		assert.Contains(t, err.Error(), "CAP_DAC_READ_SEARCH", "the error must point to the fix")
+	})
+
+	t.Run("other errors are returned as is", func(t *testing.T) {
+		readErr := &fs.PathError{Op: "open", Path: "energy_uj", Err: syscall.ENOENT}
+		assert.Equal(t, readErr, raplReadError("energy_uj", readErr))
+	})
+
+	t.Run("unreadable energy_uj", func(t *testing.T) {
+		if os.Geteuid() == 0 {
+			t.Skip("root can read files regardless of their permissions")
+		}
+		dir := t.TempDir()
+		energyFile := filepath.Join(dir, "energy_uj")
+		require.NoError(t, os.WriteFile(energyFile, []byte("1000\n"), 0o000))
+
+		zone := sysfsRaplZone{sysfs.RaplZone{Name: "package", Path: dir, MaxMicrojoules: 1000000}}
+		_, err := zone.Energy()
+
+		var denied ErrRaplPermissionDenied
+		require.ErrorAs(t, err, &denied)
+		assert.Equal(t, energyFile, denied.Path)
+	})
+}
+
+func TestSysFSRaplZone_PowerLimit(t *testing.T) {
+	newZone := func(t *testing.T, limit string) sysfsRaplZone {
+		dir := t.TempDir()
+		if limit != "" {
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "constraint_0_power_limit_uw"), []byte(limit), 0o644))
+		}
+		return sysfsRaplZone{sysfs.RaplZone{Name: "package", Path: dir, MaxMicrojoules: 1000000}}
+	}
+
+	t.Run("limit is read in microwatts", func(t *testing.T) {
+		limit, err := newZone(t, "125000000\n").PowerLimit()
+		require.NoError(t, err)
+		assert.Equal(t, 125*Watt, limit)
+	})
+
+	t.Run("missing limit", func(t *testing.T) {
+		_, err := newZone(t, "").PowerLimit()
+		assert.ErrorIs(t, err, fs.ErrNotExist)
+	})
+
+	t.Run("invalid limit", func(t *testing.T) {
+		_, err := newZone(t, "n/a").PowerLimit()
+		assert.Error(t, err)
+	})
+
+	t.Run("aggregated zones sum their limits", func(t *testing.T) {
+		zone := NewAggregatedZone([]EnergyZone{newZone(t, "100000000"), newZone(t, "150000000")})
+		limit, err := zone.PowerLimit()
+		require.NoError(t, err)
+		assert.Equal(t, 250*Watt, limit)
+
+		zone = NewAggregatedZone([]EnergyZone{newZone(t, "100000000"), NewMockRaplZone("package", 1, "/mock", 1000)})
+		_, err = zone.PowerLimit()
+		assert.Error(t, err, "a zone without a limit makes the total unknown")
+	})
+}
@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package collector
+
+import (
+	prom "github.com/prometheus/client_golang/prometheus"
+)
+
+// attributionInfoCollector reports the methods used to attribute node power
+// to workloads so that metrics of nodes running different modes are not
+// compared unknowingly
+type attributionInfoCollector struct {
+	desc      *prom.Desc
+	cpuMethod string
+	gpuMethod string
+}
+
+// NewAttributionInfoCollector creates a collector exporting
+// kepler_attribution_info with a constant value of 1
+func NewAttributionInfoCollector(cpuMethod, gpuMethod, nodeName string) *attributionInfoCollector {
+	return &attributionInfoCollector{
+		cpuMethod: cpuMethod,
+		gpuMethod: gpuMethod,
+		desc: prom.NewDesc(
+			prom.BuildFQName(keplerNS, "attribution", "info"),
+			"A metric with a constant '1' value labeled with the methods attributing power to workloads",
+			[]string{"cpu_method", "gpu_method"},
+			prom.Labels{nodeNameLabel: nodeName},
+		),
+	}
+}
+
+func (c *attributionInfoCollector) Describe(ch chan<- *prom.Desc) {
+	ch <- c.desc
+}
+
+func (c *attributionInfoCollector) Collect(ch chan<- prom.Metric) {
+	ch <- prom.MustNewConstMetric(c.desc, prom.GaugeValue, 1, c.cpuMethod, c.gpuMethod)
+}
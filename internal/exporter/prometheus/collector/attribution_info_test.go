@@ -0,0 +1,30 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package collector
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttributionInfoCollector(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(NewAttributionInfoCollector("cputime", "utilization", "test-node"))
+
+	families, err := registry.Gather()
+	require.NoError(t, err)
+	require.Len(t, families, 1)
+
+	mf := families[0]
+	assert.Equal(t, "kepler_attribution_info", mf.GetName())
+	require.Len(t, mf.GetMetric(), 1)
+	m := mf.GetMetric()[0]
+	assert.Equal(t, 1.0, m.GetGauge().GetValue())
+	assert.Equal(t, "cputime", valueOfLabel(m, "cpu_method"))
+	assert.Equal(t, "utilization", valueOfLabel(m, "gpu_method"))
+	assert.Equal(t, "test-node", valueOfLabel(m, nodeNameLabel))
+}
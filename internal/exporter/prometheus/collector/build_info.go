@@ -18,13 +18,14 @@ type BuildInfoCollector struct {
 }
 
 // NewKeplerBuildInfoCollector creates a new collector for build information
-func NewKeplerBuildInfoCollector() *BuildInfoCollector {
+func NewKeplerBuildInfoCollector(nodeName string) *BuildInfoCollector {
 	buildInfo := prom.NewGaugeVec(
 		prom.GaugeOpts{
-			Namespace: keplerNS,
-			Subsystem: buildSubsystem,
-			Name:      "info",
-			Help:      "A metric with a constant '1' value labeled with version information",
+			Namespace:   keplerNS,
+			Subsystem:   buildSubsystem,
+			Name:        "info",
+			Help:        "A metric with a constant '1' value labeled with version information",
+			ConstLabels: prom.Labels{nodeNameLabel: nodeName},
 		},
 		[]string{"arch", "branch", "revision", "version", "goversion"},
 	)
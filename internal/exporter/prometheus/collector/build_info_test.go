@@ -12,7 +12,7 @@ import (
 )
 
 func TestBuildInfo_Describe(t *testing.T) {
-	collector := NewKeplerBuildInfoCollector()
+	collector := NewKeplerBuildInfoCollector("test-node")
 	ch := make(chan *prometheus.Desc, 1)
 	collector.Describe(ch)
 	assert.Len(t, ch, 1, "expected one metric description")
@@ -20,7 +20,7 @@ func TestBuildInfo_Describe(t *testing.T) {
 
 func TestBuildInfo_Collect(t *testing.T) {
 	// Create collector
-	collector := NewKeplerBuildInfoCollector()
+	collector := NewKeplerBuildInfoCollector("test-node")
 
 	// Create a channel for metrics
 	ch := make(chan prometheus.Metric, 1)
@@ -46,7 +46,7 @@ func TestBuildInfo_Collect(t *testing.T) {
 
 func TestBuildInfo_ParallelCollect(t *testing.T) {
 	// Create collector
-	collector := NewKeplerBuildInfoCollector()
+	collector := NewKeplerBuildInfoCollector("test-node")
 	parallelCalls := 10
 
 	// Create a shared channel for metrics
@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package collector
+
+import (
+	"time"
+
+	prom "github.com/prometheus/client_golang/prometheus"
+)
+
+// ConfigReloadCollector exports the time the configuration was last loaded,
+// to correlate changes of the metrics with changes of the configuration
+type ConfigReloadCollector struct {
+	lastReload prom.Gauge
+}
+
+// NewConfigReloadCollector creates a collector exporting
+// kepler_config_last_reload_timestamp_seconds, initially set to loadedAt
+func NewConfigReloadCollector(loadedAt time.Time, nodeName string) *ConfigReloadCollector {
+	c := &ConfigReloadCollector{
+		lastReload: prom.NewGauge(prom.GaugeOpts{
+			Namespace:   keplerNS,
+			Subsystem:   "config",
+			Name:        "last_reload_timestamp_seconds",
+			Help:        "Unix timestamp of the last time the configuration was loaded",
+			ConstLabels: prom.Labels{nodeNameLabel: nodeName},
+		}),
+	}
+	c.SetLastReload(loadedAt)
+	return c
+}
+
+// SetLastReload records that the configuration was (re)loaded at t
+func (c *ConfigReloadCollector) SetLastReload(t time.Time) {
+	c.lastReload.Set(float64(t.UnixNano()) / 1e9)
+}
+
+func (c *ConfigReloadCollector) Describe(ch chan<- *prom.Desc) {
+	c.lastReload.Describe(ch)
+}
+
+func (c *ConfigReloadCollector) Collect(ch chan<- prom.Metric) {
+	c.lastReload.Collect(ch)
+}
@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package collector
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigReloadCollector(t *testing.T) {
+	loadedAt := time.Unix(1700000000, 500_000_000)
+	c := NewConfigReloadCollector(loadedAt, "test-node")
+
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(c)
+
+	lastReload := func() float64 {
+		t.Helper()
+		families, err := registry.Gather()
+		require.NoError(t, err)
+		require.Len(t, families, 1)
+
+		mf := families[0]
+		assert.Equal(t, "kepler_config_last_reload_timestamp_seconds", mf.GetName())
+		require.Len(t, mf.GetMetric(), 1)
+		assert.Equal(t, "test-node", valueOfLabel(mf.GetMetric()[0], nodeNameLabel))
+		return mf.GetMetric()[0].GetGauge().GetValue()
+	}
+
+	assert.Equal(t, 1700000000.5, lastReload(), "set on startup")
+
+	// simulate a reload
+	c.SetLastReload(loadedAt.Add(90 * time.Second))
+	assert.Equal(t, 1700000090.5, lastReload(), "updated after reload")
+}
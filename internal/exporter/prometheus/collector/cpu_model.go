@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package collector
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	prom "github.com/prometheus/client_golang/prometheus"
+)
+
+// cpuModelInfoCollector exports the CPU model of the node together with the
+// energy zones discovered by the CPU meter. Unlike kepler_node_cpu_info it
+// reports a single series per node, which makes it easy to compare zone
+// coverage (e.g. DRAM RAPL availability) across nodes.
+type cpuModelInfoCollector struct {
+	sync.Mutex
+
+	fs       procFS
+	pm       PowerDataProvider
+	desc     *prom.Desc
+	labels   []string // model, vendor, rapl_zones; nil until read
+	readOnce bool
+}
+
+// NewCPUModelInfoCollector creates a collector exporting kepler_node_cpu_model_info
+func NewCPUModelInfoCollector(procPath string, pm PowerDataProvider, nodeName string) (*cpuModelInfoCollector, error) {
+	fs, err := newProcFS(procPath)
+	if err != nil {
+		return nil, fmt.Errorf("creating procfs failed: %w", err)
+	}
+	return newCPUModelInfoCollectorWithFS(fs, pm, nodeName), nil
+}
+
+// newCPUModelInfoCollectorWithFS injects a procFS interface
+func newCPUModelInfoCollectorWithFS(fs procFS, pm PowerDataProvider, nodeName string) *cpuModelInfoCollector {
+	return &cpuModelInfoCollector{
+		fs: fs,
+		pm: pm,
+		desc: prom.NewDesc(
+			prom.BuildFQName(keplerNS, "node", "cpu_model_info"),
+			"CPU model of the node and the energy zones discovered by the CPU meter",
+			[]string{"model", "vendor", "rapl_zones"},
+			prom.Labels{nodeNameLabel: nodeName},
+		),
+	}
+}
+
+func (c *cpuModelInfoCollector) Describe(ch chan<- *prom.Desc) {
+	ch <- c.desc
+}
+
+func (c *cpuModelInfoCollector) Collect(ch chan<- prom.Metric) {
+	c.Lock()
+	defer c.Unlock()
+
+	if !c.readOnce {
+		c.read()
+	}
+	if c.labels == nil {
+		return
+	}
+	ch <- prom.MustNewConstMetric(c.desc, prom.GaugeValue, 1, c.labels...)
+}
+
+// read reads the CPU model and zones once; zones are only known once the
+// monitor has been initialized, so reading is retried until they are.
+func (c *cpuModelInfoCollector) read() {
+	zones := c.pm.ZoneNames()
+	if len(zones) == 0 {
+		return
+	}
+	c.readOnce = true
+
+	cpuInfos, err := c.fs.CPUInfo()
+	if err != nil || len(cpuInfos) == 0 {
+		return
+	}
+
+	zones = slices.Clone(zones)
+	slices.Sort(zones)
+	zones = slices.Compact(zones)
+
+	c.labels = []string{cpuInfos[0].ModelName, cpuInfos[0].VendorID, strings.Join(zones, ",")}
+}
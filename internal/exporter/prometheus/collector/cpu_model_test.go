@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package collector
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/procfs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCPUModelInfoCollector(t *testing.T) {
+	t.Run("fixture cpuinfo", func(t *testing.T) {
+		mockPM := NewMockPowerMonitor()
+		mockPM.On("ZoneNames").Return([]string{"package", "dram", "core", "package"})
+
+		c, err := NewCPUModelInfoCollector("testdata/proc", mockPM, "test-node")
+		require.NoError(t, err)
+
+		registry := prometheus.NewRegistry()
+		registry.MustRegister(c)
+
+		assertMetricLabelValues(t, registry, "kepler_node_cpu_model_info", map[string]string{
+			"model":       "AMD EPYC 7763 64-Core Processor",
+			"vendor":      "AuthenticAMD",
+			"rapl_zones":  "core,dram,package",
+			nodeNameLabel: "test-node",
+		}, 1)
+
+		families, err := registry.Gather()
+		require.NoError(t, err)
+		require.Len(t, families, 1)
+		assert.Len(t, families[0].GetMetric(), 1, "one series per node")
+	})
+
+	t.Run("read once", func(t *testing.T) {
+		calls := 0
+		fs := &mockProcFS{cpuInfoFunc: func() ([]procfs.CPUInfo, error) {
+			calls++
+			return sampleCPUInfo(), nil
+		}}
+		mockPM := NewMockPowerMonitor()
+		// zones are unknown until the monitor is initialized
+		mockPM.On("ZoneNames").Return([]string{}).Once()
+		mockPM.On("ZoneNames").Return([]string{"package"})
+
+		registry := prometheus.NewRegistry()
+		registry.MustRegister(newCPUModelInfoCollectorWithFS(fs, mockPM, "test-node"))
+
+		families, err := registry.Gather()
+		require.NoError(t, err)
+		assert.Empty(t, families)
+		assert.Equal(t, 0, calls)
+
+		for range 3 {
+			assertMetricLabelValues(t, registry, "kepler_node_cpu_model_info", map[string]string{
+				"model":      "Intel(R) Core(TM) i7-9750H CPU @ 2.60GHz",
+				"vendor":     "GenuineIntel",
+				"rapl_zones": "package",
+			}, 1)
+		}
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("cpuinfo error", func(t *testing.T) {
+		fs := &mockProcFS{cpuInfoFunc: func() ([]procfs.CPUInfo, error) {
+			return nil, errors.New("no cpuinfo")
+		}}
+		mockPM := NewMockPowerMonitor()
+		mockPM.On("ZoneNames").Return([]string{"package"})
+
+		registry := prometheus.NewRegistry()
+		registry.MustRegister(newCPUModelInfoCollectorWithFS(fs, mockPM, "test-node"))
+
+		families, err := registry.Gather()
+		require.NoError(t, err)
+		assert.Empty(t, families)
+	})
+}
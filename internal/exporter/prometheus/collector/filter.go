@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package collector
+
+import (
+	"path"
+
+	prom "github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+// filterGatherer drops the metric families whose names match any of a set of
+// glob patterns
+type filterGatherer struct {
+	gatherer prom.Gatherer
+	patterns []string
+}
+
+// NewFilterGatherer wraps g so that the metric families whose names match any
+// of the patterns (e.g. "kepler_*_idle_watts", see path.Match) are dropped.
+// It disables the metrics of collectors which, unlike the PowerCollector (see
+// WithDisabledMetrics), can't skip them when they are created. Patterns are
+// expected to be valid.
+func NewFilterGatherer(g prom.Gatherer, patterns []string) prom.Gatherer {
+	if len(patterns) == 0 {
+		return g
+	}
+	return &filterGatherer{gatherer: g, patterns: patterns}
+}
+
+func (f *filterGatherer) Gather() ([]*dto.MetricFamily, error) {
+	families, err := f.gatherer.Gather()
+	filtered := families[:0]
+	for _, mf := range families {
+		if !matchesAny(f.patterns, mf.GetName()) {
+			filtered = append(filtered, mf)
+		}
+	}
+	return filtered, err
+}
+
+// matchesAny reports whether name matches any of the glob patterns; invalid
+// patterns match nothing
+func matchesAny(patterns []string, name string) bool {
+	for _, p := range patterns {
+		if ok, _ := path.Match(p, name); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package collector
+
+import (
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/sustainable-computing-io/kepler/config"
+	"github.com/sustainable-computing-io/kepler/internal/device"
+	"github.com/sustainable-computing-io/kepler/internal/monitor"
+)
+
+func TestPowerCollectorDisabledMetrics(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+	mockMonitor := NewMockPowerMonitor()
+
+	packageZone := device.NewMockRaplZone("package", 0, "/sys/class/powercap/intel-rapl/intel-rapl:0", 1000)
+	testSnapshot := monitor.NewSnapshot()
+	testSnapshot.Timestamp = time.Now()
+	testSnapshot.Node.Zones[packageZone] = monitor.NodeUsage{
+		EnergyTotal: 100 * device.Joule,
+		Power:       50 * device.Watt,
+		ActivePower: 30 * device.Watt,
+		IdlePower:   20 * device.Watt,
+	}
+	mockMonitor.On("Snapshot").Return(testSnapshot, nil)
+
+	powerCollector := NewPowerCollector(mockMonitor, "test-node", logger, config.MetricsLevelNode,
+		WithDisabledMetrics([]string{"kepler_*_idle_watts", "kepler_node_cpu_usage_ratio"}))
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(powerCollector)
+
+	mockMonitor.TriggerUpdate()
+	time.Sleep(10 * time.Millisecond)
+
+	families, err := registry.Gather()
+	require.NoError(t, err)
+	names := metricNames(families)
+	assert.NotContains(t, names, "kepler_node_cpu_idle_watts", "disabled by pattern")
+	assert.NotContains(t, names, "kepler_node_cpu_usage_ratio", "disabled by name")
+	assert.Contains(t, names, "kepler_node_cpu_watts")
+	assert.Contains(t, names, "kepler_node_cpu_active_watts")
+
+	descs := make(chan *prometheus.Desc, 100)
+	powerCollector.Describe(descs)
+	close(descs)
+	for desc := range descs {
+		assert.NotEqual(t, powerCollector.nodeCPUIdleWattsDesc, desc, "disabled metrics must not be described")
+		assert.NotEqual(t, powerCollector.nodeCPUUsageRatioDescriptor, desc, "disabled metrics must not be described")
+	}
+}
+
+func TestFilterGatherer(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(NewKeplerBuildInfoCollector("test-node"))
+	registry.MustRegister(prometheus.NewGauge(prometheus.GaugeOpts{Name: "kepler_node_other_watts"}))
+
+	families, err := NewFilterGatherer(registry, []string{"kepler_build_*"}).Gather()
+	require.NoError(t, err)
+	names := metricNames(families)
+	assert.NotContains(t, names, "kepler_build_info")
+	assert.Contains(t, names, "kepler_node_other_watts")
+
+	assert.Same(t, registry, NewFilterGatherer(registry, nil), "no patterns disable nothing")
+}
@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package collector
+
+import (
+	prom "github.com/prometheus/client_golang/prometheus"
+)
+
+// gpuMeterUpCollector reports whether a GPU power meter is running and read
+// by the monitor
+type gpuMeterUpCollector struct {
+	pm      PowerDataProvider
+	desc    *prom.Desc
+	started bool
+}
+
+// NewGPUMeterUpCollector creates a collector exporting kepler_gpu_meter_up.
+// A value of 0 means GPU monitoring is enabled but no GPU meter started, or
+// none of its devices could be read in the latest collection, so GPU power is
+// missing from the metrics.
+func NewGPUMeterUpCollector(pm PowerDataProvider, started bool, nodeName string) *gpuMeterUpCollector {
+	return &gpuMeterUpCollector{
+		pm:      pm,
+		started: started,
+		desc: prom.NewDesc(
+			prom.BuildFQName(keplerNS, "gpu", "meter_up"),
+			"Whether a GPU power meter is running and its devices were read in the latest collection (1) or not (0)",
+			nil,
+			prom.Labels{nodeNameLabel: nodeName},
+		),
+	}
+}
+
+func (c *gpuMeterUpCollector) Describe(ch chan<- *prom.Desc) {
+	ch <- c.desc
+}
+
+func (c *gpuMeterUpCollector) Collect(ch chan<- prom.Metric) {
+	up := false
+	if c.started {
+		snapshot, err := c.pm.Snapshot()
+		if err != nil {
+			return
+		}
+		// devices whose power can't be read are left out of the snapshot
+		up = len(snapshot.GPUStats) > 0
+	}
+
+	value := 0.0
+	if up {
+		value = 1
+	}
+	ch <- prom.MustNewConstMetric(c.desc, prom.GaugeValue, value)
+}
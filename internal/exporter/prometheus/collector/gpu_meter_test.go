@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package collector
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sustainable-computing-io/kepler/internal/monitor"
+)
+
+func TestGPUMeterUpCollector(t *testing.T) {
+	withGPUs := monitor.NewSnapshot()
+	withGPUs.GPUStats = sampleGPUStats()
+
+	for _, tc := range []struct {
+		name     string
+		started  bool
+		snapshot *monitor.Snapshot
+		expected float64
+	}{
+		{name: "devices read", started: true, snapshot: withGPUs, expected: 1},
+		{name: "no device read", started: true, snapshot: monitor.NewSnapshot(), expected: 0},
+		{name: "meter not started", started: false, expected: 0},
+	} {
+		mockPM := NewMockPowerMonitor()
+		if tc.snapshot != nil {
+			mockPM.On("Snapshot").Return(tc.snapshot, nil)
+		}
+		registry := prometheus.NewRegistry()
+		registry.MustRegister(NewGPUMeterUpCollector(mockPM, tc.started, "test-node"))
+
+		families, err := registry.Gather()
+		require.NoError(t, err)
+		require.Len(t, families, 1)
+
+		mf := families[0]
+		assert.Equal(t, "kepler_gpu_meter_up", mf.GetName())
+		require.Len(t, mf.GetMetric(), 1)
+		assert.Equal(t, tc.expected, mf.GetMetric()[0].GetGauge().GetValue(), tc.name)
+		assert.Equal(t, "test-node", valueOfLabel(mf.GetMetric()[0], nodeNameLabel))
+		mockPM.AssertExpectations(t)
+	}
+
+	t.Run("snapshot error", func(t *testing.T) {
+		mockPM := NewMockPowerMonitor()
+		mockPM.On("Snapshot").Return((*monitor.Snapshot)(nil), assert.AnError)
+		registry := prometheus.NewRegistry()
+		registry.MustRegister(NewGPUMeterUpCollector(mockPM, true, "test-node"))
+
+		families, err := registry.Gather()
+		require.NoError(t, err)
+		assert.Empty(t, families)
+	})
+}
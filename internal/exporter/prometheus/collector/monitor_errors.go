@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package collector
+
+import (
+	prom "github.com/prometheus/client_golang/prometheus"
+)
+
+// ConsecutiveErrorsProvider reports how many collections failed in a row
+type ConsecutiveErrorsProvider interface {
+	// ConsecutiveErrors returns the number of collections that failed since
+	// the last successful one
+	ConsecutiveErrors() int
+}
+
+// consecutiveErrorsCollector exports the number of consecutive failed collections
+type consecutiveErrorsCollector struct {
+	desc     *prom.Desc
+	provider ConsecutiveErrorsProvider
+}
+
+// NewConsecutiveErrorsCollector creates a collector exporting
+// kepler_monitor_consecutive_errors. The gauge is read directly from the
+// monitor since failed collections produce no snapshot.
+func NewConsecutiveErrorsCollector(p ConsecutiveErrorsProvider, nodeName string) *consecutiveErrorsCollector {
+	return &consecutiveErrorsCollector{
+		provider: p,
+		desc: prom.NewDesc(
+			prom.BuildFQName(keplerNS, "monitor", "consecutive_errors"),
+			"Number of consecutive failed power collections; 0 after a successful collection",
+			nil,
+			prom.Labels{nodeNameLabel: nodeName},
+		),
+	}
+}
+
+func (c *consecutiveErrorsCollector) Describe(ch chan<- *prom.Desc) {
+	ch <- c.desc
+}
+
+func (c *consecutiveErrorsCollector) Collect(ch chan<- prom.Metric) {
+	ch <- prom.MustNewConstMetric(c.desc, prom.GaugeValue, float64(c.provider.ConsecutiveErrors()))
+}
@@ -0,0 +1,34 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package collector
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fixedErrors int
+
+func (f *fixedErrors) ConsecutiveErrors() int {
+	return int(*f)
+}
+
+func TestConsecutiveErrorsCollector(t *testing.T) {
+	errs := fixedErrors(3)
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(NewConsecutiveErrorsCollector(&errs, "test-node"))
+
+	assertMetricLabelValues(t, registry, "kepler_monitor_consecutive_errors",
+		map[string]string{nodeNameLabel: "test-node"}, 3)
+
+	// the gauge follows the monitor on every scrape
+	errs = 0
+	families, err := registry.Gather()
+	require.NoError(t, err)
+	require.Len(t, families, 1)
+	assert.Equal(t, 0.0, families[0].GetMetric()[0].GetGauge().GetValue())
+}
@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package collector
+
+import (
+	"log/slog"
+	"sync"
+
+	prom "github.com/prometheus/client_golang/prometheus"
+	"github.com/sustainable-computing-io/kepler/internal/device"
+	"github.com/sustainable-computing-io/kepler/internal/monitor"
+)
+
+// nodeOtherCollector exports the power of the node not measured by RAPL or
+// the GPUs, e.g. fans, disks and NICs, as the platform power minus RAPL power,
+// and minus GPU power when the platform power includes the GPUs.
+type nodeOtherCollector struct {
+	sync.Mutex
+
+	logger   *slog.Logger
+	pm       PowerDataProvider
+	platform RedfishDataProvider
+
+	// gpuInPlatform is true if the platform power includes the GPU power
+	gpuInPlatform bool
+
+	desc *prom.Desc
+}
+
+// NewNodeOtherCollector creates a collector that exports kepler_node_other_watts.
+// The metric is only emitted when both platform and RAPL power are available.
+// The measured GPU power is subtracted as well if gpuInPlatform is set.
+func NewNodeOtherCollector(pm PowerDataProvider, platform RedfishDataProvider, gpuInPlatform bool, nodeName string, logger *slog.Logger) *nodeOtherCollector {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	return &nodeOtherCollector{
+		logger:        logger,
+		pm:            pm,
+		platform:      platform,
+		gpuInPlatform: gpuInPlatform,
+		desc: prom.NewDesc(
+			prom.BuildFQName(keplerNS, "node", "other_watts"),
+			"Power consumption of the node not measured by RAPL or GPUs in watts (platform - rapl, minus gpu if included in platform; clamped at 0)",
+			nil,
+			prom.Labels{nodeNameLabel: nodeName},
+		),
+	}
+}
+
+func (c *nodeOtherCollector) Describe(ch chan<- *prom.Desc) {
+	ch <- c.desc
+}
+
+func (c *nodeOtherCollector) Collect(ch chan<- prom.Metric) {
+	c.Lock()
+	defer c.Unlock()
+
+	platform, err := sumPlatformWatts(c.platform)
+	if err != nil {
+		c.logger.Debug("Platform power unavailable for node other power", "error", err)
+		return
+	}
+
+	snapshot, err := c.pm.Snapshot()
+	if err != nil {
+		c.logger.Error("Failed to get snapshot for node other power", "error", err)
+		return
+	}
+
+	rapl, ok := raplWatts(snapshot.Node)
+	if !ok {
+		return
+	}
+
+	gpu := 0.0
+	if c.gpuInPlatform {
+		for _, stats := range snapshot.GPUStats {
+			gpu += stats.TotalPower
+		}
+	}
+
+	ch <- prom.MustNewConstMetric(c.desc, prom.GaugeValue, max(platform-rapl-gpu, 0))
+}
+
+// raplWatts returns the power of the package and dram zones, the top level
+// RAPL domains which do not overlap, and false if neither is available
+func raplWatts(node *monitor.Node) (float64, bool) {
+	if node == nil {
+		return 0, false
+	}
+
+	total, found := 0.0, false
+	for zone, usage := range node.Zones {
+		switch zone.Name() {
+		case device.ZonePackage, device.ZoneDRAM:
+			total += usage.Power.Watts()
+			found = true
+		}
+	}
+	return total, found
+}
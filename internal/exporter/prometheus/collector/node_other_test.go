@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package collector
+
+import (
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/sustainable-computing-io/kepler/internal/device"
+	"github.com/sustainable-computing-io/kepler/internal/monitor"
+)
+
+func TestNodeOtherCollector(t *testing.T) {
+	// nodeTotalSnapshot: 40W package + 10W dram RAPL, 150.5W + 180W GPU
+	withoutRAPL := func() *monitor.Snapshot {
+		s := nodeTotalSnapshot()
+		core := device.NewMockRaplZone("core", 0, "/sys/class/powercap/intel-rapl/intel-rapl:0:0", 1000)
+		s.Node.Zones = monitor.NodeZoneUsageMap{core: {Power: 20 * device.Watt}}
+		return s
+	}
+	withoutGPU := func() *monitor.Snapshot {
+		s := nodeTotalSnapshot()
+		s.GPUStats = nil
+		return s
+	}
+
+	tt := []struct {
+		name          string
+		snapshot      *monitor.Snapshot
+		platform      RedfishDataProvider
+		gpuInPlatform bool
+		expected      []float64
+	}{{
+		name:          "platform minus rapl and gpu",
+		snapshot:      nodeTotalSnapshot(),
+		platform:      &mockRedfishDataProvider{powerReading: platformReading(300, 200)},
+		gpuInPlatform: true,
+		expected:      []float64{500 - 40 - 10 - 150.5 - 180},
+	}, {
+		name:     "platform excluding gpu minus rapl",
+		snapshot: nodeTotalSnapshot(),
+		platform: &mockRedfishDataProvider{powerReading: platformReading(300, 200)},
+		expected: []float64{500 - 40 - 10},
+	}, {
+		name:          "no gpu",
+		snapshot:      withoutGPU(),
+		platform:      &mockRedfishDataProvider{powerReading: platformReading(120)},
+		gpuInPlatform: true,
+		expected:      []float64{120 - 40 - 10},
+	}, {
+		name:          "clamped at zero",
+		snapshot:      nodeTotalSnapshot(),
+		platform:      &mockRedfishDataProvider{powerReading: platformReading(300)},
+		gpuInPlatform: true,
+		expected:      []float64{0},
+	}, {
+		name:     "platform error",
+		snapshot: nodeTotalSnapshot(),
+		platform: &mockRedfishDataProvider{err: errors.New("bmc unreachable")},
+	}, {
+		name:     "no rapl zones",
+		snapshot: withoutRAPL(),
+		platform: &mockRedfishDataProvider{powerReading: platformReading(500)},
+	}}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			mockPM := NewMockPowerMonitor()
+			mockPM.On("Snapshot").Return(tc.snapshot, nil)
+
+			registry := prometheus.NewRegistry()
+			registry.MustRegister(NewNodeOtherCollector(mockPM, tc.platform, tc.gpuInPlatform, "test-node", slog.Default()))
+
+			families, err := registry.Gather()
+			require.NoError(t, err)
+
+			var values []float64
+			for _, mf := range families {
+				assert.Equal(t, "kepler_node_other_watts", mf.GetName())
+				for _, m := range mf.GetMetric() {
+					assert.Equal(t, "test-node", valueOfLabel(m, nodeNameLabel))
+					values = append(values, m.GetGauge().GetValue())
+				}
+			}
+			require.Len(t, values, len(tc.expected))
+			for i := range values {
+				assert.InDelta(t, tc.expected[i], values[i], 1e-9)
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package collector
+
+import (
+	"errors"
+	"log/slog"
+	"slices"
+	"sync"
+
+	prom "github.com/prometheus/client_golang/prometheus"
+	"github.com/sustainable-computing-io/kepler/config"
+	"github.com/sustainable-computing-io/kepler/internal/monitor"
+)
+
+var errNoPlatformReading = errors.New("no platform power reading")
+
+// nodeTotalCollector exports a single combined node power gauge summing the
+// configured power sources (the active power of the primary CPU zone, gpu,
+// platform).
+type nodeTotalCollector struct {
+	sync.Mutex
+
+	logger   *slog.Logger
+	pm       PowerDataProvider
+	platform RedfishDataProvider // optional, nil when platform power is unavailable
+
+	useCPU      bool
+	useGPU      bool
+	usePlatform bool
+
+	// gpuInPlatform is true if the platform power includes the GPU power
+	gpuInPlatform bool
+
+	desc *prom.Desc
+}
+
+// NewNodeTotalCollector creates a collector that exports the combined node power.
+// Platform power (when enabled and available) already includes CPU power and
+// therefore replaces it in the sum to avoid double counting; GPU power is
+// replaced as well if gpuInPlatform is set, and added to the platform power
+// otherwise.
+func NewNodeTotalCollector(pm PowerDataProvider, platform RedfishDataProvider, sources []string, gpuInPlatform bool, nodeName string, logger *slog.Logger) *nodeTotalCollector {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	return &nodeTotalCollector{
+		logger:        logger,
+		pm:            pm,
+		platform:      platform,
+		useCPU:        slices.Contains(sources, config.TotalPowerSourceCPU),
+		useGPU:        slices.Contains(sources, config.TotalPowerSourceGPU),
+		usePlatform:   platform != nil && slices.Contains(sources, config.TotalPowerSourcePlatform),
+		gpuInPlatform: gpuInPlatform,
+		desc: prom.NewDesc(
+			prom.BuildFQName(keplerNS, "node", "total_watts"),
+			"Combined power consumption of the node in watts (cpu active + gpu, or platform when available, plus gpu if not included in platform)",
+			nil,
+			prom.Labels{nodeNameLabel: nodeName},
+		),
+	}
+}
+
+func (c *nodeTotalCollector) Describe(ch chan<- *prom.Desc) {
+	ch <- c.desc
+}
+
+func (c *nodeTotalCollector) Collect(ch chan<- prom.Metric) {
+	c.Lock()
+	defer c.Unlock()
+
+	snapshot, err := c.pm.Snapshot()
+	if err != nil {
+		c.logger.Error("Failed to get snapshot for node total power", "error", err)
+		return
+	}
+
+	ch <- prom.MustNewConstMetric(c.desc, prom.GaugeValue, c.totalWatts(snapshot))
+}
+
+// totalWatts sums the configured power sources
+func (c *nodeTotalCollector) totalWatts(snapshot *monitor.Snapshot) float64 {
+	gpu := 0.0
+	if c.useGPU {
+		for _, stats := range snapshot.GPUStats {
+			gpu += stats.TotalPower
+		}
+	}
+
+	if c.usePlatform {
+		if watts, ok := c.platformWatts(); ok {
+			if c.gpuInPlatform {
+				return watts
+			}
+			return watts + gpu
+		}
+	}
+
+	total := gpu
+	if c.useCPU && snapshot.Node != nil && snapshot.Node.PrimaryZone != nil {
+		// only the active CPU power counts, as requested for the top-level
+		// view; the idle power is reported by kepler_node_cpu_idle_watts. With
+		// per-socket zones, each socket has a zone named like the primary zone
+		for zone, usage := range snapshot.Node.Zones {
+			if zone.Name() == snapshot.Node.PrimaryZone.Name() {
+				total += usage.ActivePower.Watts()
+			}
+		}
+	}
+	return total
+}
+
+// platformWatts returns the sum of all platform power readings and false if
+// no reading is available
+func (c *nodeTotalCollector) platformWatts() (float64, bool) {
+	watts, err := sumPlatformWatts(c.platform)
+	if err != nil {
+		c.logger.Debug("Platform power unavailable for node total power", "error", err)
+		return 0, false
+	}
+	return watts, true
+}
+
+// sumPlatformWatts returns the sum of all platform power readings
+func sumPlatformWatts(platform RedfishDataProvider) (float64, error) {
+	reading, err := platform.Power()
+	if err != nil {
+		return 0, err
+	}
+	if reading == nil || len(reading.Chassis) == 0 {
+		return 0, errNoPlatformReading
+	}
+
+	total := 0.0
+	for _, chassis := range reading.Chassis {
+		for _, r := range chassis.Readings {
+			total += r.Power.Watts()
+		}
+	}
+	return total, nil
+}
@@ -0,0 +1,165 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package collector
+
+import (
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/sustainable-computing-io/kepler/config"
+	"github.com/sustainable-computing-io/kepler/internal/device"
+	"github.com/sustainable-computing-io/kepler/internal/monitor"
+	"github.com/sustainable-computing-io/kepler/internal/platform/redfish"
+)
+
+// nodeTotalSnapshot returns a snapshot with a 40W package zone of which 30W
+// are active, a 10W dram zone and two GPUs drawing 150.5W and 180W
+func nodeTotalSnapshot() *monitor.Snapshot {
+	pkg := device.NewMockRaplZone("package", 0, "/sys/class/powercap/intel-rapl/intel-rapl:0", 1000)
+	dram := device.NewMockRaplZone("dram", 0, "/sys/class/powercap/intel-rapl/intel-rapl:0:1", 1000)
+
+	snapshot := monitor.NewSnapshot()
+	snapshot.Node = &monitor.Node{
+		Timestamp:   time.Now(),
+		PrimaryZone: pkg,
+		Zones: monitor.NodeZoneUsageMap{
+			pkg:  {Power: 40 * device.Watt, ActivePower: 30 * device.Watt, IdlePower: 10 * device.Watt},
+			dram: {Power: 10 * device.Watt},
+		},
+	}
+	snapshot.GPUStats = sampleGPUStats()
+	return snapshot
+}
+
+func platformReading(watts ...float64) *redfish.PowerReading {
+	readings := make([]redfish.Reading, len(watts))
+	for i, w := range watts {
+		readings[i] = redfish.Reading{
+			SourceID:   "PS1",
+			SourceType: redfish.PowerSupplySource,
+			Power:      device.Power(w) * device.Watt,
+		}
+	}
+	return &redfish.PowerReading{
+		Timestamp: time.Now(),
+		Chassis:   []redfish.Chassis{{ID: "1", Readings: readings}},
+	}
+}
+
+func TestNodeTotalCollector(t *testing.T) {
+	allSources := []string{config.TotalPowerSourceCPU, config.TotalPowerSourceGPU, config.TotalPowerSourcePlatform}
+
+	tt := []struct {
+		name          string
+		sources       []string
+		platform      RedfishDataProvider
+		gpuInPlatform bool
+		expected      float64
+	}{{
+		name:     "cpu and gpu",
+		sources:  []string{config.TotalPowerSourceCPU, config.TotalPowerSourceGPU},
+		expected: 30 + 150.5 + 180,
+	}, {
+		name:     "cpu only",
+		sources:  []string{config.TotalPowerSourceCPU},
+		expected: 30,
+	}, {
+		name:     "gpu only",
+		sources:  []string{config.TotalPowerSourceGPU},
+		expected: 150.5 + 180,
+	}, {
+		name:          "platform replaces cpu and gpu",
+		sources:       allSources,
+		platform:      &mockRedfishDataProvider{powerReading: platformReading(250, 200)},
+		gpuInPlatform: true,
+		expected:      450,
+	}, {
+		name:     "platform excluding gpu replaces cpu",
+		sources:  allSources,
+		platform: &mockRedfishDataProvider{powerReading: platformReading(250, 200)},
+		expected: 450 + 150.5 + 180,
+	}, {
+		name:     "platform excluding gpu without gpu source",
+		sources:  []string{config.TotalPowerSourceCPU, config.TotalPowerSourcePlatform},
+		platform: &mockRedfishDataProvider{powerReading: platformReading(250, 200)},
+		expected: 450,
+	}, {
+		name:     "platform not configured falls back to cpu and gpu",
+		sources:  allSources,
+		expected: 30 + 150.5 + 180,
+	}, {
+		name:     "platform error falls back to cpu and gpu",
+		sources:  allSources,
+		platform: &mockRedfishDataProvider{err: errors.New("bmc unreachable")},
+		expected: 30 + 150.5 + 180,
+	}, {
+		name:     "platform without readings falls back to cpu and gpu",
+		sources:  allSources,
+		platform: &mockRedfishDataProvider{powerReading: &redfish.PowerReading{}},
+		expected: 30 + 150.5 + 180,
+	}, {
+		name:     "platform not in sources is ignored",
+		sources:  []string{config.TotalPowerSourceCPU},
+		platform: &mockRedfishDataProvider{powerReading: platformReading(250)},
+		expected: 30,
+	}}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			mockPM := NewMockPowerMonitor()
+			mockPM.On("Snapshot").Return(nodeTotalSnapshot(), nil)
+
+			c := NewNodeTotalCollector(mockPM, tc.platform, tc.sources, tc.gpuInPlatform, "test-node", slog.Default())
+
+			registry := prometheus.NewRegistry()
+			registry.MustRegister(c)
+
+			families, err := registry.Gather()
+			require.NoError(t, err)
+			require.Len(t, families, 1)
+
+			mf := families[0]
+			assert.Equal(t, "kepler_node_total_watts", mf.GetName())
+			require.Len(t, mf.GetMetric(), 1)
+			assert.InDelta(t, tc.expected, mf.GetMetric()[0].GetGauge().GetValue(), 1e-9)
+			assert.Equal(t, "test-node", valueOfLabel(mf.GetMetric()[0], nodeNameLabel))
+		})
+	}
+}
+
+func TestNodeTotalCollector_NoPrimaryZone(t *testing.T) {
+	snapshot := nodeTotalSnapshot()
+	snapshot.Node.PrimaryZone = nil
+	snapshot.GPUStats = nil
+
+	c := NewNodeTotalCollector(NewMockPowerMonitor(), nil, []string{config.TotalPowerSourceCPU}, false, "test-node", slog.Default())
+	assert.Equal(t, 0.0, c.totalWatts(snapshot))
+}
+
+func TestNodeTotalCollector_PerSocketZones(t *testing.T) {
+	snapshot := nodeTotalSnapshot()
+	snapshot.GPUStats = nil
+	pkg1 := device.NewMockRaplZone("package", 1, "/sys/class/powercap/intel-rapl/intel-rapl:1", 1000)
+	snapshot.Node.Zones[pkg1] = monitor.NodeUsage{Power: 35 * device.Watt, ActivePower: 25 * device.Watt, IdlePower: 10 * device.Watt}
+
+	c := NewNodeTotalCollector(NewMockPowerMonitor(), nil, []string{config.TotalPowerSourceCPU}, false, "test-node", slog.Default())
+	assert.InDelta(t, 30+25, c.totalWatts(snapshot), 1e-9, "the active power of the package zones of all sockets counts")
+}
+
+func TestNodeTotalCollector_SnapshotError(t *testing.T) {
+	mockPM := NewMockPowerMonitor()
+	mockPM.On("Snapshot").Return((*monitor.Snapshot)(nil), errors.New("snapshot error"))
+
+	c := NewNodeTotalCollector(mockPM, nil, []string{config.TotalPowerSourceCPU}, false, "test-node", slog.Default())
+
+	ch := make(chan prometheus.Metric, 1)
+	c.Collect(ch)
+	close(ch)
+	assert.Empty(t, ch)
+}
@@ -24,6 +24,14 @@ type RedfishDataProvider interface {
 	BMCID() string                         // BMC identifier
 }
 
+// RedfishReadingTracker is optionally implemented by a RedfishDataProvider
+// that tracks the freshness of its readings
+type RedfishReadingTracker interface {
+	// LastReading returns the time of the last successful poll, zero if none
+	// succeeded yet, and whether the most recent poll failed
+	LastReading() (time.Time, bool)
+}
+
 // PlatformCollector collects platform power metrics from Redfish BMC
 type PlatformCollector struct {
 	logger  *slog.Logger
@@ -34,7 +42,9 @@ type PlatformCollector struct {
 	bmcID    string // BMC identifier
 
 	// Metric descriptors
-	wattsDesc *prometheus.Desc
+	wattsDesc        *prometheus.Desc
+	readingAgeDesc   *prometheus.Desc
+	readingStaleDesc *prometheus.Desc
 }
 
 // NewRedfishCollector creates a new platform collector
@@ -57,18 +67,35 @@ func NewRedfishCollector(redfish RedfishDataProvider, logger *slog.Logger) *Plat
 			[]string{"source", "node_name", "bmc_id", "chassis_id", "source_id", "source_name", "source_type"},
 			nil,
 		),
+		readingAgeDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(keplerNS, platformSubsystem, "redfish_reading_age_seconds"),
+			"Age of the last successful BMC power reading in seconds",
+			[]string{"node_name", "bmc_id"},
+			nil,
+		),
+		readingStaleDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(keplerNS, platformSubsystem, "redfish_reading_stale"),
+			"Whether the most recent poll of the BMC failed so that the last power reading is stale (1) or not (0)",
+			[]string{"node_name", "bmc_id"},
+			nil,
+		),
 	}
 }
 
 // Describe sends the descriptors of platform metrics to the provided channel
 func (c *PlatformCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- c.wattsDesc
+	if _, ok := c.redfish.(RedfishReadingTracker); ok {
+		ch <- c.readingAgeDesc
+		ch <- c.readingStaleDesc
+	}
 }
 
 // Collect gathers platform power metrics and sends them to the provided channel
 func (c *PlatformCollector) Collect(ch chan<- prometheus.Metric) {
 	// Get all chassis power readings using the new simplified interface
 	powerReading, err := c.redfish.Power()
+	c.collectReadingFreshness(ch)
 	if err != nil {
 		c.logger.Error("Failed to get chassis power readings", "error", err)
 		return
@@ -106,3 +133,33 @@ func (c *PlatformCollector) Collect(ch chan<- prometheus.Metric) {
 		}
 	}
 }
+
+// collectReadingFreshness collects the age of the last successful BMC reading
+// and whether the most recent poll failed, if the provider tracks them
+func (c *PlatformCollector) collectReadingFreshness(ch chan<- prometheus.Metric) {
+	tracker, ok := c.redfish.(RedfishReadingTracker)
+	if !ok {
+		return
+	}
+
+	polled, failed := tracker.LastReading()
+	if !polled.IsZero() {
+		ch <- prometheus.MustNewConstMetric(
+			c.readingAgeDesc,
+			prometheus.GaugeValue,
+			time.Since(polled).Seconds(),
+			c.nodeName, c.bmcID,
+		)
+	}
+
+	stale := 0.0
+	if failed {
+		stale = 1
+	}
+	ch <- prometheus.MustNewConstMetric(
+		c.readingStaleDesc,
+		prometheus.GaugeValue,
+		stale,
+		c.nodeName, c.bmcID,
+	)
+}
@@ -54,6 +54,18 @@ func (m *mockRedfishDataProvider) getCallCount() int {
 	return m.callCount
 }
 
+// mockRedfishReadingTracker is a mockRedfishDataProvider that also tracks the
+// freshness of its readings
+type mockRedfishReadingTracker struct {
+	mockRedfishDataProvider
+	polled time.Time
+	failed bool
+}
+
+func (m *mockRedfishReadingTracker) LastReading() (time.Time, bool) {
+	return m.polled, m.failed
+}
+
 // Helper function to find metric value by labels
 func findMetricValue(t *testing.T, metricFamily *dto.MetricFamily, expectedLabels map[string]string) float64 {
 	for _, metric := range metricFamily.GetMetric() {
@@ -525,3 +537,83 @@ func TestPlatformCollector_Collect_MetricLabelsValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestPlatformCollector_ReadingFreshness(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+	labels := map[string]string{"node_name": "test-node", "bmc_id": "test-bmc"}
+
+	t.Run("age reflects the poll time", func(t *testing.T) {
+		polled := time.Now().Add(-30 * time.Second)
+		provider := &mockRedfishReadingTracker{
+			mockRedfishDataProvider: mockRedfishDataProvider{
+				nodeName:     "test-node",
+				bmcID:        "test-bmc",
+				powerReading: &redfish.PowerReading{Timestamp: polled},
+			},
+			polled: polled,
+		}
+
+		registry := prometheus.NewRegistry()
+		registry.MustRegister(NewRedfishCollector(provider, logger))
+
+		families, err := registry.Gather()
+		require.NoError(t, err)
+		byName := map[string]*dto.MetricFamily{}
+		for _, mf := range families {
+			byName[mf.GetName()] = mf
+		}
+
+		require.Contains(t, byName, "kepler_platform_redfish_reading_age_seconds")
+		age := findMetricValue(t, byName["kepler_platform_redfish_reading_age_seconds"], labels)
+		assert.InDelta(t, 30, age, 1)
+		require.Contains(t, byName, "kepler_platform_redfish_reading_stale")
+		assert.Equal(t, 0.0, findMetricValue(t, byName["kepler_platform_redfish_reading_stale"], labels))
+	})
+
+	t.Run("stale after a failed poll", func(t *testing.T) {
+		provider := &mockRedfishReadingTracker{
+			mockRedfishDataProvider: mockRedfishDataProvider{
+				nodeName: "test-node",
+				bmcID:    "test-bmc",
+				err:      errors.New("BMC unreachable"),
+			},
+			polled: time.Now().Add(-2 * time.Minute),
+			failed: true,
+		}
+
+		registry := prometheus.NewRegistry()
+		registry.MustRegister(NewRedfishCollector(provider, logger))
+
+		families, err := registry.Gather()
+		require.NoError(t, err)
+		byName := map[string]*dto.MetricFamily{}
+		for _, mf := range families {
+			byName[mf.GetName()] = mf
+		}
+
+		assert.NotContains(t, byName, "kepler_platform_watts")
+		require.Contains(t, byName, "kepler_platform_redfish_reading_age_seconds")
+		assert.InDelta(t, 120, findMetricValue(t, byName["kepler_platform_redfish_reading_age_seconds"], labels), 1)
+		assert.Equal(t, 1.0, findMetricValue(t, byName["kepler_platform_redfish_reading_stale"], labels))
+	})
+
+	t.Run("never polled", func(t *testing.T) {
+		provider := &mockRedfishReadingTracker{
+			mockRedfishDataProvider: mockRedfishDataProvider{
+				nodeName: "test-node",
+				bmcID:    "test-bmc",
+				err:      errors.New("BMC unreachable"),
+			},
+			failed: true,
+		}
+
+		registry := prometheus.NewRegistry()
+		registry.MustRegister(NewRedfishCollector(provider, logger))
+
+		families, err := registry.Gather()
+		require.NoError(t, err)
+		names := metricNames(families)
+		assert.NotContains(t, names, "kepler_platform_redfish_reading_age_seconds")
+		assert.Contains(t, names, "kepler_platform_redfish_reading_stale")
+	})
+}
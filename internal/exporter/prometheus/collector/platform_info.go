@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package collector
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	prom "github.com/prometheus/client_golang/prometheus"
+)
+
+// azureAssetTag is the chassis asset tag of Azure virtual machines
+const azureAssetTag = "7783-7084-3265-9085-8269-3286-77"
+
+// PlatformInfo identifies the cloud platform a node runs on. Empty fields are
+// unknown.
+type PlatformInfo struct {
+	Cloud        string
+	InstanceType string
+}
+
+// platformInfoCollector exports the cloud provider and instance type of the
+// node, which contextualizes power readings that may be estimated or
+// unavailable on virtualized hardware
+type platformInfoCollector struct {
+	desc *prom.Desc
+	info PlatformInfo
+}
+
+// NewPlatformInfoCollector creates a collector exporting
+// kepler_node_platform_info with a constant value of 1. The platform is
+// detected from the DMI data in <sysfsPath>/class/dmi/id; non-empty fields of
+// override take precedence over the detected ones.
+func NewPlatformInfoCollector(sysfsPath string, override PlatformInfo, nodeName string) *platformInfoCollector {
+	info := DetectPlatformInfo(filepath.Join(sysfsPath, "class", "dmi", "id"))
+	if override.Cloud != "" {
+		info.Cloud = override.Cloud
+	}
+	if override.InstanceType != "" {
+		info.InstanceType = override.InstanceType
+	}
+
+	return &platformInfoCollector{
+		info: info,
+		desc: prom.NewDesc(
+			prom.BuildFQName(keplerNS, "node", "platform_info"),
+			"A metric with a constant '1' value labeled with the cloud provider and instance type of the node",
+			[]string{"cloud", "instance_type"},
+			prom.Labels{nodeNameLabel: nodeName},
+		),
+	}
+}
+
+func (c *platformInfoCollector) Describe(ch chan<- *prom.Desc) {
+	ch <- c.desc
+}
+
+func (c *platformInfoCollector) Collect(ch chan<- prom.Metric) {
+	ch <- prom.MustNewConstMetric(c.desc, prom.GaugeValue, 1, c.info.Cloud, c.info.InstanceType)
+}
+
+// DetectPlatformInfo detects the cloud provider and instance type from the
+// DMI data in dmiPath (e.g. /sys/class/dmi/id). The instance type is only
+// known for clouds that expose it in DMI. Missing or unreadable DMI data
+// detects nothing.
+func DetectPlatformInfo(dmiPath string) PlatformInfo {
+	read := func(name string) string {
+		data, err := os.ReadFile(filepath.Join(dmiPath, name))
+		if err != nil {
+			return ""
+		}
+		return strings.TrimSpace(string(data))
+	}
+
+	sysVendor := read("sys_vendor")
+	productName := read("product_name")
+
+	switch {
+	case sysVendor == "Amazon EC2" || strings.HasPrefix(read("bios_vendor"), "Amazon EC2"):
+		return PlatformInfo{Cloud: "aws", InstanceType: productName}
+	case sysVendor == "Google" || productName == "Google Compute Engine":
+		return PlatformInfo{Cloud: "gcp"}
+	case read("chassis_asset_tag") == azureAssetTag:
+		return PlatformInfo{Cloud: "azure"}
+	case read("chassis_asset_tag") == "OracleCloud.com":
+		return PlatformInfo{Cloud: "oracle"}
+	case sysVendor == "Alibaba Cloud":
+		return PlatformInfo{Cloud: "alibaba"}
+	case sysVendor == "DigitalOcean":
+		return PlatformInfo{Cloud: "digitalocean"}
+	case sysVendor == "Hetzner":
+		return PlatformInfo{Cloud: "hetzner"}
+	case strings.HasPrefix(productName, "OpenStack"):
+		return PlatformInfo{Cloud: "openstack"}
+	}
+	return PlatformInfo{}
+}
@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package collector
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeDMI writes the DMI files to a temporary sysfs and returns its path
+func writeDMI(t *testing.T, files map[string]string) string {
+	t.Helper()
+	sysfs := t.TempDir()
+	dmi := filepath.Join(sysfs, "class", "dmi", "id")
+	require.NoError(t, os.MkdirAll(dmi, 0o755))
+	for name, content := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dmi, name), []byte(content+"\n"), 0o644))
+	}
+	return sysfs
+}
+
+func TestDetectPlatformInfo(t *testing.T) {
+	tt := []struct {
+		name     string
+		files    map[string]string
+		expected PlatformInfo
+	}{{
+		name:     "gcp",
+		files:    map[string]string{"sys_vendor": "Google", "product_name": "Google Compute Engine"},
+		expected: PlatformInfo{Cloud: "gcp"},
+	}, {
+		name: "azure",
+		files: map[string]string{
+			"sys_vendor":        "Microsoft Corporation",
+			"product_name":      "Virtual Machine",
+			"chassis_asset_tag": azureAssetTag,
+		},
+		expected: PlatformInfo{Cloud: "azure"},
+	}, {
+		name:     "hyper-v is not azure",
+		files:    map[string]string{"sys_vendor": "Microsoft Corporation", "product_name": "Virtual Machine"},
+		expected: PlatformInfo{},
+	}, {
+		name:     "openstack",
+		files:    map[string]string{"sys_vendor": "OpenStack Foundation", "product_name": "OpenStack Nova"},
+		expected: PlatformInfo{Cloud: "openstack"},
+	}, {
+		name:     "bare metal",
+		files:    map[string]string{"sys_vendor": "Dell Inc.", "product_name": "PowerEdge R650"},
+		expected: PlatformInfo{},
+	}, {
+		name:     "no DMI data",
+		files:    map[string]string{},
+		expected: PlatformInfo{},
+	}}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			sysfs := writeDMI(t, tc.files)
+			assert.Equal(t, tc.expected, DetectPlatformInfo(filepath.Join(sysfs, "class", "dmi", "id")))
+		})
+	}
+}
+
+func TestPlatformInfoCollector(t *testing.T) {
+	collect := func(t *testing.T, c prometheus.Collector) (cloud, instanceType string) {
+		t.Helper()
+		registry := prometheus.NewRegistry()
+		registry.MustRegister(c)
+
+		families, err := registry.Gather()
+		require.NoError(t, err)
+		require.Len(t, families, 1)
+
+		mf := families[0]
+		assert.Equal(t, "kepler_node_platform_info", mf.GetName())
+		require.Len(t, mf.GetMetric(), 1)
+		m := mf.GetMetric()[0]
+		assert.Equal(t, 1.0, m.GetGauge().GetValue())
+		assert.Equal(t, "test-node", valueOfLabel(m, nodeNameLabel))
+		return valueOfLabel(m, "cloud"), valueOfLabel(m, "instance_type")
+	}
+
+	t.Run("detected from fixture", func(t *testing.T) {
+		cloud, instanceType := collect(t, NewPlatformInfoCollector("testdata/sys", PlatformInfo{}, "test-node"))
+		assert.Equal(t, "aws", cloud)
+		assert.Equal(t, "m5.large", instanceType)
+	})
+
+	t.Run("configured values override detected", func(t *testing.T) {
+		cloud, instanceType := collect(t,
+			NewPlatformInfoCollector("testdata/sys", PlatformInfo{InstanceType: "m5.xlarge"}, "test-node"))
+		assert.Equal(t, "aws", cloud)
+		assert.Equal(t, "m5.xlarge", instanceType)
+	})
+
+	t.Run("configured source without DMI", func(t *testing.T) {
+		cloud, instanceType := collect(t,
+			NewPlatformInfoCollector(t.TempDir(), PlatformInfo{Cloud: "on-prem"}, "test-node"))
+		assert.Equal(t, "on-prem", cloud)
+		assert.Empty(t, instanceType)
+	})
+}
@@ -6,6 +6,9 @@ package collector
 import (
 	"fmt"
 	"log/slog"
+	"math"
+	"slices"
+	"strconv"
 	"sync"
 	"time"
 
@@ -25,6 +28,35 @@ type PowerCollector struct {
 	logger       *slog.Logger
 	metricsLevel config.Level
 
+	// zoneNameMap aliases zone names in metric labels
+	zoneNameMap map[string]string
+
+	// emitKWh enables the kWh variants of the CPU and GPU energy counters
+	emitKWh bool
+
+	// staleness withholds the metrics of snapshots older than it, as reported
+	// by a monitor implementing monitor.LatestSnapshotProvider; 0 disables
+	staleness time.Duration
+
+	// gpuPowerPrecision is the number of decimal places of the GPU power
+	// gauges; negative exports full precision
+	gpuPowerPrecision int
+
+	// includeZonePath adds the zone path label to the workload CPU metrics
+	includeZonePath bool
+
+	// disabledMetrics are the glob patterns of the disabled metric names and
+	// disabled their descriptors, which are neither described nor collected
+	disabledMetrics []string
+	disabled        map[*prometheus.Desc]bool
+
+	// maxTotalSeries caps the estimated series of a scrape by dropping
+	// workload levels; 0 disables the cap. lastDropped are the levels
+	// dropped by the previous scrape, to log changes only.
+	maxTotalSeries int
+	capMutex       sync.Mutex
+	lastDropped    string
+
 	// Lock to ensure thread safety during collection
 	mutex sync.RWMutex
 
@@ -40,20 +72,37 @@ type PowerCollector struct {
 	nodeCPUIdleWattsDesc  *prometheus.Desc
 	nodeCPUIdleJoulesDesc *prometheus.Desc
 
-	nodeCPUUsageRatioDescriptor *prometheus.Desc
+	nodeCPUUsageRatioDescriptor        *prometheus.Desc
+	nodeCPUCoreWattsDescriptor         *prometheus.Desc
+	nodeRaplPermissionDeniedDescriptor *prometheus.Desc
+	nodeCPUPowerUtilizationDescriptor  *prometheus.Desc
 
 	// Process power metrics
 	processCPUJoulesDescriptor *prometheus.Desc
 	processCPUWattsDescriptor  *prometheus.Desc
+	processIntervalJoulesDesc  *prometheus.Desc
 	processCPUTimeDescriptor   *prometheus.Desc
+	processMemoryDescriptor    *prometheus.Desc
 	processGPUWattsDescriptor  *prometheus.Desc
 	processGPUJoulesDescriptor *prometheus.Desc
+	processGPUEncoderDesc      *prometheus.Desc
+	processGPUDecoderDesc      *prometheus.Desc
+	processGPUSMUtilDesc       *prometheus.Desc
+
+	processUnattributedWattsDescriptor *prometheus.Desc
+	processAgedJoulesDescriptor        *prometheus.Desc
+	processThreadsDescriptor           *prometheus.Desc
 
 	// Container power metrics
 	containerCPUJoulesDescriptor *prometheus.Desc
 	containerCPUWattsDescriptor  *prometheus.Desc
+	containerMemoryDescriptor    *prometheus.Desc
 	containerGPUWattsDescriptor  *prometheus.Desc
 	containerGPUJoulesDescriptor *prometheus.Desc
+	containerGPUEncoderDesc      *prometheus.Desc
+	containerGPUDecoderDesc      *prometheus.Desc
+
+	containerTerminatedJoulesDescriptor *prometheus.Desc
 
 	// Virtual Machine power metrics
 	vmCPUJoulesDescriptor *prometheus.Desc
@@ -64,6 +113,17 @@ type PowerCollector struct {
 	podCPUWattsDescriptor  *prometheus.Desc
 	podGPUWattsDescriptor  *prometheus.Desc
 	podGPUJoulesDescriptor *prometheus.Desc
+	podGPUShareDescriptor  *prometheus.Desc
+	podGPUEncoderDesc      *prometheus.Desc
+	podGPUDecoderDesc      *prometheus.Desc
+
+	podTerminatedJoulesDescriptor *prometheus.Desc
+
+	// User power metrics
+	userWattsDescriptor *prometheus.Desc
+
+	// Energy attributed to the workloads of each level
+	attributedJoulesDescriptor *prometheus.Desc
 
 	// GPU device power metrics
 	gpuTotalWattsDescriptor   *prometheus.Desc
@@ -72,46 +132,201 @@ type PowerCollector struct {
 	gpuJoulesDescriptor       *prometheus.Desc
 	gpuActiveJoulesDescriptor *prometheus.Desc
 	gpuIdleJoulesDescriptor   *prometheus.Desc
+	gpuWattsPerUtilDescriptor *prometheus.Desc
+	gpuFanSpeedDescriptor     *prometheus.Desc
+	gpuPStateDescriptor       *prometheus.Desc
+	gpuNodeTotalWattsDesc     *prometheus.Desc
+
+	// Meter health metrics
+	meterReadErrorsDescriptor *prometheus.Desc
+
+	// Series cap metrics
+	seriesCappedDescriptor *prometheus.Desc
+
+	// Process churn metrics
+	processesStartedDescriptor    *prometheus.Desc
+	processesTerminatedDescriptor *prometheus.Desc
+	negativeCPUTimeDescriptor     *prometheus.Desc
+
+	// kWh variants of the CPU energy counters
+	nodeKWhDescriptor      *prometheus.Desc
+	processKWhDescriptor   *prometheus.Desc
+	containerKWhDescriptor *prometheus.Desc
+	vmKWhDescriptor        *prometheus.Desc
+	podKWhDescriptor       *prometheus.Desc
+
+	// kWh variants of the GPU energy counters
+	nodeGPUKWhDescriptor      *prometheus.Desc
+	processGPUKWhDescriptor   *prometheus.Desc
+	containerGPUKWhDescriptor *prometheus.Desc
+	podGPUKWhDescriptor       *prometheus.Desc
+}
+
+// joulesPerKWh is the number of joules in a kilowatt-hour
+const joulesPerKWh = 3.6e6
+
+// descBuilder builds the descriptors of a PowerCollector and records their
+// names, which a Desc does not expose, so that metrics can be disabled by name
+type descBuilder struct {
+	nodeName string
+	names    map[*prometheus.Desc]string
+}
+
+func (b *descBuilder) newDesc(fqName, help string, labels []string) *prometheus.Desc {
+	desc := prometheus.NewDesc(fqName, help, labels, prometheus.Labels{nodeNameLabel: b.nodeName})
+	b.names[desc] = fqName
+	return desc
 }
 
-func joulesDesc(level, device, nodeName string, labels []string) *prometheus.Desc {
-	return prometheus.NewDesc(
+func (b *descBuilder) joulesDesc(level, device string, labels []string) *prometheus.Desc {
+	return b.newDesc(
 		prometheus.BuildFQName(keplerNS, level, device+"_joules_total"),
 		fmt.Sprintf("Energy consumption of %s at %s level in joules", device, level),
-		labels, prometheus.Labels{nodeNameLabel: nodeName})
+		labels)
 }
 
-func wattsDesc(level, device, nodeName string, labels []string) *prometheus.Desc {
-	return prometheus.NewDesc(
+func (b *descBuilder) wattsDesc(level, device string, labels []string) *prometheus.Desc {
+	return b.newDesc(
 		prometheus.BuildFQName(keplerNS, level, device+"_watts"),
 		fmt.Sprintf("Power consumption of %s at %s level in watts", device, level),
-		labels, prometheus.Labels{nodeNameLabel: nodeName})
+		labels)
 }
 
-func deviceStateJoulesDesc(level, device, state, nodeName string, labels []string) *prometheus.Desc {
-	return prometheus.NewDesc(
+func (b *descBuilder) deviceStateJoulesDesc(level, device, state string, labels []string) *prometheus.Desc {
+	return b.newDesc(
 		prometheus.BuildFQName(keplerNS, level, fmt.Sprintf("%s_%s_joules_total", device, state)),
 		fmt.Sprintf("Energy consumption of %s in %s state at %s level in joules", device, state, level),
-		labels, prometheus.Labels{nodeNameLabel: nodeName})
+		labels)
+}
+
+func (b *descBuilder) terminatedJoulesDesc(level string) *prometheus.Desc {
+	return b.newDesc(
+		prometheus.BuildFQName(keplerNS, level, "terminated_joules_total"),
+		fmt.Sprintf("Cumulative CPU energy of all terminated workloads at %s level in joules", level),
+		[]string{"zone"})
 }
 
-func deviceStateWattsDesc(level, device, state, nodeName string, labels []string) *prometheus.Desc {
-	return prometheus.NewDesc(
+func (b *descBuilder) deviceStateWattsDesc(level, device, state string, labels []string) *prometheus.Desc {
+	return b.newDesc(
 		prometheus.BuildFQName(keplerNS, level, fmt.Sprintf("%s_%s_watts", device, state)),
 		fmt.Sprintf("Power consumption of %s in %s state at %s level in watts", device, state, level),
-		labels, prometheus.Labels{nodeNameLabel: nodeName})
+		labels)
+}
+
+func (b *descBuilder) memoryDesc(level string, labels []string) *prometheus.Desc {
+	return b.newDesc(
+		prometheus.BuildFQName(keplerNS, level, "memory_bytes"),
+		fmt.Sprintf("Resident memory of running workloads at %s level in bytes", level),
+		labels)
+}
+
+func (b *descBuilder) gpuEngineUtilizationDesc(level, engine string, labels []string) *prometheus.Desc {
+	return b.newDesc(
+		prometheus.BuildFQName(keplerNS, level, fmt.Sprintf("gpu_%s_utilization", engine)),
+		fmt.Sprintf("GPU %s utilization of running workloads at %s level in percent, summed across GPUs (only where per-process utilization is available)", engine, level),
+		labels)
+}
+
+func (b *descBuilder) kwhDesc(level string, labels []string) *prometheus.Desc {
+	return b.newDesc(
+		prometheus.BuildFQName(keplerNS, level, "energy_kwh_total"),
+		fmt.Sprintf("Energy consumption of cpu at %s level in kilowatt-hours", level),
+		labels)
+}
+
+func (b *descBuilder) gpuKWhDesc(level string, labels []string) *prometheus.Desc {
+	return b.newDesc(
+		prometheus.BuildFQName(keplerNS, level, "gpu_energy_kwh_total"),
+		fmt.Sprintf("Energy consumption of gpu at %s level in kilowatt-hours", level),
+		labels)
+}
+
+func (b *descBuilder) intervalJoulesDesc(labels []string) *prometheus.Desc {
+	return b.newDesc(
+		prometheus.BuildFQName(keplerNS, "process", "energy_interval_joules"),
+		"Energy attributed to running processes in the latest monitor interval in joules",
+		labels)
 }
 
-func timeDesc(level, device, nodeName string, labels []string) *prometheus.Desc {
-	return prometheus.NewDesc(
+func (b *descBuilder) timeDesc(level, device string, labels []string) *prometheus.Desc {
+	return b.newDesc(
 		prometheus.BuildFQName(keplerNS, level, device+"_seconds_total"),
 		fmt.Sprintf("Total user and system time of %s at %s level in seconds", device, level),
-		labels, prometheus.Labels{nodeNameLabel: nodeName})
+		labels)
+}
+
+// PowerCollectorOption configures optional behavior of the PowerCollector
+type PowerCollectorOption func(*PowerCollector)
+
+// WithZoneNameMap aliases zone names in metric labels, by zone name (e.g.
+// package -> cpu-package) or by zone name and index (e.g. package-0 ->
+// cpu-socket-0). Zones without an entry keep their name.
+func WithZoneNameMap(m map[string]string) PowerCollectorOption {
+	return func(c *PowerCollector) {
+		c.zoneNameMap = m
+	}
+}
+
+// WithKWh enables exporting the CPU and GPU energy counters in kilowatt-hours
+// in addition to joules
+func WithKWh(enabled bool) PowerCollectorOption {
+	return func(c *PowerCollector) {
+		c.emitKWh = enabled
+	}
+}
+
+// WithStaleMarkers withholds all metrics of a snapshot older than staleness
+// so that Prometheus marks the series stale instead of ingesting old values
+// while the monitor stalls. The latest snapshot is then exported without
+// refreshing it, so staleness should allow for the monitor interval. A
+// staleness of 0, or a monitor that can't report the age of its latest
+// snapshot, disables the check.
+func WithStaleMarkers(staleness time.Duration) PowerCollectorOption {
+	return func(c *PowerCollector) {
+		c.staleness = staleness
+	}
+}
+
+// WithGPUPowerPrecision rounds the GPU power gauges to the given number of
+// decimal places at export time; energy counters are unaffected. A negative
+// precision exports full precision.
+func WithGPUPowerPrecision(decimals int) PowerCollectorOption {
+	return func(c *PowerCollector) {
+		c.gpuPowerPrecision = decimals
+	}
+}
+
+// WithZonePathLabel adds the zone path (e.g. the RAPL sysfs path) as a "path"
+// label to the process, container, vm and pod CPU metrics, which tells apart
+// zones with identical names such as the package zones of different sockets.
+// Node metrics always carry the path label.
+func WithZonePathLabel(enabled bool) PowerCollectorOption {
+	return func(c *PowerCollector) {
+		c.includeZonePath = enabled
+	}
+}
+
+// WithMaxTotalSeries caps the number of series exported per scrape. When the
+// estimated series of a snapshot exceed limit, the process, container, vm and
+// pod levels are dropped in that order until they fit. 0 disables the cap.
+func WithMaxTotalSeries(limit int) PowerCollectorOption {
+	return func(c *PowerCollector) {
+		c.maxTotalSeries = limit
+	}
+}
+
+// WithDisabledMetrics disables the metrics whose names match any of the glob
+// patterns (e.g. "kepler_*_idle_watts", see path.Match); they are neither
+// described nor created. Patterns are expected to be valid.
+func WithDisabledMetrics(patterns []string) PowerCollectorOption {
+	return func(c *PowerCollector) {
+		c.disabledMetrics = patterns
+	}
 }
 
 // NewPowerCollector creates a collector that provides consistent metrics
 // by fetching all data in a single snapshot during collection
-func NewPowerCollector(monitor PowerDataProvider, nodeName string, logger *slog.Logger, metricsLevel config.Level) *PowerCollector {
+func NewPowerCollector(monitor PowerDataProvider, nodeName string, logger *slog.Logger, metricsLevel config.Level, opts ...PowerCollectorOption) *PowerCollector {
 	const (
 		// these labels should remain the same across all descriptors to ease querying
 		zone   = "zone"
@@ -120,60 +335,215 @@ func NewPowerCollector(monitor PowerDataProvider, nodeName string, logger *slog.
 		podID  = "pod_id"
 	)
 
+	// labels of the workload zone metrics
+	var (
+		processZoneLabels     = []string{"pid", "comm", "exe", "type", "state", cntrID, vmID, zone}
+		processIntervalLabels = []string{"pid", "comm", "exe", "type", cntrID, vmID, zone}
+		containerZoneLabels   = []string{cntrID, "container_name", "runtime", "state", zone, podID}
+		vmZoneLabels          = []string{vmID, "vm_name", "hypervisor", "state", zone}
+		podZoneLabels         = []string{podID, "pod_name", "pod_namespace", "state", zone}
+	)
+
+	b := &descBuilder{nodeName: nodeName, names: map[*prometheus.Desc]string{}}
+
 	c := &PowerCollector{
-		pm:           monitor,
-		logger:       logger.With("collector", "power"),
-		metricsLevel: metricsLevel,
+		pm:                monitor,
+		logger:            logger.With("collector", "power"),
+		metricsLevel:      metricsLevel,
+		gpuPowerPrecision: -1,
 
-		nodeCPUJoulesDescriptor: joulesDesc("node", "cpu", nodeName, []string{zone, "path"}),
-		nodeCPUWattsDescriptor:  wattsDesc("node", "cpu", nodeName, []string{zone, "path"}),
+		nodeCPUJoulesDescriptor: b.joulesDesc("node", "cpu", []string{zone, "path"}),
+		nodeCPUWattsDescriptor:  b.wattsDesc("node", "cpu", []string{zone, "path"}),
 
-		nodeCPUActiveJoulesDesc: deviceStateJoulesDesc("node", "cpu", "active", nodeName, []string{zone, "path"}),
-		nodeCPUIdleJoulesDesc:   deviceStateJoulesDesc("node", "cpu", "idle", nodeName, []string{zone, "path"}),
+		nodeCPUActiveJoulesDesc: b.deviceStateJoulesDesc("node", "cpu", "active", []string{zone, "path"}),
+		nodeCPUIdleJoulesDesc:   b.deviceStateJoulesDesc("node", "cpu", "idle", []string{zone, "path"}),
 
-		nodeCPUActiveWattsDesc: deviceStateWattsDesc("node", "cpu", "active", nodeName, []string{zone, "path"}),
-		nodeCPUIdleWattsDesc:   deviceStateWattsDesc("node", "cpu", "idle", nodeName, []string{zone, "path"}),
+		nodeCPUActiveWattsDesc: b.deviceStateWattsDesc("node", "cpu", "active", []string{zone, "path"}),
+		nodeCPUIdleWattsDesc:   b.deviceStateWattsDesc("node", "cpu", "idle", []string{zone, "path"}),
 
-		nodeCPUUsageRatioDescriptor: prometheus.NewDesc(
+		nodeCPUUsageRatioDescriptor: b.newDesc(
 			prometheus.BuildFQName(keplerNS, "node", "cpu_usage_ratio"),
 			"CPU usage ratio of a node (value between 0.0 and 1.0)",
-			nil, prometheus.Labels{nodeNameLabel: nodeName}),
-
-		processCPUJoulesDescriptor: joulesDesc("process", "cpu", nodeName, []string{"pid", "comm", "exe", "type", "state", cntrID, vmID, zone}),
-		processCPUWattsDescriptor:  wattsDesc("process", "cpu", nodeName, []string{"pid", "comm", "exe", "type", "state", cntrID, vmID, zone}),
-		processCPUTimeDescriptor:   timeDesc("process", "cpu", nodeName, []string{"pid", "comm", "exe", "type", cntrID, vmID}),
-		processGPUJoulesDescriptor: joulesDesc("process", "gpu", nodeName, []string{"pid", "comm", "exe", "type", "state", cntrID, vmID}),
-		processGPUWattsDescriptor:  wattsDesc("process", "gpu", nodeName, []string{"pid", "comm", "exe", "type", "state", cntrID, vmID}),
-
-		containerCPUJoulesDescriptor: joulesDesc("container", "cpu", nodeName, []string{cntrID, "container_name", "runtime", "state", zone, podID}),
-		containerCPUWattsDescriptor:  wattsDesc("container", "cpu", nodeName, []string{cntrID, "container_name", "runtime", "state", zone, podID}),
-		containerGPUJoulesDescriptor: joulesDesc("container", "gpu", nodeName, []string{cntrID, "container_name", "runtime", "state", podID}),
-		containerGPUWattsDescriptor:  wattsDesc("container", "gpu", nodeName, []string{cntrID, "container_name", "runtime", "state", podID}),
-
-		vmCPUJoulesDescriptor: joulesDesc("vm", "cpu", nodeName, []string{vmID, "vm_name", "hypervisor", "state", zone}),
-		vmCPUWattsDescriptor:  wattsDesc("vm", "cpu", nodeName, []string{vmID, "vm_name", "hypervisor", "state", zone}),
-
-		podCPUJoulesDescriptor: joulesDesc("pod", "cpu", nodeName, []string{podID, "pod_name", "pod_namespace", "state", zone}),
-		podCPUWattsDescriptor:  wattsDesc("pod", "cpu", nodeName, []string{podID, "pod_name", "pod_namespace", "state", zone}),
-		podGPUJoulesDescriptor: joulesDesc("pod", "gpu", nodeName, []string{podID, "pod_name", "pod_namespace", "state"}),
-		podGPUWattsDescriptor:  wattsDesc("pod", "gpu", nodeName, []string{podID, "pod_name", "pod_namespace", "state"}),
+			nil),
+		nodeCPUCoreWattsDescriptor: b.newDesc(
+			prometheus.BuildFQName(keplerNS, "node", "cpu_core_watts"),
+			"Power consumption of a CPU core in watts (only where per-core energy counters are available)",
+			[]string{"core"}),
+		nodeRaplPermissionDeniedDescriptor: b.newDesc(
+			prometheus.BuildFQName(keplerNS, "node", "rapl_permission_denied"),
+			"Whether RAPL energy counters could not be read due to missing permissions (1) or not (0)",
+			nil),
+		nodeCPUPowerUtilizationDescriptor: b.newDesc(
+			prometheus.BuildFQName(keplerNS, "node", "cpu_power_utilization"),
+			"Power consumption of cpu as a ratio of the zone power limit (only where a power limit is available)",
+			[]string{zone, "path"}),
+
+		processCPUJoulesDescriptor: b.joulesDesc("process", "cpu", processZoneLabels),
+		processCPUWattsDescriptor:  b.wattsDesc("process", "cpu", processZoneLabels),
+		processCPUTimeDescriptor:   b.timeDesc("process", "cpu", []string{"pid", "comm", "exe", "type", cntrID, vmID}),
+		processMemoryDescriptor:    b.memoryDesc("process", []string{"pid", "comm", "exe", "type", cntrID, vmID}),
+		processGPUJoulesDescriptor: b.joulesDesc("process", "gpu", []string{"pid", "comm", "exe", "type", "state", cntrID, vmID}),
+		processGPUWattsDescriptor:  b.wattsDesc("process", "gpu", []string{"pid", "comm", "exe", "type", "state", cntrID, vmID}),
+		processIntervalJoulesDesc:  b.intervalJoulesDesc(processIntervalLabels),
+		processGPUEncoderDesc:      b.gpuEngineUtilizationDesc("process", "encoder", []string{"pid", "comm", "exe", "type", cntrID, vmID}),
+		processGPUDecoderDesc:      b.gpuEngineUtilizationDesc("process", "decoder", []string{"pid", "comm", "exe", "type", cntrID, vmID}),
+		processGPUSMUtilDesc: b.newDesc(
+			prometheus.BuildFQName(keplerNS, "process", "gpu_sm_utilization_ratio"),
+			"GPU SM utilization of running processes as a ratio (0-1), summed across GPUs (only where per-process utilization is available)",
+			[]string{"pid", "comm", "exe", "type", cntrID, vmID}),
+		processThreadsDescriptor: b.newDesc(
+			prometheus.BuildFQName(keplerNS, "process", "threads"),
+			"Number of threads of running processes; process_state is the kernel process state (R, S, D, Z, ...)",
+			[]string{"pid", "comm", "exe", "type", "process_state", cntrID, vmID},
+		),
+		processUnattributedWattsDescriptor: b.newDesc(
+			prometheus.BuildFQName(keplerNS, "process", "unattributed_watts"),
+			"Node attributable CPU power, the active or with the total process energy basis the total power, not attributed to any running process in watts",
+			[]string{zone}),
+		processAgedJoulesDescriptor: b.newDesc(
+			prometheus.BuildFQName(keplerNS, "process", "aged_joules_total"),
+			"Cumulative CPU energy of processes while older than the max process age in joules; these processes are not reported individually",
+			[]string{zone}),
+
+		containerCPUJoulesDescriptor: b.joulesDesc("container", "cpu", containerZoneLabels),
+		containerCPUWattsDescriptor:  b.wattsDesc("container", "cpu", containerZoneLabels),
+		containerMemoryDescriptor:    b.memoryDesc("container", []string{cntrID, "container_name", "runtime", podID}),
+		containerGPUJoulesDescriptor: b.joulesDesc("container", "gpu", []string{cntrID, "container_name", "runtime", "state", podID}),
+		containerGPUWattsDescriptor:  b.wattsDesc("container", "gpu", []string{cntrID, "container_name", "runtime", "state", podID}),
+		containerGPUEncoderDesc:      b.gpuEngineUtilizationDesc("container", "encoder", []string{cntrID, "container_name", "runtime", podID}),
+		containerGPUDecoderDesc:      b.gpuEngineUtilizationDesc("container", "decoder", []string{cntrID, "container_name", "runtime", podID}),
+
+		vmCPUJoulesDescriptor: b.joulesDesc("vm", "cpu", vmZoneLabels),
+		vmCPUWattsDescriptor:  b.wattsDesc("vm", "cpu", vmZoneLabels),
+
+		podCPUJoulesDescriptor: b.joulesDesc("pod", "cpu", podZoneLabels),
+		podCPUWattsDescriptor:  b.wattsDesc("pod", "cpu", podZoneLabels),
+		podGPUJoulesDescriptor: b.joulesDesc("pod", "gpu", []string{podID, "pod_name", "pod_namespace", "state"}),
+		podGPUWattsDescriptor:  b.wattsDesc("pod", "gpu", []string{podID, "pod_name", "pod_namespace", "state"}),
+		podGPUEncoderDesc:      b.gpuEngineUtilizationDesc("pod", "encoder", []string{podID, "pod_name", "pod_namespace"}),
+		podGPUDecoderDesc:      b.gpuEngineUtilizationDesc("pod", "decoder", []string{podID, "pod_name", "pod_namespace"}),
+		podGPUShareDescriptor: b.newDesc(
+			prometheus.BuildFQName(keplerNS, "pod", "gpu_share_ratio"),
+			"Share of the attributable power of a GPU attributed to a running pod (value between 0.0 and 1.0)",
+			[]string{podID, "pod_name", "pod_namespace", "gpu"},
+		),
+
+		containerTerminatedJoulesDescriptor: b.terminatedJoulesDesc("container"),
+		podTerminatedJoulesDescriptor:       b.terminatedJoulesDesc("pod"),
+
+		userWattsDescriptor: b.newDesc(
+			prometheus.BuildFQName(keplerNS, "user", "watts"),
+			"CPU power consumption of all running processes of a user in watts",
+			[]string{"uid", "user_name", zone}),
+
+		attributedJoulesDescriptor: b.newDesc(
+			prometheus.BuildFQName(keplerNS, "", "attributed_joules_total"),
+			"Cumulative CPU energy attributed to all workloads of a level (process, container, vm, pod) in joules",
+			[]string{"level", zone}),
 
 		// GPU device power metrics (node-level)
-		gpuTotalWattsDescriptor: prometheus.NewDesc(
+		gpuTotalWattsDescriptor: b.newDesc(
 			prometheus.BuildFQName(keplerNS, "node", "gpu_watts"),
 			"Total GPU power consumption in watts",
-			[]string{"gpu", "gpu_uuid", "gpu_name", "vendor"}, prometheus.Labels{nodeNameLabel: nodeName}),
-		gpuIdleWattsDescriptor: prometheus.NewDesc(
+			[]string{"gpu", "gpu_uuid", "gpu_name", "vendor"}),
+		gpuIdleWattsDescriptor: b.newDesc(
 			prometheus.BuildFQName(keplerNS, "node", "gpu_idle_watts"),
 			"GPU idle power (auto-detected minimum) in watts",
-			[]string{"gpu", "gpu_uuid", "gpu_name", "vendor"}, prometheus.Labels{nodeNameLabel: nodeName}),
-		gpuActiveWattsDescriptor: prometheus.NewDesc(
+			[]string{"gpu", "gpu_uuid", "gpu_name", "vendor"}),
+		gpuActiveWattsDescriptor: b.newDesc(
 			prometheus.BuildFQName(keplerNS, "node", "gpu_active_watts"),
 			"GPU active power (total - idle) in watts",
-			[]string{"gpu", "gpu_uuid", "gpu_name", "vendor"}, prometheus.Labels{nodeNameLabel: nodeName}),
-		gpuJoulesDescriptor:       joulesDesc("node", "gpu", nodeName, []string{"gpu", "gpu_uuid", "gpu_name", "vendor"}),
-		gpuActiveJoulesDescriptor: deviceStateJoulesDesc("node", "gpu", "active", nodeName, []string{"gpu", "gpu_uuid", "gpu_name", "vendor"}),
-		gpuIdleJoulesDescriptor:   deviceStateJoulesDesc("node", "gpu", "idle", nodeName, []string{"gpu", "gpu_uuid", "gpu_name", "vendor"}),
+			[]string{"gpu", "gpu_uuid", "gpu_name", "vendor"}),
+		gpuJoulesDescriptor:       b.joulesDesc("node", "gpu", []string{"gpu", "gpu_uuid", "gpu_name", "vendor"}),
+		gpuActiveJoulesDescriptor: b.deviceStateJoulesDesc("node", "gpu", "active", []string{"gpu", "gpu_uuid", "gpu_name", "vendor"}),
+		gpuIdleJoulesDescriptor:   b.deviceStateJoulesDesc("node", "gpu", "idle", []string{"gpu", "gpu_uuid", "gpu_name", "vendor"}),
+		gpuWattsPerUtilDescriptor: b.newDesc(
+			prometheus.BuildFQName(keplerNS, "node", "gpu_watts_per_util"),
+			"GPU power in watts per percent of SM utilization (0 when the GPU is not utilized)",
+			[]string{"gpu", "gpu_uuid", "gpu_name", "vendor"}),
+		gpuFanSpeedDescriptor: b.newDesc(
+			prometheus.BuildFQName(keplerNS, "node", "gpu_fan_speed_percent"),
+			"GPU fan speed in percent of the maximum speed (only with GPU reliability metrics enabled)",
+			[]string{"gpu", "gpu_uuid", "gpu_name", "vendor"}),
+		gpuPStateDescriptor: b.newDesc(
+			prometheus.BuildFQName(keplerNS, "node", "gpu_pstate"),
+			"GPU performance state, from 0 (maximum) to 15 (minimum performance) (only with GPU reliability metrics enabled)",
+			[]string{"gpu", "gpu_uuid", "gpu_name", "vendor"}),
+		gpuNodeTotalWattsDesc: b.newDesc(
+			prometheus.BuildFQName(keplerNS, "node", "gpu_total_watts"),
+			"Total power consumption of all GPUs of the node in watts",
+			nil),
+
+		meterReadErrorsDescriptor: b.newDesc(
+			prometheus.BuildFQName(keplerNS, "meter", "read_errors_total"),
+			"Total number of failed power meter reads",
+			[]string{"meter", zone},
+		),
+
+		seriesCappedDescriptor: b.newDesc(
+			prometheus.BuildFQName(keplerNS, "exporter", "series_capped"),
+			"Whether the metrics of a level are dropped (1) or not (0) to keep the exported series within exporter.prometheus.maxTotalSeries",
+			[]string{"level"},
+		),
+		processesStartedDescriptor: b.newDesc(
+			prometheus.BuildFQName(keplerNS, "monitor", "processes_started_total"),
+			"Total number of processes that started between consecutive snapshots",
+			nil,
+		),
+		processesTerminatedDescriptor: b.newDesc(
+			prometheus.BuildFQName(keplerNS, "monitor", "processes_terminated_total"),
+			"Total number of processes that terminated between consecutive snapshots",
+			nil,
+		),
+		negativeCPUTimeDescriptor: b.newDesc(
+			prometheus.BuildFQName(keplerNS, "monitor", "negative_cputime_total"),
+			"Total number of process CPU time decreases that were clamped to a zero CPU time delta",
+			nil,
+		),
+
+		nodeKWhDescriptor:      b.kwhDesc("node", []string{zone, "path"}),
+		processKWhDescriptor:   b.kwhDesc("process", processZoneLabels),
+		containerKWhDescriptor: b.kwhDesc("container", containerZoneLabels),
+		vmKWhDescriptor:        b.kwhDesc("vm", vmZoneLabels),
+		podKWhDescriptor:       b.kwhDesc("pod", podZoneLabels),
+
+		nodeGPUKWhDescriptor:      b.gpuKWhDesc("node", []string{"gpu", "gpu_uuid", "gpu_name", "vendor"}),
+		processGPUKWhDescriptor:   b.gpuKWhDesc("process", []string{"pid", "comm", "exe", "type", "state", cntrID, vmID}),
+		containerGPUKWhDescriptor: b.gpuKWhDesc("container", []string{cntrID, "container_name", "runtime", "state", podID}),
+		podGPUKWhDescriptor:       b.gpuKWhDesc("pod", []string{podID, "pod_name", "pod_namespace", "state"}),
+	}
+
+	for _, apply := range opts {
+		apply(c)
+	}
+
+	// the path is the last label of the workload zone metrics; see zoneValues
+	if c.includeZonePath {
+		withPath := func(labels []string) []string {
+			return append(slices.Clip(labels), "path")
+		}
+		c.processCPUJoulesDescriptor = b.joulesDesc("process", "cpu", withPath(processZoneLabels))
+		c.processCPUWattsDescriptor = b.wattsDesc("process", "cpu", withPath(processZoneLabels))
+		c.processIntervalJoulesDesc = b.intervalJoulesDesc(withPath(processIntervalLabels))
+		c.processKWhDescriptor = b.kwhDesc("process", withPath(processZoneLabels))
+		c.containerCPUJoulesDescriptor = b.joulesDesc("container", "cpu", withPath(containerZoneLabels))
+		c.containerCPUWattsDescriptor = b.wattsDesc("container", "cpu", withPath(containerZoneLabels))
+		c.containerKWhDescriptor = b.kwhDesc("container", withPath(containerZoneLabels))
+		c.vmCPUJoulesDescriptor = b.joulesDesc("vm", "cpu", withPath(vmZoneLabels))
+		c.vmCPUWattsDescriptor = b.wattsDesc("vm", "cpu", withPath(vmZoneLabels))
+		c.vmKWhDescriptor = b.kwhDesc("vm", withPath(vmZoneLabels))
+		c.podCPUJoulesDescriptor = b.joulesDesc("pod", "cpu", withPath(podZoneLabels))
+		c.podCPUWattsDescriptor = b.wattsDesc("pod", "cpu", withPath(podZoneLabels))
+		c.podKWhDescriptor = b.kwhDesc("pod", withPath(podZoneLabels))
+	}
+
+	for desc, name := range b.names {
+		if matchesAny(c.disabledMetrics, name) {
+			if c.disabled == nil {
+				c.disabled = make(map[*prometheus.Desc]bool)
+			}
+			c.disabled[desc] = true
+		}
 	}
 
 	go c.waitForData()
@@ -192,57 +562,106 @@ func (c *PowerCollector) waitForData() {
 func (c *PowerCollector) Describe(ch chan<- *prometheus.Desc) {
 	// node
 	if c.metricsLevel.IsNodeEnabled() {
-		ch <- c.nodeCPUJoulesDescriptor
-		ch <- c.nodeCPUWattsDescriptor
-		ch <- c.nodeCPUUsageRatioDescriptor
+		c.describe(ch, c.nodeCPUJoulesDescriptor)
+		c.describe(ch, c.nodeCPUWattsDescriptor)
+		c.describe(ch, c.nodeCPUUsageRatioDescriptor)
+		c.describe(ch, c.nodeRaplPermissionDeniedDescriptor)
 		// node cpu active
-		ch <- c.nodeCPUActiveJoulesDesc
-		ch <- c.nodeCPUActiveWattsDesc
+		c.describe(ch, c.nodeCPUActiveJoulesDesc)
+		c.describe(ch, c.nodeCPUActiveWattsDesc)
 		// node cpu idle
-		ch <- c.nodeCPUIdleJoulesDesc
-		ch <- c.nodeCPUIdleWattsDesc
+		c.describe(ch, c.nodeCPUIdleJoulesDesc)
+		c.describe(ch, c.nodeCPUIdleWattsDesc)
+		c.describe(ch, c.nodeCPUCoreWattsDescriptor)
+		c.describe(ch, c.nodeCPUPowerUtilizationDescriptor)
+		c.describeKWh(ch, c.nodeKWhDescriptor)
 	}
 
 	// process
 	if c.metricsLevel.IsProcessEnabled() {
-		ch <- c.processCPUJoulesDescriptor
-		ch <- c.processCPUWattsDescriptor
-		ch <- c.processCPUTimeDescriptor
-		ch <- c.processGPUJoulesDescriptor
-		ch <- c.processGPUWattsDescriptor
+		c.describe(ch, c.processCPUJoulesDescriptor)
+		c.describe(ch, c.processCPUWattsDescriptor)
+		c.describe(ch, c.processIntervalJoulesDesc)
+		c.describe(ch, c.processCPUTimeDescriptor)
+		c.describe(ch, c.processMemoryDescriptor)
+		c.describe(ch, c.processThreadsDescriptor)
+		c.describe(ch, c.processGPUJoulesDescriptor)
+		c.describeKWh(ch, c.processGPUKWhDescriptor)
+		c.describe(ch, c.processGPUWattsDescriptor)
+		c.describe(ch, c.processGPUEncoderDesc)
+		c.describe(ch, c.processGPUDecoderDesc)
+		c.describe(ch, c.processGPUSMUtilDesc)
+		c.describe(ch, c.processUnattributedWattsDescriptor)
+		c.describe(ch, c.processAgedJoulesDescriptor)
+		c.describe(ch, c.processesStartedDescriptor)
+		c.describe(ch, c.processesTerminatedDescriptor)
+		c.describe(ch, c.negativeCPUTimeDescriptor)
+		c.describeKWh(ch, c.processKWhDescriptor)
 	}
 
 	// container
 	if c.metricsLevel.IsContainerEnabled() {
-		ch <- c.containerCPUJoulesDescriptor
-		ch <- c.containerCPUWattsDescriptor
-		ch <- c.containerGPUJoulesDescriptor
-		ch <- c.containerGPUWattsDescriptor
+		c.describe(ch, c.containerCPUJoulesDescriptor)
+		c.describe(ch, c.containerCPUWattsDescriptor)
+		c.describe(ch, c.containerMemoryDescriptor)
+		c.describe(ch, c.containerGPUJoulesDescriptor)
+		c.describeKWh(ch, c.containerGPUKWhDescriptor)
+		c.describe(ch, c.containerGPUWattsDescriptor)
+		c.describe(ch, c.containerGPUEncoderDesc)
+		c.describe(ch, c.containerGPUDecoderDesc)
+		c.describe(ch, c.containerTerminatedJoulesDescriptor)
+		c.describeKWh(ch, c.containerKWhDescriptor)
 		// ch <- c.containerCPUTimeDescriptor // TODO: add conntainerCPUTimeDescriptor
 	}
 
 	// vm
 	if c.metricsLevel.IsVMEnabled() {
-		ch <- c.vmCPUJoulesDescriptor
-		ch <- c.vmCPUWattsDescriptor
+		c.describe(ch, c.vmCPUJoulesDescriptor)
+		c.describe(ch, c.vmCPUWattsDescriptor)
+		c.describeKWh(ch, c.vmKWhDescriptor)
 	}
 
 	// pod
 	if c.metricsLevel.IsPodEnabled() {
-		ch <- c.podCPUJoulesDescriptor
-		ch <- c.podCPUWattsDescriptor
-		ch <- c.podGPUJoulesDescriptor
-		ch <- c.podGPUWattsDescriptor
+		c.describe(ch, c.podCPUJoulesDescriptor)
+		c.describe(ch, c.podCPUWattsDescriptor)
+		c.describe(ch, c.podGPUJoulesDescriptor)
+		c.describeKWh(ch, c.podGPUKWhDescriptor)
+		c.describe(ch, c.podGPUWattsDescriptor)
+		c.describe(ch, c.podGPUShareDescriptor)
+		c.describe(ch, c.podGPUEncoderDesc)
+		c.describe(ch, c.podGPUDecoderDesc)
+		c.describe(ch, c.podTerminatedJoulesDescriptor)
+		c.describeKWh(ch, c.podKWhDescriptor)
+	}
+
+	// user
+	if c.metricsLevel.IsUserEnabled() {
+		c.describe(ch, c.userWattsDescriptor)
+	}
+
+	if c.isWorkloadLevelEnabled() {
+		c.describe(ch, c.attributedJoulesDescriptor)
+	}
+
+	if c.maxTotalSeries > 0 {
+		c.describe(ch, c.seriesCappedDescriptor)
 	}
 
 	// GPU device power metrics (node-level)
 	if c.metricsLevel.IsNodeEnabled() {
-		ch <- c.gpuTotalWattsDescriptor
-		ch <- c.gpuIdleWattsDescriptor
-		ch <- c.gpuActiveWattsDescriptor
-		ch <- c.gpuJoulesDescriptor
-		ch <- c.gpuActiveJoulesDescriptor
-		ch <- c.gpuIdleJoulesDescriptor
+		c.describe(ch, c.gpuTotalWattsDescriptor)
+		c.describe(ch, c.gpuIdleWattsDescriptor)
+		c.describe(ch, c.gpuActiveWattsDescriptor)
+		c.describe(ch, c.gpuJoulesDescriptor)
+		c.describeKWh(ch, c.nodeGPUKWhDescriptor)
+		c.describe(ch, c.gpuActiveJoulesDescriptor)
+		c.describe(ch, c.gpuIdleJoulesDescriptor)
+		c.describe(ch, c.gpuWattsPerUtilDescriptor)
+		c.describe(ch, c.gpuFanSpeedDescriptor)
+		c.describe(ch, c.gpuPStateDescriptor)
+		c.describe(ch, c.gpuNodeTotalWattsDesc)
+		c.describe(ch, c.meterReadErrorsDescriptor)
 	}
 }
 
@@ -265,40 +684,84 @@ func (c *PowerCollector) Collect(ch chan<- prometheus.Metric) {
 		c.logger.Info("Collected unified power data", "duration", time.Since(started))
 	}()
 
-	snapshot, err := c.pm.Snapshot() // snapshot is thread-safe
+	snapshot, age, err := c.snapshot() // snapshot is thread-safe
 	if err != nil {
 		c.logger.Error("Failed to collect power data", "error", err)
 		return
 	}
 
+	if c.isStale(age) {
+		c.logger.Warn("Withholding stale power data", "age", age, "staleness", c.staleness)
+		return
+	}
+
+	// drop workload levels whose series would exceed the cap
+	levels, dropped := c.cappedLevels(snapshot)
+	if c.maxTotalSeries > 0 {
+		c.collectSeriesCapped(ch, dropped)
+	}
+
 	if c.metricsLevel.IsNodeEnabled() {
 		c.collectNodeMetrics(ch, snapshot.Node)
 	}
 
-	if c.metricsLevel.IsProcessEnabled() {
+	if levels.IsProcessEnabled() {
 		c.collectProcessMetrics(ch, "running", snapshot.Processes)
 		c.collectProcessMetrics(ch, "terminated", snapshot.TerminatedProcesses)
+		c.collectUnattributedPower(ch, snapshot.Node, snapshot.Processes, snapshot.ProcessEnergyBasis)
+		c.collectZoneEnergy(ch, c.processAgedJoulesDescriptor, snapshot.AgedProcessesEnergy)
+		c.collectProcessChurn(ch, snapshot)
 	}
 
-	if c.metricsLevel.IsContainerEnabled() {
+	if levels.IsContainerEnabled() {
 		c.collectContainerMetrics(ch, "running", snapshot.Containers)
 		c.collectContainerMetrics(ch, "terminated", snapshot.TerminatedContainers)
+		c.collectZoneEnergy(ch, c.containerTerminatedJoulesDescriptor, snapshot.TerminatedContainersEnergy)
 	}
 
-	if c.metricsLevel.IsVMEnabled() {
+	if levels.IsVMEnabled() {
 		c.collectVMMetrics(ch, "running", snapshot.VirtualMachines)
 		c.collectVMMetrics(ch, "terminated", snapshot.TerminatedVirtualMachines)
 	}
 
-	if c.metricsLevel.IsPodEnabled() {
+	if levels.IsPodEnabled() {
 		c.collectPodMetrics(ch, "running", snapshot.Pods)
 		c.collectPodMetrics(ch, "terminated", snapshot.TerminatedPods)
+		c.collectPodGPUShare(ch, snapshot.Pods, snapshot.GPUStats)
+		c.collectZoneEnergy(ch, c.podTerminatedJoulesDescriptor, snapshot.TerminatedPodsEnergy)
+	}
+
+	if levels.IsUserEnabled() {
+		c.collectUserMetrics(ch, snapshot.Users)
+	}
+
+	if c.isWorkloadLevelEnabled() {
+		c.collectAttributedEnergy(ch, snapshot.AttributedEnergy)
 	}
 
 	// Collect GPU device stats (node-level)
 	if c.metricsLevel.IsNodeEnabled() {
 		c.collectGPUMetrics(ch, snapshot.GPUStats)
+		c.collectMeterReadErrors(ch, snapshot.MeterReadErrors)
+		c.collectRaplPermissionDenied(ch, snapshot.RaplPermissionDenied)
+	}
+}
+
+// snapshot returns the power data to export. With stale markers, it is the
+// latest snapshot of the monitor, not refreshed so that a stalled monitor is
+// detected, with its age; otherwise a fresh snapshot whose age is 0.
+func (c *PowerCollector) snapshot() (*monitor.Snapshot, time.Duration, error) {
+	if latest, ok := c.pm.(monitor.LatestSnapshotProvider); ok && c.staleness > 0 {
+		return latest.LatestSnapshot()
 	}
+	snapshot, err := c.pm.Snapshot()
+	return snapshot, 0, err
+}
+
+// isStale returns true if stale markers are enabled and the snapshot age
+// exceeds the staleness threshold
+func (c *PowerCollector) isStale(age time.Duration) bool {
+	return c.staleness > 0 && age > c.staleness
 }
 
 // collectNodeMetrics collects node-level power metrics
@@ -306,31 +769,32 @@ func (c *PowerCollector) collectNodeMetrics(ch chan<- prometheus.Metric, node *m
 	c.mutex.RLock() // locking nodeJoulesDescriptors
 	defer c.mutex.RUnlock()
 
-	ch <- prometheus.MustNewConstMetric(
+	c.emit(ch,
 		c.nodeCPUUsageRatioDescriptor,
 		prometheus.GaugeValue,
 		node.UsageRatio,
 	)
 	for zone, energy := range node.Zones {
 		path := zone.Path()
-		zoneName := zone.Name()
+		zoneName := c.zoneLabel(zone)
 
 		// joules
-		ch <- prometheus.MustNewConstMetric(
+		c.emit(ch,
 			c.nodeCPUJoulesDescriptor,
 			prometheus.CounterValue,
 			energy.EnergyTotal.Joules(),
 			zoneName, path,
 		)
+		c.collectKWh(ch, c.nodeKWhDescriptor, energy.EnergyTotal, zoneName, path)
 
-		ch <- prometheus.MustNewConstMetric(
+		c.emit(ch,
 			c.nodeCPUActiveJoulesDesc,
 			prometheus.CounterValue,
 			energy.ActiveEnergyTotal.Joules(),
 			zoneName, path,
 		)
 
-		ch <- prometheus.MustNewConstMetric(
+		c.emit(ch,
 			c.nodeCPUIdleJoulesDesc,
 			prometheus.CounterValue,
 			energy.IdleEnergyTotal.Joules(),
@@ -338,25 +802,43 @@ func (c *PowerCollector) collectNodeMetrics(ch chan<- prometheus.Metric, node *m
 		)
 
 		// watts
-		ch <- prometheus.MustNewConstMetric(
+		c.emit(ch,
 			c.nodeCPUWattsDescriptor,
 			prometheus.GaugeValue,
 			energy.Power.Watts(),
 			zoneName, path,
 		)
-		ch <- prometheus.MustNewConstMetric(
+		c.emit(ch,
 			c.nodeCPUActiveWattsDesc,
 			prometheus.GaugeValue,
 			energy.ActivePower.Watts(),
 			zoneName, path,
 		)
-		ch <- prometheus.MustNewConstMetric(
+		c.emit(ch,
 			c.nodeCPUIdleWattsDesc,
 			prometheus.GaugeValue,
 			energy.IdlePower.Watts(),
 			zoneName, path,
 		)
 
+		// zones without a known limit have no headroom to report
+		if energy.PowerLimit > 0 {
+			c.emit(ch,
+				c.nodeCPUPowerUtilizationDescriptor,
+				prometheus.GaugeValue,
+				energy.Power.Watts()/energy.PowerLimit.Watts(),
+				zoneName, path,
+			)
+		}
+	}
+
+	for zone, usage := range node.CoreZones {
+		c.emit(ch,
+			c.nodeCPUCoreWattsDescriptor,
+			prometheus.GaugeValue,
+			usage.Power.Watts(),
+			fmt.Sprintf("%d", zone.Index()),
+		)
 	}
 }
 
@@ -368,9 +850,17 @@ func (c *PowerCollector) collectProcessMetrics(ch chan<- prometheus.Metric, stat
 	}
 
 	// No need to lock, already done by the calling function
-	for pid, proc := range processes {
+	for _, proc := range processes {
+		// aged processes are only reported in the aggregate
+		if proc.Aged {
+			continue
+		}
+
+		// the PID label is not the map key which may be qualified by the
+		// container in namespaced PID mode
+		pid := strconv.Itoa(proc.PID)
 
-		ch <- prometheus.MustNewConstMetric(
+		c.emit(ch,
 			c.processCPUTimeDescriptor,
 			prometheus.CounterValue,
 			proc.CPUTotalTime,
@@ -378,33 +868,78 @@ func (c *PowerCollector) collectProcessMetrics(ch chan<- prometheus.Metric, stat
 			proc.ContainerID, proc.VirtualMachineID,
 		)
 
+		// memory and threads are only meaningful while the process is running
+		if state == "running" {
+			c.emit(ch,
+				c.processMemoryDescriptor,
+				prometheus.GaugeValue,
+				float64(proc.MemoryBytes),
+				pid, proc.Comm, proc.Exe, string(proc.Type),
+				proc.ContainerID, proc.VirtualMachineID,
+			)
+			c.emit(ch,
+				c.processThreadsDescriptor,
+				prometheus.GaugeValue,
+				float64(proc.Threads),
+				pid, proc.Comm, proc.Exe, string(proc.Type), proc.State,
+				proc.ContainerID, proc.VirtualMachineID,
+			)
+			c.collectGPUEngineUtilization(ch, c.processGPUEncoderDesc, c.processGPUDecoderDesc,
+				proc.GPUEncoderUtil, proc.GPUDecoderUtil,
+				pid, proc.Comm, proc.Exe, string(proc.Type), proc.ContainerID, proc.VirtualMachineID,
+			)
+			if proc.GPUSMUtil > 0 {
+				c.emit(ch,
+					c.processGPUSMUtilDesc,
+					prometheus.GaugeValue,
+					proc.GPUSMUtil/100, // percent to ratio
+					pid, proc.Comm, proc.Exe, string(proc.Type), proc.ContainerID, proc.VirtualMachineID,
+				)
+			}
+		}
+
 		for zone, usage := range proc.Zones {
-			zoneName := zone.Name()
-			ch <- prometheus.MustNewConstMetric(
+			labels := c.zoneValues(zone,
+				pid, proc.Comm, proc.Exe, string(proc.Type), state,
+				proc.ContainerID, proc.VirtualMachineID,
+				c.zoneLabel(zone),
+			)
+			c.emit(ch,
 				c.processCPUJoulesDescriptor,
 				prometheus.CounterValue,
 				usage.EnergyTotal.Joules(),
-				pid, proc.Comm, proc.Exe, string(proc.Type), state,
-				proc.ContainerID, proc.VirtualMachineID,
-				zoneName,
+				labels...,
 			)
+			c.collectKWh(ch, c.processKWhDescriptor, usage.EnergyTotal, labels...)
 
-			ch <- prometheus.MustNewConstMetric(
+			c.emit(ch,
 				c.processCPUWattsDescriptor,
 				prometheus.GaugeValue,
 				usage.Power.Watts(),
-				pid, proc.Comm, proc.Exe, string(proc.Type), state,
-				proc.ContainerID, proc.VirtualMachineID,
-				zoneName,
+				labels...,
 			)
+
+			// terminated processes consumed no energy in the latest interval
+			if state == "running" {
+				c.emit(ch,
+					c.processIntervalJoulesDesc,
+					prometheus.GaugeValue,
+					usage.EnergyDelta.Joules(),
+					c.zoneValues(zone,
+						pid, proc.Comm, proc.Exe, string(proc.Type),
+						proc.ContainerID, proc.VirtualMachineID,
+						c.zoneLabel(zone),
+					)...,
+				)
+			}
 		}
 
 		// GPU power metric (only for processes actively using GPU)
 		if proc.GPUPower > 0 {
-			ch <- prometheus.MustNewConstMetric(
+			c.emit(ch,
 				c.processGPUWattsDescriptor,
 				prometheus.GaugeValue,
-				proc.GPUPower,
+				c.gpuWatts(proc.GPUPower),
 				pid, proc.Comm, proc.Exe, string(proc.Type), state,
 				proc.ContainerID, proc.VirtualMachineID,
 			)
@@ -412,15 +947,54 @@ func (c *PowerCollector) collectProcessMetrics(ch chan<- prometheus.Metric, stat
 
 		// GPU energy metric (cumulative counter)
 		if proc.GPUEnergyTotal > 0 {
-			ch <- prometheus.MustNewConstMetric(
+			c.emit(ch,
 				c.processGPUJoulesDescriptor,
 				prometheus.CounterValue,
 				proc.GPUEnergyTotal.Joules(),
 				pid, proc.Comm, proc.Exe, string(proc.Type), state,
 				proc.ContainerID, proc.VirtualMachineID,
 			)
+			c.collectKWh(ch, c.processGPUKWhDescriptor, proc.GPUEnergyTotal,
+				pid, proc.Comm, proc.Exe, string(proc.Type), state,
+				proc.ContainerID, proc.VirtualMachineID)
+		}
+	}
+}
+
+// collectUnattributedPower collects, per zone, the node attributable power,
+// the active or, with the total basis, the total power, that is not attributed
+// to any running process (e.g. power of processes that are not visible to
+// Kepler), so that process power stacked with it adds up to the node
+// attributable power. Without running processes, all of the node attributable
+// power is unattributed. Zones sharing a name (e.g. one package zone per
+// socket) are summed since the metric is only labeled by zone name.
+func (c *PowerCollector) collectUnattributedPower(ch chan<- prometheus.Metric, node *monitor.Node, processes monitor.Processes, basis monitor.EnergyBasis) {
+	if node == nil {
+		return
+	}
+
+	unattributed := make(map[string]float64, len(node.Zones))
+	for zone, nodeUsage := range node.Zones {
+		watts := nodeUsage.ActivePower.Watts()
+		if basis == monitor.EnergyBasisTotal {
+			watts += nodeUsage.IdlePower.Watts()
+		}
+		unattributed[c.zoneLabel(zone)] += watts
+	}
+	for _, proc := range processes {
+		for zone, usage := range proc.Zones {
+			unattributed[c.zoneLabel(zone)] -= usage.Power.Watts()
 		}
 	}
+
+	for zoneName, watts := range unattributed {
+		c.emit(ch,
+			c.processUnattributedWattsDescriptor,
+			prometheus.GaugeValue,
+			max(watts, 0), // rounding may attribute marginally more than the attributable power
+			zoneName,
+		)
+	}
 }
 
 // collectContainerMetrics collects container-level power metrics
@@ -432,34 +1006,49 @@ func (c *PowerCollector) collectContainerMetrics(ch chan<- prometheus.Metric, st
 
 	// No need to lock, already done by the calling function
 	for id, container := range containers {
+		// memory is only meaningful while the container is running
+		if state == "running" {
+			c.emit(ch,
+				c.containerMemoryDescriptor,
+				prometheus.GaugeValue,
+				float64(container.MemoryBytes),
+				id, container.Name, string(container.Runtime), container.PodID,
+			)
+			c.collectGPUEngineUtilization(ch, c.containerGPUEncoderDesc, c.containerGPUDecoderDesc,
+				container.GPUEncoderUtil, container.GPUDecoderUtil,
+				id, container.Name, string(container.Runtime), container.PodID,
+			)
+		}
+
 		for zone, usage := range container.Zones {
-			zoneName := zone.Name()
+			labels := c.zoneValues(zone,
+				id, container.Name, string(container.Runtime), state,
+				c.zoneLabel(zone),
+				container.PodID,
+			)
 
-			ch <- prometheus.MustNewConstMetric(
+			c.emit(ch,
 				c.containerCPUJoulesDescriptor,
 				prometheus.CounterValue,
 				usage.EnergyTotal.Joules(),
-				id, container.Name, string(container.Runtime), state,
-				zoneName,
-				container.PodID,
+				labels...,
 			)
+			c.collectKWh(ch, c.containerKWhDescriptor, usage.EnergyTotal, labels...)
 
-			ch <- prometheus.MustNewConstMetric(
+			c.emit(ch,
 				c.containerCPUWattsDescriptor,
 				prometheus.GaugeValue,
 				usage.Power.Watts(),
-				id, container.Name, string(container.Runtime), state,
-				zoneName,
-				container.PodID,
+				labels...,
 			)
 		}
 
 		// GPU power metric (only for containers with GPU-using processes)
 		if container.GPUPower > 0 {
-			ch <- prometheus.MustNewConstMetric(
+			c.emit(ch,
 				c.containerGPUWattsDescriptor,
 				prometheus.GaugeValue,
-				container.GPUPower,
+				c.gpuWatts(container.GPUPower),
 				id, container.Name, string(container.Runtime), state,
 				container.PodID,
 			)
@@ -467,13 +1056,15 @@ func (c *PowerCollector) collectContainerMetrics(ch chan<- prometheus.Metric, st
 
 		// GPU energy metric (cumulative counter)
 		if container.GPUEnergyTotal > 0 {
-			ch <- prometheus.MustNewConstMetric(
+			c.emit(ch,
 				c.containerGPUJoulesDescriptor,
 				prometheus.CounterValue,
 				container.GPUEnergyTotal.Joules(),
 				id, container.Name, string(container.Runtime), state,
 				container.PodID,
 			)
+			c.collectKWh(ch, c.containerGPUKWhDescriptor, container.GPUEnergyTotal,
+				id, container.Name, string(container.Runtime), state, container.PodID)
 		}
 	}
 }
@@ -488,21 +1079,23 @@ func (c *PowerCollector) collectVMMetrics(ch chan<- prometheus.Metric, state str
 	// No need to lock, already done by the calling function
 	for id, vm := range vms {
 		for zone, usage := range vm.Zones {
-			zoneName := zone.Name()
-			ch <- prometheus.MustNewConstMetric(
+			labels := c.zoneValues(zone,
+				id, vm.Name, string(vm.Hypervisor), state,
+				c.zoneLabel(zone),
+			)
+			c.emit(ch,
 				c.vmCPUJoulesDescriptor,
 				prometheus.CounterValue,
 				usage.EnergyTotal.Joules(),
-				id, vm.Name, string(vm.Hypervisor), state,
-				zoneName,
+				labels...,
 			)
+			c.collectKWh(ch, c.vmKWhDescriptor, usage.EnergyTotal, labels...)
 
-			ch <- prometheus.MustNewConstMetric(
+			c.emit(ch,
 				c.vmCPUWattsDescriptor,
 				prometheus.GaugeValue,
 				usage.Power.Watts(),
-				id, vm.Name, string(vm.Hypervisor), state,
-				zoneName,
+				labels...,
 			)
 		}
 	}
@@ -516,44 +1109,215 @@ func (c *PowerCollector) collectPodMetrics(ch chan<- prometheus.Metric, state st
 
 	// No need to lock, already done by the calling function
 	for id, pod := range pods {
+		// utilization is only meaningful while the pod is running
+		if state == "running" {
+			c.collectGPUEngineUtilization(ch, c.podGPUEncoderDesc, c.podGPUDecoderDesc,
+				pod.GPUEncoderUtil, pod.GPUDecoderUtil,
+				id, pod.Name, pod.Namespace,
+			)
+		}
+
 		for zone, usage := range pod.Zones {
-			zoneName := zone.Name()
-			ch <- prometheus.MustNewConstMetric(
+			labels := c.zoneValues(zone,
+				id, pod.Name, pod.Namespace, state,
+				c.zoneLabel(zone),
+			)
+			c.emit(ch,
 				c.podCPUJoulesDescriptor,
 				prometheus.CounterValue,
 				usage.EnergyTotal.Joules(),
-				id, pod.Name, pod.Namespace, state,
-				zoneName,
+				labels...,
 			)
+			c.collectKWh(ch, c.podKWhDescriptor, usage.EnergyTotal, labels...)
 
-			ch <- prometheus.MustNewConstMetric(
+			c.emit(ch,
 				c.podCPUWattsDescriptor,
 				prometheus.GaugeValue,
 				usage.Power.Watts(),
-				id, pod.Name, pod.Namespace, state,
-				zoneName,
+				labels...,
 			)
 		}
 
 		// GPU power metric (only for pods with GPU-using containers)
 		if pod.GPUPower > 0 {
-			ch <- prometheus.MustNewConstMetric(
+			c.emit(ch,
 				c.podGPUWattsDescriptor,
 				prometheus.GaugeValue,
-				pod.GPUPower,
+				c.gpuWatts(pod.GPUPower),
 				id, pod.Name, pod.Namespace, state,
 			)
 		}
 
 		// GPU energy metric (cumulative counter)
 		if pod.GPUEnergyTotal > 0 {
-			ch <- prometheus.MustNewConstMetric(
+			c.emit(ch,
 				c.podGPUJoulesDescriptor,
 				prometheus.CounterValue,
 				pod.GPUEnergyTotal.Joules(),
 				id, pod.Name, pod.Namespace, state,
 			)
+			c.collectKWh(ch, c.podGPUKWhDescriptor, pod.GPUEnergyTotal,
+				id, pod.Name, pod.Namespace, state)
+		}
+	}
+}
+
+// collectGPUEngineUtilization collects the GPU encoder and decoder utilization
+// of a running workload; idle engines are not reported
+func (c *PowerCollector) collectGPUEngineUtilization(ch chan<- prometheus.Metric, encDesc, decDesc *prometheus.Desc,
+	enc, dec float64, labels ...string,
+) {
+	if enc > 0 {
+		c.emit(ch, encDesc, prometheus.GaugeValue, enc, labels...)
+	}
+	if dec > 0 {
+		c.emit(ch, decDesc, prometheus.GaugeValue, dec, labels...)
+	}
+}
+
+// collectPodGPUShare collects the share of the attributable power of each GPU,
+// the active or, with the total basis, the total power, attributed to each
+// running pod. The shares of all pods on a GPU add up to 1 when all work on
+// the GPU runs in pods.
+func (c *PowerCollector) collectPodGPUShare(ch chan<- prometheus.Metric, pods monitor.Pods, gpuStats []monitor.GPUDeviceStats) {
+	for _, stats := range gpuStats {
+		if stats.AttributablePower <= 0 {
+			continue
+		}
+		gpuIndex := strconv.Itoa(stats.DeviceIndex)
+		for id, pod := range pods {
+			watts := pod.GPUDevicePower[stats.DeviceIndex]
+			if watts <= 0 {
+				continue
+			}
+			c.emit(ch,
+				c.podGPUShareDescriptor,
+				prometheus.GaugeValue,
+				watts/stats.AttributablePower,
+				id, pod.Name, pod.Namespace, gpuIndex,
+			)
+		}
+	}
+}
+
+// describe sends desc to ch unless its metric is disabled
+func (c *PowerCollector) describe(ch chan<- *prometheus.Desc, desc *prometheus.Desc) {
+	if !c.disabled[desc] {
+		ch <- desc
+	}
+}
+
+// emit creates a metric of desc and sends it to ch unless the metric is
+// disabled
+func (c *PowerCollector) emit(ch chan<- prometheus.Metric, desc *prometheus.Desc, valueType prometheus.ValueType, value float64, labels ...string) {
+	if c.disabled[desc] {
+		return
+	}
+	ch <- prometheus.MustNewConstMetric(desc, valueType, value, labels...)
+}
+
+// describeKWh describes desc if kWh counters are enabled
+func (c *PowerCollector) describeKWh(ch chan<- *prometheus.Desc, desc *prometheus.Desc) {
+	if c.emitKWh {
+		c.describe(ch, desc)
+	}
+}
+
+// collectKWh collects energy in kilowatt-hours if kWh counters are enabled.
+// The value is derived from the same cumulative energy as the joules counter
+// so the two views never drift apart.
+func (c *PowerCollector) collectKWh(ch chan<- prometheus.Metric, desc *prometheus.Desc, energy monitor.Energy, labels ...string) {
+	if !c.emitKWh {
+		return
+	}
+	c.emit(ch,
+		desc,
+		prometheus.CounterValue,
+		energy.Joules()/joulesPerKWh,
+		labels...,
+	)
+}
+
+// zoneValues returns the label values of a workload zone metric, appending the
+// zone path if the path label is enabled
+func (c *PowerCollector) zoneValues(zone monitor.EnergyZone, values ...string) []string {
+	if c.includeZonePath {
+		return append(values, zone.Path())
+	}
+	return values
+}
+
+// zoneLabel returns the zone name to use in metric labels. The zone name map
+// is looked up by the indexed name of the zone (e.g. package-0) first, so that
+// the zones of each socket can be told apart, and then by its name.
+func (c *PowerCollector) zoneLabel(zone monitor.EnergyZone) string {
+	if alias, ok := c.zoneNameMap[fmt.Sprintf("%s-%d", zone.Name(), zone.Index())]; ok {
+		return alias
+	}
+	return c.zoneNameLabel(zone.Name())
+}
+
+// zoneNameLabel returns the zone name to use in metric labels for a zone known
+// only by its name, e.g. of energy aggregated across the zones sharing a name
+func (c *PowerCollector) zoneNameLabel(name string) string {
+	if alias, ok := c.zoneNameMap[name]; ok {
+		return alias
+	}
+	return name
+}
+
+// collectZoneEnergy collects a cumulative energy by zone, e.g. of terminated
+// workloads or aged processes
+func (c *PowerCollector) collectZoneEnergy(ch chan<- prometheus.Metric, desc *prometheus.Desc, energy map[string]monitor.Energy) {
+	for zone, e := range energy {
+		c.emit(ch,
+			desc,
+			prometheus.CounterValue,
+			e.Joules(),
+			c.zoneNameLabel(zone),
+		)
+	}
+}
+
+// collectUserMetrics collects user-level power metrics
+func (c *PowerCollector) collectUserMetrics(ch chan<- prometheus.Metric, users monitor.Users) {
+	for _, u := range users {
+		uid := strconv.Itoa(u.UID)
+		for zone, usage := range u.Zones {
+			c.emit(ch,
+				c.userWattsDescriptor,
+				prometheus.GaugeValue,
+				usage.Power.Watts(),
+				uid, u.Name, c.zoneLabel(zone),
+			)
+		}
+	}
+}
+
+// isWorkloadLevelEnabled returns true if metrics of any workload level are enabled
+func (c *PowerCollector) isWorkloadLevelEnabled() bool {
+	return c.metricsLevel.IsProcessEnabled() || c.metricsLevel.IsContainerEnabled() ||
+		c.metricsLevel.IsVMEnabled() || c.metricsLevel.IsPodEnabled()
+}
+
+// collectAttributedEnergy collects the energy attributed to each enabled workload level
+func (c *PowerCollector) collectAttributedEnergy(ch chan<- prometheus.Metric, energy map[monitor.LevelZone]monitor.Energy) {
+	enabled := map[string]bool{
+		monitor.ProcessLevel:   c.metricsLevel.IsProcessEnabled(),
+		monitor.ContainerLevel: c.metricsLevel.IsContainerEnabled(),
+		monitor.VMLevel:        c.metricsLevel.IsVMEnabled(),
+		monitor.PodLevel:       c.metricsLevel.IsPodEnabled(),
+	}
+	for lz, e := range energy {
+		if !enabled[lz.Level] {
+			continue
 		}
+		c.emit(ch,
+			c.attributedJoulesDescriptor,
+			prometheus.CounterValue,
+			e.Joules(),
+			lz.Level, c.zoneNameLabel(lz.Zone),
+		)
 	}
 }
 
@@ -565,49 +1329,149 @@ func (c *PowerCollector) collectGPUMetrics(ch chan<- prometheus.Metric, gpuStats
 	}
 	c.logger.Debug("Exporting GPU metrics", "devices", len(gpuStats))
 
+	nodeGPUPower := 0.0
 	for _, stats := range gpuStats {
 		gpuIndex := fmt.Sprintf("%d", stats.DeviceIndex)
+		nodeGPUPower += stats.TotalPower
 
-		ch <- prometheus.MustNewConstMetric(
+		c.emit(ch,
 			c.gpuTotalWattsDescriptor,
 			prometheus.GaugeValue,
-			stats.TotalPower,
+			c.gpuWatts(stats.TotalPower),
 			gpuIndex, stats.UUID, stats.Name, stats.Vendor,
 		)
 
-		ch <- prometheus.MustNewConstMetric(
+		c.emit(ch,
 			c.gpuIdleWattsDescriptor,
 			prometheus.GaugeValue,
-			stats.IdlePower,
+			c.gpuWatts(stats.IdlePower),
 			gpuIndex, stats.UUID, stats.Name, stats.Vendor,
 		)
 
-		ch <- prometheus.MustNewConstMetric(
+		c.emit(ch,
 			c.gpuActiveWattsDescriptor,
 			prometheus.GaugeValue,
-			stats.ActivePower,
+			c.gpuWatts(stats.ActivePower),
 			gpuIndex, stats.UUID, stats.Name, stats.Vendor,
 		)
 
-		ch <- prometheus.MustNewConstMetric(
+		c.emit(ch,
 			c.gpuJoulesDescriptor,
 			prometheus.CounterValue,
 			stats.EnergyTotal.Joules(),
 			gpuIndex, stats.UUID, stats.Name, stats.Vendor,
 		)
+		c.collectKWh(ch, c.nodeGPUKWhDescriptor, stats.EnergyTotal,
+			gpuIndex, stats.UUID, stats.Name, stats.Vendor)
 
-		ch <- prometheus.MustNewConstMetric(
+		c.emit(ch,
 			c.gpuActiveJoulesDescriptor,
 			prometheus.CounterValue,
 			stats.ActiveEnergyTotal.Joules(),
 			gpuIndex, stats.UUID, stats.Name, stats.Vendor,
 		)
 
-		ch <- prometheus.MustNewConstMetric(
+		c.emit(ch,
 			c.gpuIdleJoulesDescriptor,
 			prometheus.CounterValue,
 			stats.IdleEnergyTotal.Joules(),
 			gpuIndex, stats.UUID, stats.Name, stats.Vendor,
 		)
+
+		c.emit(ch,
+			c.gpuWattsPerUtilDescriptor,
+			prometheus.GaugeValue,
+			gpuWattsPerUtil(stats),
+			gpuIndex, stats.UUID, stats.Name, stats.Vendor,
+		)
+
+		c.collectGPUReliability(ch, stats, gpuIndex)
+	}
+
+	c.emit(ch,
+		c.gpuNodeTotalWattsDesc,
+		prometheus.GaugeValue,
+		c.gpuWatts(nodeGPUPower),
+	)
+}
+
+// collectGPUReliability collects the fan speed and performance state of a GPU
+// when they were read and the device reports them
+func (c *PowerCollector) collectGPUReliability(ch chan<- prometheus.Metric, stats monitor.GPUDeviceStats, gpuIndex string) {
+	if stats.Reliability == nil {
+		return
+	}
+
+	if stats.Reliability.FanSpeed >= 0 {
+		c.emit(ch,
+			c.gpuFanSpeedDescriptor,
+			prometheus.GaugeValue,
+			stats.Reliability.FanSpeed,
+			gpuIndex, stats.UUID, stats.Name, stats.Vendor,
+		)
+	}
+
+	if stats.Reliability.PState >= 0 {
+		c.emit(ch,
+			c.gpuPStateDescriptor,
+			prometheus.GaugeValue,
+			float64(stats.Reliability.PState),
+			gpuIndex, stats.UUID, stats.Name, stats.Vendor,
+		)
+	}
+}
+
+// gpuWatts rounds GPU power to the configured precision
+func (c *PowerCollector) gpuWatts(watts float64) float64 {
+	if c.gpuPowerPrecision < 0 {
+		return watts
+	}
+	scale := math.Pow10(c.gpuPowerPrecision)
+	return math.Round(watts*scale) / scale
+}
+
+// gpuWattsPerUtil returns the GPU power per percent of SM utilization; 0 when
+// the GPU is not utilized to avoid dividing by zero
+func gpuWattsPerUtil(stats monitor.GPUDeviceStats) float64 {
+	if stats.Utilization <= 0 {
+		return 0
+	}
+	return stats.TotalPower / stats.Utilization
+}
+
+// collectProcessChurn collects the number of processes started and terminated
+func (c *PowerCollector) collectProcessChurn(ch chan<- prometheus.Metric, snapshot *monitor.Snapshot) {
+	c.emit(ch, c.processesStartedDescriptor, prometheus.CounterValue, float64(snapshot.ProcessesStarted))
+	c.emit(ch, c.processesTerminatedDescriptor, prometheus.CounterValue, float64(snapshot.ProcessesTerminated))
+	c.emit(ch, c.negativeCPUTimeDescriptor, prometheus.CounterValue, float64(snapshot.NegativeCPUTimeDeltas))
+}
+
+// collectRaplPermissionDenied reports whether RAPL energy counters could not
+// be read due to missing permissions
+func (c *PowerCollector) collectRaplPermissionDenied(ch chan<- prometheus.Metric, denied bool) {
+	value := 0.0
+	if denied {
+		value = 1
+	}
+	c.emit(ch,
+		c.nodeRaplPermissionDeniedDescriptor,
+		prometheus.GaugeValue,
+		value,
+	)
+}
+
+// collectMeterReadErrors collects the number of failed meter reads
+func (c *PowerCollector) collectMeterReadErrors(ch chan<- prometheus.Metric, readErrors map[monitor.MeterZone]uint64) {
+	for mz, count := range readErrors {
+		zone := mz.Zone
+		if mz.Meter == "cpu" {
+			zone = c.zoneNameLabel(zone)
+		}
+		c.emit(ch,
+			c.meterReadErrorsDescriptor,
+			prometheus.CounterValue,
+			float64(count),
+			mz.Meter, zone,
+		)
 	}
 }
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
+	"slices"
 	"strings"
 	"testing"
 	"time"
@@ -16,8 +17,10 @@ import (
 	dto "github.com/prometheus/client_model/go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"github.com/sustainable-computing-io/kepler/config"
 	"github.com/sustainable-computing-io/kepler/internal/device"
+	"github.com/sustainable-computing-io/kepler/internal/device/gpu"
 	"github.com/sustainable-computing-io/kepler/internal/monitor"
 	"github.com/sustainable-computing-io/kepler/internal/resource"
 )
@@ -51,6 +54,11 @@ func (m *MockPowerMonitor) Snapshot() (*monitor.Snapshot, error) {
 	return args.Get(0).(*monitor.Snapshot), args.Error(1)
 }
 
+func (m *MockPowerMonitor) LatestSnapshot() (*monitor.Snapshot, time.Duration, error) {
+	args := m.Called()
+	return args.Get(0).(*monitor.Snapshot), args.Get(1).(time.Duration), args.Error(2)
+}
+
 func (m *MockPowerMonitor) DataChannel() <-chan struct{} {
 	return m.dataCh
 }
@@ -221,6 +229,9 @@ func TestPowerCollector(t *testing.T) {
 			Exe:            "/usr/bin/123",
 			Type:           resource.RegularProcess,
 			CPUTotalTime:   100,
+			MemoryBytes:    256 << 20,
+			Threads:        8,
+			State:          "S",
 			GPUPower:       50.5,
 			GPUEnergyTotal: 250 * device.Joule,
 			Zones: monitor.ZoneUsageMap{
@@ -237,6 +248,7 @@ func TestPowerCollector(t *testing.T) {
 			ID:             "abcd-efgh",
 			Name:           "test-container",
 			Runtime:        resource.PodmanRuntime,
+			MemoryBytes:    512 << 20,
 			GPUPower:       42.5,
 			GPUEnergyTotal: 250 * device.Joule,
 			PodID:          "test-pod",
@@ -269,6 +281,7 @@ func TestPowerCollector(t *testing.T) {
 			Namespace:      "default",
 			GPUPower:       42.5,
 			GPUEnergyTotal: 250 * device.Joule,
+			GPUDevicePower: map[int]float64{0: 42.5},
 			Zones: monitor.ZoneUsageMap{
 				packageZone: {
 					EnergyTotal: 100 * device.Joule,
@@ -288,6 +301,7 @@ func TestPowerCollector(t *testing.T) {
 			TotalPower:        150.5,
 			IdlePower:         25.0,
 			ActivePower:       125.5,
+			AttributablePower: 125.5,
 			EnergyTotal:       5000 * device.Joule,
 			ActiveEnergyTotal: 4000 * device.Joule,
 			IdleEnergyTotal:   1000 * device.Joule,
@@ -332,6 +346,7 @@ func TestPowerCollector(t *testing.T) {
 			"kepler_node_cpu_joules_total",
 			"kepler_node_cpu_watts",
 			"kepler_node_cpu_usage_ratio",
+			"kepler_node_rapl_permission_denied",
 			"kepler_node_cpu_active_joules_total",
 			"kepler_node_cpu_idle_joules_total",
 			"kepler_node_cpu_active_watts",
@@ -339,12 +354,20 @@ func TestPowerCollector(t *testing.T) {
 
 			"kepler_process_cpu_joules_total",
 			"kepler_process_cpu_watts",
+			"kepler_process_energy_interval_joules",
 			"kepler_process_cpu_seconds_total",
+			"kepler_process_memory_bytes",
+			"kepler_process_threads",
+			"kepler_process_unattributed_watts",
 			"kepler_process_gpu_watts",
 			"kepler_process_gpu_joules_total",
+			"kepler_monitor_processes_started_total",
+			"kepler_monitor_processes_terminated_total",
+			"kepler_monitor_negative_cputime_total",
 
 			"kepler_container_cpu_joules_total",
 			"kepler_container_cpu_watts",
+			"kepler_container_memory_bytes",
 			"kepler_container_gpu_watts",
 			"kepler_container_gpu_joules_total",
 
@@ -355,6 +378,7 @@ func TestPowerCollector(t *testing.T) {
 			"kepler_pod_cpu_watts",
 			"kepler_pod_gpu_watts",
 			"kepler_pod_gpu_joules_total",
+			"kepler_pod_gpu_share_ratio",
 
 			"kepler_node_gpu_watts",
 			"kepler_node_gpu_idle_watts",
@@ -362,6 +386,8 @@ func TestPowerCollector(t *testing.T) {
 			"kepler_node_gpu_joules_total",
 			"kepler_node_gpu_active_joules_total",
 			"kepler_node_gpu_idle_joules_total",
+			"kepler_node_gpu_watts_per_util",
+			"kepler_node_gpu_total_watts",
 		}
 
 		assert.ElementsMatch(t, expectedMetricNames, metricNames(metrics))
@@ -533,6 +559,28 @@ func TestPowerCollector(t *testing.T) {
 		assertMetricLabelValues(t, registry, "kepler_process_gpu_joules_total", expectedLabels, 250.0)
 	})
 
+	t.Run("Memory Metrics", func(t *testing.T) {
+		assertMetricLabelValues(t, registry, "kepler_process_memory_bytes", map[string]string{
+			"node_name": "test-node",
+			"pid":       "123",
+			"comm":      "test-process",
+			"type":      "regular",
+		}, 256<<20)
+		assertMetricLabelValues(t, registry, "kepler_process_threads", map[string]string{
+			"node_name":     "test-node",
+			"pid":           "123",
+			"comm":          "test-process",
+			"type":          "regular",
+			"process_state": "S",
+		}, 8)
+		assertMetricLabelValues(t, registry, "kepler_container_memory_bytes", map[string]string{
+			"node_name":      "test-node",
+			"container_id":   "abcd-efgh",
+			"container_name": "test-container",
+			"pod_id":         "test-pod",
+		}, 512<<20)
+	})
+
 	t.Run("Container GPU Metrics", func(t *testing.T) {
 		expectedLabels := map[string]string{
 			"node_name":      "test-node",
@@ -705,6 +753,430 @@ func TestTerminatedProcessExport(t *testing.T) {
 	mockMonitor.AssertExpectations(t)
 }
 
+func TestMeterReadErrorsExport(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+	mockMonitor := NewMockPowerMonitor()
+
+	packageZone := device.NewMockRaplZone("package", 0, "/sys/class/powercap/intel-rapl/intel-rapl:0", 1000)
+
+	testSnapshot := monitor.NewSnapshot()
+	testSnapshot.Timestamp = time.Now()
+	testSnapshot.Node.Zones[packageZone] = monitor.NodeUsage{EnergyTotal: 1000 * device.Joule}
+	testSnapshot.MeterReadErrors = map[monitor.MeterZone]uint64{
+		{Meter: "cpu", Zone: "package"}: 3,
+		{Meter: "gpu", Zone: "0"}:       1,
+	}
+	mockMonitor.On("Snapshot").Return(testSnapshot, nil)
+
+	t.Run("node level enabled", func(t *testing.T) {
+		collector := NewPowerCollector(mockMonitor, "test-node", logger, config.MetricsLevelAll)
+		registry := prometheus.NewRegistry()
+		registry.MustRegister(collector)
+
+		mockMonitor.TriggerUpdate()
+		time.Sleep(10 * time.Millisecond)
+
+		assertMetricLabelValues(t, registry, "kepler_meter_read_errors_total",
+			map[string]string{"meter": "cpu", "zone": "package", "node_name": "test-node"}, 3)
+		assertMetricLabelValues(t, registry, "kepler_meter_read_errors_total",
+			map[string]string{"meter": "gpu", "zone": "0"}, 1)
+	})
+
+	t.Run("node level disabled", func(t *testing.T) {
+		collector := NewPowerCollector(mockMonitor, "test-node", logger, config.MetricsLevelProcess)
+		registry := prometheus.NewRegistry()
+		registry.MustRegister(collector)
+
+		mockMonitor.TriggerUpdate()
+		time.Sleep(10 * time.Millisecond)
+
+		families, err := registry.Gather()
+		require.NoError(t, err)
+		assert.NotContains(t, metricNames(families), "kepler_meter_read_errors_total")
+	})
+}
+
+func TestRaplPermissionDeniedExport(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+
+	for _, tc := range []struct {
+		name     string
+		denied   bool
+		expected float64
+	}{
+		{name: "permission denied", denied: true, expected: 1},
+		{name: "readable", denied: false, expected: 0},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			mockMonitor := NewMockPowerMonitor()
+			testSnapshot := monitor.NewSnapshot()
+			testSnapshot.Timestamp = time.Now()
+			testSnapshot.RaplPermissionDenied = tc.denied
+			mockMonitor.On("Snapshot").Return(testSnapshot, nil)
+
+			collector := NewPowerCollector(mockMonitor, "test-node", logger, config.MetricsLevelAll)
+			registry := prometheus.NewRegistry()
+			registry.MustRegister(collector)
+
+			mockMonitor.TriggerUpdate()
+			time.Sleep(10 * time.Millisecond)
+
+			assertMetricLabelValues(t, registry, "kepler_node_rapl_permission_denied",
+				map[string]string{"node_name": "test-node"}, tc.expected)
+		})
+	}
+}
+
+func TestProcessChurnExport(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+	mockMonitor := NewMockPowerMonitor()
+
+	testSnapshot := monitor.NewSnapshot()
+	testSnapshot.Timestamp = time.Now()
+	testSnapshot.ProcessesStarted = 12
+	testSnapshot.ProcessesTerminated = 7
+	testSnapshot.NegativeCPUTimeDeltas = 3
+	mockMonitor.On("Snapshot").Return(testSnapshot, nil)
+
+	t.Run("process level enabled", func(t *testing.T) {
+		collector := NewPowerCollector(mockMonitor, "test-node", logger, config.MetricsLevelProcess)
+		registry := prometheus.NewRegistry()
+		registry.MustRegister(collector)
+
+		mockMonitor.TriggerUpdate()
+		time.Sleep(10 * time.Millisecond)
+
+		assertMetricLabelValues(t, registry, "kepler_monitor_processes_started_total",
+			map[string]string{"node_name": "test-node"}, 12)
+		assertMetricLabelValues(t, registry, "kepler_monitor_processes_terminated_total",
+			map[string]string{"node_name": "test-node"}, 7)
+		assertMetricLabelValues(t, registry, "kepler_monitor_negative_cputime_total",
+			map[string]string{"node_name": "test-node"}, 3)
+	})
+
+	t.Run("process level disabled", func(t *testing.T) {
+		collector := NewPowerCollector(mockMonitor, "test-node", logger, config.MetricsLevelNode)
+		registry := prometheus.NewRegistry()
+		registry.MustRegister(collector)
+
+		mockMonitor.TriggerUpdate()
+		time.Sleep(10 * time.Millisecond)
+
+		families, err := registry.Gather()
+		require.NoError(t, err)
+		assert.NotContains(t, metricNames(families), "kepler_monitor_processes_started_total")
+	})
+}
+
+func TestZonePathLabel(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+	mockMonitor := NewMockPowerMonitor()
+
+	// package zones of two sockets share a name
+	pkg0 := device.NewMockRaplZone("package", 0, "/sys/class/powercap/intel-rapl/intel-rapl:0", 1000)
+	pkg1 := device.NewMockRaplZone("package", 1, "/sys/class/powercap/intel-rapl/intel-rapl:1", 1000)
+
+	testSnapshot := monitor.NewSnapshot()
+	testSnapshot.Timestamp = time.Now()
+	testSnapshot.Processes["123"] = &monitor.Process{
+		PID:         123,
+		Comm:        "test-proc",
+		Type:        resource.ContainerProcess,
+		ContainerID: "abc",
+		Zones: monitor.ZoneUsageMap{
+			pkg0: {EnergyTotal: 10 * device.Joule, Power: 1 * device.Watt},
+			pkg1: {EnergyTotal: 20 * device.Joule, Power: 2 * device.Watt},
+		},
+	}
+	testSnapshot.Containers["abc"] = &monitor.Container{
+		ID:   "abc",
+		Name: "test-container",
+		Zones: monitor.ZoneUsageMap{
+			pkg0: {EnergyTotal: 10 * device.Joule, Power: 1 * device.Watt},
+			pkg1: {EnergyTotal: 20 * device.Joule, Power: 2 * device.Watt},
+		},
+	}
+	mockMonitor.On("Snapshot").Return(testSnapshot, nil)
+
+	t.Run("enabled", func(t *testing.T) {
+		collector := NewPowerCollector(mockMonitor, "test-node", logger, config.MetricsLevelAll,
+			WithZonePathLabel(true), WithKWh(true))
+		registry := prometheus.NewRegistry()
+		registry.MustRegister(collector)
+
+		mockMonitor.TriggerUpdate()
+		time.Sleep(10 * time.Millisecond)
+
+		for path, watts := range map[string]float64{pkg0.Path(): 1, pkg1.Path(): 2} {
+			assertMetricLabelValues(t, registry, "kepler_process_cpu_watts",
+				map[string]string{"pid": "123", "zone": "package", "path": path}, watts)
+			assertMetricLabelValues(t, registry, "kepler_container_cpu_watts",
+				map[string]string{"container_id": "abc", "zone": "package", "path": path}, watts)
+		}
+		assertMetricLabelValues(t, registry, "kepler_process_cpu_joules_total",
+			map[string]string{"pid": "123", "path": pkg1.Path()}, 20)
+		assertMetricLabelValues(t, registry, "kepler_process_energy_kwh_total",
+			map[string]string{"pid": "123", "path": pkg1.Path()}, 20/joulesPerKWh)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		single := monitor.NewSnapshot()
+		single.Timestamp = time.Now()
+		single.Processes["123"] = &monitor.Process{
+			PID:   123,
+			Comm:  "test-proc",
+			Type:  resource.RegularProcess,
+			Zones: monitor.ZoneUsageMap{pkg0: {EnergyTotal: 10 * device.Joule, Power: 1 * device.Watt}},
+		}
+		mockMonitor := NewMockPowerMonitor()
+		mockMonitor.On("Snapshot").Return(single, nil)
+
+		collector := NewPowerCollector(mockMonitor, "test-node", logger, config.MetricsLevelProcess)
+		registry := prometheus.NewRegistry()
+		registry.MustRegister(collector)
+
+		mockMonitor.TriggerUpdate()
+		time.Sleep(10 * time.Millisecond)
+
+		families, err := registry.Gather()
+		require.NoError(t, err)
+		for _, mf := range families {
+			if mf.GetName() != "kepler_process_cpu_watts" {
+				continue
+			}
+			require.Len(t, mf.GetMetric(), 1)
+			for _, l := range mf.GetMetric()[0].GetLabel() {
+				assert.NotEqual(t, "path", l.GetName())
+			}
+		}
+	})
+}
+
+func TestProcessIntervalEnergyExport(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+	mockMonitor := NewMockPowerMonitor()
+
+	packageZone := device.NewMockRaplZone("package", 0, "/sys/class/powercap/intel-rapl/intel-rapl:0", 1000)
+
+	testSnapshot := monitor.NewSnapshot()
+	testSnapshot.Timestamp = time.Now()
+	testSnapshot.Processes["123"] = &monitor.Process{
+		PID:  123,
+		Comm: "running",
+		Type: resource.RegularProcess,
+		Zones: monitor.ZoneUsageMap{
+			packageZone: {EnergyTotal: 40 * device.Joule, EnergyDelta: 3 * device.Joule, Power: 3 * device.Watt},
+		},
+	}
+	testSnapshot.TerminatedProcesses["456"] = &monitor.Process{
+		PID:  456,
+		Comm: "terminated",
+		Type: resource.RegularProcess,
+		Zones: monitor.ZoneUsageMap{
+			packageZone: {EnergyTotal: 10 * device.Joule, EnergyDelta: 1 * device.Joule},
+		},
+	}
+	mockMonitor.On("Snapshot").Return(testSnapshot, nil)
+
+	t.Run("process level enabled", func(t *testing.T) {
+		collector := NewPowerCollector(mockMonitor, "test-node", logger, config.MetricsLevelProcess)
+		registry := prometheus.NewRegistry()
+		registry.MustRegister(collector)
+
+		mockMonitor.TriggerUpdate()
+		time.Sleep(10 * time.Millisecond)
+
+		assertMetricLabelValues(t, registry, "kepler_process_energy_interval_joules",
+			map[string]string{"pid": "123", "comm": "running", "zone": "package"}, 3)
+
+		families, err := registry.Gather()
+		require.NoError(t, err)
+		for _, mf := range families {
+			if mf.GetName() != "kepler_process_energy_interval_joules" {
+				continue
+			}
+			assert.Len(t, mf.GetMetric(), 1, "terminated processes have no interval energy")
+		}
+	})
+
+	t.Run("process level disabled", func(t *testing.T) {
+		collector := NewPowerCollector(mockMonitor, "test-node", logger, config.MetricsLevelNode)
+		registry := prometheus.NewRegistry()
+		registry.MustRegister(collector)
+
+		mockMonitor.TriggerUpdate()
+		time.Sleep(10 * time.Millisecond)
+
+		families, err := registry.Gather()
+		require.NoError(t, err)
+		assert.NotContains(t, metricNames(families), "kepler_process_energy_interval_joules")
+	})
+}
+
+func TestNodeCPUPowerUtilizationExport(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+	mockMonitor := NewMockPowerMonitor()
+
+	packageZone := device.NewMockRaplZone("package", 0, "/sys/class/powercap/intel-rapl/intel-rapl:0", 1000)
+	dramZone := device.NewMockRaplZone("dram", 0, "/sys/class/powercap/intel-rapl/intel-rapl:0/intel-rapl:0:1", 1000)
+
+	testSnapshot := monitor.NewSnapshot()
+	testSnapshot.Timestamp = time.Now()
+	testSnapshot.Node.Zones[packageZone] = monitor.NodeUsage{
+		Power:      50 * device.Watt,
+		PowerLimit: 200 * device.Watt,
+	}
+	// no known limit
+	testSnapshot.Node.Zones[dramZone] = monitor.NodeUsage{
+		Power: 8 * device.Watt,
+	}
+	mockMonitor.On("Snapshot").Return(testSnapshot, nil)
+
+	collector := NewPowerCollector(mockMonitor, "test-node", logger, config.MetricsLevelNode)
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(collector)
+
+	mockMonitor.TriggerUpdate()
+	time.Sleep(10 * time.Millisecond)
+
+	assertMetricLabelValues(t, registry, "kepler_node_cpu_power_utilization",
+		map[string]string{"zone": "package", "path": packageZone.Path()}, 0.25)
+
+	families, err := registry.Gather()
+	require.NoError(t, err)
+	for _, mf := range families {
+		if mf.GetName() != "kepler_node_cpu_power_utilization" {
+			continue
+		}
+		require.Len(t, mf.GetMetric(), 1, "zones without a limit must not be exported")
+	}
+}
+
+func TestNodeActiveIdleExport(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+	mockMonitor := NewMockPowerMonitor()
+
+	packageZone := device.NewMockRaplZone("package", 0, "/sys/class/powercap/intel-rapl/intel-rapl:0", 1000)
+	dramZone := device.NewMockRaplZone("dram", 0, "/sys/class/powercap/intel-rapl/intel-rapl:0/intel-rapl:0:1", 1000)
+
+	testSnapshot := monitor.NewSnapshot()
+	testSnapshot.Timestamp = time.Now()
+	testSnapshot.Node.Zones[packageZone] = monitor.NodeUsage{
+		EnergyTotal:       300 * device.Joule,
+		ActiveEnergyTotal: 180 * device.Joule,
+		IdleEnergyTotal:   120 * device.Joule,
+		Power:             50 * device.Watt,
+		ActivePower:       30 * device.Watt,
+		IdlePower:         20 * device.Watt,
+	}
+	testSnapshot.Node.Zones[dramZone] = monitor.NodeUsage{
+		EnergyTotal:       75 * device.Joule,
+		ActiveEnergyTotal: 15 * device.Joule,
+		IdleEnergyTotal:   60 * device.Joule,
+		Power:             8 * device.Watt,
+		ActivePower:       2 * device.Watt,
+		IdlePower:         6 * device.Watt,
+	}
+	mockMonitor.On("Snapshot").Return(testSnapshot, nil)
+
+	collector := NewPowerCollector(mockMonitor, "test-node", logger, config.MetricsLevelNode)
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(collector)
+
+	mockMonitor.TriggerUpdate()
+	time.Sleep(10 * time.Millisecond)
+
+	families, err := registry.Gather()
+	require.NoError(t, err)
+
+	// values[metric][zone]
+	values := map[string]map[string]float64{}
+	for _, mf := range families {
+		for _, m := range mf.GetMetric() {
+			zone := valueOfLabel(m, "zone")
+			if zone == "" {
+				continue
+			}
+			if values[mf.GetName()] == nil {
+				values[mf.GetName()] = map[string]float64{}
+			}
+			if m.GetCounter() != nil {
+				values[mf.GetName()][zone] = m.GetCounter().GetValue()
+			} else {
+				values[mf.GetName()][zone] = m.GetGauge().GetValue()
+			}
+		}
+	}
+
+	for _, zone := range []string{"package", "dram"} {
+		total, ok := values["kepler_node_cpu_joules_total"][zone]
+		require.True(t, ok, "zone %s: missing total energy counter", zone)
+		active, ok := values["kepler_node_cpu_active_joules_total"][zone]
+		require.True(t, ok, "zone %s: missing active energy counter", zone)
+		idle, ok := values["kepler_node_cpu_idle_joules_total"][zone]
+		require.True(t, ok, "zone %s: missing idle energy counter", zone)
+		assert.InDelta(t, total, active+idle, 1e-9, "zone %s: active + idle joules must equal total joules", zone)
+
+		assert.InDelta(t, values["kepler_node_cpu_watts"][zone],
+			values["kepler_node_cpu_active_watts"][zone]+values["kepler_node_cpu_idle_watts"][zone], 1e-9,
+			"zone %s: active + idle watts must equal watts", zone)
+	}
+	assert.Equal(t, 180.0, values["kepler_node_cpu_active_joules_total"]["package"])
+	assert.Equal(t, 60.0, values["kepler_node_cpu_idle_joules_total"]["dram"])
+}
+
+func TestCPUCoreWattsExport(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+
+	packageZone := device.NewMockRaplZone("package", 0, "/sys/class/powercap/intel-rapl/intel-rapl:0", 1000)
+	core0 := device.NewMockRaplZone("core", 0, "/sys/class/hwmon/hwmon2/energy1_input", 1000)
+	core1 := device.NewMockRaplZone("core", 1, "/sys/class/hwmon/hwmon2/energy2_input", 1000)
+
+	t.Run("per-core zones available", func(t *testing.T) {
+		mockMonitor := NewMockPowerMonitor()
+		testSnapshot := monitor.NewSnapshot()
+		testSnapshot.Timestamp = time.Now()
+		testSnapshot.Node.Zones[packageZone] = monitor.NodeUsage{Power: 20 * device.Watt}
+		testSnapshot.Node.CoreZones = monitor.ZoneUsageMap{
+			core0: {Power: 5 * device.Watt},
+			core1: {Power: 2 * device.Watt},
+		}
+		mockMonitor.On("Snapshot").Return(testSnapshot, nil)
+
+		collector := NewPowerCollector(mockMonitor, "test-node", logger, config.MetricsLevelAll)
+		registry := prometheus.NewRegistry()
+		registry.MustRegister(collector)
+
+		mockMonitor.TriggerUpdate()
+		time.Sleep(10 * time.Millisecond)
+
+		assertMetricLabelValues(t, registry, "kepler_node_cpu_core_watts",
+			map[string]string{"core": "0", "node_name": "test-node"}, 5)
+		assertMetricLabelValues(t, registry, "kepler_node_cpu_core_watts",
+			map[string]string{"core": "1"}, 2)
+	})
+
+	t.Run("package level only", func(t *testing.T) {
+		mockMonitor := NewMockPowerMonitor()
+		testSnapshot := monitor.NewSnapshot()
+		testSnapshot.Timestamp = time.Now()
+		testSnapshot.Node.Zones[packageZone] = monitor.NodeUsage{Power: 20 * device.Watt}
+		mockMonitor.On("Snapshot").Return(testSnapshot, nil)
+
+		collector := NewPowerCollector(mockMonitor, "test-node", logger, config.MetricsLevelAll)
+		registry := prometheus.NewRegistry()
+		registry.MustRegister(collector)
+
+		mockMonitor.TriggerUpdate()
+		time.Sleep(10 * time.Millisecond)
+
+		families, err := registry.Gather()
+		require.NoError(t, err)
+		assert.Contains(t, metricNames(families), "kepler_node_cpu_watts")
+		assert.NotContains(t, metricNames(families), "kepler_node_cpu_core_watts")
+	})
+}
+
 func TestEnhancedErrorReporting(t *testing.T) {
 	t.Skip("This test demonstrates enhanced error reporting - skipped by default")
 
@@ -809,7 +1281,10 @@ func TestPowerCollector_MetricsLevelFiltering(t *testing.T) {
 				"kepler_process_cpu_joules_total":   true,
 				"kepler_process_cpu_watts":          true,
 				"kepler_process_cpu_seconds_total":  true,
+				"kepler_process_memory_bytes":       true,
+				"kepler_process_threads":            true,
 				"kepler_container_cpu_joules_total": false,
+				"kepler_container_memory_bytes":     false,
 				"kepler_vm_cpu_joules_total":        false,
 				"kepler_pod_cpu_joules_total":       false,
 			},
@@ -823,6 +1298,9 @@ func TestPowerCollector_MetricsLevelFiltering(t *testing.T) {
 				"kepler_process_cpu_joules_total":   false,
 				"kepler_container_cpu_joules_total": true,
 				"kepler_container_cpu_watts":        true,
+				"kepler_container_memory_bytes":     true,
+				"kepler_process_memory_bytes":       false,
+				"kepler_process_threads":            false,
 				"kepler_vm_cpu_joules_total":        false,
 				"kepler_pod_cpu_joules_total":       false,
 			},
@@ -1183,3 +1661,926 @@ func TestTerminatedVMExport(t *testing.T) {
 
 	mockMonitor.AssertExpectations(t)
 }
+
+func TestGPUWattsPerUtilExport(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+
+	mockMonitor := NewMockPowerMonitor()
+	testSnapshot := monitor.NewSnapshot()
+	testSnapshot.Timestamp = time.Now()
+	testSnapshot.GPUStats = sampleGPUStats()
+	testSnapshot.GPUStats[0].Utilization = 60 // 150.5W at 60%
+	testSnapshot.GPUStats[1].Utilization = 0  // not utilized
+	mockMonitor.On("Snapshot").Return(testSnapshot, nil)
+
+	collector := NewPowerCollector(mockMonitor, "test-node", logger, config.MetricsLevelAll)
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(collector)
+
+	mockMonitor.TriggerUpdate()
+	time.Sleep(10 * time.Millisecond)
+
+	assertMetricLabelValues(t, registry, "kepler_node_gpu_watts_per_util",
+		map[string]string{"gpu": "0", "node_name": "test-node"}, 150.5/60)
+	assertMetricLabelValues(t, registry, "kepler_node_gpu_watts_per_util",
+		map[string]string{"gpu": "1"}, 0)
+}
+
+func TestNodeGPUTotalWattsExport(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+
+	mockMonitor := NewMockPowerMonitor()
+	testSnapshot := monitor.NewSnapshot()
+	testSnapshot.Timestamp = time.Now()
+	testSnapshot.GPUStats = []monitor.GPUDeviceStats{
+		{DeviceIndex: 0, UUID: "GPU-0", Name: "NVIDIA A100", Vendor: "nvidia", TotalPower: 100, IdlePower: 60, ActivePower: 40},
+		{DeviceIndex: 1, UUID: "GPU-1", Name: "NVIDIA A100", Vendor: "nvidia", TotalPower: 250.5, IdlePower: 60, ActivePower: 190.5},
+		{DeviceIndex: 2, UUID: "GPU-2", Name: "NVIDIA A100", Vendor: "nvidia", TotalPower: 75.25, IdlePower: 60, ActivePower: 15.25},
+	}
+	mockMonitor.On("Snapshot").Return(testSnapshot, nil)
+
+	collector := NewPowerCollector(mockMonitor, "test-node", logger, config.MetricsLevelAll)
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(collector)
+
+	mockMonitor.TriggerUpdate()
+	time.Sleep(10 * time.Millisecond)
+
+	families, err := registry.Gather()
+	require.NoError(t, err)
+
+	devices, total := 0.0, 0.0
+	found := false
+	for _, mf := range families {
+		switch mf.GetName() {
+		case "kepler_node_gpu_watts":
+			for _, m := range mf.GetMetric() {
+				devices += m.GetGauge().GetValue()
+			}
+		case "kepler_node_gpu_total_watts":
+			require.Len(t, mf.GetMetric(), 1)
+			total = mf.GetMetric()[0].GetGauge().GetValue()
+			found = true
+		}
+	}
+	require.True(t, found, "kepler_node_gpu_total_watts not exported")
+	assert.InDelta(t, 425.75, total, 1e-9)
+	assert.InDelta(t, devices, total, 1e-9, "total equals the sum of per-device power")
+}
+
+func TestPodGPUShareExport(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+
+	mockMonitor := NewMockPowerMonitor()
+	testSnapshot := monitor.NewSnapshot()
+	testSnapshot.Timestamp = time.Now()
+	testSnapshot.GPUStats = []monitor.GPUDeviceStats{
+		{DeviceIndex: 0, UUID: "GPU-0", Name: "NVIDIA A100", Vendor: "nvidia", TotalPower: 100, IdlePower: 60, ActivePower: 40, AttributablePower: 40},
+		{DeviceIndex: 1, UUID: "GPU-1", Name: "NVIDIA A100", Vendor: "nvidia", TotalPower: 80, IdlePower: 60, ActivePower: 20, AttributablePower: 20},
+	}
+	// two pods time-slicing GPU 0; pod-a also runs alone on GPU 1
+	testSnapshot.Pods = monitor.Pods{
+		"pod-a": {ID: "pod-a", Name: "train", Namespace: "ml", GPUPower: 50, GPUDevicePower: map[int]float64{0: 30, 1: 20}},
+		"pod-b": {ID: "pod-b", Name: "infer", Namespace: "ml", GPUPower: 10, GPUDevicePower: map[int]float64{0: 10}},
+		"pod-c": {ID: "pod-c", Name: "web", Namespace: "default"},
+	}
+	mockMonitor.On("Snapshot").Return(testSnapshot, nil)
+
+	collector := NewPowerCollector(mockMonitor, "test-node", logger, config.MetricsLevelAll)
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(collector)
+
+	mockMonitor.TriggerUpdate()
+	time.Sleep(10 * time.Millisecond)
+
+	assertMetricLabelValues(t, registry, "kepler_pod_gpu_share_ratio",
+		map[string]string{"pod_id": "pod-a", "pod_name": "train", "gpu": "0"}, 0.75)
+	assertMetricLabelValues(t, registry, "kepler_pod_gpu_share_ratio",
+		map[string]string{"pod_id": "pod-b", "pod_name": "infer", "gpu": "0"}, 0.25)
+	assertMetricLabelValues(t, registry, "kepler_pod_gpu_share_ratio",
+		map[string]string{"pod_id": "pod-a", "pod_name": "train", "gpu": "1"}, 1.0)
+
+	families, err := registry.Gather()
+	require.NoError(t, err)
+	sums := map[string]float64{}
+	for _, mf := range families {
+		if mf.GetName() != "kepler_pod_gpu_share_ratio" {
+			continue
+		}
+		assert.Len(t, mf.GetMetric(), 3, "pods without GPU power must not be exported")
+		for _, m := range mf.GetMetric() {
+			sums[valueOfLabel(m, "gpu")] += m.GetGauge().GetValue()
+		}
+	}
+	assert.InDelta(t, 1.0, sums["0"], 1e-9)
+	assert.InDelta(t, 1.0, sums["1"], 1e-9)
+}
+
+func TestPodGPUShareExport_TotalBasis(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+
+	mockMonitor := NewMockPowerMonitor()
+	testSnapshot := monitor.NewSnapshot()
+	testSnapshot.Timestamp = time.Now()
+	// idle power is attributed too, so pod power is relative to the total power
+	testSnapshot.GPUStats = []monitor.GPUDeviceStats{
+		{DeviceIndex: 0, UUID: "GPU-0", Name: "NVIDIA A100", Vendor: "nvidia", TotalPower: 100, IdlePower: 60, ActivePower: 40, AttributablePower: 100},
+	}
+	testSnapshot.Pods = monitor.Pods{
+		"pod-a": {ID: "pod-a", Name: "train", Namespace: "ml", GPUPower: 75, GPUDevicePower: map[int]float64{0: 75}},
+		"pod-b": {ID: "pod-b", Name: "infer", Namespace: "ml", GPUPower: 25, GPUDevicePower: map[int]float64{0: 25}},
+	}
+	mockMonitor.On("Snapshot").Return(testSnapshot, nil)
+
+	collector := NewPowerCollector(mockMonitor, "test-node", logger, config.MetricsLevelAll)
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(collector)
+
+	mockMonitor.TriggerUpdate()
+	time.Sleep(10 * time.Millisecond)
+
+	assertMetricLabelValues(t, registry, "kepler_pod_gpu_share_ratio",
+		map[string]string{"pod_id": "pod-a", "pod_name": "train"}, 0.75)
+	assertMetricLabelValues(t, registry, "kepler_pod_gpu_share_ratio",
+		map[string]string{"pod_id": "pod-b", "pod_name": "infer"}, 0.25)
+}
+
+func TestGPUEncoderDecoderUtilizationExport(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+
+	mockMonitor := NewMockPowerMonitor()
+	testSnapshot := monitor.NewSnapshot()
+	testSnapshot.Timestamp = time.Now()
+	// two ffmpeg processes transcoding in the same container
+	testSnapshot.Processes = monitor.Processes{
+		"123": {
+			PID: 123, Comm: "ffmpeg", Exe: "/usr/bin/ffmpeg", Type: resource.ContainerProcess, ContainerID: "ctr-1",
+			GPUPower: 20, GPUEncoderUtil: 60, GPUDecoderUtil: 25,
+		},
+		"124": {
+			PID: 124, Comm: "ffmpeg", Exe: "/usr/bin/ffmpeg", Type: resource.ContainerProcess, ContainerID: "ctr-1",
+			GPUPower: 10, GPUEncoderUtil: 15,
+		},
+		"125": {PID: 125, Comm: "train", Exe: "/usr/bin/python", Type: resource.RegularProcess, GPUPower: 50},
+	}
+	testSnapshot.TerminatedProcesses = monitor.Processes{
+		"126": {PID: 126, Comm: "ffmpeg", Exe: "/usr/bin/ffmpeg", Type: resource.RegularProcess, GPUEncoderUtil: 40},
+	}
+	testSnapshot.Containers = monitor.Containers{
+		"ctr-1": {
+			ID: "ctr-1", Name: "transcoder", Runtime: resource.ContainerDRuntime, PodID: "pod-1",
+			GPUPower: 30, GPUEncoderUtil: 75, GPUDecoderUtil: 25,
+		},
+	}
+	testSnapshot.Pods = monitor.Pods{
+		"pod-1": {ID: "pod-1", Name: "transcoder", Namespace: "media", GPUPower: 30, GPUEncoderUtil: 75, GPUDecoderUtil: 25},
+	}
+	mockMonitor.On("Snapshot").Return(testSnapshot, nil)
+
+	collector := NewPowerCollector(mockMonitor, "test-node", logger, config.MetricsLevelAll)
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(collector)
+
+	mockMonitor.TriggerUpdate()
+	time.Sleep(10 * time.Millisecond)
+
+	assertMetricLabelValues(t, registry, "kepler_process_gpu_encoder_utilization",
+		map[string]string{"pid": "123", "container_id": "ctr-1"}, 60)
+	assertMetricLabelValues(t, registry, "kepler_process_gpu_decoder_utilization",
+		map[string]string{"pid": "123", "container_id": "ctr-1"}, 25)
+	assertMetricLabelValues(t, registry, "kepler_process_gpu_encoder_utilization",
+		map[string]string{"pid": "124", "container_id": "ctr-1"}, 15)
+
+	assertMetricLabelValues(t, registry, "kepler_container_gpu_encoder_utilization",
+		map[string]string{"container_id": "ctr-1", "pod_id": "pod-1"}, 75)
+	assertMetricLabelValues(t, registry, "kepler_container_gpu_decoder_utilization",
+		map[string]string{"container_id": "ctr-1", "pod_id": "pod-1"}, 25)
+
+	assertMetricLabelValues(t, registry, "kepler_pod_gpu_encoder_utilization",
+		map[string]string{"pod_id": "pod-1", "pod_namespace": "media"}, 75)
+	assertMetricLabelValues(t, registry, "kepler_pod_gpu_decoder_utilization",
+		map[string]string{"pod_id": "pod-1", "pod_namespace": "media"}, 25)
+
+	families, err := registry.Gather()
+	require.NoError(t, err)
+	for _, mf := range families {
+		switch mf.GetName() {
+		case "kepler_process_gpu_encoder_utilization":
+			// neither idle encoders nor terminated processes are exported
+			assert.Len(t, mf.GetMetric(), 2)
+		case "kepler_process_gpu_decoder_utilization":
+			assert.Len(t, mf.GetMetric(), 1)
+		}
+	}
+}
+
+func TestProcessGPUSMUtilizationExport(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+
+	newSnapshot := func() *monitor.Snapshot {
+		snapshot := monitor.NewSnapshot()
+		snapshot.Timestamp = time.Now()
+		snapshot.Processes = monitor.Processes{
+			"123": {PID: 123, Comm: "train", Exe: "/usr/bin/python", Type: resource.RegularProcess, GPUPower: 60, GPUSMUtil: 75},
+			"124": {PID: 124, Comm: "infer", Exe: "/usr/bin/python", Type: resource.RegularProcess, GPUPower: 20, GPUSMUtil: 25},
+			"125": {PID: 125, Comm: "idle", Exe: "/usr/bin/python", Type: resource.RegularProcess, GPUPower: 5},
+		}
+		snapshot.TerminatedProcesses = monitor.Processes{
+			"126": {PID: 126, Comm: "train", Exe: "/usr/bin/python", Type: resource.RegularProcess, GPUSMUtil: 50},
+		}
+		return snapshot
+	}
+
+	t.Run("exported as a ratio for running processes", func(t *testing.T) {
+		mockMonitor := NewMockPowerMonitor()
+		mockMonitor.On("Snapshot").Return(newSnapshot(), nil)
+
+		collector := NewPowerCollector(mockMonitor, "test-node", logger, config.MetricsLevelProcess)
+		registry := prometheus.NewRegistry()
+		registry.MustRegister(collector)
+
+		mockMonitor.TriggerUpdate()
+		time.Sleep(10 * time.Millisecond)
+
+		assertMetricLabelValues(t, registry, "kepler_process_gpu_sm_utilization_ratio",
+			map[string]string{"pid": "123", "comm": "train"}, 0.75)
+		assertMetricLabelValues(t, registry, "kepler_process_gpu_sm_utilization_ratio",
+			map[string]string{"pid": "124", "comm": "infer"}, 0.25)
+
+		families, err := registry.Gather()
+		require.NoError(t, err)
+		found := false
+		for _, mf := range families {
+			if mf.GetName() == "kepler_process_gpu_sm_utilization_ratio" {
+				found = true
+				// neither idle nor terminated processes are exported
+				assert.Len(t, mf.GetMetric(), 2)
+			}
+		}
+		assert.True(t, found)
+	})
+
+	t.Run("not exported without the process level", func(t *testing.T) {
+		mockMonitor := NewMockPowerMonitor()
+		mockMonitor.On("Snapshot").Return(newSnapshot(), nil)
+
+		collector := NewPowerCollector(mockMonitor, "test-node", logger, config.MetricsLevelNode|config.MetricsLevelPod)
+		registry := prometheus.NewRegistry()
+		registry.MustRegister(collector)
+
+		mockMonitor.TriggerUpdate()
+		time.Sleep(10 * time.Millisecond)
+
+		families, err := registry.Gather()
+		require.NoError(t, err)
+		assert.NotContains(t, metricNames(families), "kepler_process_gpu_sm_utilization_ratio")
+	})
+}
+
+func TestGPUPowerPrecision(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+
+	newSnapshot := func() *monitor.Snapshot {
+		s := monitor.NewSnapshot()
+		s.Timestamp = time.Now()
+		s.GPUStats = []monitor.GPUDeviceStats{{
+			DeviceIndex: 0, UUID: "GPU-0", Name: "NVIDIA A100", Vendor: "nvidia",
+			TotalPower: 123.456789, IdlePower: 60.04, ActivePower: 63.416789,
+			EnergyTotal: 1234567890, // µJ
+		}}
+		s.Processes = monitor.Processes{
+			"123": {PID: 123, Comm: "train", Exe: "/usr/bin/python", Type: resource.RegularProcess, GPUPower: 31.7083945},
+		}
+		return s
+	}
+
+	tt := []struct {
+		name      string
+		opts      []PowerCollectorOption
+		total     float64
+		idle      float64
+		active    float64
+		processes float64
+	}{
+		{name: "full precision by default", total: 123.456789, idle: 60.04, active: 63.416789, processes: 31.7083945},
+		{name: "one decimal place", opts: []PowerCollectorOption{WithGPUPowerPrecision(1)}, total: 123.5, idle: 60.0, active: 63.4, processes: 31.7},
+		{name: "whole watts", opts: []PowerCollectorOption{WithGPUPowerPrecision(0)}, total: 123, idle: 60, active: 63, processes: 32},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			mockMonitor := NewMockPowerMonitor()
+			mockMonitor.On("Snapshot").Return(newSnapshot(), nil)
+
+			collector := NewPowerCollector(mockMonitor, "test-node", logger, config.MetricsLevelAll, tc.opts...)
+			registry := prometheus.NewRegistry()
+			registry.MustRegister(collector)
+
+			mockMonitor.TriggerUpdate()
+			time.Sleep(10 * time.Millisecond)
+
+			gpu := map[string]string{"gpu": "0", "gpu_uuid": "GPU-0"}
+			assertMetricLabelValues(t, registry, "kepler_node_gpu_watts", gpu, tc.total)
+			assertMetricLabelValues(t, registry, "kepler_node_gpu_idle_watts", gpu, tc.idle)
+			assertMetricLabelValues(t, registry, "kepler_node_gpu_active_watts", gpu, tc.active)
+			assertMetricLabelValues(t, registry, "kepler_process_gpu_watts",
+				map[string]string{"pid": "123"}, tc.processes)
+
+			// energy is never rounded
+			assertMetricLabelValues(t, registry, "kepler_node_gpu_joules_total", gpu, 1234.56789)
+		})
+	}
+}
+
+func TestTerminatedEnergyExport(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+
+	mockMonitor := NewMockPowerMonitor()
+	testSnapshot := monitor.NewSnapshot()
+	testSnapshot.Timestamp = time.Now()
+	testSnapshot.TerminatedContainersEnergy = map[string]device.Energy{
+		"package": 120 * device.Joule,
+		"dram":    30 * device.Joule,
+	}
+	testSnapshot.TerminatedPodsEnergy = map[string]device.Energy{
+		"package": 150 * device.Joule,
+	}
+	mockMonitor.On("Snapshot").Return(testSnapshot, nil)
+
+	collector := NewPowerCollector(mockMonitor, "test-node", logger, config.MetricsLevelAll)
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(collector)
+
+	mockMonitor.TriggerUpdate()
+	time.Sleep(10 * time.Millisecond)
+
+	assertMetricLabelValues(t, registry, "kepler_container_terminated_joules_total",
+		map[string]string{"zone": "package", "node_name": "test-node"}, 120)
+	assertMetricLabelValues(t, registry, "kepler_container_terminated_joules_total",
+		map[string]string{"zone": "dram"}, 30)
+	assertMetricLabelValues(t, registry, "kepler_pod_terminated_joules_total",
+		map[string]string{"zone": "package"}, 150)
+}
+
+func TestAttributedEnergyExport(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+
+	mockMonitor := NewMockPowerMonitor()
+	testSnapshot := monitor.NewSnapshot()
+	testSnapshot.Timestamp = time.Now()
+	testSnapshot.AttributedEnergy = map[monitor.LevelZone]device.Energy{
+		{Level: monitor.ProcessLevel, Zone: "package"}:   100 * device.Joule,
+		{Level: monitor.ContainerLevel, Zone: "package"}: 60 * device.Joule,
+		{Level: monitor.PodLevel, Zone: "package"}:       40 * device.Joule,
+		{Level: monitor.VMLevel, Zone: "package"}:        25 * device.Joule,
+	}
+	mockMonitor.On("Snapshot").Return(testSnapshot, nil)
+
+	collector := NewPowerCollector(mockMonitor, "test-node", logger,
+		config.MetricsLevelNode|config.MetricsLevelProcess|config.MetricsLevelContainer|config.MetricsLevelPod)
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(collector)
+
+	mockMonitor.TriggerUpdate()
+	time.Sleep(10 * time.Millisecond)
+
+	assertMetricLabelValues(t, registry, "kepler_attributed_joules_total",
+		map[string]string{"level": "process", "zone": "package", "node_name": "test-node"}, 100)
+	assertMetricLabelValues(t, registry, "kepler_attributed_joules_total",
+		map[string]string{"level": "container", "zone": "package"}, 60)
+	assertMetricLabelValues(t, registry, "kepler_attributed_joules_total",
+		map[string]string{"level": "pod", "zone": "package"}, 40)
+
+	metrics, err := registry.Gather()
+	require.NoError(t, err)
+	for _, mf := range metrics {
+		if mf.GetName() != "kepler_attributed_joules_total" {
+			continue
+		}
+		assert.Len(t, mf.GetMetric(), 3, "disabled vm level must not be exported")
+	}
+}
+
+func TestUserMetricsExport(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+
+	pkg := device.NewMockRaplZone("package", 0, "/sys/class/powercap/intel-rapl/intel-rapl:0", 1000)
+
+	newMonitor := func() *MockPowerMonitor {
+		mockMonitor := NewMockPowerMonitor()
+		testSnapshot := monitor.NewSnapshot()
+		testSnapshot.Timestamp = time.Now()
+		testSnapshot.Users = monitor.Users{
+			"1000": {UID: 1000, Name: "alice", Zones: monitor.ZoneUsageMap{pkg: {Power: 12 * device.Watt}}},
+			"0":    {UID: 0, Zones: monitor.ZoneUsageMap{pkg: {Power: 3 * device.Watt}}},
+		}
+		mockMonitor.On("Snapshot").Return(testSnapshot, nil)
+		return mockMonitor
+	}
+
+	t.Run("user level enabled", func(t *testing.T) {
+		mockMonitor := newMonitor()
+		collector := NewPowerCollector(mockMonitor, "test-node", logger, config.MetricsLevelNode|config.MetricsLevelUser)
+		registry := prometheus.NewRegistry()
+		registry.MustRegister(collector)
+
+		mockMonitor.TriggerUpdate()
+		time.Sleep(10 * time.Millisecond)
+
+		assertMetricLabelValues(t, registry, "kepler_user_watts",
+			map[string]string{"uid": "1000", "user_name": "alice", "zone": "package", "node_name": "test-node"}, 12)
+		assertMetricLabelValues(t, registry, "kepler_user_watts",
+			map[string]string{"uid": "0", "user_name": "", "zone": "package"}, 3)
+	})
+
+	t.Run("user level is opt-in", func(t *testing.T) {
+		mockMonitor := newMonitor()
+		collector := NewPowerCollector(mockMonitor, "test-node", logger, config.MetricsLevelAll)
+		registry := prometheus.NewRegistry()
+		registry.MustRegister(collector)
+
+		mockMonitor.TriggerUpdate()
+		time.Sleep(10 * time.Millisecond)
+
+		metrics, err := registry.Gather()
+		require.NoError(t, err)
+		assert.NotContains(t, metricNames(metrics), "kepler_user_watts")
+	})
+}
+
+func TestZoneNameMapExport(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+
+	pkg0 := device.NewMockRaplZone("package", 0, "/sys/class/powercap/intel-rapl/intel-rapl:0", 1000)
+	pkg1 := device.NewMockRaplZone("package", 1, "/sys/class/powercap/intel-rapl/intel-rapl:1", 1000)
+	dram := device.NewMockRaplZone("dram", 0, "/sys/class/powercap/intel-rapl/intel-rapl:0:1", 1000)
+
+	mockMonitor := NewMockPowerMonitor()
+	testSnapshot := monitor.NewSnapshot()
+	testSnapshot.Timestamp = time.Now()
+	testSnapshot.Node = &monitor.Node{
+		Timestamp: time.Now(),
+		Zones: monitor.NodeZoneUsageMap{
+			pkg0: {EnergyTotal: 100 * device.Joule, Power: 40 * device.Watt},
+			pkg1: {EnergyTotal: 80 * device.Joule, Power: 30 * device.Watt},
+			dram: {EnergyTotal: 20 * device.Joule, Power: 10 * device.Watt},
+		},
+	}
+	// energy aggregated across the zones sharing a name is keyed by the name
+	testSnapshot.TerminatedContainersEnergy = map[string]device.Energy{
+		"package": 120 * device.Joule,
+		"dram":    12 * device.Joule,
+	}
+	mockMonitor.On("Snapshot").Return(testSnapshot, nil)
+
+	collector := NewPowerCollector(mockMonitor, "test-node", logger, config.MetricsLevelAll,
+		WithZoneNameMap(map[string]string{
+			"package-0": "cpu-socket-0",
+			"package-1": "cpu-socket-1",
+			"package":   "cpu-package",
+			"dram":      "memory",
+		}))
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(collector)
+
+	mockMonitor.TriggerUpdate()
+	time.Sleep(10 * time.Millisecond)
+
+	// the indexed zone name takes precedence over the zone name
+	assertMetricLabelValues(t, registry, "kepler_node_cpu_joules_total",
+		map[string]string{"zone": "cpu-socket-0"}, 100)
+	assertMetricLabelValues(t, registry, "kepler_node_cpu_joules_total",
+		map[string]string{"zone": "cpu-socket-1"}, 80)
+	// dram-0 has no entry, so the zone name is looked up
+	assertMetricLabelValues(t, registry, "kepler_node_cpu_joules_total",
+		map[string]string{"zone": "memory"}, 20)
+	assertMetricLabelValues(t, registry, "kepler_container_terminated_joules_total",
+		map[string]string{"zone": "cpu-package"}, 120)
+	assertMetricLabelValues(t, registry, "kepler_container_terminated_joules_total",
+		map[string]string{"zone": "memory"}, 12)
+
+	metrics, err := registry.Gather()
+	require.NoError(t, err)
+	for _, mf := range metrics {
+		for _, m := range mf.GetMetric() {
+			zone := valueOfLabel(m, "zone")
+			assert.NotEqual(t, "package", zone, "%s exports the unmapped zone name", mf.GetName())
+			assert.NotEqual(t, "dram", zone, "%s exports the unmapped zone name", mf.GetName())
+		}
+	}
+}
+
+func TestUnattributedPowerExport(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+
+	pkg := device.NewMockRaplZone("package", 0, "/sys/class/powercap/intel-rapl/intel-rapl:0", 1000)
+	dram := device.NewMockRaplZone("dram", 0, "/sys/class/powercap/intel-rapl/intel-rapl:0:1", 1000)
+
+	mockMonitor := NewMockPowerMonitor()
+	testSnapshot := monitor.NewSnapshot()
+	testSnapshot.Timestamp = time.Now()
+	testSnapshot.Node = &monitor.Node{
+		Timestamp: time.Now(),
+		Zones: monitor.NodeZoneUsageMap{
+			pkg:  {Power: 40 * device.Watt, ActivePower: 30 * device.Watt, IdlePower: 10 * device.Watt},
+			dram: {Power: 8 * device.Watt, ActivePower: 4 * device.Watt, IdlePower: 4 * device.Watt},
+		},
+	}
+	testSnapshot.Processes = monitor.Processes{
+		"1": {PID: 1, Comm: "a", Zones: monitor.ZoneUsageMap{
+			pkg:  {Power: 10 * device.Watt},
+			dram: {Power: 1 * device.Watt},
+		}},
+		"2": {PID: 2, Comm: "b", Zones: monitor.ZoneUsageMap{
+			pkg:  {Power: 12 * device.Watt},
+			dram: {Power: 3 * device.Watt},
+		}},
+	}
+	mockMonitor.On("Snapshot").Return(testSnapshot, nil)
+
+	collector := NewPowerCollector(mockMonitor, "test-node", logger, config.MetricsLevelProcess)
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(collector)
+
+	mockMonitor.TriggerUpdate()
+	time.Sleep(10 * time.Millisecond)
+
+	assertMetricLabelValues(t, registry, "kepler_process_unattributed_watts",
+		map[string]string{"zone": "package", "node_name": "test-node"}, 8)
+	assertMetricLabelValues(t, registry, "kepler_process_unattributed_watts",
+		map[string]string{"zone": "dram"}, 0)
+
+	// unattributed and attributed process power add up to node active power
+	families, err := registry.Gather()
+	require.NoError(t, err)
+	sums := map[string]float64{}
+	for _, mf := range families {
+		switch mf.GetName() {
+		case "kepler_process_cpu_watts", "kepler_process_unattributed_watts":
+			for _, m := range mf.GetMetric() {
+				sums[valueOfLabel(m, "zone")] += m.GetGauge().GetValue()
+			}
+		}
+	}
+	assert.InDelta(t, 30.0, sums["package"], 1e-9)
+	assert.InDelta(t, 4.0, sums["dram"], 1e-9)
+}
+
+func TestUnattributedPowerExport_TotalBasis(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+
+	pkg := device.NewMockRaplZone("package", 0, "/sys/class/powercap/intel-rapl/intel-rapl:0", 1000)
+
+	mockMonitor := NewMockPowerMonitor()
+	testSnapshot := monitor.NewSnapshot()
+	testSnapshot.Timestamp = time.Now()
+	// idle power is attributed too, so process power includes it
+	testSnapshot.ProcessEnergyBasis = monitor.EnergyBasisTotal
+	testSnapshot.Node = &monitor.Node{
+		Timestamp: time.Now(),
+		Zones: monitor.NodeZoneUsageMap{
+			pkg: {Power: 40 * device.Watt, ActivePower: 30 * device.Watt, IdlePower: 10 * device.Watt},
+		},
+	}
+	testSnapshot.Processes = monitor.Processes{
+		"1": {PID: 1, Comm: "a", Zones: monitor.ZoneUsageMap{pkg: {Power: 20 * device.Watt}}},
+		"2": {PID: 2, Comm: "b", Zones: monitor.ZoneUsageMap{pkg: {Power: 15 * device.Watt}}},
+	}
+	mockMonitor.On("Snapshot").Return(testSnapshot, nil)
+
+	collector := NewPowerCollector(mockMonitor, "test-node", logger, config.MetricsLevelProcess)
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(collector)
+
+	mockMonitor.TriggerUpdate()
+	time.Sleep(10 * time.Millisecond)
+
+	assertMetricLabelValues(t, registry, "kepler_process_unattributed_watts",
+		map[string]string{"zone": "package"}, 5)
+}
+
+func TestUnattributedPowerExport_NoProcesses(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+
+	pkg := device.NewMockRaplZone("package", 0, "/sys/class/powercap/intel-rapl/intel-rapl:0", 1000)
+	dram := device.NewMockRaplZone("dram", 0, "/sys/class/powercap/intel-rapl/intel-rapl:0:1", 1000)
+
+	mockMonitor := NewMockPowerMonitor()
+	testSnapshot := monitor.NewSnapshot()
+	testSnapshot.Timestamp = time.Now()
+	testSnapshot.Node = &monitor.Node{
+		Timestamp: time.Now(),
+		Zones: monitor.NodeZoneUsageMap{
+			pkg:  {Power: 40 * device.Watt, ActivePower: 30 * device.Watt, IdlePower: 10 * device.Watt},
+			dram: {Power: 8 * device.Watt, ActivePower: 4 * device.Watt, IdlePower: 4 * device.Watt},
+		},
+	}
+	mockMonitor.On("Snapshot").Return(testSnapshot, nil)
+
+	collector := NewPowerCollector(mockMonitor, "test-node", logger, config.MetricsLevelProcess)
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(collector)
+
+	mockMonitor.TriggerUpdate()
+	time.Sleep(10 * time.Millisecond)
+
+	// without running processes, all of the node active power is unattributed
+	assertMetricLabelValues(t, registry, "kepler_process_unattributed_watts",
+		map[string]string{"zone": "package"}, 30)
+	assertMetricLabelValues(t, registry, "kepler_process_unattributed_watts",
+		map[string]string{"zone": "dram"}, 4)
+}
+
+func TestAgedProcessesExport(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+
+	pkg := device.NewMockRaplZone("package", 0, "/sys/class/powercap/intel-rapl/intel-rapl:0", 1000)
+
+	mockMonitor := NewMockPowerMonitor()
+	testSnapshot := monitor.NewSnapshot()
+	testSnapshot.Timestamp = time.Now()
+	testSnapshot.Processes = monitor.Processes{
+		"1": {PID: 1, Comm: "systemd", Aged: true, Zones: monitor.ZoneUsageMap{
+			pkg: {Power: 10 * device.Watt, EnergyTotal: 500 * device.Joule},
+		}},
+		"2": {PID: 2, Comm: "app", Zones: monitor.ZoneUsageMap{
+			pkg: {Power: 12 * device.Watt, EnergyTotal: 20 * device.Joule},
+		}},
+	}
+	testSnapshot.AgedProcessesEnergy = map[string]monitor.Energy{"package": 100 * device.Joule}
+	mockMonitor.On("Snapshot").Return(testSnapshot, nil)
+
+	collector := NewPowerCollector(mockMonitor, "test-node", logger, config.MetricsLevelProcess)
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(collector)
+
+	mockMonitor.TriggerUpdate()
+	time.Sleep(10 * time.Millisecond)
+
+	assertMetricLabelValues(t, registry, "kepler_process_cpu_joules_total",
+		map[string]string{"pid": "2", "zone": "package"}, 20)
+	assertMetricLabelValues(t, registry, "kepler_process_aged_joules_total",
+		map[string]string{"zone": "package", "node_name": "test-node"}, 100)
+
+	families, err := registry.Gather()
+	require.NoError(t, err)
+	for _, mf := range families {
+		if !strings.HasPrefix(mf.GetName(), "kepler_process_") {
+			continue
+		}
+		for _, m := range mf.GetMetric() {
+			assert.NotEqual(t, "1", valueOfLabel(m, "pid"), "aged process reported by %s", mf.GetName())
+		}
+	}
+}
+
+func TestKWhExport(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+
+	pkg := device.NewMockRaplZone("package", 0, "/sys/class/powercap/intel-rapl/intel-rapl:0", 1000)
+
+	newSnapshot := func() *monitor.Snapshot {
+		snapshot := monitor.NewSnapshot()
+		snapshot.Timestamp = time.Now()
+		snapshot.Node = &monitor.Node{
+			Timestamp: time.Now(),
+			Zones: monitor.NodeZoneUsageMap{
+				// 3.6 MJ is exactly 1 kWh
+				pkg: {EnergyTotal: 3_600_000 * device.Joule, Power: 40 * device.Watt},
+			},
+		}
+		snapshot.Processes = monitor.Processes{
+			"1": {PID: 1, Comm: "a", Zones: monitor.ZoneUsageMap{
+				pkg: {EnergyTotal: 900_000 * device.Joule, Power: 10 * device.Watt},
+			}, GPUEnergyTotal: 360_000 * device.Joule, GPUPower: 5},
+		}
+		snapshot.GPUStats = []monitor.GPUDeviceStats{{
+			DeviceIndex: 0, UUID: "GPU-0", Name: "A100", Vendor: "nvidia",
+			TotalPower: 100, EnergyTotal: 7_200_000 * device.Joule,
+		}}
+		return snapshot
+	}
+
+	t.Run("enabled", func(t *testing.T) {
+		mockMonitor := NewMockPowerMonitor()
+		mockMonitor.On("Snapshot").Return(newSnapshot(), nil)
+
+		collector := NewPowerCollector(mockMonitor, "test-node", logger, config.MetricsLevelAll, WithKWh(true))
+		registry := prometheus.NewRegistry()
+		registry.MustRegister(collector)
+
+		mockMonitor.TriggerUpdate()
+		time.Sleep(10 * time.Millisecond)
+
+		assertMetricLabelValues(t, registry, "kepler_node_energy_kwh_total",
+			map[string]string{"zone": "package"}, 1)
+		assertMetricLabelValues(t, registry, "kepler_node_cpu_joules_total",
+			map[string]string{"zone": "package"}, 3_600_000)
+		assertMetricLabelValues(t, registry, "kepler_process_energy_kwh_total",
+			map[string]string{"pid": "1", "zone": "package"}, 0.25)
+		assertMetricLabelValues(t, registry, "kepler_node_gpu_energy_kwh_total",
+			map[string]string{"gpu": "0", "gpu_uuid": "GPU-0"}, 2)
+		assertMetricLabelValues(t, registry, "kepler_process_gpu_energy_kwh_total",
+			map[string]string{"pid": "1"}, 0.1)
+
+		families, err := registry.Gather()
+		require.NoError(t, err)
+		for _, mf := range families {
+			if strings.HasSuffix(mf.GetName(), "_kwh_total") {
+				assert.Equal(t, "COUNTER", mf.GetType().String())
+			}
+		}
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		mockMonitor := NewMockPowerMonitor()
+		mockMonitor.On("Snapshot").Return(newSnapshot(), nil)
+
+		collector := NewPowerCollector(mockMonitor, "test-node", logger, config.MetricsLevelAll)
+		registry := prometheus.NewRegistry()
+		registry.MustRegister(collector)
+
+		mockMonitor.TriggerUpdate()
+		time.Sleep(10 * time.Millisecond)
+
+		families, err := registry.Gather()
+		require.NoError(t, err)
+		for _, mf := range families {
+			assert.NotContains(t, mf.GetName(), "kwh")
+		}
+	})
+}
+
+func TestStaleMarkers(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+
+	pkg := device.NewMockRaplZone("package", 0, "/sys/class/powercap/intel-rapl/intel-rapl:0", 1000)
+
+	newSnapshot := func() *monitor.Snapshot {
+		snapshot := monitor.NewSnapshot()
+		snapshot.Timestamp = time.Now()
+		snapshot.Node = &monitor.Node{
+			Timestamp: snapshot.Timestamp,
+			Zones: monitor.NodeZoneUsageMap{
+				pkg: {EnergyTotal: 100 * device.Joule, Power: 40 * device.Watt},
+			},
+		}
+		snapshot.Processes = monitor.Processes{
+			"1": {PID: 1, Comm: "a", Zones: monitor.ZoneUsageMap{
+				pkg: {EnergyTotal: 50 * device.Joule, Power: 20 * device.Watt},
+			}},
+		}
+		return snapshot
+	}
+
+	// gather exports a snapshot of the given age, as reported by the monitor
+	// without refreshing it
+	gather := func(t *testing.T, age time.Duration, opts ...PowerCollectorOption) []string {
+		t.Helper()
+		mockMonitor := NewMockPowerMonitor()
+		mockMonitor.On("Snapshot").Return(newSnapshot(), nil).Maybe()
+		mockMonitor.On("LatestSnapshot").Return(newSnapshot(), age, nil).Maybe()
+
+		collector := NewPowerCollector(mockMonitor, "test-node", logger, config.MetricsLevelAll, opts...)
+		registry := prometheus.NewRegistry()
+		registry.MustRegister(collector)
+
+		mockMonitor.TriggerUpdate()
+		time.Sleep(10 * time.Millisecond)
+
+		families, err := registry.Gather()
+		require.NoError(t, err)
+		return metricNames(families)
+	}
+
+	t.Run("stale snapshot is withheld", func(t *testing.T) {
+		names := gather(t, time.Minute, WithStaleMarkers(time.Second))
+		assert.Empty(t, names)
+	})
+
+	t.Run("fresh snapshot is exported", func(t *testing.T) {
+		names := gather(t, 500*time.Millisecond, WithStaleMarkers(time.Second))
+		assert.Contains(t, names, "kepler_node_cpu_joules_total")
+		assert.Contains(t, names, "kepler_process_cpu_watts")
+	})
+
+	t.Run("disabled refreshes the snapshot", func(t *testing.T) {
+		names := gather(t, time.Minute)
+		assert.Contains(t, names, "kepler_node_cpu_joules_total")
+		assert.Contains(t, names, "kepler_process_cpu_watts")
+	})
+}
+
+func TestProcessPIDLabel(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+
+	pkg := device.NewMockRaplZone("package", 0, "/sys/class/powercap/intel-rapl/intel-rapl:0", 1000)
+
+	mockMonitor := NewMockPowerMonitor()
+	testSnapshot := monitor.NewSnapshot()
+	testSnapshot.Timestamp = time.Now()
+	testSnapshot.Node = &monitor.Node{
+		Timestamp: time.Now(),
+		Zones:     monitor.NodeZoneUsageMap{pkg: {Power: 40 * device.Watt}},
+	}
+	// process identified by its namespaced PID
+	testSnapshot.Processes = monitor.Processes{
+		"abc/7": {PID: 7, HostPID: 4242, Comm: "app", ContainerID: "abc", Zones: monitor.ZoneUsageMap{
+			pkg: {EnergyTotal: 10 * device.Joule, Power: 5 * device.Watt},
+		}},
+	}
+	mockMonitor.On("Snapshot").Return(testSnapshot, nil)
+
+	collector := NewPowerCollector(mockMonitor, "test-node", logger, config.MetricsLevelProcess)
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(collector)
+
+	mockMonitor.TriggerUpdate()
+	time.Sleep(10 * time.Millisecond)
+
+	assertMetricLabelValues(t, registry, "kepler_process_cpu_joules_total",
+		map[string]string{"pid": "7", "container_id": "abc"}, 10)
+}
+
+func TestGPUReliabilityExport(t *testing.T) {
+	logger := slog.New(slog.DiscardHandler)
+
+	gpuMeter := gpu.NewFakeGPUMeter([]gpu.GPUDevice{
+		{Index: 0, UUID: "GPU-fake-0", Name: "Fake GPU", Vendor: gpu.VendorNVIDIA},
+		{Index: 1, UUID: "GPU-fake-1", Name: "Fake GPU", Vendor: gpu.VendorNVIDIA},
+	})
+	gpuMeter.SetPower(0, 120*device.Watt)
+	gpuMeter.SetFanSpeed(0, 55)
+	gpuMeter.SetPState(0, 2)
+	// passively cooled device without a fan
+	gpuMeter.SetPState(1, 8)
+
+	// newRegistry runs a node-only monitor reading the fake GPU meter and
+	// returns a registry exporting its metrics once the first snapshot is ready
+	newRegistry := func(t *testing.T, reliability bool) *prometheus.Registry {
+		t.Helper()
+		cpuMeter, err := device.NewFakeCPUMeter(nil)
+		require.NoError(t, err)
+		informer, err := resource.NewInformer(
+			resource.WithLogger(logger),
+			resource.WithProcFSPath("/proc"),
+			resource.WithNodeOnly(true),
+		)
+		require.NoError(t, err)
+
+		pm := monitor.NewPowerMonitor(cpuMeter,
+			monitor.WithLogger(logger),
+			monitor.WithResourceInformer(informer),
+			monitor.WithMode(monitor.ModeNodeOnly),
+			monitor.WithGPUPowerMeters([]gpu.GPUPowerMeter{gpuMeter}),
+			monitor.WithGPUReliabilityMetrics(reliability),
+		)
+		require.NoError(t, pm.Init())
+
+		ctx, cancel := context.WithCancel(context.Background())
+		go func() { _ = pm.Run(ctx) }()
+		t.Cleanup(cancel)
+
+		registry := prometheus.NewRegistry()
+		registry.MustRegister(NewPowerCollector(pm, "test-node", logger, config.MetricsLevelAll))
+
+		require.Eventually(t, func() bool {
+			metrics, err := registry.Gather()
+			return err == nil && slices.Contains(metricNames(metrics), "kepler_node_gpu_watts")
+		}, 5*time.Second, 10*time.Millisecond)
+		return registry
+	}
+
+	t.Run("enabled", func(t *testing.T) {
+		registry := newRegistry(t, true)
+
+		labels := map[string]string{
+			"gpu":       "0",
+			"gpu_uuid":  "GPU-fake-0",
+			"gpu_name":  "Fake GPU",
+			"vendor":    "nvidia",
+			"node_name": "test-node",
+		}
+		assertMetricLabelValues(t, registry, "kepler_node_gpu_fan_speed_percent", labels, 55)
+		assertMetricLabelValues(t, registry, "kepler_node_gpu_pstate", labels, 2)
+		assertMetricLabelValues(t, registry, "kepler_node_gpu_pstate", map[string]string{"gpu": "1"}, 8)
+
+		metrics, err := registry.Gather()
+		require.NoError(t, err)
+		for _, mf := range metrics {
+			if mf.GetName() == "kepler_node_gpu_fan_speed_percent" {
+				assert.Len(t, mf.GetMetric(), 1, "devices without a fan must not report a fan speed")
+			}
+		}
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		registry := newRegistry(t, false)
+
+		metrics, err := registry.Gather()
+		require.NoError(t, err)
+		names := metricNames(metrics)
+		assert.NotContains(t, names, "kepler_node_gpu_fan_speed_percent")
+		assert.NotContains(t, names, "kepler_node_gpu_pstate")
+	})
+}
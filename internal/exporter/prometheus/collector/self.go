@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package collector
+
+import (
+	"fmt"
+
+	prom "github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/procfs"
+)
+
+// selfCPUCollector exports the CPU time consumed by Kepler itself so that its
+// measurement overhead can be quantified
+type selfCPUCollector struct {
+	fs   procfs.FS
+	desc *prom.Desc
+}
+
+// NewSelfCPUCollector creates a collector exporting kepler_self_cpu_seconds_total
+// read from <procPath>/self/stat
+func NewSelfCPUCollector(procPath, nodeName string) (*selfCPUCollector, error) {
+	fs, err := procfs.NewFS(procPath)
+	if err != nil {
+		return nil, fmt.Errorf("creating procfs failed: %w", err)
+	}
+	return &selfCPUCollector{
+		fs: fs,
+		desc: prom.NewDesc(
+			prom.BuildFQName(keplerNS, "self", "cpu_seconds_total"),
+			"Total user and system CPU time of the Kepler process in seconds",
+			nil,
+			prom.Labels{nodeNameLabel: nodeName},
+		),
+	}, nil
+}
+
+func (c *selfCPUCollector) Describe(ch chan<- *prom.Desc) {
+	ch <- c.desc
+}
+
+func (c *selfCPUCollector) Collect(ch chan<- prom.Metric) {
+	self, err := c.fs.Self()
+	if err != nil {
+		return
+	}
+	stat, err := self.Stat()
+	if err != nil {
+		return
+	}
+	ch <- prom.MustNewConstMetric(c.desc, prom.CounterValue, stat.CPUTime())
+}
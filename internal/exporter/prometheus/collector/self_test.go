@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package collector
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeSelfStat writes <procfs>/42/stat with the given user and system ticks
+func writeSelfStat(t *testing.T, procfs string, utime, stime int) {
+	t.Helper()
+	stat := fmt.Sprintf("42 (kepler) S 1 42 42 0 -1 4194304 81 0 0 0 %d %d 0 0 20 0 10 0 1239047 2703360 314 "+
+		"18446744073709551615 0 0 0 0 0 0 0 0 0 0 0 0 17 0 0 0 0 0 0 0 0 0 0 0 0 0\n", utime, stime)
+	require.NoError(t, os.WriteFile(filepath.Join(procfs, "42", "stat"), []byte(stat), 0o644))
+}
+
+func TestSelfCPUCollector(t *testing.T) {
+	procfs := t.TempDir()
+	require.NoError(t, os.Mkdir(filepath.Join(procfs, "42"), 0o755))
+	require.NoError(t, os.Symlink("42", filepath.Join(procfs, "self")))
+
+	c, err := NewSelfCPUCollector(procfs, "test-node")
+	require.NoError(t, err)
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(c)
+
+	cpuSeconds := func() float64 {
+		t.Helper()
+		families, err := registry.Gather()
+		require.NoError(t, err)
+		require.Len(t, families, 1)
+
+		mf := families[0]
+		assert.Equal(t, "kepler_self_cpu_seconds_total", mf.GetName())
+		require.Len(t, mf.GetMetric(), 1)
+		m := mf.GetMetric()[0]
+		assert.Equal(t, "test-node", valueOfLabel(m, nodeNameLabel))
+		return m.GetCounter().GetValue()
+	}
+
+	// 100 ticks per second
+	writeSelfStat(t, procfs, 100, 50)
+	assert.Equal(t, 1.5, cpuSeconds())
+
+	writeSelfStat(t, procfs, 300, 100)
+	assert.Equal(t, 4.0, cpuSeconds())
+
+	t.Run("real procfs", func(t *testing.T) {
+		c, err := NewSelfCPUCollector("/proc", "test-node")
+		require.NoError(t, err)
+		registry := prometheus.NewRegistry()
+		registry.MustRegister(c)
+
+		families, err := registry.Gather()
+		require.NoError(t, err)
+		require.Len(t, families, 1)
+		assert.GreaterOrEqual(t, families[0].GetMetric()[0].GetCounter().GetValue(), 0.0)
+	})
+}
@@ -0,0 +1,199 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package collector
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sustainable-computing-io/kepler/config"
+	"github.com/sustainable-computing-io/kepler/internal/monitor"
+)
+
+// cappableLevel is a workload level that is dropped when the exported series
+// would exceed maxTotalSeries
+type cappableLevel struct {
+	level config.Level
+	name  string
+}
+
+// cappableLevels are the workload levels in the order they are dropped,
+// lowest priority first; node and user metrics are never dropped
+var cappableLevels = []cappableLevel{
+	{config.MetricsLevelProcess, "process"},
+	{config.MetricsLevelContainer, "container"},
+	{config.MetricsLevelVM, "vm"},
+	{config.MetricsLevelPod, "pod"},
+}
+
+// cappedLevels returns the metrics levels to export for a snapshot and the
+// enabled levels dropped to stay within maxTotalSeries
+func (c *PowerCollector) cappedLevels(snapshot *monitor.Snapshot) (config.Level, []string) {
+	levels := c.metricsLevel
+	if c.maxTotalSeries <= 0 {
+		return levels, nil
+	}
+
+	series := c.estimateSeries(snapshot)
+	total := series[config.MetricsLevelNode]
+	for _, l := range cappableLevels {
+		if levels&l.level != 0 {
+			total += series[l.level]
+		}
+	}
+
+	var dropped []string
+	for _, l := range cappableLevels {
+		if total <= c.maxTotalSeries {
+			break
+		}
+		if levels&l.level == 0 {
+			continue
+		}
+		levels &^= l.level
+		total -= series[l.level]
+		dropped = append(dropped, l.name)
+	}
+
+	c.logCappedLevels(dropped, total)
+	return levels, dropped
+}
+
+// logCappedLevels warns when the levels dropped by the series cap change
+func (c *PowerCollector) logCappedLevels(dropped []string, total int) {
+	current := strings.Join(dropped, ",")
+
+	c.capMutex.Lock()
+	defer c.capMutex.Unlock()
+	if current == c.lastDropped {
+		return
+	}
+	c.lastDropped = current
+
+	if len(dropped) == 0 {
+		c.logger.Info("Exported series within cap, exporting all metrics levels",
+			"max_total_series", c.maxTotalSeries, "estimated_series", total)
+		return
+	}
+	c.logger.Warn("Exported series exceed cap, dropping metrics levels",
+		"max_total_series", c.maxTotalSeries, "estimated_series", total, "dropped", dropped)
+}
+
+// estimateSeries estimates the series exported for a snapshot per metrics
+// level; the series of the node and user levels, which are never dropped,
+// are counted under the node level
+func (c *PowerCollector) estimateSeries(snapshot *monitor.Snapshot) map[config.Level]int {
+	// kWh counters double the CPU and GPU energy series
+	energySeries := 1
+	if c.emitKWh {
+		energySeries = 2
+	}
+
+	series := make(map[config.Level]int, len(cappableLevels)+1)
+
+	if node := snapshot.Node; node != nil {
+		// joules, watts and their active and idle variants per zone
+		series[config.MetricsLevelNode] += len(node.Zones)*(5+energySeries) + len(node.CoreZones) + 1
+	}
+	// power, energy and utilization metrics per GPU and the node GPU total
+	if len(snapshot.GPUStats) > 0 {
+		series[config.MetricsLevelNode] += len(snapshot.GPUStats)*(8+energySeries) + 1
+	}
+	for _, u := range snapshot.Users {
+		series[config.MetricsLevelNode] += len(u.Zones)
+	}
+
+	for state, processes := range map[string]monitor.Processes{"running": snapshot.Processes, "terminated": snapshot.TerminatedProcesses} {
+		running := state == "running"
+		for _, p := range processes {
+			if p.Aged {
+				continue
+			}
+			n := 1 + len(p.Zones)*(1+energySeries) // cpu time, watts and energy
+			if running {
+				n += 2 + len(p.Zones) // memory, threads and interval joules
+				n += gpuEngineSeries(p.GPUEncoderUtil, p.GPUDecoderUtil)
+				if p.GPUSMUtil > 0 {
+					n++
+				}
+			}
+			n += gpuSeries(p.GPUPower, p.GPUEnergyTotal, energySeries)
+			series[config.MetricsLevelProcess] += n
+		}
+	}
+
+	for state, containers := range map[string]monitor.Containers{"running": snapshot.Containers, "terminated": snapshot.TerminatedContainers} {
+		for _, ctr := range containers {
+			n := len(ctr.Zones)*(1+energySeries) + gpuSeries(ctr.GPUPower, ctr.GPUEnergyTotal, energySeries)
+			if state == "running" {
+				n++ // memory
+				n += gpuEngineSeries(ctr.GPUEncoderUtil, ctr.GPUDecoderUtil)
+			}
+			series[config.MetricsLevelContainer] += n
+		}
+	}
+
+	for _, vms := range []monitor.VirtualMachines{snapshot.VirtualMachines, snapshot.TerminatedVirtualMachines} {
+		for _, vm := range vms {
+			series[config.MetricsLevelVM] += len(vm.Zones) * (1 + energySeries)
+		}
+	}
+
+	for _, pods := range []monitor.Pods{snapshot.Pods, snapshot.TerminatedPods} {
+		for _, pod := range pods {
+			series[config.MetricsLevelPod] += len(pod.Zones)*(1+energySeries) + gpuSeries(pod.GPUPower, pod.GPUEnergyTotal, energySeries)
+		}
+	}
+	for _, pod := range snapshot.Pods {
+		series[config.MetricsLevelPod] += gpuEngineSeries(pod.GPUEncoderUtil, pod.GPUDecoderUtil)
+	}
+
+	return series
+}
+
+// gpuSeries returns the number of GPU power and energy series of a workload
+func gpuSeries(power float64, energy monitor.Energy, energySeries int) int {
+	n := 0
+	if power > 0 {
+		n++
+	}
+	if energy > 0 {
+		n += energySeries
+	}
+	return n
+}
+
+// gpuEngineSeries returns the number of GPU encoder and decoder utilization
+// series of a running workload
+func gpuEngineSeries(enc, dec float64) int {
+	n := 0
+	if enc > 0 {
+		n++
+	}
+	if dec > 0 {
+		n++
+	}
+	return n
+}
+
+// collectSeriesCapped reports whether each enabled workload level is dropped
+// by the series cap (1) or exported (0)
+func (c *PowerCollector) collectSeriesCapped(ch chan<- prometheus.Metric, dropped []string) {
+	for _, l := range cappableLevels {
+		if c.metricsLevel&l.level == 0 {
+			continue
+		}
+		value := 0.0
+		if slices.Contains(dropped, l.name) {
+			value = 1
+		}
+		ch <- prometheus.MustNewConstMetric(
+			c.seriesCappedDescriptor,
+			prometheus.GaugeValue,
+			value,
+			l.name,
+		)
+	}
+}
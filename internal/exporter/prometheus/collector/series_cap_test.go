@@ -0,0 +1,112 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package collector
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sustainable-computing-io/kepler/config"
+	"github.com/sustainable-computing-io/kepler/internal/device"
+	"github.com/sustainable-computing-io/kepler/internal/monitor"
+	"github.com/sustainable-computing-io/kepler/internal/resource"
+)
+
+func TestMaxTotalSeries(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+	mockMonitor := NewMockPowerMonitor()
+
+	packageZone := device.NewMockRaplZone("package", 0, "/sys/class/powercap/intel-rapl/intel-rapl:0", 1000)
+	usage := monitor.ZoneUsageMap{packageZone: {EnergyTotal: 10 * device.Joule, Power: 1 * device.Watt}}
+
+	// 7 node series, 6 per process, 3 per container and 2 per pod
+	testSnapshot := monitor.NewSnapshot()
+	testSnapshot.Timestamp = time.Now()
+	testSnapshot.Node.Zones[packageZone] = monitor.NodeUsage{EnergyTotal: 1000 * device.Joule, Power: 10 * device.Watt}
+	for i := range 10000 {
+		id := fmt.Sprint(i + 1)
+		testSnapshot.Processes[id] = &monitor.Process{
+			PID: i + 1, Comm: "proc", Type: resource.RegularProcess, Zones: usage,
+		}
+	}
+	for i := range 100 {
+		id := fmt.Sprintf("ctr-%d", i)
+		testSnapshot.Containers[id] = &monitor.Container{ID: id, Name: id, Zones: usage}
+	}
+	for i := range 10 {
+		id := fmt.Sprintf("pod-%d", i)
+		testSnapshot.Pods[id] = &monitor.Pod{ID: id, Name: id, Namespace: "default", Zones: usage}
+	}
+	mockMonitor.On("Snapshot").Return(testSnapshot, nil)
+
+	levels := config.MetricsLevelNode | config.MetricsLevelProcess | config.MetricsLevelContainer | config.MetricsLevelPod
+
+	gather := func(t *testing.T, maxTotalSeries int) *prometheus.Registry {
+		t.Helper()
+		collector := NewPowerCollector(mockMonitor, "test-node", logger, levels, WithMaxTotalSeries(maxTotalSeries))
+		registry := prometheus.NewRegistry()
+		registry.MustRegister(collector)
+
+		mockMonitor.TriggerUpdate()
+		time.Sleep(10 * time.Millisecond)
+		return registry
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		registry := gather(t, 0)
+		families, err := registry.Gather()
+		require.NoError(t, err)
+		names := metricNames(families)
+		assert.Contains(t, names, "kepler_process_cpu_watts")
+		assert.NotContains(t, names, "kepler_exporter_series_capped")
+	})
+
+	t.Run("process dropped first", func(t *testing.T) {
+		registry := gather(t, 1000)
+		families, err := registry.Gather()
+		require.NoError(t, err)
+		names := metricNames(families)
+		assert.NotContains(t, names, "kepler_process_cpu_watts")
+		assert.Contains(t, names, "kepler_container_cpu_watts")
+		assert.Contains(t, names, "kepler_pod_cpu_watts")
+		assert.Contains(t, names, "kepler_node_cpu_watts")
+
+		assertMetricLabelValues(t, registry, "kepler_exporter_series_capped", map[string]string{"level": "process"}, 1)
+		assertMetricLabelValues(t, registry, "kepler_exporter_series_capped", map[string]string{"level": "container"}, 0)
+		assertMetricLabelValues(t, registry, "kepler_exporter_series_capped", map[string]string{"level": "pod"}, 0)
+		for _, f := range families {
+			if f.GetName() == "kepler_exporter_series_capped" {
+				assert.Len(t, f.GetMetric(), 3, "only enabled levels are reported")
+			}
+		}
+	})
+
+	t.Run("then container", func(t *testing.T) {
+		registry := gather(t, 100)
+		families, err := registry.Gather()
+		require.NoError(t, err)
+		names := metricNames(families)
+		assert.NotContains(t, names, "kepler_process_cpu_watts")
+		assert.NotContains(t, names, "kepler_container_cpu_watts")
+		assert.Contains(t, names, "kepler_pod_cpu_watts")
+		assert.Contains(t, names, "kepler_node_cpu_watts")
+
+		assertMetricLabelValues(t, registry, "kepler_exporter_series_capped", map[string]string{"level": "process"}, 1)
+		assertMetricLabelValues(t, registry, "kepler_exporter_series_capped", map[string]string{"level": "container"}, 1)
+		assertMetricLabelValues(t, registry, "kepler_exporter_series_capped", map[string]string{"level": "pod"}, 0)
+	})
+
+	t.Run("within cap", func(t *testing.T) {
+		registry := gather(t, 100000)
+		assertMetricExists(t, registry, "kepler_process_cpu_watts", map[string]string{"pid": "1"})
+		assertMetricLabelValues(t, registry, "kepler_exporter_series_capped", map[string]string{"level": "process"}, 0)
+	})
+}
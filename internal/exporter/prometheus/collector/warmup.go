@@ -0,0 +1,42 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package collector
+
+import (
+	"sync/atomic"
+	"time"
+
+	prom "github.com/prometheus/client_golang/prometheus"
+)
+
+// warmupCollector withholds the metrics of a collector until a warm-up period
+// has elapsed, so that the transients of the first readings are not exported
+type warmupCollector struct {
+	collector prom.Collector
+	ready     atomic.Bool
+}
+
+// NewWarmupCollector wraps c so that it collects no metrics until warmup has
+// elapsed since its creation. Descriptions are always reported so that the
+// collector can be registered right away.
+func NewWarmupCollector(c prom.Collector, warmup time.Duration) prom.Collector {
+	w := &warmupCollector{collector: c}
+	if warmup <= 0 {
+		w.ready.Store(true)
+		return w
+	}
+	time.AfterFunc(warmup, func() { w.ready.Store(true) })
+	return w
+}
+
+func (w *warmupCollector) Describe(ch chan<- *prom.Desc) {
+	w.collector.Describe(ch)
+}
+
+func (w *warmupCollector) Collect(ch chan<- prom.Metric) {
+	if !w.ready.Load() {
+		return
+	}
+	w.collector.Collect(ch)
+}
@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package collector
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWarmupCollector(t *testing.T) {
+	gather := func(t *testing.T, registry *prometheus.Registry) []string {
+		families, err := registry.Gather()
+		require.NoError(t, err)
+		return metricNames(families)
+	}
+
+	t.Run("withholds metrics during warm-up", func(t *testing.T) {
+		registry := prometheus.NewRegistry()
+		registry.MustRegister(NewWarmupCollector(NewGPUMeterUpCollector(nil, false, "test-node"), 100*time.Millisecond))
+
+		assert.Empty(t, gather(t, registry))
+		assert.Eventually(t, func() bool {
+			return len(gather(t, registry)) == 1
+		}, time.Second, 10*time.Millisecond, "metrics must be collected after warm-up")
+	})
+
+	t.Run("no warm-up", func(t *testing.T) {
+		registry := prometheus.NewRegistry()
+		registry.MustRegister(NewWarmupCollector(NewGPUMeterUpCollector(nil, false, "test-node"), 0))
+		assert.Equal(t, []string{"kepler_gpu_meter_up"}, gather(t, registry))
+	})
+}
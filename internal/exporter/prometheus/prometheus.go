@@ -4,9 +4,11 @@
 package prometheus
 
 import (
+	"crypto/subtle"
 	"fmt"
 	"log/slog"
 	"net/http"
+	"time"
 
 	prom "github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/collectors"
@@ -34,6 +36,28 @@ type Opts struct {
 	nodeName             string
 	metricsLevel         config.Level
 	platformDataProvider collector.RedfishDataProvider
+	totalPowerSources    []string
+	gpuInPlatformTotal   bool
+	zoneNameMap          map[string]string
+	emitKWh              bool
+	includeZonePath      bool
+	disabledMetrics      []string
+	staleness            time.Duration
+	gpuMeterUp           *bool
+	cpuMethod            string
+	gpuMethod            string
+	warmup               time.Duration
+	gpuPowerPrecision    int
+	maxTotalSeries       int
+	platformInfo         *platformInfoOpts
+	configLoadedAt       time.Time
+	authToken            string
+	metricsPath          string
+}
+
+type platformInfoOpts struct {
+	sysfs    string
+	override collector.PlatformInfo
 }
 
 // DefaultOpts() returns a new Opts with defaults set
@@ -43,8 +67,10 @@ func DefaultOpts() Opts {
 		debugCollectors: map[string]bool{
 			"go": true,
 		},
-		collectors:   map[string]prom.Collector{},
-		metricsLevel: config.MetricsLevelAll,
+		collectors:         map[string]prom.Collector{},
+		metricsLevel:       config.MetricsLevelAll,
+		metricsPath:        "/metrics",
+		gpuInPlatformTotal: true,
 	}
 }
 
@@ -101,6 +127,136 @@ func WithPlatformDataProvider(provider collector.RedfishDataProvider) OptionFn {
 	}
 }
 
+// WithTotalPowerSources sets the power sources summed into the node total power metric
+func WithTotalPowerSources(sources []string) OptionFn {
+	return func(o *Opts) {
+		o.totalPowerSources = sources
+	}
+}
+
+// WithGPUInPlatformTotal declares that the platform power includes the GPU power
+func WithGPUInPlatformTotal(included bool) OptionFn {
+	return func(o *Opts) {
+		o.gpuInPlatformTotal = included
+	}
+}
+
+// WithConfigLoadTime exports the time the configuration was loaded as
+// kepler_config_last_reload_timestamp_seconds
+func WithConfigLoadTime(t time.Time) OptionFn {
+	return func(o *Opts) {
+		o.configLoadedAt = t
+	}
+}
+
+// WithZoneNameMap sets the aliases of zone names used in metric labels
+func WithZoneNameMap(m map[string]string) OptionFn {
+	return func(o *Opts) {
+		o.zoneNameMap = m
+	}
+}
+
+// WithZonePathLabel adds the zone path as a label to the workload CPU metrics
+func WithZonePathLabel(enabled bool) OptionFn {
+	return func(o *Opts) {
+		o.includeZonePath = enabled
+	}
+}
+
+// WithDisabledMetrics disables the metrics whose names match any of the glob
+// patterns: the power collector doesn't create them and the exporter drops
+// those of the other collectors
+func WithDisabledMetrics(patterns []string) OptionFn {
+	return func(o *Opts) {
+		o.disabledMetrics = patterns
+	}
+}
+
+// WithKWh enables exporting energy counters in kilowatt-hours
+func WithKWh(enabled bool) OptionFn {
+	return func(o *Opts) {
+		o.emitKWh = enabled
+	}
+}
+
+// WithStaleMarkers withholds the power metrics while the latest snapshot is
+// older than staleness so that Prometheus marks them stale
+func WithStaleMarkers(staleness time.Duration) OptionFn {
+	return func(o *Opts) {
+		o.staleness = staleness
+	}
+}
+
+// WithGPUMeterUp exports kepler_gpu_meter_up reporting whether a GPU meter
+// started and its devices are read; it should only be set when GPU monitoring
+// is enabled
+func WithGPUMeterUp(up bool) OptionFn {
+	return func(o *Opts) {
+		o.gpuMeterUp = &up
+	}
+}
+
+// WithAttributionMethods exports kepler_attribution_info reporting the methods
+// used to attribute CPU and GPU power to workloads
+func WithAttributionMethods(cpuMethod, gpuMethod string) OptionFn {
+	return func(o *Opts) {
+		o.cpuMethod = cpuMethod
+		o.gpuMethod = gpuMethod
+	}
+}
+
+// WithPlatformInfo exports kepler_node_platform_info with the cloud provider
+// and instance type detected from the DMI data under sysfs; non-empty cloud and
+// instanceType override the detected values
+func WithPlatformInfo(sysfs, cloud, instanceType string) OptionFn {
+	return func(o *Opts) {
+		o.platformInfo = &platformInfoOpts{
+			sysfs:    sysfs,
+			override: collector.PlatformInfo{Cloud: cloud, InstanceType: instanceType},
+		}
+	}
+}
+
+// WithGPUPowerPrecision rounds the GPU power gauges to the given number of
+// decimal places; a negative precision exports full precision
+func WithGPUPowerPrecision(decimals int) OptionFn {
+	return func(o *Opts) {
+		o.gpuPowerPrecision = decimals
+	}
+}
+
+// WithMaxTotalSeries caps the series exported per scrape by dropping the
+// process, container, vm and pod levels, in that order; 0 disables the cap
+func WithMaxTotalSeries(limit int) OptionFn {
+	return func(o *Opts) {
+		o.maxTotalSeries = limit
+	}
+}
+
+// WithMetricsPath sets the path the metrics are served at
+func WithMetricsPath(path string) OptionFn {
+	return func(o *Opts) {
+		o.metricsPath = path
+	}
+}
+
+// WithAuthToken requires scrapes of the metrics endpoint to present token as
+// a bearer token; empty disables authentication
+func WithAuthToken(token string) OptionFn {
+	return func(o *Opts) {
+		o.authToken = token
+	}
+}
+
+// WithWarmup withholds the power metrics until warmup has elapsed so that the
+// transients of the first readings are not exported; build and configuration
+// info is exported right away
+func WithWarmup(warmup time.Duration) OptionFn {
+	return func(o *Opts) {
+		o.warmup = warmup
+	}
+}
+
 // Exporter exports power data to Prometheus
 type Exporter struct {
 	logger          *slog.Logger
@@ -109,6 +265,9 @@ type Exporter struct {
 	server          APIRegistry
 	debugCollectors map[string]bool
 	collectors      map[string]prom.Collector
+	authToken       string
+	metricsPath     string
+	disabledMetrics []string
 }
 
 var _ Initializer = (*Exporter)(nil)
@@ -127,6 +286,9 @@ func NewExporter(pm Monitor, s APIRegistry, applyOpts ...OptionFn) *Exporter {
 		debugCollectors: opts.debugCollectors,
 		collectors:      opts.collectors,
 		registry:        prom.NewRegistry(),
+		authToken:       opts.authToken,
+		metricsPath:     opts.metricsPath,
+		disabledMetrics: opts.disabledMetrics,
 	}
 
 	return exporter
@@ -145,34 +307,97 @@ func collectorForName(name string) (prom.Collector, error) {
 
 func CreateCollectors(pm Monitor, applyOpts ...OptionFn) (map[string]prom.Collector, error) {
 	opts := Opts{
-		logger:       slog.Default(),
-		procfs:       "/proc",
-		metricsLevel: config.MetricsLevelAll,
+		logger:            slog.Default(),
+		procfs:            "/proc",
+		metricsLevel:      config.MetricsLevelAll,
+		gpuPowerPrecision: -1,
 	}
 	for _, apply := range applyOpts {
 		apply(&opts)
 	}
 	collectors := map[string]prom.Collector{
-		"build_info": collector.NewKeplerBuildInfoCollector(),
-		"power":      collector.NewPowerCollector(pm, opts.nodeName, opts.logger, opts.metricsLevel),
+		"build_info": collector.NewKeplerBuildInfoCollector(opts.nodeName),
+		"power": collector.NewPowerCollector(pm, opts.nodeName, opts.logger, opts.metricsLevel,
+			collector.WithZoneNameMap(opts.zoneNameMap),
+			collector.WithKWh(opts.emitKWh),
+			collector.WithZonePathLabel(opts.includeZonePath),
+			collector.WithStaleMarkers(opts.staleness),
+			collector.WithGPUPowerPrecision(opts.gpuPowerPrecision),
+			collector.WithMaxTotalSeries(opts.maxTotalSeries),
+			collector.WithDisabledMetrics(opts.disabledMetrics)),
 	}
-	cpuInfoCollector, err := collector.NewCPUInfoCollector(opts.procfs)
+	cpuInfoCollector, err := collector.NewCPUInfoCollector(opts.procfs, opts.nodeName)
 	if err != nil {
 		return nil, err
 	}
 	collectors["cpu_info"] = cpuInfoCollector
 
+	cpuModelInfoCollector, err := collector.NewCPUModelInfoCollector(opts.procfs, pm, opts.nodeName)
+	if err != nil {
+		return nil, err
+	}
+	collectors["cpu_model_info"] = cpuModelInfoCollector
+
+	selfCPUCollector, err := collector.NewSelfCPUCollector(opts.procfs, opts.nodeName)
+	if err != nil {
+		return nil, err
+	}
+	collectors["self_cpu"] = selfCPUCollector
+
 	// Add GPU info collector
 	collectors["gpu_info"] = collector.NewGPUInfoCollector(pm, opts.nodeName)
 
+	if opts.gpuMeterUp != nil {
+		collectors["gpu_meter_up"] = collector.NewGPUMeterUpCollector(pm, *opts.gpuMeterUp, opts.nodeName)
+	}
+
+	if opts.cpuMethod != "" {
+		collectors["attribution_info"] = collector.NewAttributionInfoCollector(
+			opts.cpuMethod, opts.gpuMethod, opts.nodeName)
+	}
+
+	if !opts.configLoadedAt.IsZero() {
+		collectors["config_reload"] = collector.NewConfigReloadCollector(opts.configLoadedAt, opts.nodeName)
+	}
+
+	if pi := opts.platformInfo; pi != nil {
+		collectors["platform_info"] = collector.NewPlatformInfoCollector(pi.sysfs, pi.override, opts.nodeName)
+	}
+
+	if p, ok := pm.(collector.ConsecutiveErrorsProvider); ok {
+		collectors["consecutive_errors"] = collector.NewConsecutiveErrorsCollector(p, opts.nodeName)
+	}
+
 	// Add platform collector if platform data provider is available
 	if opts.platformDataProvider != nil {
 		collectors["platform"] = collector.NewRedfishCollector(opts.platformDataProvider, opts.logger)
 	}
 
+	if opts.metricsLevel.IsNodeEnabled() && opts.platformDataProvider != nil {
+		collectors["node_other"] = collector.NewNodeOtherCollector(
+			pm, opts.platformDataProvider, opts.gpuInPlatformTotal, opts.nodeName, opts.logger)
+	}
+
+	if opts.metricsLevel.IsNodeEnabled() && len(opts.totalPowerSources) > 0 {
+		collectors["node_total"] = collector.NewNodeTotalCollector(
+			pm, opts.platformDataProvider, opts.totalPowerSources, opts.gpuInPlatformTotal, opts.nodeName, opts.logger)
+	}
+
+	if opts.warmup > 0 {
+		for _, name := range warmupCollectors {
+			if c, ok := collectors[name]; ok {
+				collectors[name] = collector.NewWarmupCollector(c, opts.warmup)
+			}
+		}
+	}
+
 	return collectors, nil
 }
 
+// warmupCollectors are the collectors reporting readings, which are withheld
+// during warm-up; the others only report build and configuration info
+var warmupCollectors = []string{"power", "consecutive_errors", "platform", "node_other", "node_total"}
+
 func (e *Exporter) Init() error {
 	e.logger.Info("Initializing Prometheus exporter")
 	for c := range e.debugCollectors {
@@ -190,17 +415,38 @@ func (e *Exporter) Init() error {
 		e.registry.MustRegister(collector)
 	}
 
-	err := e.server.Register("/metrics", "Metrics", "Prometheus metrics",
-		promhttp.HandlerFor(
-			e.registry,
-			promhttp.HandlerOpts{
-				EnableOpenMetrics: true,
-				Registry:          e.registry,
-			},
-		))
+	var handler http.Handler = promhttp.HandlerFor(
+		collector.NewFilterGatherer(e.registry, e.disabledMetrics),
+		promhttp.HandlerOpts{
+			EnableOpenMetrics: true,
+			Registry:          e.registry,
+		},
+	)
+	if e.authToken != "" {
+		e.logger.Info("Requiring bearer token to scrape metrics")
+		handler = bearerTokenHandler(e.authToken, handler)
+	}
+
+	e.logger.Info("Serving metrics", "path", e.metricsPath)
+	err := e.server.Register(e.metricsPath, "Metrics", "Prometheus metrics", handler)
 	return err
 }
 
+// bearerTokenHandler rejects requests whose Authorization header does not
+// hold token as a bearer token with 401
+func bearerTokenHandler(token string, next http.Handler) http.Handler {
+	expected := []byte("Bearer " + token)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got := []byte(r.Header.Get("Authorization"))
+		if subtle.ConstantTimeCompare(got, expected) != 1 {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="kepler"`)
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
 // Name implements service.Name
 func (e *Exporter) Name() string {
 	return "prometheus"
@@ -8,13 +8,19 @@ import (
 	"errors"
 	"log/slog"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
 	prom "github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/sustainable-computing-io/kepler/config"
+	"github.com/sustainable-computing-io/kepler/internal/device"
 	"github.com/sustainable-computing-io/kepler/internal/monitor"
+	"github.com/sustainable-computing-io/kepler/internal/platform/redfish"
 )
 
 // MockMonitor mocks the Monitor interface
@@ -204,6 +210,42 @@ func TestExporter_Init(t *testing.T) {
 	})
 }
 
+func TestExporter_AuthToken(t *testing.T) {
+	mockMonitor := &MockMonitor{}
+	mockRegistry := &MockAPIRegistry{}
+
+	var handler http.Handler
+	mockRegistry.On("Register", "/metrics", "Metrics", "Prometheus metrics", mock.Anything).
+		Run(func(args mock.Arguments) { handler = args.Get(3).(http.Handler) }).
+		Return(nil)
+
+	exporter := NewExporter(mockMonitor, mockRegistry, WithAuthToken("s3cr3t"))
+	require.NoError(t, exporter.Init())
+	require.NotNil(t, handler)
+
+	tt := []struct {
+		name          string
+		authorization string
+		status        int
+	}{
+		{"no token", "", http.StatusUnauthorized},
+		{"wrong token", "Bearer wrong", http.StatusUnauthorized},
+		{"not a bearer token", "Basic s3cr3t", http.StatusUnauthorized},
+		{"valid token", "Bearer s3cr3t", http.StatusOK},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+			if tc.authorization != "" {
+				req.Header.Set("Authorization", tc.authorization)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			assert.Equal(t, tc.status, rec.Code)
+		})
+	}
+}
+
 func TestCollectorForName(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -283,6 +325,43 @@ func TestDefaultOpts(t *testing.T) {
 	assert.NotNil(t, opts.logger)
 	assert.NotNil(t, opts.debugCollectors)
 	assert.True(t, opts.debugCollectors["go"])
+	assert.Equal(t, "/metrics", opts.metricsPath)
+}
+
+// muxRegistry registers endpoints on an http.ServeMux
+type muxRegistry struct {
+	*http.ServeMux
+}
+
+func (r muxRegistry) Register(endpoint, summary, description string, handler http.Handler) error {
+	r.Handle(endpoint, handler)
+	return nil
+}
+
+func TestExporter_MetricsPath(t *testing.T) {
+	serve := func(t *testing.T, opts ...OptionFn) *http.ServeMux {
+		t.Helper()
+		mux := http.NewServeMux()
+		exporter := NewExporter(&MockMonitor{}, muxRegistry{mux}, opts...)
+		require.NoError(t, exporter.Init())
+		return mux
+	}
+	get := func(mux *http.ServeMux, path string) int {
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		return rec.Code
+	}
+
+	t.Run("default path", func(t *testing.T) {
+		mux := serve(t)
+		assert.Equal(t, http.StatusOK, get(mux, "/metrics"))
+	})
+
+	t.Run("custom path", func(t *testing.T) {
+		mux := serve(t, WithMetricsPath("/kepler/metrics"))
+		assert.Equal(t, http.StatusOK, get(mux, "/kepler/metrics"))
+		assert.Equal(t, http.StatusNotFound, get(mux, "/metrics"))
+	})
 }
 
 func TestExporter_Integration(t *testing.T) {
@@ -324,5 +403,281 @@ func TestExporter_CreateCollectors(t *testing.T) {
 	mockMonitor.AssertExpectations(t)
 
 	assert.NoError(t, err)
-	assert.Len(t, coll, 4) // build_info, power, cpu_info, gpu_info
+	assert.Len(t, coll, 6) // build_info, power, cpu_info, cpu_model_info, self_cpu, gpu_info
+}
+
+func TestExporter_CreateCollectors_AttributionInfo(t *testing.T) {
+	mockMonitor := &MockMonitor{}
+	mockMonitor.On("DataChannel").Return(make(<-chan struct{}))
+
+	coll, err := CreateCollectors(mockMonitor, WithProcFSPath("/proc"))
+	require.NoError(t, err)
+	assert.NotContains(t, coll, "attribution_info")
+
+	coll, err = CreateCollectors(mockMonitor,
+		WithProcFSPath("/proc"),
+		WithAttributionMethods("cputime-total", "utilization"),
+	)
+	require.NoError(t, err)
+	assert.Contains(t, coll, "attribution_info")
+}
+
+func TestExporter_DisabledMetrics(t *testing.T) {
+	mockMonitor := &MockMonitor{}
+	mockMonitor.On("DataChannel").Return(make(<-chan struct{}))
+
+	coll, err := CreateCollectors(mockMonitor,
+		WithProcFSPath("/proc"),
+		WithNodeName("test-node"),
+		WithDisabledMetrics([]string{"kepler_build_*"}),
+	)
+	require.NoError(t, err)
+
+	mux := http.NewServeMux()
+	exporter := NewExporter(mockMonitor, muxRegistry{mux},
+		WithCollectors(map[string]prom.Collector{"build_info": coll["build_info"]}),
+		WithDisabledMetrics([]string{"kepler_build_*"}),
+	)
+	require.NoError(t, exporter.Init())
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.NotContains(t, rec.Body.String(), "kepler_build_info", "kepler_build_info must be disabled")
+}
+
+func TestExporter_CreateCollectors_PlatformInfo(t *testing.T) {
+	mockMonitor := &MockMonitor{}
+	mockMonitor.On("DataChannel").Return(make(<-chan struct{}))
+
+	coll, err := CreateCollectors(mockMonitor, WithProcFSPath("/proc"))
+	require.NoError(t, err)
+	assert.NotContains(t, coll, "platform_info")
+
+	coll, err = CreateCollectors(mockMonitor,
+		WithProcFSPath("/proc"),
+		WithPlatformInfo(t.TempDir(), "aws", ""),
+	)
+	require.NoError(t, err)
+	assert.Contains(t, coll, "platform_info")
+}
+
+func TestExporter_CreateCollectors_ConfigReload(t *testing.T) {
+	mockMonitor := &MockMonitor{}
+	mockMonitor.On("DataChannel").Return(make(<-chan struct{}))
+
+	coll, err := CreateCollectors(mockMonitor, WithProcFSPath("/proc"))
+	require.NoError(t, err)
+	assert.NotContains(t, coll, "config_reload")
+
+	coll, err = CreateCollectors(mockMonitor,
+		WithProcFSPath("/proc"),
+		WithConfigLoadTime(time.Unix(1700000000, 0)),
+	)
+	require.NoError(t, err)
+	assert.Contains(t, coll, "config_reload")
+}
+
+func TestExporter_CreateCollectors_NodeNameLabel(t *testing.T) {
+	pkg := device.NewMockRaplZone("package", 0, "/sys/class/powercap/intel-rapl/intel-rapl:0", 1000)
+
+	snapshot := monitor.NewSnapshot()
+	snapshot.Timestamp = time.Now()
+	snapshot.Node = &monitor.Node{
+		Timestamp: time.Now(),
+		Zones: monitor.NodeZoneUsageMap{
+			pkg: {EnergyTotal: 100 * device.Joule, Power: 40 * device.Watt},
+		},
+	}
+	snapshot.Processes = monitor.Processes{
+		"1": {PID: 1, Comm: "init", Zones: monitor.ZoneUsageMap{
+			pkg: {EnergyTotal: 50 * device.Joule, Power: 20 * device.Watt},
+		}},
+	}
+
+	dataCh := make(chan struct{}, 1)
+	dataCh <- struct{}{}
+	mockMonitor := &MockMonitor{}
+	mockMonitor.On("DataChannel").Return((<-chan struct{})(dataCh))
+	mockMonitor.On("Snapshot").Return(snapshot, nil)
+	mockMonitor.On("ZoneNames").Return([]string{"package"})
+
+	coll, err := CreateCollectors(mockMonitor,
+		WithProcFSPath("/proc"),
+		WithNodeName("node-a"),
+	)
+	require.NoError(t, err)
+
+	registry := prom.NewRegistry()
+	for _, c := range coll {
+		registry.MustRegister(c)
+	}
+
+	var families []*dto.MetricFamily
+	require.Eventually(t, func() bool {
+		families, err = registry.Gather()
+		require.NoError(t, err)
+		for _, mf := range families {
+			if mf.GetName() == "kepler_process_cpu_watts" {
+				return true
+			}
+		}
+		return false
+	}, time.Second, 10*time.Millisecond)
+
+	names := map[string]bool{}
+	for _, mf := range families {
+		names[mf.GetName()] = true
+		for _, m := range mf.GetMetric() {
+			var node string
+			for _, l := range m.GetLabel() {
+				if l.GetName() == "node_name" {
+					node = l.GetValue()
+				}
+			}
+			assert.Equal(t, "node-a", node, "%s must carry the node_name label", mf.GetName())
+		}
+	}
+	assert.True(t, names["kepler_node_cpu_watts"])
+	assert.True(t, names["kepler_process_cpu_watts"])
+	assert.True(t, names["kepler_build_info"])
+}
+
+func TestExporter_CreateCollectors_Warmup(t *testing.T) {
+	pkg := device.NewMockRaplZone("package", 0, "/sys/class/powercap/intel-rapl/intel-rapl:0", 1000)
+
+	snapshot := monitor.NewSnapshot()
+	snapshot.Timestamp = time.Now()
+	snapshot.Node = &monitor.Node{
+		Timestamp: time.Now(),
+		Zones: monitor.NodeZoneUsageMap{
+			pkg: {EnergyTotal: 100 * device.Joule, Power: 40 * device.Watt},
+		},
+	}
+
+	dataCh := make(chan struct{}, 1)
+	dataCh <- struct{}{}
+	mockMonitor := &MockMonitor{}
+	mockMonitor.On("DataChannel").Return((<-chan struct{})(dataCh))
+	mockMonitor.On("Snapshot").Return(snapshot, nil)
+	mockMonitor.On("ZoneNames").Return([]string{"package"})
+
+	coll, err := CreateCollectors(mockMonitor,
+		WithProcFSPath("/proc"),
+		WithTotalPowerSources([]string{"cpu"}),
+		WithWarmup(300*time.Millisecond),
+	)
+	require.NoError(t, err)
+
+	registry := prom.NewRegistry()
+	for _, c := range coll {
+		registry.MustRegister(c)
+	}
+
+	gather := func() map[string]bool {
+		families, err := registry.Gather()
+		require.NoError(t, err)
+		names := map[string]bool{}
+		for _, mf := range families {
+			names[mf.GetName()] = true
+		}
+		return names
+	}
+
+	// wait for the power collector to receive data from the monitor
+	time.Sleep(50 * time.Millisecond)
+	names := gather()
+	assert.True(t, names["kepler_build_info"], "build info must be exported during warm-up")
+	assert.False(t, names["kepler_node_cpu_watts"], "power must be withheld during warm-up")
+	assert.False(t, names["kepler_node_total_watts"], "power must be withheld during warm-up")
+
+	assert.Eventually(t, func() bool {
+		names := gather()
+		return names["kepler_node_cpu_watts"] && names["kepler_node_total_watts"]
+	}, 2*time.Second, 20*time.Millisecond, "power must be exported after warm-up")
+}
+
+func TestExporter_CreateCollectors_NodeTotal(t *testing.T) {
+	mockMonitor := &MockMonitor{}
+	mockMonitor.On("DataChannel").Return(make(<-chan struct{}))
+
+	coll, err := CreateCollectors(
+		mockMonitor,
+		WithLogger(slog.Default()),
+		WithProcFSPath("/proc"),
+		WithTotalPowerSources([]string{"cpu", "gpu"}),
+	)
+	time.Sleep(50 * time.Millisecond)
+
+	assert.NoError(t, err)
+	assert.Contains(t, coll, "node_total")
+
+	// node total is a node level metric
+	coll, err = CreateCollectors(
+		mockMonitor,
+		WithLogger(slog.Default()),
+		WithProcFSPath("/proc"),
+		WithMetricsLevel(config.MetricsLevelPod),
+		WithTotalPowerSources([]string{"cpu", "gpu"}),
+	)
+	time.Sleep(50 * time.Millisecond)
+
+	assert.NoError(t, err)
+	assert.NotContains(t, coll, "node_total")
+}
+
+func TestExporter_CreateCollectors_GPUMeterUp(t *testing.T) {
+	mockMonitor := &MockMonitor{}
+	mockMonitor.On("DataChannel").Return(make(<-chan struct{}))
+
+	coll, err := CreateCollectors(mockMonitor, WithProcFSPath("/proc"))
+	assert.NoError(t, err)
+	assert.NotContains(t, coll, "gpu_meter_up")
+
+	coll, err = CreateCollectors(mockMonitor, WithProcFSPath("/proc"), WithGPUMeterUp(false))
+	assert.NoError(t, err)
+	assert.Contains(t, coll, "gpu_meter_up")
+}
+
+// erroringMonitor is a MockMonitor that reports consecutive failed collections
+type erroringMonitor struct {
+	MockMonitor
+}
+
+func (m *erroringMonitor) ConsecutiveErrors() int {
+	return 2
+}
+
+func TestExporter_CreateCollectors_ConsecutiveErrors(t *testing.T) {
+	mockMonitor := &MockMonitor{}
+	mockMonitor.On("DataChannel").Return(make(<-chan struct{}))
+	coll, err := CreateCollectors(mockMonitor, WithProcFSPath("/proc"))
+	assert.NoError(t, err)
+	assert.NotContains(t, coll, "consecutive_errors")
+
+	erroring := &erroringMonitor{}
+	erroring.On("DataChannel").Return(make(<-chan struct{}))
+	coll, err = CreateCollectors(erroring, WithProcFSPath("/proc"))
+	assert.NoError(t, err)
+	assert.Contains(t, coll, "consecutive_errors")
+}
+
+func TestExporter_CreateCollectors_NodeOther(t *testing.T) {
+	mockMonitor := &MockMonitor{}
+	mockMonitor.On("DataChannel").Return(make(<-chan struct{}))
+
+	coll, err := CreateCollectors(mockMonitor, WithProcFSPath("/proc"))
+	assert.NoError(t, err)
+	assert.NotContains(t, coll, "node_other")
+
+	platform := &redfish.Service{}
+	coll, err = CreateCollectors(mockMonitor, WithProcFSPath("/proc"), WithPlatformDataProvider(platform))
+	assert.NoError(t, err)
+	assert.Contains(t, coll, "node_other")
+
+	// node other is a node level metric
+	coll, err = CreateCollectors(mockMonitor, WithProcFSPath("/proc"),
+		WithPlatformDataProvider(platform), WithMetricsLevel(config.MetricsLevelPod))
+	assert.NoError(t, err)
+	assert.NotContains(t, coll, "node_other")
 }
@@ -0,0 +1,247 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pushgateway
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+
+	prom "github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/push"
+	"github.com/sustainable-computing-io/kepler/internal/exporter/prometheus/collector"
+	"github.com/sustainable-computing-io/kepler/internal/service"
+)
+
+type (
+	Initializer = service.Initializer
+	Runner      = service.Runner
+)
+
+const (
+	// job is the job label of the pushed metrics
+	job = "kepler"
+
+	// groupingLabel groups the pushed metrics per node. The collectors already
+	// add a node_name label, which the Pushgateway client does not allow to
+	// overlap with the grouping key.
+	groupingLabel = "instance"
+)
+
+// Exporter periodically pushes metrics to a Prometheus Pushgateway
+type Exporter struct {
+	logger      *slog.Logger
+	url         string
+	nodeName    string
+	interval    time.Duration
+	maxAttempts int
+	retryDelay  time.Duration
+	client      push.HTTPDoer
+	collectors  map[string]prom.Collector
+	registry    *prom.Registry
+	gatherer    prom.Gatherer
+
+	retries prom.Counter
+	dropped prom.Counter
+}
+
+var (
+	_ Initializer = (*Exporter)(nil)
+	_ Runner      = (*Exporter)(nil)
+)
+
+type Opts struct {
+	logger      *slog.Logger
+	nodeName    string
+	interval    time.Duration
+	maxAttempts int
+	retryDelay  time.Duration
+	client      push.HTTPDoer
+	collectors  map[string]prom.Collector
+	disabled    []string
+}
+
+// DefaultOpts() returns a new Opts with defaults set
+func DefaultOpts() Opts {
+	return Opts{
+		logger:      slog.Default(),
+		interval:    30 * time.Second,
+		maxAttempts: 3,
+		retryDelay:  time.Second,
+		client:      &http.Client{Timeout: 10 * time.Second},
+		collectors:  map[string]prom.Collector{},
+	}
+}
+
+// OptionFn is a function sets one more more options in Opts struct
+type OptionFn func(*Opts)
+
+// WithLogger sets the logger for the Exporter
+func WithLogger(logger *slog.Logger) OptionFn {
+	return func(o *Opts) {
+		o.logger = logger
+	}
+}
+
+// WithNodeName sets the node name used as the grouping key of the pushed
+// metrics; it is required
+func WithNodeName(nodeName string) OptionFn {
+	return func(o *Opts) {
+		o.nodeName = nodeName
+	}
+}
+
+// WithInterval sets the interval between pushes
+func WithInterval(interval time.Duration) OptionFn {
+	return func(o *Opts) {
+		o.interval = interval
+	}
+}
+
+// WithRetry sets the number of attempts made for each push and the delay
+// between them; a push that fails every attempt is dropped
+func WithRetry(maxAttempts int, delay time.Duration) OptionFn {
+	return func(o *Opts) {
+		o.maxAttempts = maxAttempts
+		o.retryDelay = delay
+	}
+}
+
+// WithClient sets the HTTP client used to push
+func WithClient(c push.HTTPDoer) OptionFn {
+	return func(o *Opts) {
+		o.client = c
+	}
+}
+
+// WithCollectors sets the collectors whose metrics are pushed. They may be
+// shared with other exporters.
+func WithCollectors(c map[string]prom.Collector) OptionFn {
+	return func(o *Opts) {
+		o.collectors = c
+	}
+}
+
+// WithDisabledMetrics drops the metrics whose names match any of the glob
+// patterns from the pushed metrics
+func WithDisabledMetrics(patterns []string) OptionFn {
+	return func(o *Opts) {
+		o.disabled = patterns
+	}
+}
+
+// NewExporter creates a new Pushgateway exporter pushing to url
+func NewExporter(url string, applyOpts ...OptionFn) *Exporter {
+	opts := DefaultOpts()
+	for _, apply := range applyOpts {
+		apply(&opts)
+	}
+
+	registry := prom.NewRegistry()
+	return &Exporter{
+		logger:      opts.logger.With("service", "pushgateway"),
+		url:         url,
+		nodeName:    opts.nodeName,
+		interval:    opts.interval,
+		maxAttempts: max(opts.maxAttempts, 1),
+		retryDelay:  opts.retryDelay,
+		client:      opts.client,
+		collectors:  opts.collectors,
+		registry:    registry,
+		gatherer:    collector.NewFilterGatherer(registry, opts.disabled),
+		retries: prom.NewCounter(prom.CounterOpts{
+			Namespace: "kepler",
+			Subsystem: "pushgateway",
+			Name:      "push_retries_total",
+			Help:      "Number of retried pushes to the Pushgateway",
+		}),
+		dropped: prom.NewCounter(prom.CounterOpts{
+			Namespace: "kepler",
+			Subsystem: "pushgateway",
+			Name:      "push_dropped_total",
+			Help:      "Number of pushes dropped after exhausting all retries",
+		}),
+	}
+}
+
+// Name implements service.Name
+func (e *Exporter) Name() string {
+	return "pushgateway"
+}
+
+func (e *Exporter) Init() error {
+	e.logger.Info("Initializing Pushgateway exporter", "url", redactURL(e.url), "interval", e.interval)
+	// metrics of all nodes would overwrite each other under an empty
+	// grouping key
+	if e.nodeName == "" {
+		return fmt.Errorf("node name required for the %s grouping label", groupingLabel)
+	}
+	for name, c := range e.collectors {
+		if err := e.registry.Register(c); err != nil {
+			return fmt.Errorf("failed to register collector %s: %w", name, err)
+		}
+	}
+	e.registry.MustRegister(e.retries, e.dropped)
+	return nil
+}
+
+func (e *Exporter) Run(ctx context.Context) error {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			e.pushWithRetry(ctx)
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// pushWithRetry pushes the current metrics, retrying failed pushes up to
+// maxAttempts; the metrics are dropped if every attempt fails
+func (e *Exporter) pushWithRetry(ctx context.Context) {
+	var err error
+	for attempt := 1; attempt <= e.maxAttempts; attempt++ {
+		if attempt > 1 {
+			e.retries.Inc()
+			select {
+			case <-time.After(e.retryDelay):
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		if err = e.push(ctx); err == nil {
+			return
+		}
+		e.logger.Debug("Push to Pushgateway failed", "attempt", attempt, "error", err)
+	}
+
+	e.dropped.Inc()
+	e.logger.Warn("Dropping metrics; push to Pushgateway failed",
+		"attempts", e.maxAttempts, "error", err)
+}
+
+// redactURL masks the password of a URL, e.g. of basic auth credentials, so
+// that it can be logged
+func redactURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "<invalid>"
+	}
+	return u.Redacted()
+}
+
+func (e *Exporter) push(ctx context.Context) error {
+	return push.New(e.url, job).
+		Client(e.client).
+		Gatherer(e.gatherer).
+		Grouping(groupingLabel, e.nodeName).
+		PushContext(ctx)
+}
@@ -0,0 +1,332 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package statsd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"maps"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sustainable-computing-io/kepler/config"
+	"github.com/sustainable-computing-io/kepler/internal/monitor"
+	"github.com/sustainable-computing-io/kepler/internal/service"
+)
+
+type (
+	Initializer = service.Initializer
+	Runner      = service.Runner
+	Shutdowner  = service.Shutdowner
+	Monitor     = monitor.PowerDataProvider
+)
+
+const (
+	// prefix of all metric names sent
+	prefix = "kepler."
+
+	// droppedMetric reports the number of packets dropped since the previous
+	// send, similar to the client telemetry of DogStatsD clients
+	droppedMetric = prefix + "statsd.dropped_packets"
+)
+
+// Exporter periodically sends the node, process and container power and
+// energy as statsd gauges over UDP. Tags are sent in the DogStatsD format.
+type Exporter struct {
+	logger        *slog.Logger
+	monitor       Monitor
+	address       string
+	interval      time.Duration
+	maxPacketSize int
+	metricsLevel  config.Level
+	tags          string // formatted constant tags, e.g. "env:prod,node_name:n1"
+
+	conn io.WriteCloser
+
+	// dropped counts the packets that failed to send since they were last
+	// reported
+	dropped int
+}
+
+var (
+	_ Initializer = (*Exporter)(nil)
+	_ Runner      = (*Exporter)(nil)
+	_ Shutdowner  = (*Exporter)(nil)
+)
+
+type Opts struct {
+	logger        *slog.Logger
+	interval      time.Duration
+	maxPacketSize int
+	metricsLevel  config.Level
+	nodeName      string
+	tags          map[string]string
+}
+
+// DefaultOpts() returns a new Opts with defaults set
+func DefaultOpts() Opts {
+	return Opts{
+		logger:        slog.Default(),
+		interval:      10 * time.Second,
+		maxPacketSize: 1432,
+		metricsLevel:  config.MetricsLevelAll,
+	}
+}
+
+// OptionFn is a function sets one more more options in Opts struct
+type OptionFn func(*Opts)
+
+// WithLogger sets the logger for the Exporter
+func WithLogger(logger *slog.Logger) OptionFn {
+	return func(o *Opts) {
+		o.logger = logger
+	}
+}
+
+// WithInterval sets the interval between sends
+func WithInterval(interval time.Duration) OptionFn {
+	return func(o *Opts) {
+		o.interval = interval
+	}
+}
+
+// WithMaxPacketSize sets the maximum size of a UDP packet; metrics are batched
+// into packets of up to this size
+func WithMaxPacketSize(size int) OptionFn {
+	return func(o *Opts) {
+		o.maxPacketSize = size
+	}
+}
+
+// WithMetricsLevel sets which of the node, process and container metrics are sent
+func WithMetricsLevel(level config.Level) OptionFn {
+	return func(o *Opts) {
+		o.metricsLevel = level
+	}
+}
+
+// WithNodeName sets the node_name tag added to all metrics
+func WithNodeName(nodeName string) OptionFn {
+	return func(o *Opts) {
+		o.nodeName = nodeName
+	}
+}
+
+// WithTags sets additional tags added to all metrics
+func WithTags(tags map[string]string) OptionFn {
+	return func(o *Opts) {
+		o.tags = tags
+	}
+}
+
+// NewExporter creates a new statsd exporter sending to the UDP address
+func NewExporter(pm Monitor, address string, applyOpts ...OptionFn) *Exporter {
+	opts := DefaultOpts()
+	for _, apply := range applyOpts {
+		apply(&opts)
+	}
+
+	tags := maps.Clone(opts.tags)
+	if tags == nil {
+		tags = map[string]string{}
+	}
+	if opts.nodeName != "" {
+		tags["node_name"] = opts.nodeName
+	}
+
+	return &Exporter{
+		logger:        opts.logger.With("service", "statsd"),
+		monitor:       pm,
+		address:       address,
+		interval:      opts.interval,
+		maxPacketSize: max(opts.maxPacketSize, 1),
+		metricsLevel:  opts.metricsLevel,
+		tags:          formatTags(tags),
+	}
+}
+
+// Name implements service.Name
+func (e *Exporter) Name() string {
+	return "statsd"
+}
+
+func (e *Exporter) Init() error {
+	e.logger.Info("Initializing statsd exporter", "address", e.address, "interval", e.interval)
+	conn, err := net.Dial("udp", e.address)
+	if err != nil {
+		return fmt.Errorf("failed to connect to statsd at %s: %w", e.address, err)
+	}
+	e.conn = conn
+	return nil
+}
+
+func (e *Exporter) Run(ctx context.Context) error {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			snapshot, err := e.monitor.Snapshot()
+			if err != nil {
+				e.logger.Warn("Failed to get snapshot; skipping send", "error", err)
+				continue
+			}
+			e.send(snapshot)
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (e *Exporter) Shutdown() error {
+	if e.conn == nil {
+		return nil
+	}
+	return e.conn.Close()
+}
+
+// send writes the metrics of the snapshot in packets of up to maxPacketSize
+// bytes; packets that fail to send are dropped and reported in the next send
+func (e *Exporter) send(snapshot *monitor.Snapshot) {
+	b := &batch{maxSize: e.maxPacketSize, flush: e.write}
+
+	if e.dropped > 0 {
+		b.add(e.line(droppedMetric, float64(e.dropped), "c"))
+		e.dropped = 0
+	}
+
+	if e.metricsLevel.IsNodeEnabled() && snapshot.Node != nil {
+		for zone, usage := range snapshot.Node.Zones {
+			tags := zoneTags(zone)
+			b.add(e.line(prefix+"node.cpu.watts", usage.Power.Watts(), "g", tags...))
+			b.add(e.line(prefix+"node.cpu.joules", usage.EnergyTotal.Joules(), "g", tags...))
+		}
+	}
+
+	if e.metricsLevel.IsProcessEnabled() {
+		for _, p := range snapshot.Processes {
+			// aged processes are only sent in the aggregate
+			if p.Aged {
+				continue
+			}
+			for zone, usage := range p.Zones {
+				tags := append(zoneTags(zone),
+					"pid:"+strconv.Itoa(p.PID),
+					"comm:"+tagValue(p.Comm),
+					"container_id:"+p.ContainerID,
+				)
+				b.add(e.line(prefix+"process.cpu.watts", usage.Power.Watts(), "g", tags...))
+				b.add(e.line(prefix+"process.cpu.joules", usage.EnergyTotal.Joules(), "g", tags...))
+			}
+		}
+		for _, zone := range slices.Sorted(maps.Keys(snapshot.AgedProcessesEnergy)) {
+			b.add(e.line(prefix+"process.aged.joules", snapshot.AgedProcessesEnergy[zone].Joules(), "g", "zone:"+zone))
+		}
+	}
+
+	if e.metricsLevel.IsContainerEnabled() {
+		for id, c := range snapshot.Containers {
+			for zone, usage := range c.Zones {
+				tags := append(zoneTags(zone),
+					"container_id:"+id,
+					"container_name:"+tagValue(c.Name),
+				)
+				b.add(e.line(prefix+"container.cpu.watts", usage.Power.Watts(), "g", tags...))
+				b.add(e.line(prefix+"container.cpu.joules", usage.EnergyTotal.Joules(), "g", tags...))
+			}
+		}
+	}
+
+	b.flushPending()
+}
+
+// write sends a packet, counting it as dropped if it fails
+func (e *Exporter) write(packet []byte) {
+	if _, err := e.conn.Write(packet); err != nil {
+		e.dropped++
+		e.logger.Debug("Dropping statsd packet", "bytes", len(packet), "error", err)
+	}
+}
+
+// line formats a metric in the DogStatsD format, e.g.
+// kepler.node.cpu.watts:12.5|g|#zone:package,node_name:n1
+func (e *Exporter) line(name string, value float64, typ string, tags ...string) string {
+	sb := strings.Builder{}
+	sb.WriteString(name)
+	sb.WriteByte(':')
+	sb.WriteString(strconv.FormatFloat(value, 'f', -1, 64))
+	sb.WriteByte('|')
+	sb.WriteString(typ)
+
+	if len(tags) == 0 && e.tags == "" {
+		return sb.String()
+	}
+	sb.WriteString("|#")
+	sb.WriteString(strings.Join(tags, ","))
+	if len(tags) > 0 && e.tags != "" {
+		sb.WriteByte(',')
+	}
+	sb.WriteString(e.tags)
+	return sb.String()
+}
+
+// batch joins lines into newline separated packets of up to maxSize bytes
+type batch struct {
+	maxSize int
+	buf     bytes.Buffer
+	flush   func([]byte)
+}
+
+func (b *batch) add(line string) {
+	if b.buf.Len() > 0 && b.buf.Len()+1+len(line) > b.maxSize {
+		b.flushPending()
+	}
+	if b.buf.Len() > 0 {
+		b.buf.WriteByte('\n')
+	}
+	// a line longer than maxSize is sent in a packet of its own
+	b.buf.WriteString(line)
+}
+
+func (b *batch) flushPending() {
+	if b.buf.Len() == 0 {
+		return
+	}
+	b.flush(bytes.Clone(b.buf.Bytes()))
+	b.buf.Reset()
+}
+
+// zoneTags returns the tags of a zone; the index tells apart zones sharing a
+// name, e.g. the package zone of each socket with per socket zones
+func zoneTags(zone monitor.EnergyZone) []string {
+	return []string{"zone:" + zone.Name(), "zone_index:" + strconv.Itoa(zone.Index())}
+}
+
+// formatTags formats tags sorted by key as k:v pairs separated by commas
+func formatTags(tags map[string]string) string {
+	pairs := make([]string, 0, len(tags))
+	for _, k := range slices.Sorted(maps.Keys(tags)) {
+		pairs = append(pairs, k+":"+tagValue(tags[k]))
+	}
+	return strings.Join(pairs, ",")
+}
+
+// tagValue replaces the characters that delimit tags and metrics in the
+// DogStatsD format
+func tagValue(v string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case ',', '|', '#', '\n':
+			return '_'
+		}
+		return r
+	}, v)
+}
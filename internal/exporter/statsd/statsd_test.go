@@ -0,0 +1,240 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package statsd
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/sustainable-computing-io/kepler/config"
+	"github.com/sustainable-computing-io/kepler/internal/device"
+	"github.com/sustainable-computing-io/kepler/internal/monitor"
+)
+
+// MockMonitor mocks the Monitor interface
+type MockMonitor struct {
+	mock.Mock
+}
+
+func (m *MockMonitor) Snapshot() (*monitor.Snapshot, error) {
+	args := m.Called()
+	if s := args.Get(0); s != nil {
+		return s.(*monitor.Snapshot), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockMonitor) DataChannel() <-chan struct{} {
+	args := m.Called()
+	return args.Get(0).(<-chan struct{})
+}
+
+func (m *MockMonitor) ZoneNames() []string {
+	args := m.Called()
+	return args.Get(0).([]string)
+}
+
+// failingConn fails every write
+type failingConn struct{}
+
+func (failingConn) Write([]byte) (int, error) { return 0, errors.New("connection refused") }
+func (failingConn) Close() error              { return nil }
+
+func testSnapshot() *monitor.Snapshot {
+	pkg := device.NewMockRaplZone("package", 0, "/sys/class/powercap/intel-rapl/intel-rapl:0", 1000)
+
+	snapshot := monitor.NewSnapshot()
+	snapshot.Node.Zones[pkg] = monitor.NodeUsage{
+		EnergyTotal: 100 * device.Joule,
+		Power:       12 * device.Watt,
+	}
+	snapshot.Processes["123"] = &monitor.Process{
+		PID:         123,
+		Comm:        "stress,ng",
+		ContainerID: "abc",
+		Zones:       monitor.ZoneUsageMap{pkg: {EnergyTotal: 40 * device.Joule, Power: 5 * device.Watt}},
+	}
+	snapshot.Containers["abc"] = &monitor.Container{
+		ID:    "abc",
+		Name:  "web",
+		Zones: monitor.ZoneUsageMap{pkg: {EnergyTotal: 40 * device.Joule, Power: 5 * device.Watt}},
+	}
+	return snapshot
+}
+
+// listen starts a local UDP listener and returns its address and a function
+// reading the lines of all packets received within the timeout
+func listen(t *testing.T) (string, func(n int) []string) {
+	t.Helper()
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = pc.Close() })
+
+	read := func(n int) []string {
+		var lines []string
+		buf := make([]byte, 65536)
+		for range n {
+			require.NoError(t, pc.SetReadDeadline(time.Now().Add(2*time.Second)))
+			size, _, err := pc.ReadFrom(buf)
+			require.NoError(t, err)
+			lines = append(lines, strings.Split(string(buf[:size]), "\n")...)
+		}
+		return lines
+	}
+	return pc.LocalAddr().String(), read
+}
+
+func TestExporterSend(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+
+	t.Run("emits node, process and container gauges", func(t *testing.T) {
+		addr, read := listen(t)
+		e := NewExporter(&MockMonitor{}, addr,
+			WithLogger(logger),
+			WithNodeName("n1"),
+			WithTags(map[string]string{"env": "prod"}),
+		)
+		require.NoError(t, e.Init())
+		defer func() { assert.NoError(t, e.Shutdown()) }()
+
+		e.send(testSnapshot())
+
+		assert.ElementsMatch(t, []string{
+			"kepler.node.cpu.watts:12|g|#zone:package,zone_index:0,env:prod,node_name:n1",
+			"kepler.node.cpu.joules:100|g|#zone:package,zone_index:0,env:prod,node_name:n1",
+			"kepler.process.cpu.watts:5|g|#zone:package,zone_index:0,pid:123,comm:stress_ng,container_id:abc,env:prod,node_name:n1",
+			"kepler.process.cpu.joules:40|g|#zone:package,zone_index:0,pid:123,comm:stress_ng,container_id:abc,env:prod,node_name:n1",
+			"kepler.container.cpu.watts:5|g|#zone:package,zone_index:0,container_id:abc,container_name:web,env:prod,node_name:n1",
+			"kepler.container.cpu.joules:40|g|#zone:package,zone_index:0,container_id:abc,container_name:web,env:prod,node_name:n1",
+		}, read(1))
+	})
+
+	t.Run("respects the metrics level", func(t *testing.T) {
+		addr, read := listen(t)
+		e := NewExporter(&MockMonitor{}, addr, WithLogger(logger), WithMetricsLevel(config.MetricsLevelNode))
+		require.NoError(t, e.Init())
+		defer func() { assert.NoError(t, e.Shutdown()) }()
+
+		e.send(testSnapshot())
+
+		assert.ElementsMatch(t, []string{
+			"kepler.node.cpu.watts:12|g|#zone:package,zone_index:0",
+			"kepler.node.cpu.joules:100|g|#zone:package,zone_index:0",
+		}, read(1))
+	})
+
+	t.Run("sends aged processes in the aggregate", func(t *testing.T) {
+		addr, read := listen(t)
+		e := NewExporter(&MockMonitor{}, addr, WithLogger(logger), WithMetricsLevel(config.MetricsLevelProcess))
+		require.NoError(t, e.Init())
+		defer func() { assert.NoError(t, e.Shutdown()) }()
+
+		snapshot := testSnapshot()
+		snapshot.Processes["123"].Aged = true
+		snapshot.AgedProcessesEnergy = map[string]monitor.Energy{"package": 30 * device.Joule}
+		e.send(snapshot)
+
+		assert.ElementsMatch(t, []string{
+			"kepler.process.aged.joules:30|g|#zone:package",
+		}, read(1))
+	})
+
+	t.Run("tells apart zones sharing a name", func(t *testing.T) {
+		addr, read := listen(t)
+		e := NewExporter(&MockMonitor{}, addr, WithLogger(logger), WithMetricsLevel(config.MetricsLevelNode))
+		require.NoError(t, e.Init())
+		defer func() { assert.NoError(t, e.Shutdown()) }()
+
+		// per socket zones: one package zone per socket
+		pkg0 := device.NewMockRaplZone("package", 0, "/sys/class/powercap/intel-rapl/intel-rapl:0", 1000)
+		pkg1 := device.NewMockRaplZone("package", 1, "/sys/class/powercap/intel-rapl/intel-rapl:1", 1000)
+		snapshot := monitor.NewSnapshot()
+		snapshot.Node.Zones[pkg0] = monitor.NodeUsage{EnergyTotal: 100 * device.Joule, Power: 12 * device.Watt}
+		snapshot.Node.Zones[pkg1] = monitor.NodeUsage{EnergyTotal: 80 * device.Joule, Power: 9 * device.Watt}
+		e.send(snapshot)
+
+		assert.ElementsMatch(t, []string{
+			"kepler.node.cpu.watts:12|g|#zone:package,zone_index:0",
+			"kepler.node.cpu.joules:100|g|#zone:package,zone_index:0",
+			"kepler.node.cpu.watts:9|g|#zone:package,zone_index:1",
+			"kepler.node.cpu.joules:80|g|#zone:package,zone_index:1",
+		}, read(1))
+	})
+
+	t.Run("batches lines into packets of max size", func(t *testing.T) {
+		e := NewExporter(&MockMonitor{}, "", WithLogger(logger), WithMaxPacketSize(100))
+
+		var packets []string
+		e.conn = writerFunc(func(p []byte) { packets = append(packets, string(p)) })
+		e.send(testSnapshot())
+
+		lines := 0
+		for _, p := range packets {
+			n := len(strings.Split(p, "\n"))
+			// lines longer than the max size are sent on their own
+			assert.True(t, len(p) <= 100 || n == 1, "packet exceeds max size: %q", p)
+			lines += n
+		}
+		assert.Equal(t, 6, lines)
+		assert.Greater(t, len(packets), 1)
+	})
+
+	t.Run("drops packets on error and reports them", func(t *testing.T) {
+		e := NewExporter(&MockMonitor{}, "", WithLogger(logger), WithMetricsLevel(config.MetricsLevelNode))
+		e.conn = failingConn{}
+		e.send(testSnapshot())
+		assert.Equal(t, 1, e.dropped)
+
+		var packets []string
+		e.conn = writerFunc(func(p []byte) { packets = append(packets, string(p)) })
+		e.send(testSnapshot())
+		require.Len(t, packets, 1)
+		assert.Contains(t, strings.Split(packets[0], "\n"), "kepler.statsd.dropped_packets:1|c")
+		assert.Zero(t, e.dropped)
+	})
+}
+
+func TestExporterRun(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+	addr, read := listen(t)
+
+	pm := &MockMonitor{}
+	pm.On("Snapshot").Return(testSnapshot(), nil)
+
+	e := NewExporter(pm, addr,
+		WithLogger(logger),
+		WithInterval(10*time.Millisecond),
+		WithMetricsLevel(config.MetricsLevelNode),
+	)
+	require.NoError(t, e.Init())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- e.Run(ctx) }()
+
+	assert.Contains(t, read(1), "kepler.node.cpu.watts:12|g|#zone:package,zone_index:0")
+
+	cancel()
+	assert.NoError(t, <-done)
+	assert.NoError(t, e.Shutdown())
+}
+
+// writerFunc records the packets written
+type writerFunc func([]byte)
+
+func (f writerFunc) Write(p []byte) (int, error) {
+	f(p)
+	return len(p), nil
+}
+
+func (writerFunc) Close() error { return nil }
@@ -0,0 +1,210 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package stdout
+
+import (
+	"cmp"
+	"encoding/json"
+	"io"
+	"slices"
+	"time"
+
+	"github.com/sustainable-computing-io/kepler/internal/monitor"
+)
+
+// jsonZone is the energy and power of a workload in a zone
+type jsonZone struct {
+	Name        string  `json:"name"`
+	Index       int     `json:"index"`
+	Path        string  `json:"path"`
+	EnergyTotal float64 `json:"energyTotalJoules"`
+	Power       float64 `json:"powerWatts"`
+}
+
+// jsonNodeZone is the energy and power of the node in a zone
+type jsonNodeZone struct {
+	jsonZone
+	ActiveEnergyTotal float64 `json:"activeEnergyTotalJoules"`
+	IdleEnergyTotal   float64 `json:"idleEnergyTotalJoules"`
+	ActivePower       float64 `json:"activePowerWatts"`
+	IdlePower         float64 `json:"idlePowerWatts"`
+}
+
+// jsonNode is the node of a snapshot
+type jsonNode struct {
+	UsageRatio float64        `json:"usageRatio"`
+	Zones      []jsonNodeZone `json:"zones"`
+}
+
+// jsonGPUUsage is the GPU energy and power attributed to a workload
+type jsonGPUUsage struct {
+	GPUEnergyTotal float64 `json:"gpuEnergyTotalJoules"`
+	GPUPower       float64 `json:"gpuPowerWatts"`
+}
+
+type jsonProcess struct {
+	PID         int        `json:"pid"`
+	Comm        string     `json:"comm"`
+	Exe         string     `json:"exe"`
+	Type        string     `json:"type"`
+	ContainerID string     `json:"containerId"`
+	VMID        string     `json:"vmId"`
+	Zones       []jsonZone `json:"zones"`
+	jsonGPUUsage
+}
+
+type jsonContainer struct {
+	ID      string     `json:"id"`
+	Name    string     `json:"name"`
+	Runtime string     `json:"runtime"`
+	PodID   string     `json:"podId"`
+	Zones   []jsonZone `json:"zones"`
+	jsonGPUUsage
+}
+
+type jsonPod struct {
+	ID        string     `json:"id"`
+	Name      string     `json:"name"`
+	Namespace string     `json:"namespace"`
+	Zones     []jsonZone `json:"zones"`
+	jsonGPUUsage
+}
+
+type jsonGPU struct {
+	Index             int     `json:"index"`
+	UUID              string  `json:"uuid"`
+	Name              string  `json:"name"`
+	Vendor            string  `json:"vendor"`
+	EnergyTotal       float64 `json:"energyTotalJoules"`
+	ActiveEnergyTotal float64 `json:"activeEnergyTotalJoules"`
+	IdleEnergyTotal   float64 `json:"idleEnergyTotalJoules"`
+	Power             float64 `json:"powerWatts"`
+	ActivePower       float64 `json:"activePowerWatts"`
+	IdlePower         float64 `json:"idlePowerWatts"`
+	Utilization       float64 `json:"utilizationPercent"`
+}
+
+// jsonSnapshot is a snapshot written in the JSON format. Workloads and zones
+// are sorted so that consecutive snapshots are easy to compare.
+type jsonSnapshot struct {
+	Timestamp  time.Time       `json:"timestamp"`
+	Node       *jsonNode       `json:"node"`
+	Processes  []jsonProcess   `json:"processes"`
+	Containers []jsonContainer `json:"containers"`
+	Pods       []jsonPod       `json:"pods"`
+	GPUs       []jsonGPU       `json:"gpus"`
+}
+
+// writeJSON writes snapshot as a single line JSON object
+func writeJSON(out io.Writer, snapshot *monitor.Snapshot) error {
+	return json.NewEncoder(out).Encode(jsonSnapshotOf(snapshot))
+}
+
+func jsonSnapshotOf(snapshot *monitor.Snapshot) jsonSnapshot {
+	ret := jsonSnapshot{
+		Timestamp:  snapshot.Timestamp,
+		Processes:  []jsonProcess{},
+		Containers: []jsonContainer{},
+		Pods:       []jsonPod{},
+		GPUs:       []jsonGPU{},
+	}
+
+	if node := snapshot.Node; node != nil {
+		ret.Node = &jsonNode{UsageRatio: node.UsageRatio, Zones: []jsonNodeZone{}}
+		for zone, usage := range node.Zones {
+			ret.Node.Zones = append(ret.Node.Zones, jsonNodeZone{
+				jsonZone: jsonZone{
+					Name:        zone.Name(),
+					Index:       zone.Index(),
+					Path:        zone.Path(),
+					EnergyTotal: usage.EnergyTotal.Joules(),
+					Power:       usage.Power.Watts(),
+				},
+				ActiveEnergyTotal: usage.ActiveEnergyTotal.Joules(),
+				IdleEnergyTotal:   usage.IdleEnergyTotal.Joules(),
+				ActivePower:       usage.ActivePower.Watts(),
+				IdlePower:         usage.IdlePower.Watts(),
+			})
+		}
+		slices.SortFunc(ret.Node.Zones, func(a, b jsonNodeZone) int {
+			return compareZones(a.jsonZone, b.jsonZone)
+		})
+	}
+
+	for _, p := range snapshot.Processes {
+		ret.Processes = append(ret.Processes, jsonProcess{
+			PID:          p.PID,
+			Comm:         p.Comm,
+			Exe:          p.Exe,
+			Type:         string(p.Type),
+			ContainerID:  p.ContainerID,
+			VMID:         p.VirtualMachineID,
+			Zones:        jsonZones(p.Zones),
+			jsonGPUUsage: jsonGPUUsage{GPUEnergyTotal: p.GPUEnergyTotal.Joules(), GPUPower: p.GPUPower},
+		})
+	}
+	slices.SortFunc(ret.Processes, func(a, b jsonProcess) int { return cmp.Compare(a.PID, b.PID) })
+
+	for _, c := range snapshot.Containers {
+		ret.Containers = append(ret.Containers, jsonContainer{
+			ID:           c.ID,
+			Name:         c.Name,
+			Runtime:      string(c.Runtime),
+			PodID:        c.PodID,
+			Zones:        jsonZones(c.Zones),
+			jsonGPUUsage: jsonGPUUsage{GPUEnergyTotal: c.GPUEnergyTotal.Joules(), GPUPower: c.GPUPower},
+		})
+	}
+	slices.SortFunc(ret.Containers, func(a, b jsonContainer) int { return cmp.Compare(a.ID, b.ID) })
+
+	for _, p := range snapshot.Pods {
+		ret.Pods = append(ret.Pods, jsonPod{
+			ID:           p.ID,
+			Name:         p.Name,
+			Namespace:    p.Namespace,
+			Zones:        jsonZones(p.Zones),
+			jsonGPUUsage: jsonGPUUsage{GPUEnergyTotal: p.GPUEnergyTotal.Joules(), GPUPower: p.GPUPower},
+		})
+	}
+	slices.SortFunc(ret.Pods, func(a, b jsonPod) int { return cmp.Compare(a.ID, b.ID) })
+
+	for _, dev := range snapshot.GPUStats {
+		ret.GPUs = append(ret.GPUs, jsonGPU{
+			Index:             dev.DeviceIndex,
+			UUID:              dev.UUID,
+			Name:              dev.Name,
+			Vendor:            dev.Vendor,
+			EnergyTotal:       dev.EnergyTotal.Joules(),
+			ActiveEnergyTotal: dev.ActiveEnergyTotal.Joules(),
+			IdleEnergyTotal:   dev.IdleEnergyTotal.Joules(),
+			Power:             dev.TotalPower,
+			ActivePower:       dev.ActivePower,
+			IdlePower:         dev.IdlePower,
+			Utilization:       dev.Utilization,
+		})
+	}
+	slices.SortFunc(ret.GPUs, func(a, b jsonGPU) int { return cmp.Compare(a.Index, b.Index) })
+
+	return ret
+}
+
+// jsonZones returns the zones of a workload sorted by name and index
+func jsonZones(zones monitor.ZoneUsageMap) []jsonZone {
+	ret := make([]jsonZone, 0, len(zones))
+	for zone, usage := range zones {
+		ret = append(ret, jsonZone{
+			Name:        zone.Name(),
+			Index:       zone.Index(),
+			Path:        zone.Path(),
+			EnergyTotal: usage.EnergyTotal.Joules(),
+			Power:       usage.Power.Watts(),
+		})
+	}
+	slices.SortFunc(ret, compareZones)
+	return ret
+}
+
+func compareZones(a, b jsonZone) int {
+	return cmp.Or(cmp.Compare(a.Name, b.Name), cmp.Compare(a.Index, b.Index))
+}
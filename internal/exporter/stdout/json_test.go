@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package stdout
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/sustainable-computing-io/kepler/internal/device"
+	"github.com/sustainable-computing-io/kepler/internal/monitor"
+	"github.com/sustainable-computing-io/kepler/internal/resource"
+)
+
+func TestWriteJSON(t *testing.T) {
+	pkg := device.NewMockRaplZone("package", 0, "/sys/class/powercap/intel-rapl/intel-rapl:0", 1000)
+
+	snapshot := getTestNodeSnapshot()
+	snapshot.Timestamp = time.Date(2025, 5, 15, 1, 1, 1, 0, time.UTC)
+	snapshot.Processes = monitor.Processes{
+		"42": {
+			PID:            42,
+			Comm:           "worker",
+			Exe:            "/usr/bin/worker",
+			Type:           resource.ContainerProcess,
+			ContainerID:    "ctr-1",
+			Zones:          monitor.ZoneUsageMap{pkg: {EnergyTotal: 30 * device.Joule, Power: 3 * device.Watt}},
+			GPUPower:       25,
+			GPUEnergyTotal: 100 * device.Joule,
+		},
+		"7": {PID: 7, Comm: "init", Type: resource.RegularProcess, Zones: monitor.ZoneUsageMap{}},
+	}
+	snapshot.Containers = monitor.Containers{
+		"ctr-1": {
+			ID:      "ctr-1",
+			Name:    "app",
+			Runtime: resource.ContainerDRuntime,
+			PodID:   "pod-1",
+			Zones:   monitor.ZoneUsageMap{pkg: {EnergyTotal: 30 * device.Joule, Power: 3 * device.Watt}},
+		},
+	}
+	snapshot.Pods = monitor.Pods{
+		"pod-1": {
+			ID:        "pod-1",
+			Name:      "app-pod",
+			Namespace: "default",
+			Zones:     monitor.ZoneUsageMap{pkg: {EnergyTotal: 30 * device.Joule, Power: 3 * device.Watt}},
+		},
+	}
+	snapshot.GPUStats = []monitor.GPUDeviceStats{{
+		DeviceIndex: 0,
+		UUID:        "GPU-0",
+		Name:        "NVIDIA A100",
+		Vendor:      "nvidia",
+		TotalPower:  150,
+		IdlePower:   50,
+		ActivePower: 100,
+		Utilization: 80,
+		EnergyTotal: 9000 * device.Joule,
+	}}
+
+	buf := bytes.Buffer{}
+	require.NoError(t, writeJSON(&buf, snapshot))
+	assert.Equal(t, 1, strings.Count(buf.String(), "\n"), "a snapshot is written as a single line")
+
+	var got jsonSnapshot
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &got))
+
+	assert.Equal(t, snapshot.Timestamp, got.Timestamp)
+
+	require.NotNil(t, got.Node)
+	require.Len(t, got.Node.Zones, 2)
+	assert.Equal(t, "dram", got.Node.Zones[0].Name, "zones are sorted by name")
+	assert.Equal(t, "package", got.Node.Zones[1].Name)
+	assert.Equal(t, 12300.0, got.Node.Zones[1].EnergyTotal)
+	assert.Equal(t, 12.0, got.Node.Zones[1].Power)
+
+	require.Len(t, got.Processes, 2)
+	assert.Equal(t, 7, got.Processes[0].PID, "processes are sorted by pid")
+	assert.Empty(t, got.Processes[0].Zones)
+	assert.Equal(t, jsonProcess{
+		PID:          42,
+		Comm:         "worker",
+		Exe:          "/usr/bin/worker",
+		Type:         "container",
+		ContainerID:  "ctr-1",
+		Zones:        []jsonZone{{Name: "package", Index: 0, Path: "/sys/class/powercap/intel-rapl/intel-rapl:0", EnergyTotal: 30, Power: 3}},
+		jsonGPUUsage: jsonGPUUsage{GPUEnergyTotal: 100, GPUPower: 25},
+	}, got.Processes[1])
+
+	require.Len(t, got.Containers, 1)
+	assert.Equal(t, "pod-1", got.Containers[0].PodID)
+	assert.Equal(t, "containerd", got.Containers[0].Runtime)
+
+	require.Len(t, got.Pods, 1)
+	assert.Equal(t, "default", got.Pods[0].Namespace)
+	assert.Equal(t, 30.0, got.Pods[0].Zones[0].EnergyTotal)
+
+	require.Len(t, got.GPUs, 1)
+	assert.Equal(t, jsonGPU{
+		Index: 0, UUID: "GPU-0", Name: "NVIDIA A100", Vendor: "nvidia",
+		EnergyTotal: 9000, Power: 150, ActivePower: 100, IdlePower: 50, Utilization: 80,
+	}, got.GPUs[0])
+
+	t.Run("stable field names", func(t *testing.T) {
+		var fields map[string]any
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &fields))
+		for _, key := range []string{"timestamp", "node", "processes", "containers", "pods", "gpus"} {
+			assert.Contains(t, fields, key)
+		}
+		process := fields["processes"].([]any)[1].(map[string]any)
+		for _, key := range []string{"pid", "comm", "exe", "type", "containerId", "vmId", "zones", "gpuEnergyTotalJoules", "gpuPowerWatts"} {
+			assert.Contains(t, process, key)
+		}
+		zone := process["zones"].([]any)[0].(map[string]any)
+		for _, key := range []string{"name", "index", "path", "energyTotalJoules", "powerWatts"} {
+			assert.Contains(t, zone, key)
+		}
+	})
+
+	t.Run("empty snapshot", func(t *testing.T) {
+		buf := bytes.Buffer{}
+		require.NoError(t, writeJSON(&buf, &monitor.Snapshot{}))
+		assert.JSONEq(t, `{"timestamp":"0001-01-01T00:00:00Z","node":null,"processes":[],"containers":[],"pods":[],"gpus":[]}`, buf.String())
+	})
+}
@@ -24,6 +24,18 @@ type (
 	Monitor     = monitor.Service
 )
 
+// Format is the format snapshots are written in
+type Format string
+
+const (
+	// FormatText writes the node zones as a table
+	FormatText Format = "text"
+
+	// FormatJSON writes each snapshot as a single line JSON object with the
+	// node, process, container, pod and GPU energy and power
+	FormatJSON Format = "json"
+)
+
 // Exporter exports power data to stdout
 type Exporter struct {
 	logger   *slog.Logger
@@ -31,6 +43,7 @@ type Exporter struct {
 	out      io.WriteCloser
 	ticker   time.Ticker
 	interval time.Duration
+	format   Format
 }
 
 var (
@@ -43,6 +56,7 @@ type Opts struct {
 	logger   *slog.Logger
 	out      io.WriteCloser
 	interval time.Duration
+	format   Format
 }
 
 // DefaultOpts() returns a new Opts with defaults set
@@ -51,6 +65,7 @@ func DefaultOpts() Opts {
 		logger:   slog.Default().With("service", "stdout"),
 		out:      os.Stdout,
 		interval: 2 * time.Second,
+		format:   FormatText,
 	}
 }
 
@@ -76,6 +91,13 @@ func WithInterval(interval time.Duration) OptionFn {
 	}
 }
 
+// WithFormat sets the format snapshots are written in
+func WithFormat(format Format) OptionFn {
+	return func(o *Opts) {
+		o.format = format
+	}
+}
+
 func NewExporter(pm Monitor, applyOpts ...OptionFn) *Exporter {
 	opts := DefaultOpts()
 	for _, apply := range applyOpts {
@@ -87,6 +109,7 @@ func NewExporter(pm Monitor, applyOpts ...OptionFn) *Exporter {
 		monitor:  pm,
 		out:      opts.out,
 		interval: opts.interval,
+		format:   opts.format,
 	}
 
 	return exporter
@@ -106,6 +129,12 @@ func (e *Exporter) Run(ctx context.Context) error {
 				e.logger.Error("Failed to collect power data", "error", err)
 				return nil
 			}
+			if e.format == FormatJSON {
+				if err := writeJSON(e.out, snapshot); err != nil {
+					e.logger.Error("Failed to write power data", "error", err)
+				}
+				continue
+			}
 			write(e.out, now, snapshot)
 		case <-ctx.Done():
 			e.logger.Info("Exiting ticker")
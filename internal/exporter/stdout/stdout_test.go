@@ -69,12 +69,14 @@ func TestNewExporter(t *testing.T) {
 		opts          []OptionFn
 		out           io.WriteCloser
 		interval      time.Duration
+		format        Format
 	}{{
 		name:          "default options",
 		expectService: "stdout",
 		opts:          []OptionFn{},
 		out:           os.Stdout,
 		interval:      2 * time.Second,
+		format:        FormatText,
 	}, {
 		name:          "custom options",
 		expectService: "stdout",
@@ -82,9 +84,11 @@ func TestNewExporter(t *testing.T) {
 			WithLogger(slog.Default()),
 			WithOutput(os.Stderr),
 			WithInterval(20 * time.Second),
+			WithFormat(FormatJSON),
 		},
 		out:      os.Stderr,
 		interval: 20 * time.Second,
+		format:   FormatJSON,
 	}}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -96,6 +100,7 @@ func TestNewExporter(t *testing.T) {
 			assert.Same(t, mockMonitor, exporter.monitor)
 			assert.Same(t, tt.out, exporter.out)
 			assert.Equal(t, tt.interval, exporter.interval)
+			assert.Equal(t, tt.format, exporter.format)
 		})
 	}
 }
@@ -0,0 +1,160 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package monitor
+
+// workload levels of the attributed energy
+const (
+	ProcessLevel   = "process"
+	ContainerLevel = "container"
+	VMLevel        = "vm"
+	PodLevel       = "pod"
+)
+
+// LevelZone identifies the energy attributed to a workload level in a zone
+type LevelZone struct {
+	Level string // workload level: process, container, vm, pod
+	Zone  string // zone name
+}
+
+// kernelPID is the PID of the pseudo-process some accounting lumps kernel and
+// idle time into
+const kernelPID = 0
+
+// kernelComm is the name of the kernel pseudo-process when none is reported
+const kernelComm = "kernel"
+
+// foldedKernelCPUTimeDelta returns the CPU time of the kernel pseudo-process
+// when it is folded into node idle power, and 0 when it is reported as a
+// process or not present
+func (pm *PowerMonitor) foldedKernelCPUTimeDelta() float64 {
+	if !pm.foldKernel {
+		return 0
+	}
+	procs := pm.resources.Processes()
+	if procs == nil {
+		return 0
+	}
+	kernel, ok := procs.Running[kernelPID]
+	if !ok {
+		return 0
+	}
+	return kernel.CPUTimeDelta
+}
+
+// workloadCPUTimeDelta returns the CPU time that node power is shared by
+// among workloads; it excludes the CPU time folded into node idle power
+func (pm *PowerMonitor) workloadCPUTimeDelta() float64 {
+	return max(pm.resources.Node().ProcessTotalCPUTimeDelta-pm.foldedKernelCPUTimeDelta(), 0)
+}
+
+// activeUsageRatio returns the share of the node power that is active. The
+// CPU usage ratio is scaled down by the share of CPU time folded into idle.
+func (pm *PowerMonitor) activeUsageRatio() float64 {
+	node := pm.resources.Node()
+	folded := pm.foldedKernelCPUTimeDelta()
+	if folded == 0 || node.ProcessTotalCPUTimeDelta == 0 {
+		return node.CPUUsageRatio
+	}
+	return node.CPUUsageRatio * pm.workloadCPUTimeDelta() / node.ProcessTotalCPUTimeDelta
+}
+
+// attributable returns the energy since the previous reading and the power of
+// a node zone that is shared among workloads by their CPU time ratio. Energy
+// and power always use the same basis.
+func (pm *PowerMonitor) attributable(u NodeUsage) (Energy, Power) {
+	if pm.processEnergyBasis == EnergyBasisTotal {
+		return u.activeEnergy + u.idleEnergy, u.ActivePower + u.IdlePower
+	}
+	return u.activeEnergy, u.ActivePower
+}
+
+// accumulateAttributedEnergy adds the energy attributed to the workloads of
+// each level since the previous snapshot. prev is nil on the first reading.
+func (pm *PowerMonitor) accumulateAttributedEnergy(prev, newSnapshot *Snapshot) {
+	if pm.attributedEnergy == nil {
+		pm.attributedEnergy = make(map[LevelZone]Energy)
+	}
+	if prev == nil {
+		prev = NewSnapshot()
+	}
+
+	for id, proc := range newSnapshot.Processes {
+		var prevZones ZoneUsageMap
+		if p, ok := prev.Processes[id]; ok {
+			prevZones = p.Zones
+		}
+		pm.addAttributedEnergy(ProcessLevel, prevZones, proc.Zones)
+	}
+
+	for id, cntr := range newSnapshot.Containers {
+		var prevZones ZoneUsageMap
+		if c, ok := prev.Containers[id]; ok {
+			prevZones = c.Zones
+		}
+		pm.addAttributedEnergy(ContainerLevel, prevZones, cntr.Zones)
+	}
+
+	for id, vm := range newSnapshot.VirtualMachines {
+		var prevZones ZoneUsageMap
+		if v, ok := prev.VirtualMachines[id]; ok {
+			prevZones = v.Zones
+		}
+		pm.addAttributedEnergy(VMLevel, prevZones, vm.Zones)
+	}
+
+	for id, pod := range newSnapshot.Pods {
+		var prevZones ZoneUsageMap
+		if p, ok := prev.Pods[id]; ok {
+			prevZones = p.Zones
+		}
+		pm.addAttributedEnergy(PodLevel, prevZones, pod.Zones)
+	}
+}
+
+// accumulateAgedProcessesEnergy adds the energy aged processes consumed since
+// the previous snapshot to the cumulative energy of aged processes. Only the
+// energy consumed while a process is aged is added, since its energy before is
+// reported by its own metrics.
+func (pm *PowerMonitor) accumulateAgedProcessesEnergy(prev, newSnapshot *Snapshot) {
+	if pm.maxProcessAge <= 0 {
+		return
+	}
+	if pm.agedProcessesEnergy == nil {
+		pm.agedProcessesEnergy = make(map[string]Energy)
+	}
+	if prev == nil {
+		prev = NewSnapshot()
+	}
+
+	for id, proc := range newSnapshot.Processes {
+		if !proc.Aged {
+			continue
+		}
+		var prevZones ZoneUsageMap
+		if p, ok := prev.Processes[id]; ok {
+			prevZones = p.Zones
+		}
+		for zone, usage := range proc.Zones {
+			pm.agedProcessesEnergy[zone.Name()] += energyDelta(prevZones, zone, usage)
+		}
+	}
+}
+
+// addAttributedEnergy adds the energy a workload consumed between prev and
+// cur to the attributed energy of level
+func (pm *PowerMonitor) addAttributedEnergy(level string, prev, cur ZoneUsageMap) {
+	for zone, usage := range cur {
+		pm.attributedEnergy[LevelZone{Level: level, Zone: zone.Name()}] += energyDelta(prev, zone, usage)
+	}
+}
+
+// energyDelta returns the energy a workload consumed in zone since prev; all
+// of its energy if it was not seen before or its counter was reset
+func energyDelta(prev ZoneUsageMap, zone EnergyZone, usage Usage) Energy {
+	delta := usage.EnergyTotal
+	if p, ok := prev[zone]; ok && p.EnergyTotal <= usage.EnergyTotal {
+		delta -= p.EnergyTotal
+	}
+	return delta
+}
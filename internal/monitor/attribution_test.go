@@ -0,0 +1,142 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package monitor
+
+import (
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/sustainable-computing-io/kepler/internal/device"
+	testingclock "k8s.io/utils/clock/testing"
+)
+
+func TestAttributedEnergy(t *testing.T) {
+	pkg := device.NewMockRaplZone("package-0", 0, "/sys/class/powercap/intel-rapl/intel-rapl:0", 1000*Joule)
+	zones := []EnergyZone{pkg}
+
+	mockMeter := &MockCPUPowerMeter{}
+	mockMeter.On("Zones").Return(zones, nil)
+	mockMeter.On("PrimaryEnergyZone").Return(pkg, nil)
+
+	// CreateTestResources has both containerized and host processes:
+	// containers use 60%, VMs 25%, the pod 40% of the process CPU time
+	tr := CreateTestResources()
+	resourceInformer := &MockResourceInformer{}
+	resourceInformer.SetExpectations(t, tr)
+	resourceInformer.On("Refresh").Return(nil)
+
+	fakeClock := testingclock.NewFakeClock(time.Now())
+	pm := NewPowerMonitor(
+		mockMeter,
+		WithLogger(slog.New(slog.DiscardHandler)),
+		WithClock(fakeClock),
+		WithResourceInformer(resourceInformer),
+	)
+	require.NoError(t, pm.Init())
+
+	var unattributed Energy // node active energy of the first reading
+	assertLevels := func(t *testing.T, snapshot *Snapshot) {
+		t.Helper()
+		zone := pkg.Name()
+		processes := snapshot.AttributedEnergy[LevelZone{ProcessLevel, zone}]
+		require.NotZero(t, processes)
+
+		assert.InDelta(t, (snapshot.Node.Zones[pkg].ActiveEnergyTotal - unattributed).Joules(), processes.Joules(), 0.001,
+			"all active energy since the first reading must be attributed to processes")
+		assert.InDelta(t, 0.60*processes.Joules(), snapshot.AttributedEnergy[LevelZone{ContainerLevel, zone}].Joules(), 0.001)
+		assert.InDelta(t, 0.25*processes.Joules(), snapshot.AttributedEnergy[LevelZone{VMLevel, zone}].Joules(), 0.001)
+		assert.InDelta(t, 0.40*processes.Joules(), snapshot.AttributedEnergy[LevelZone{PodLevel, zone}].Joules(), 0.001)
+	}
+
+	// nothing is attributed until the first power reading
+	pkg.Inc(100 * Joule)
+	require.NoError(t, pm.refreshSnapshot())
+	unattributed = pm.snapshot.Load().Node.Zones[pkg].ActiveEnergyTotal
+
+	fakeClock.Step(time.Second)
+	pkg.Inc(50 * Joule)
+	require.NoError(t, pm.refreshSnapshot())
+	first := pm.snapshot.Load()
+	assertLevels(t, first)
+
+	fakeClock.Step(time.Second)
+	pkg.Inc(80 * Joule)
+	require.NoError(t, pm.refreshSnapshot())
+	second := pm.snapshot.Load()
+	assertLevels(t, second)
+
+	for lz, energy := range first.AttributedEnergy {
+		assert.Greater(t, second.AttributedEnergy[lz], energy, "attributed energy of %v must increase", lz)
+	}
+}
+
+func TestProcessEnergyBasis(t *testing.T) {
+	tt := []struct {
+		basis EnergyBasis
+		// node power and energy expected to be attributed to processes
+		power  func(NodeUsage) Power
+		energy func(prev, cur NodeUsage) Energy
+	}{{
+		basis:  EnergyBasisActive,
+		power:  func(u NodeUsage) Power { return u.ActivePower },
+		energy: func(prev, cur NodeUsage) Energy { return cur.ActiveEnergyTotal - prev.ActiveEnergyTotal },
+	}, {
+		basis:  EnergyBasisTotal,
+		power:  func(u NodeUsage) Power { return u.Power },
+		energy: func(prev, cur NodeUsage) Energy { return cur.EnergyTotal - prev.EnergyTotal },
+	}}
+
+	for _, tc := range tt {
+		t.Run(string(tc.basis), func(t *testing.T) {
+			pkg := device.NewMockRaplZone("package-0", 0, "/sys/class/powercap/intel-rapl/intel-rapl:0", 1000*Joule)
+
+			mockMeter := &MockCPUPowerMeter{}
+			mockMeter.On("Zones").Return([]EnergyZone{pkg}, nil)
+			mockMeter.On("PrimaryEnergyZone").Return(pkg, nil)
+
+			resourceInformer := &MockResourceInformer{}
+			resourceInformer.SetExpectations(t, CreateTestResources())
+			resourceInformer.On("Refresh").Return(nil)
+
+			fakeClock := testingclock.NewFakeClock(time.Now())
+			pm := NewPowerMonitor(
+				mockMeter,
+				WithLogger(slog.New(slog.DiscardHandler)),
+				WithClock(fakeClock),
+				WithResourceInformer(resourceInformer),
+				WithProcessEnergyBasis(tc.basis),
+			)
+			require.NoError(t, pm.Init())
+
+			pkg.Inc(100 * Joule)
+			require.NoError(t, pm.refreshSnapshot())
+			prev := pm.snapshot.Load()
+
+			fakeClock.Step(time.Second)
+			pkg.Inc(50 * Joule)
+			require.NoError(t, pm.refreshSnapshot())
+			cur := pm.snapshot.Load()
+
+			node := cur.Node.Zones[pkg]
+			require.NotZero(t, node.IdlePower, "the test needs idle power to tell the bases apart")
+
+			var power Power
+			var energy Energy
+			for id, proc := range cur.Processes {
+				power += proc.Zones[pkg].Power
+				energy += proc.Zones[pkg].EnergyTotal - prev.Processes[id].Zones[pkg].EnergyTotal
+			}
+
+			assert.InDelta(t, tc.power(node).Watts(), power.Watts(), 0.001,
+				"process power must sum to the node power of the basis")
+			assert.InDelta(t, tc.energy(prev.Node.Zones[pkg], node).Joules(), energy.Joules(), 0.001,
+				"process energy must sum to the node energy of the basis")
+			assert.InDelta(t, energy.Joules(), power.Watts(), 0.001,
+				"power and energy must use the same basis")
+		})
+	}
+}
@@ -4,6 +4,8 @@
 package monitor
 
 import (
+	"maps"
+	"strconv"
 	"sync"
 	"testing"
 	"time"
@@ -626,3 +628,70 @@ func TestCloneConcurrency(t *testing.T) {
 		}
 	})
 }
+
+func TestSnapshotShallowClone(t *testing.T) {
+	zone := &fakeZone{name: "package", index: 0}
+	original := NewSnapshot()
+	original.Timestamp = time.Now()
+	original.Node.Zones[zone] = NodeUsage{EnergyTotal: 1000 * Joule, Power: 50 * Watt}
+	original.Processes["1"] = &Process{PID: 1, Comm: "init"}
+	original.GPUStats = []GPUDeviceStats{{DeviceIndex: 0, TotalPower: 100}}
+
+	clone := original.ShallowClone()
+	require.NotSame(t, original, clone)
+	assert.Equal(t, original, clone)
+
+	// node is deep copied
+	clone.Node.Zones[zone] = NodeUsage{EnergyTotal: 2000 * Joule}
+	assert.Equal(t, 1000*Joule, original.Node.Zones[zone].EnergyTotal, "original node must be unchanged")
+
+	// workloads are shared
+	assert.Same(t, original.Processes["1"], clone.Processes["1"])
+
+	// top level fields are copied
+	clone.Timestamp = time.Time{}
+	assert.False(t, original.Timestamp.IsZero(), "original timestamp must be unchanged")
+}
+
+// largeSnapshot creates a snapshot with n processes, containers and pods
+func largeSnapshot(n int) *Snapshot {
+	zones := []EnergyZone{&fakeZone{name: "package", index: 0}, &fakeZone{name: "dram", index: 1}}
+	s := NewSnapshot()
+	s.Timestamp = time.Now()
+	for _, z := range zones {
+		s.Node.Zones[z] = NodeUsage{EnergyTotal: 1000 * Joule, Power: 50 * Watt}
+	}
+	for i := range n {
+		usage := make(ZoneUsageMap, len(zones))
+		for _, z := range zones {
+			usage[z] = Usage{EnergyTotal: Energy(i) * Joule, Power: Watt}
+		}
+		id := strconv.Itoa(i)
+		s.Processes[id] = &Process{PID: i, Comm: "proc", Zones: usage}
+		if i%10 == 0 {
+			s.Containers[id] = &Container{ID: id, Name: "container", Zones: maps.Clone(usage)}
+			s.Pods[id] = &Pod{ID: id, Name: "pod", Zones: maps.Clone(usage)}
+		}
+	}
+	return s
+}
+
+// BenchmarkSnapshotDelivery compares deep and shallow delivery of a snapshot
+// on a node with many processes
+func BenchmarkSnapshotDelivery(b *testing.B) {
+	snapshot := largeSnapshot(10_000)
+
+	b.Run("deep", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			_ = snapshot.Clone()
+		}
+	})
+
+	b.Run("shallow", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			_ = snapshot.ShallowClone()
+		}
+	})
+}
@@ -0,0 +1,84 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package monitor
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+)
+
+// DefaultConservationEpsilon is the relative tolerance used by
+// VerifyConservation when comparing node power with the sum of the power
+// attributed to processes
+const DefaultConservationEpsilon = 1e-6
+
+// VerifyConservation checks that the attributable power of every node zone,
+// and the attributable power of the GPUs, is fully attributed to the running
+// processes of the snapshot. The attributable power is the active power, or
+// the total power for the total energy basis. It returns an error describing
+// every violation, or nil when power is conserved. It is meant to be used as
+// an assertion in tests.
+func VerifyConservation(s *Snapshot) error {
+	return VerifyConservationWithin(s, DefaultConservationEpsilon)
+}
+
+// VerifyConservationWithin is VerifyConservation with the given relative
+// tolerance, e.g. to allow for the floating-point drift of long running
+// snapshots
+func VerifyConservationWithin(s *Snapshot, epsilon float64) error {
+	if s == nil || s.Node == nil {
+		return errors.New("snapshot has no node data")
+	}
+
+	var violations []string
+
+	for zone, usage := range s.Node.Zones {
+		nodeWatts := usage.ActivePower.Watts()
+		if s.ProcessEnergyBasis == EnergyBasisTotal {
+			nodeWatts += usage.IdlePower.Watts()
+		}
+		procWatts := 0.0
+		for _, p := range s.Processes {
+			procWatts += p.Zones[zone].Power.Watts()
+		}
+		if !conserved(nodeWatts, procWatts, epsilon) {
+			violations = append(violations, fmt.Sprintf(
+				"zone %s-%d: node attributable power %.6fW != sum of process power %.6fW",
+				zone.Name(), zone.Index(), nodeWatts, procWatts))
+		}
+	}
+
+	if len(s.GPUStats) > 0 {
+		gpuWatts := 0.0
+		for _, dev := range s.GPUStats {
+			gpuWatts += dev.AttributablePower
+		}
+		procWatts := 0.0
+		for _, p := range s.Processes {
+			procWatts += p.GPUPower
+		}
+		if !conserved(gpuWatts, procWatts, epsilon) {
+			violations = append(violations, fmt.Sprintf(
+				"gpu: device attributable power %.6fW != sum of process power %.6fW",
+				gpuWatts, procWatts))
+		}
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+
+	// map iteration order is random; keep the error stable
+	slices.Sort(violations)
+	return fmt.Errorf("power not conserved: %s", strings.Join(violations, "; "))
+}
+
+// conserved returns true if attributed is within the relative tolerance
+// epsilon of total
+func conserved(total, attributed, epsilon float64) bool {
+	return math.Abs(total-attributed) <= epsilon*math.Max(1, math.Abs(total))
+}
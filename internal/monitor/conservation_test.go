@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package monitor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/sustainable-computing-io/kepler/internal/device"
+)
+
+func TestVerifyConservation(t *testing.T) {
+	pkg := device.NewMockRaplZone("package", 0, "/sys/class/powercap/intel-rapl/intel-rapl:0", 1000*Joule)
+	dram := device.NewMockRaplZone("dram", 0, "/sys/class/powercap/intel-rapl/intel-rapl:0:1", 1000*Joule)
+
+	// snapshot returns a snapshot whose node and GPU active power is fully
+	// attributed to two processes
+	snapshot := func() *Snapshot {
+		s := NewSnapshot()
+		s.Node.Zones = NodeZoneUsageMap{
+			pkg:  {Power: 40 * Watt, ActivePower: 30 * Watt, IdlePower: 10 * Watt},
+			dram: {Power: 10 * Watt, ActivePower: 6 * Watt, IdlePower: 4 * Watt},
+		}
+		s.Processes = Processes{
+			"1": {PID: 1, GPUPower: 50, Zones: ZoneUsageMap{pkg: {Power: 10 * Watt}, dram: {Power: 2 * Watt}}},
+			"2": {PID: 2, GPUPower: 25, Zones: ZoneUsageMap{pkg: {Power: 20 * Watt}, dram: {Power: 4 * Watt}}},
+		}
+		s.GPUStats = []GPUDeviceStats{
+			{DeviceIndex: 0, TotalPower: 100, IdlePower: 50, ActivePower: 50, AttributablePower: 50},
+			{DeviceIndex: 1, TotalPower: 75, IdlePower: 50, ActivePower: 25, AttributablePower: 25},
+		}
+		return s
+	}
+
+	t.Run("conserved", func(t *testing.T) {
+		assert.NoError(t, VerifyConservation(snapshot()))
+	})
+
+	t.Run("conserved within epsilon", func(t *testing.T) {
+		s := snapshot()
+		s.Processes["1"].Zones[pkg] = Usage{Power: 10*Watt + 1} // 1µW off
+		assert.NoError(t, VerifyConservation(s))
+	})
+
+	t.Run("configured epsilon", func(t *testing.T) {
+		s := snapshot()
+		s.Processes["1"].Zones[pkg] = Usage{Power: 10*Watt + 3000} // 3mW, 1e-4 of 30W off
+
+		assert.ErrorContains(t, VerifyConservation(s), "zone package-0")
+		assert.ErrorContains(t, VerifyConservationWithin(s, 1e-5), "zone package-0")
+		assert.NoError(t, VerifyConservationWithin(s, 1e-3))
+	})
+
+	t.Run("no active power", func(t *testing.T) {
+		s := NewSnapshot()
+		s.Node.Zones = NodeZoneUsageMap{pkg: {Power: 10 * Watt, IdlePower: 10 * Watt}}
+		assert.NoError(t, VerifyConservation(s))
+	})
+
+	t.Run("cpu zone not conserved", func(t *testing.T) {
+		s := snapshot()
+		delete(s.Processes, "2")
+
+		err := VerifyConservation(s)
+		assert.ErrorContains(t, err, "zone package-0: node attributable power 30.000000W != sum of process power 10.000000W")
+		assert.ErrorContains(t, err, "zone dram-0: node attributable power 6.000000W != sum of process power 2.000000W")
+		assert.ErrorContains(t, err, "gpu: device attributable power 75.000000W != sum of process power 50.000000W")
+	})
+
+	t.Run("gpu not conserved", func(t *testing.T) {
+		s := snapshot()
+		s.GPUStats[1].AttributablePower = 40
+
+		err := VerifyConservation(s)
+		assert.EqualError(t, err, "power not conserved: gpu: device attributable power 90.000000W != sum of process power 75.000000W")
+	})
+
+	t.Run("over attribution", func(t *testing.T) {
+		s := snapshot()
+		s.Processes["3"] = &Process{PID: 3, Zones: ZoneUsageMap{pkg: {Power: 5 * Watt}}}
+
+		err := VerifyConservation(s)
+		assert.EqualError(t, err, "power not conserved: zone package-0: node attributable power 30.000000W != sum of process power 35.000000W")
+	})
+
+	t.Run("total basis", func(t *testing.T) {
+		s := snapshot()
+		s.ProcessEnergyBasis = EnergyBasisTotal
+		s.Processes["1"].Zones[pkg] = Usage{Power: 20 * Watt}
+		s.Processes["1"].Zones[dram] = Usage{Power: 6 * Watt}
+		s.Processes["1"].GPUPower = 100
+		s.Processes["2"].GPUPower = 75
+		s.GPUStats[0].AttributablePower = 100
+		s.GPUStats[1].AttributablePower = 75
+		assert.NoError(t, VerifyConservation(s))
+
+		s.ProcessEnergyBasis = EnergyBasisActive
+		err := VerifyConservation(s)
+		assert.ErrorContains(t, err, "zone package-0: node attributable power 30.000000W != sum of process power 40.000000W")
+		assert.ErrorContains(t, err, "zone dram-0: node attributable power 6.000000W != sum of process power 10.000000W")
+	})
+
+	t.Run("missing node", func(t *testing.T) {
+		assert.Error(t, VerifyConservation(nil))
+		assert.Error(t, VerifyConservation(&Snapshot{}))
+	})
+}
@@ -4,6 +4,8 @@
 package monitor
 
 import (
+	"maps"
+
 	"github.com/sustainable-computing-io/kepler/internal/resource"
 )
 
@@ -13,19 +15,20 @@ func (pm *PowerMonitor) firstContainerRead(snapshot *Snapshot) error {
 	containers := make(Containers, len(running))
 
 	zones := snapshot.Node.Zones
-	nodeCPUTimeDelta := pm.resources.Node().ProcessTotalCPUTimeDelta
+	nodeCPUTimeDelta := pm.workloadCPUTimeDelta()
 
 	for id, cntr := range running {
 		container := newContainer(cntr, zones)
 
 		// Calculate initial energy based on CPU ratio * nodeActiveEnergy
 		for zone, nodeZoneUsage := range zones {
-			if nodeZoneUsage.ActivePower == 0 || nodeZoneUsage.activeEnergy == 0 || nodeCPUTimeDelta == 0 {
+			energy, power := pm.attributable(nodeZoneUsage)
+			if power == 0 || energy == 0 || nodeCPUTimeDelta == 0 {
 				continue
 			}
 
 			cpuTimeRatio := cntr.CPUTimeDelta / nodeCPUTimeDelta
-			activeEnergy := Energy(cpuTimeRatio * float64(nodeZoneUsage.activeEnergy))
+			activeEnergy := Energy(cpuTimeRatio * float64(energy))
 
 			container.Zones[zone] = Usage{
 				Power:       Power(0), // No power in first read - no delta time to calculate rate
@@ -43,6 +46,9 @@ func (pm *PowerMonitor) firstContainerRead(snapshot *Snapshot) error {
 		if container, ok := containers[proc.ContainerID]; ok {
 			container.GPUPower += proc.GPUPower
 			container.GPUEnergyTotal += proc.GPUEnergyTotal
+			container.GPUDevicePower = addGPUDevicePower(container.GPUDevicePower, proc.GPUDevicePower)
+			container.GPUEncoderUtil += proc.GPUEncoderUtil
+			container.GPUDecoderUtil += proc.GPUDecoderUtil
 		}
 	}
 
@@ -59,6 +65,7 @@ func newContainer(cntr *resource.Container, zones NodeZoneUsageMap) *Container {
 		Name:         cntr.Name,
 		Runtime:      cntr.Runtime,
 		CPUTotalTime: cntr.CPUTotalTime,
+		MemoryBytes:  cntr.MemoryBytes,
 		Zones:        make(ZoneUsageMap, len(zones)),
 	}
 
@@ -99,12 +106,12 @@ func (pm *PowerMonitor) calculateContainerPower(prev, newSnapshot *Snapshot) err
 		// Add to internal tracker (which will handle priority-based retention)
 		// NOTE: Each terminated container is only added once since a container cannot be terminated twice
 		pm.terminatedContainersTracker.Add(prevContainer.Clone())
+		pm.terminatedContainersEnergy = addZoneEnergy(pm.terminatedContainersEnergy, prevContainer.Zones)
 	}
 
 	// process running containers
 	zones := newSnapshot.Node.Zones
-	node := pm.resources.Node()
-	nodeCPUTimeDelta := node.ProcessTotalCPUTimeDelta
+	nodeCPUTimeDelta := pm.workloadCPUTimeDelta()
 
 	pm.logger.Debug("Calculating container power",
 		"node.cpu.time", nodeCPUTimeDelta,
@@ -121,15 +128,16 @@ func (pm *PowerMonitor) calculateContainerPower(prev, newSnapshot *Snapshot) err
 
 		// For each zone in the node, calculate container's share
 		for zone, nodeZoneUsage := range zones {
+			energy, power := pm.attributable(nodeZoneUsage)
 			// Skip zones with zero power to avoid division by zero
-			if nodeZoneUsage.ActivePower == 0 || nodeZoneUsage.activeEnergy == 0 || nodeCPUTimeDelta == 0 {
+			if power == 0 || energy == 0 || nodeCPUTimeDelta == 0 {
 				continue
 			}
 
 			cpuTimeRatio := c.CPUTimeDelta / nodeCPUTimeDelta
 
 			// Calculate energy delta for this interval
-			activeEnergy := Energy(cpuTimeRatio * float64(nodeZoneUsage.activeEnergy))
+			activeEnergy := Energy(cpuTimeRatio * float64(energy))
 
 			// Calculate absolute energy based on previous data
 			// New container, starts with delta
@@ -142,7 +150,7 @@ func (pm *PowerMonitor) calculateContainerPower(prev, newSnapshot *Snapshot) err
 
 			// Calculate container's share of this zone's power and energy
 			container.Zones[zone] = Usage{
-				Power:       Power(cpuTimeRatio * nodeZoneUsage.ActivePower.MicroWatts()),
+				Power:       Power(cpuTimeRatio * power.MicroWatts()),
 				EnergyTotal: absoluteEnergy,
 			}
 		}
@@ -150,15 +158,37 @@ func (pm *PowerMonitor) calculateContainerPower(prev, newSnapshot *Snapshot) err
 		containerMap[id] = container
 	}
 
+	// Carry forward the GPU energy of containers; like the zone energy above, it
+	// only grows by the energy their processes consumed since the previous
+	// snapshot, so energy consumed before a process moved to another container
+	// stays with the container it was consumed in
+	for id, container := range containerMap {
+		if prevContainer, exists := prev.Containers[id]; exists {
+			container.GPUEnergyTotal = prevContainer.GPUEnergyTotal
+		}
+	}
+
 	// Aggregate GPU power and energy from processes into containers
-	for _, proc := range newSnapshot.Processes {
+	for pid, proc := range newSnapshot.Processes {
 		if proc.ContainerID == "" {
 			continue
 		}
-		if container, ok := containerMap[proc.ContainerID]; ok {
-			container.GPUPower += proc.GPUPower
-			container.GPUEnergyTotal += proc.GPUEnergyTotal
+		container, ok := containerMap[proc.ContainerID]
+		if !ok {
+			continue
+		}
+
+		prevProc := prev.Processes[pid]
+		if prevProc != nil && prevProc.ContainerID != "" && prevProc.ContainerID != proc.ContainerID {
+			pm.logger.Debug("Process moved to a different container",
+				"pid", pid, "from", prevProc.ContainerID, "to", proc.ContainerID)
 		}
+
+		container.GPUPower += proc.GPUPower
+		container.GPUEnergyTotal += gpuEnergyDelta(prevProc, proc)
+		container.GPUDevicePower = addGPUDevicePower(container.GPUDevicePower, proc.GPUDevicePower)
+		container.GPUEncoderUtil += proc.GPUEncoderUtil
+		container.GPUDecoderUtil += proc.GPUDecoderUtil
 	}
 
 	// Update the snapshot
@@ -166,6 +196,7 @@ func (pm *PowerMonitor) calculateContainerPower(prev, newSnapshot *Snapshot) err
 
 	// Populate terminated containers from tracker
 	newSnapshot.TerminatedContainers = pm.terminatedContainersTracker.Items()
+	newSnapshot.TerminatedContainersEnergy = maps.Clone(pm.terminatedContainersEnergy)
 	pm.logger.Debug("snapshot updated for containers",
 		"running", len(newSnapshot.Containers),
 		"terminated", len(newSnapshot.TerminatedContainers),
@@ -173,3 +204,39 @@ func (pm *PowerMonitor) calculateContainerPower(prev, newSnapshot *Snapshot) err
 
 	return nil
 }
+
+// gpuEnergyDelta returns the GPU energy proc consumed since the previous
+// snapshot, or all of it if proc is new
+func gpuEnergyDelta(prevProc, proc *Process) Energy {
+	if prevProc == nil || proc.GPUEnergyTotal < prevProc.GPUEnergyTotal {
+		return proc.GPUEnergyTotal
+	}
+	return proc.GPUEnergyTotal - prevProc.GPUEnergyTotal
+}
+
+// addZoneEnergy adds the energy of each zone in zones to total, keyed by zone
+// name, and returns the updated total
+func addZoneEnergy(total map[string]Energy, zones ZoneUsageMap) map[string]Energy {
+	if total == nil {
+		total = make(map[string]Energy, len(zones))
+	}
+	for zone, usage := range zones {
+		total[zone.Name()] += usage.EnergyTotal
+	}
+	return total
+}
+
+// addGPUDevicePower adds the per-device GPU power in devices to total and
+// returns the updated total
+func addGPUDevicePower(total, devices map[int]float64) map[int]float64 {
+	if len(devices) == 0 {
+		return total
+	}
+	if total == nil {
+		total = make(map[int]float64, len(devices))
+	}
+	for idx, watts := range devices {
+		total[idx] += watts
+	}
+	return total
+}
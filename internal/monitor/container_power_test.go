@@ -256,6 +256,8 @@ func TestContainerGPUPowerAggregation(t *testing.T) {
 				ContainerID:    "container-1",
 				GPUPower:       50.0,        // 50W GPU
 				GPUEnergyTotal: 200 * Joule, // 200J accumulated
+				GPUEncoderUtil: 60,
+				GPUDecoderUtil: 10,
 				Zones:          make(ZoneUsageMap),
 			},
 			"124": &Process{
@@ -264,6 +266,7 @@ func TestContainerGPUPowerAggregation(t *testing.T) {
 				ContainerID:    "container-1",
 				GPUPower:       30.0,        // 30W GPU
 				GPUEnergyTotal: 100 * Joule, // 100J accumulated
+				GPUEncoderUtil: 20,
 				Zones:          make(ZoneUsageMap),
 			},
 			"125": &Process{
@@ -310,6 +313,11 @@ func TestContainerGPUPowerAggregation(t *testing.T) {
 		// container-1 should have 200 + 100 = 300J GPU energy
 		assert.Equal(t, 300*Joule, newSnapshot.Containers["container-1"].GPUEnergyTotal)
 
+		// container-1 encoder/decoder utilization is summed from its processes
+		assert.Equal(t, 80.0, newSnapshot.Containers["container-1"].GPUEncoderUtil)
+		assert.Equal(t, 10.0, newSnapshot.Containers["container-1"].GPUDecoderUtil)
+		assert.Zero(t, newSnapshot.Containers["container-2"].GPUEncoderUtil)
+
 		// container-2 should have 20W GPU power (proc 126 has 0 GPU)
 		assert.Equal(t, 20.0, newSnapshot.Containers["container-2"].GPUPower)
 		// container-2 should have 50J GPU energy
@@ -671,6 +679,158 @@ func TestTerminatedContainerTracking(t *testing.T) {
 		resInformer.AssertExpectations(t)
 	})
 
+	t.Run("terminated container energy survives export", func(t *testing.T) {
+		mockMeter := &MockCPUPowerMeter{}
+		mockMeter.On("Zones").Return(zones, nil)
+		mockMeter.On("PrimaryEnergyZone").Return(zones[0], nil)
+		resInformer := &MockResourceInformer{}
+
+		monitor := &PowerMonitor{
+			logger:        logger,
+			cpu:           mockMeter,
+			clock:         fakeClock,
+			resources:     resInformer,
+			maxTerminated: 500,
+		}
+		require.NoError(t, monitor.Init())
+
+		// short-lived job container whose processes have all exited
+		prevSnapshot := NewSnapshot()
+		prevSnapshot.Node = createNodeSnapshot(zones, fakeClock.Now(), 0.5)
+		prevSnapshot.Containers["job-1"] = &Container{ID: "job-1", Zones: make(ZoneUsageMap)}
+		prevSnapshot.Containers["job-2"] = &Container{ID: "job-2", Zones: make(ZoneUsageMap)}
+		for _, zone := range zones {
+			prevSnapshot.Containers["job-1"].Zones[zone] = Usage{EnergyTotal: 15 * Joule}
+			prevSnapshot.Containers["job-2"].Zones[zone] = Usage{EnergyTotal: 5 * Joule}
+		}
+
+		tr := CreateTestResources(createOnly(testNode))
+		resInformer.On("Node").Return(tr.Node, nil).Maybe()
+		resInformer.On("Containers").Return(&resource.Containers{
+			Running: map[string]*resource.Container{
+				"job-2": {ID: "job-2", CPUTimeDelta: 0},
+			},
+			Terminated: map[string]*resource.Container{
+				"job-1": {ID: "job-1"},
+			},
+		}).Once()
+
+		snapshot1 := NewSnapshot()
+		snapshot1.Node = createNodeSnapshot(zones, fakeClock.Now().Add(time.Second), 0.5)
+		require.NoError(t, monitor.calculateContainerPower(prevSnapshot, snapshot1))
+
+		for _, zone := range zones {
+			assert.Equal(t, 15*Joule, snapshot1.TerminatedContainersEnergy[zone.Name()])
+		}
+
+		// after export the terminated container is dropped from the tracker,
+		// but its energy remains in the cumulative total
+		monitor.exported.Store(true)
+		resInformer.On("Containers").Return(&resource.Containers{
+			Running: map[string]*resource.Container{},
+			Terminated: map[string]*resource.Container{
+				"job-2": {ID: "job-2"},
+			},
+		}).Once()
+
+		snapshot2 := NewSnapshot()
+		snapshot2.Node = createNodeSnapshot(zones, fakeClock.Now().Add(2*time.Second), 0.5)
+		require.NoError(t, monitor.calculateContainerPower(prevSnapshot, snapshot2))
+
+		assert.Len(t, snapshot2.TerminatedContainers, 1)
+		assert.NotContains(t, snapshot2.TerminatedContainers, "job-1")
+		for _, zone := range zones {
+			assert.Equal(t, 20*Joule, snapshot2.TerminatedContainersEnergy[zone.Name()])
+		}
+		// earlier snapshots must not observe later updates
+		for _, zone := range zones {
+			assert.Equal(t, 15*Joule, snapshot1.TerminatedContainersEnergy[zone.Name()])
+		}
+
+		resInformer.AssertExpectations(t)
+	})
+
+	t.Run("process moving container neither loses nor double counts energy", func(t *testing.T) {
+		mockMeter := &MockCPUPowerMeter{}
+		mockMeter.On("Zones").Return(zones, nil)
+		mockMeter.On("PrimaryEnergyZone").Return(zones[0], nil)
+		resInformer := &MockResourceInformer{}
+
+		monitor := &PowerMonitor{
+			logger:        logger,
+			cpu:           mockMeter,
+			clock:         fakeClock,
+			resources:     resInformer,
+			maxTerminated: 500,
+		}
+		require.NoError(t, monitor.Init())
+
+		prevSnapshot := NewSnapshot()
+		prevSnapshot.Node = createNodeSnapshot(zones, fakeClock.Now(), 0.5)
+		prevSnapshot.Containers["old"] = &Container{ID: "old", Zones: make(ZoneUsageMap)}
+		prevSnapshot.Containers["new"] = &Container{ID: "new", Zones: make(ZoneUsageMap)}
+		prevSnapshot.Containers["old"].GPUEnergyTotal = 8 * Joule
+		prevSnapshot.Containers["new"].GPUEnergyTotal = 2 * Joule
+		prevSnapshot.Processes["42"] = &Process{
+			PID: 42, ContainerID: "old", GPUEnergyTotal: 6 * Joule, Zones: make(ZoneUsageMap),
+		}
+		for _, zone := range zones {
+			prevSnapshot.Containers["old"].Zones[zone] = Usage{EnergyTotal: 30 * Joule}
+			prevSnapshot.Containers["new"].Zones[zone] = Usage{EnergyTotal: 10 * Joule}
+			prevSnapshot.Processes["42"].Zones[zone] = Usage{EnergyTotal: 12 * Joule}
+		}
+
+		tr := CreateTestResources(createOnly(testNode))
+		resInformer.On("Node").Return(tr.Node, nil).Maybe()
+		resInformer.On("Containers").Return(&resource.Containers{
+			Running: map[string]*resource.Container{
+				"old": {ID: "old", CPUTimeDelta: 0},
+				"new": {ID: "new", CPUTimeDelta: 0},
+			},
+		})
+
+		// the process now belongs to the "new" container
+		snapshot1 := NewSnapshot()
+		snapshot1.Node = createNodeSnapshot(zones, fakeClock.Now().Add(time.Second), 0.5)
+		snapshot1.Processes["42"] = &Process{
+			PID: 42, ContainerID: "new", GPUEnergyTotal: 9 * Joule, Zones: make(ZoneUsageMap),
+		}
+		for _, zone := range zones {
+			snapshot1.Processes["42"].Zones[zone] = Usage{EnergyTotal: 12 * Joule}
+		}
+		require.NoError(t, monitor.calculateContainerPower(prevSnapshot, snapshot1))
+
+		for _, zone := range zones {
+			assert.Equal(t, 30*Joule, snapshot1.Containers["old"].Zones[zone].EnergyTotal,
+				"energy consumed before the move stays with the old container")
+			assert.Equal(t, 10*Joule, snapshot1.Containers["new"].Zones[zone].EnergyTotal,
+				"energy consumed before the move must not be counted twice")
+			total := snapshot1.Containers["old"].Zones[zone].EnergyTotal +
+				snapshot1.Containers["new"].Zones[zone].EnergyTotal
+			assert.Equal(t, 40*Joule, total, "no energy is lost or added by the move")
+		}
+
+		// only the 3J of GPU energy consumed since the move goes to the new container
+		assert.Equal(t, 8*Joule, snapshot1.Containers["old"].GPUEnergyTotal,
+			"GPU energy consumed before the move stays with the old container")
+		assert.Equal(t, 5*Joule, snapshot1.Containers["new"].GPUEnergyTotal,
+			"GPU energy consumed before the move must not be counted twice")
+		assert.Equal(t, 13*Joule,
+			snapshot1.Containers["old"].GPUEnergyTotal+snapshot1.Containers["new"].GPUEnergyTotal,
+			"no GPU energy is lost or added by the move")
+
+		// the process stays in the new container; nothing is counted twice
+		snapshot2 := NewSnapshot()
+		snapshot2.Node = createNodeSnapshot(zones, fakeClock.Now().Add(2*time.Second), 0.5)
+		snapshot2.Processes["42"] = snapshot1.Processes["42"].Clone()
+		require.NoError(t, monitor.calculateContainerPower(snapshot1, snapshot2))
+
+		for _, zone := range zones {
+			assert.Equal(t, 10*Joule, snapshot2.Containers["new"].Zones[zone].EnergyTotal)
+		}
+		assert.Equal(t, 5*Joule, snapshot2.Containers["new"].GPUEnergyTotal)
+	})
+
 	t.Run("multiple terminated containers accumulation", func(t *testing.T) {
 		mockMeter := &MockCPUPowerMeter{}
 		mockMeter.On("Zones").Return(zones, nil)
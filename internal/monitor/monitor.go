@@ -7,6 +7,8 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"maps"
+	"os/user"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -30,6 +32,13 @@ type PowerDataProvider interface {
 	ZoneNames() []string
 }
 
+// LatestSnapshotProvider is implemented by monitors that can return their
+// latest power data without refreshing it, e.g. to detect a stalled monitor
+type LatestSnapshotProvider interface {
+	// LatestSnapshot returns the latest power data and its age
+	LatestSnapshot() (*Snapshot, time.Duration, error)
+}
+
 // Service defines the interface for the power monitoring service
 type Service interface {
 	service.Service
@@ -42,10 +51,21 @@ type PowerMonitor struct {
 	logger    *slog.Logger
 	cpu       device.CPUPowerMeter
 	gpuMeters []gpu.GPUPowerMeter // optional, empty if no GPUs available
+	coreZones []EnergyZone        // optional, empty if per-core energy is unavailable
+
+	// gpuReliability reads the fan speed and performance state of GPU devices
+	gpuReliability bool
+
+	// gpuEnergyJitterTolerance is the largest backward move of GPU energy
+	// counters treated as jitter rather than a reset
+	gpuEnergyJitterTolerance Energy
 
 	interval time.Duration
 	clock    clock.WithTicker
 
+	// maxBackoff caps the collection interval while collections keep failing
+	maxBackoff time.Duration
+
 	// related to snapshots
 	maxStaleness time.Duration
 
@@ -53,6 +73,38 @@ type PowerMonitor struct {
 	maxTerminated                int
 	minTerminatedEnergyThreshold Energy
 
+	// pidMode selects the PID used to identify processes
+	pidMode PIDMode
+
+	// mode selects whether workload power is computed
+	mode Mode
+
+	// processEnergyBasis selects whether idle power is attributed to workloads
+	processEnergyBasis EnergyBasis
+
+	// gpuProcessEnergyBasis is whether the GPU meters attribute idle power to
+	// processes
+	gpuProcessEnergyBasis EnergyBasis
+
+	// foldKernel folds the CPU time of PID 0 into node idle power instead of
+	// reporting it as a process
+	foldKernel bool
+
+	// maxProcessAge is the age after which processes are only reported in
+	// the aggregate energy of aged processes; 0 reports all processes
+	maxProcessAge time.Duration
+
+	// powerSmoothingWindow is the window over which the energy of each
+	// interval is spread to smooth node zone power; 0 disables smoothing
+	powerSmoothingWindow time.Duration
+	powerSmoothers       map[EnergyZone]*zoneSmoother
+
+	// resolveUsernames resolves the UIDs of users with lookupUser; resolved
+	// names are cached in userNames
+	resolveUsernames bool
+	lookupUser       func(uid string) (*user.User, error)
+	userNames        map[int]string
+
 	resources resource.Informer
 
 	// signals when a snapshot has been updated
@@ -61,6 +113,11 @@ type PowerMonitor struct {
 	computeGroup singleflight.Group
 	snapshot     atomic.Pointer[Snapshot]
 
+	// computeMu is held while a snapshot is computed, including by
+	// computations abandoned after collectionTimeout
+	computeMu         sync.Mutex
+	collectionTimeout time.Duration
+
 	// exported tracks if the current snapshot has been exported (through Snapshot).
 	// This flag is used to clear the terminated processes from the snapshot in
 	// the next collection cycle
@@ -70,7 +127,22 @@ type PowerMonitor struct {
 	// state atomically across goroutines.
 	exported atomic.Bool
 
-	zonesNames []string // cache of all zones
+	zonesNames  []string   // cache of all zones
+	primaryZone EnergyZone // primary energy zone of the CPU meter
+
+	// readErrors counts failed meter reads; only accessed while computing
+	// a snapshot which is serialized by computeGroup
+	readErrors map[MeterZone]uint64
+
+	// raplPermissionDenied is set when a RAPL zone read of the last node read
+	// failed due to missing permissions; only accessed while computing a
+	// snapshot
+	raplPermissionDenied bool
+
+	// cumulative number of processes that started and terminated between
+	// consecutive snapshots; only accessed while computing a snapshot
+	processesStarted    uint64
+	processesTerminated uint64
 
 	// Internal terminated workload trackers (not exposed)
 	terminatedProcessesTracker  *TerminatedResourceTracker[*Process]
@@ -78,13 +150,33 @@ type PowerMonitor struct {
 	terminatedVMsTracker        *TerminatedResourceTracker[*VirtualMachine]
 	terminatedPodsTracker       *TerminatedResourceTracker[*Pod]
 
+	// cumulative energy of all terminated containers and pods per zone name;
+	// only accessed while computing a snapshot which is serialized by computeGroup
+	terminatedContainersEnergy map[string]Energy
+	terminatedPodsEnergy       map[string]Energy
+
+	// cumulative energy attributed to the workloads of each level per zone;
+	// only accessed while computing a snapshot
+	attributedEnergy map[LevelZone]Energy
+
+	// cumulative energy of aged processes per zone name while they were
+	// aged; only accessed while computing a snapshot
+	agedProcessesEnergy map[string]Energy
+
+	// consecutiveErrors counts the collections that failed since the last
+	// successful one
+	consecutiveErrors atomic.Int64
+
 	// For managing the collection loop
 	collectionCtx    context.Context
 	collectionCancel context.CancelFunc
 	collectionWg     sync.WaitGroup
 }
 
-var _ Service = (*PowerMonitor)(nil)
+var (
+	_ Service                = (*PowerMonitor)(nil)
+	_ LatestSnapshotProvider = (*PowerMonitor)(nil)
+)
 
 // NewPowerMonitor creates a new PowerMonitor instance
 func NewPowerMonitor(meter device.CPUPowerMeter, applyOpts ...OptionFn) *PowerMonitor {
@@ -99,16 +191,35 @@ func NewPowerMonitor(meter device.CPUPowerMeter, applyOpts ...OptionFn) *PowerMo
 		logger:    opts.logger.With("service", "monitor"),
 		cpu:       meter,
 		gpuMeters: opts.gpuMeters,
+		coreZones: opts.coreZones,
 		clock:     opts.clock,
 		interval:  opts.interval,
 		resources: opts.resources,
 		dataCh:    make(chan struct{}, 1),
 
+		gpuReliability:           opts.gpuReliability,
+		gpuEnergyJitterTolerance: opts.gpuEnergyJitterTolerance,
+
 		maxStaleness: opts.maxStaleness,
+		maxBackoff:   opts.maxBackoff,
+
+		collectionTimeout: opts.collectionTimeout,
 
 		maxTerminated:                opts.maxTerminated,
 		minTerminatedEnergyThreshold: opts.minTerminatedEnergyThreshold,
 
+		pidMode:               opts.pidMode,
+		mode:                  opts.mode,
+		processEnergyBasis:    opts.processEnergyBasis,
+		foldKernel:            opts.foldKernel,
+		gpuProcessEnergyBasis: opts.gpuProcessEnergyBasis,
+		maxProcessAge:         opts.maxProcessAge,
+
+		powerSmoothingWindow: opts.powerSmoothingWindow,
+
+		resolveUsernames: opts.resolveUsernames,
+		lookupUser:       user.LookupId,
+
 		collectionCtx:    ctx,
 		collectionCancel: cancel,
 	}
@@ -133,6 +244,7 @@ func (pm *PowerMonitor) Init() error {
 
 	pm.logger.Info("Using primary energy zone for terminated workload tracking",
 		"zone", primaryEnergyZone.Name())
+	pm.primaryZone = primaryEnergyZone
 
 	// Log GPU meter status
 	if len(pm.gpuMeters) > 0 {
@@ -199,10 +311,46 @@ func (pm *PowerMonitor) ZoneNames() []string {
 }
 
 func (pm *PowerMonitor) Snapshot() (*Snapshot, error) {
+	snapshot, err := pm.exportSnapshot()
+	if err != nil {
+		return nil, err
+	}
+	return snapshot.Clone(), nil
+}
+
+// ShallowSnapshot returns the current power data without deep copying the
+// workloads. The returned snapshot shares its process, container, VM and pod
+// maps with the monitor and MUST be treated as read-only; it suits consumers
+// that only read the data, e.g. node level metrics on nodes with many processes.
+func (pm *PowerMonitor) ShallowSnapshot() (*Snapshot, error) {
+	snapshot, err := pm.exportSnapshot()
+	if err != nil {
+		return nil, err
+	}
+	return snapshot.ShallowClone(), nil
+}
+
+// LatestSnapshot returns the power data of the last collection without
+// refreshing it, however old, with its age as measured by the monitor clock.
+// Like Snapshot, it marks the snapshot as exported.
+func (pm *PowerMonitor) LatestSnapshot() (*Snapshot, time.Duration, error) {
+	snapshot, err := pm.markExported()
+	if err != nil {
+		return nil, 0, err
+	}
+	return snapshot.Clone(), pm.clock.Now().Sub(snapshot.Timestamp), nil
+}
+
+// exportSnapshot returns the current, fresh snapshot and marks it as exported
+func (pm *PowerMonitor) exportSnapshot() (*Snapshot, error) {
 	if err := pm.ensureFreshData(); err != nil {
 		return nil, err
 	}
+	return pm.markExported()
+}
 
+// markExported returns the current snapshot and marks it as exported
+func (pm *PowerMonitor) markExported() (*Snapshot, error) {
 	snapshot := pm.snapshot.Load()
 	if snapshot == nil {
 		return nil, fmt.Errorf("failed to get snapshot")
@@ -212,7 +360,21 @@ func (pm *PowerMonitor) Snapshot() (*Snapshot, error) {
 	// in the next collection
 	pm.exported.Store(true)
 
-	return snapshot.Clone(), nil
+	return snapshot, nil
+}
+
+// ConsecutiveErrors returns the number of collections that failed since the
+// last successful one
+func (pm *PowerMonitor) ConsecutiveErrors() int {
+	return int(pm.consecutiveErrors.Load())
+}
+
+// recordReadError counts a failed read of the given meter zone
+func (pm *PowerMonitor) recordReadError(meter, zone string) {
+	if pm.readErrors == nil {
+		pm.readErrors = make(map[MeterZone]uint64)
+	}
+	pm.readErrors[MeterZone{Meter: meter, Zone: zone}]++
 }
 
 func (pm *PowerMonitor) initZones() error {
@@ -241,9 +403,33 @@ func (pm *PowerMonitor) collectionLoop() {
 	}
 }
 
+// nextCollectionInterval returns the interval until the next collection. It
+// doubles with every consecutive failed collection, up to maxBackoff, and is
+// back to the monitor interval once a collection succeeds.
+func (pm *PowerMonitor) nextCollectionInterval() time.Duration {
+	errs := pm.consecutiveErrors.Load()
+	if errs == 0 || pm.maxBackoff <= pm.interval {
+		return pm.interval
+	}
+
+	next := pm.interval
+	for range errs {
+		next *= 2
+		if next >= pm.maxBackoff {
+			return pm.maxBackoff
+		}
+	}
+	return next
+}
+
 // scheduleNextCollection schedules the next data collection
 func (pm *PowerMonitor) scheduleNextCollection() {
-	timer := pm.clock.After(pm.interval)
+	next := pm.nextCollectionInterval()
+	if next > pm.interval {
+		pm.logger.Warn("Collection failing; backing off",
+			"consecutive_errors", pm.consecutiveErrors.Load(), "next_collection", next)
+	}
+	timer := pm.clock.After(next)
 	pm.collectionWg.Add(1)
 	go func() {
 		defer pm.collectionWg.Done()
@@ -313,7 +499,12 @@ func (pm *PowerMonitor) synchronizedPowerRefresh() error {
 			return nil, nil
 		}
 
-		return nil, pm.refreshSnapshot()
+		if err := pm.refreshSnapshot(); err != nil {
+			pm.consecutiveErrors.Add(1)
+			return nil, err
+		}
+		pm.consecutiveErrors.Store(0)
+		return nil, nil
 	})
 
 	return err
@@ -329,10 +520,65 @@ func (pm *PowerMonitor) isFresh() bool {
 	return age <= pm.maxStaleness
 }
 
-// refreshSnapshot creates a new snapshot of the power consumption
-// It handles both initial and subsequent collections assuming previous Snapshot
-// is nil only on first call.
+// refreshSnapshot creates a new snapshot of the power consumption. If the
+// computation takes longer than collectionTimeout, an error is returned without
+// waiting for it. A computation still reading the node is abandoned: the
+// previous snapshot is kept and the computation is discarded once it completes.
+// A computation that already read the node publishes the node data right away,
+// along with the workloads of the previous snapshot, and its full snapshot once
+// complete.
 func (pm *PowerMonitor) refreshSnapshot() error {
+	// an abandoned computation may still be running; fail instead of waiting
+	// for it so that a slow cycle does not delay the next one
+	if !pm.computeMu.TryLock() {
+		return fmt.Errorf("previous collection is still in progress")
+	}
+
+	if pm.collectionTimeout <= 0 {
+		defer pm.computeMu.Unlock()
+		return pm.computeSnapshot(nil, nil)
+	}
+
+	// whoever completes first, the computation or the timeout, claims the
+	// collection; the computation only publishes its snapshot if it does
+	claimed := &atomic.Bool{}
+	node := &atomic.Pointer[Node]{}
+	prev := pm.snapshot.Load()
+	done := make(chan error, 1)
+	go func() {
+		defer pm.computeMu.Unlock()
+		done <- pm.computeSnapshot(claimed, node)
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-pm.clock.After(pm.collectionTimeout):
+		if !claimed.CompareAndSwap(false, true) {
+			// the computation read the node before timing out and is
+			// committed; publish the node data it read and its full snapshot
+			// once complete
+			published := pm.publishPartialSnapshot(prev, node.Load())
+			pm.logger.Warn("Collection timed out; publishing its snapshot once complete",
+				"timeout", pm.collectionTimeout, "node_published", published)
+			return fmt.Errorf("collection timed out after %s", pm.collectionTimeout)
+		}
+		pm.logger.Warn("Collection timed out; keeping the previous snapshot",
+			"timeout", pm.collectionTimeout)
+		return fmt.Errorf("collection timed out after %s", pm.collectionTimeout)
+	}
+}
+
+// computeSnapshot computes and publishes a new snapshot of the power consumption
+// It handles both initial and subsequent collections assuming previous Snapshot
+// is nil only on first call. If claimed is not nil, the collection must be
+// claimed once the node is read, i.e. it has not timed out while reading the
+// node; otherwise it is discarded and the state the node read changed is
+// restored. Before claiming, a copy of the node data read is stored in node.
+// A claimed collection changes the state of the resource informer, the meters
+// and the terminated workload trackers, so it always runs to completion and
+// publishes its snapshot.
+func (pm *PowerMonitor) computeSnapshot(claimed *atomic.Bool, node *atomic.Pointer[Node]) error {
 	started := pm.clock.Now()
 	defer func() {
 		pm.logger.Info("Computed power", "duration", pm.clock.Since(started))
@@ -341,6 +587,26 @@ func (pm *PowerMonitor) refreshSnapshot() error {
 	newSnapshot := NewSnapshot()
 	prevSnapshot := pm.snapshot.Load()
 
+	var saved nodeReadState
+	if claimed != nil {
+		saved = pm.saveNodeReadState()
+	}
+
+	nodeErr := pm.readNode(prevSnapshot, newSnapshot)
+	if nodeErr == nil && node != nil {
+		node.Store(newSnapshot.Node.Clone())
+	}
+
+	if claimed != nil && !claimed.CompareAndSwap(false, true) {
+		pm.restoreNodeReadState(saved)
+		pm.logger.Warn("Discarding snapshot of timed out collection")
+		return fmt.Errorf("collection timed out after %s", pm.collectionTimeout)
+	}
+
+	if nodeErr != nil {
+		return fmt.Errorf(nodePowerError, nodeErr)
+	}
+
 	if prevSnapshot == nil {
 		// Handle initial collection explicitly
 		if err := pm.firstReading(newSnapshot); err != nil {
@@ -355,6 +621,14 @@ func (pm *PowerMonitor) refreshSnapshot() error {
 	// Reset exported to keep track of terminated processes until Snapshot is exported
 	pm.exported.Store(false)
 
+	pm.accumulateAttributedEnergy(prevSnapshot, newSnapshot)
+	newSnapshot.AttributedEnergy = maps.Clone(pm.attributedEnergy)
+	pm.accumulateAgedProcessesEnergy(prevSnapshot, newSnapshot)
+	newSnapshot.AgedProcessesEnergy = maps.Clone(pm.agedProcessesEnergy)
+	newSnapshot.MeterReadErrors = maps.Clone(pm.readErrors)
+	newSnapshot.RaplPermissionDenied = pm.raplPermissionDenied
+	newSnapshot.ProcessEnergyBasis = pm.processEnergyBasis
+
 	// Update snapshot with current timestamp
 	newSnapshot.Timestamp = pm.clock.Now()
 	pm.snapshot.Store(newSnapshot)
@@ -373,6 +647,29 @@ func (pm *PowerMonitor) refreshSnapshot() error {
 	return nil
 }
 
+// publishPartialSnapshot publishes the node data read by a timed out
+// collection along with the workloads of prev, so that the node power is
+// reported on time while the collection attributes it to the workloads. It
+// returns false if there is no node data or the collection already published
+// its snapshot.
+func (pm *PowerMonitor) publishPartialSnapshot(prev *Snapshot, node *Node) bool {
+	if node == nil {
+		return false
+	}
+
+	partial := NewSnapshot()
+	if prev != nil {
+		partial = prev.Clone()
+	}
+	partial.Node = node
+	partial.Timestamp = pm.clock.Now()
+	if !pm.snapshot.CompareAndSwap(prev, partial) {
+		return false
+	}
+	pm.signalNewData()
+	return true
+}
+
 const (
 	nodePowerError      = "failed to calculate node power: %w"
 	processPowerError   = "failed to calculate process power: %w"
@@ -381,17 +678,55 @@ const (
 	podPowerError       = "failed to calculate pod power: %w"
 )
 
-func (pm *PowerMonitor) firstReading(newSnapshot *Snapshot) error {
-	// First read for node
-	if err := pm.firstNodeRead(newSnapshot.Node); err != nil {
-		return fmt.Errorf(nodePowerError, err)
+// readNode reads the node power into newSnapshot, for the first time if there
+// is no previous snapshot
+func (pm *PowerMonitor) readNode(prev, newSnapshot *Snapshot) error {
+	if prev == nil {
+		return pm.firstNodeRead(newSnapshot.Node)
 	}
+	return pm.calculateNodePower(prev.Node, newSnapshot.Node)
+}
+
+// nodeReadState is the state of the monitor changed by a node read
+type nodeReadState struct {
+	readErrors           map[MeterZone]uint64
+	raplPermissionDenied bool
+	powerSmoothers       map[EnergyZone]*zoneSmoother
+}
 
+// saveNodeReadState returns a copy of the state a node read changes
+func (pm *PowerMonitor) saveNodeReadState() nodeReadState {
+	s := nodeReadState{
+		readErrors:           maps.Clone(pm.readErrors),
+		raplPermissionDenied: pm.raplPermissionDenied,
+	}
+	if pm.powerSmoothers != nil {
+		s.powerSmoothers = make(map[EnergyZone]*zoneSmoother, len(pm.powerSmoothers))
+		for zone, smoother := range pm.powerSmoothers {
+			s.powerSmoothers[zone] = smoother.clone()
+		}
+	}
+	return s
+}
+
+// restoreNodeReadState undoes the changes of a node read since s was saved
+func (pm *PowerMonitor) restoreNodeReadState(s nodeReadState) {
+	pm.readErrors = s.readErrors
+	pm.raplPermissionDenied = s.raplPermissionDenied
+	pm.powerSmoothers = s.powerSmoothers
+}
+
+func (pm *PowerMonitor) firstReading(newSnapshot *Snapshot) error {
 	if err := pm.resources.Refresh(); err != nil {
 		pm.logger.Error("snapshot rebuild failed to refresh resources", "error", err)
 		return err
 	}
 
+	if pm.mode == ModeNodeOnly {
+		newSnapshot.GPUStats = pm.readGPUDeviceStats(pm.gpuMeters)
+		return nil
+	}
+
 	// First read for processes
 	if err := pm.firstProcessRead(newSnapshot); err != nil {
 		return fmt.Errorf(processPowerError, err)
@@ -411,20 +746,22 @@ func (pm *PowerMonitor) firstReading(newSnapshot *Snapshot) error {
 		return fmt.Errorf(podPowerError, err)
 	}
 
+	pm.calculateUserPower(newSnapshot)
+
 	return nil
 }
 
 func (pm *PowerMonitor) calculatePower(prev, newSnapshot *Snapshot) error {
-	// Calculate node power
-	if err := pm.calculateNodePower(prev.Node, newSnapshot.Node); err != nil {
-		return fmt.Errorf(nodePowerError, err)
-	}
-
 	if err := pm.resources.Refresh(); err != nil {
 		pm.logger.Error("snapshot rebuild failed to refresh resources", "error", err)
 		return err
 	}
 
+	if pm.mode == ModeNodeOnly {
+		pm.calculateGPUDeviceStats(prev, newSnapshot, pm.gpuMeters)
+		return nil
+	}
+
 	// Calculate process power
 	if err := pm.calculateProcessPower(prev, newSnapshot); err != nil {
 		return fmt.Errorf(processPowerError, err)
@@ -445,5 +782,7 @@ func (pm *PowerMonitor) calculatePower(prev, newSnapshot *Snapshot) error {
 		return fmt.Errorf(podPowerError, err)
 	}
 
+	pm.calculateUserPower(newSnapshot)
+
 	return nil
 }
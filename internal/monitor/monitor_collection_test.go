@@ -5,6 +5,7 @@ package monitor
 
 import (
 	"context"
+	"log/slog"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -12,6 +13,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"github.com/sustainable-computing-io/kepler/internal/device"
 	testingclock "k8s.io/utils/clock/testing"
 )
 
@@ -398,6 +400,104 @@ func TestCollectionErrorHandling(t *testing.T) {
 	pkg.AssertExpectations(t)
 }
 
+func TestCollectionBackoff(t *testing.T) {
+	pkg := device.NewMockRaplZone("package-0", 0, "/sys/class/powercap/intel-rapl/intel-rapl:0", 1000*Joule)
+
+	// zones are read once by Init; every collection afterwards fails until
+	// the meter recovers
+	var failing atomic.Bool
+	failing.Store(true)
+	var collections atomic.Int32
+	mockMeter := &MockCPUPowerMeter{}
+	mockMeter.On("Zones").Return([]EnergyZone{pkg}, nil).Once()
+	zonesCall := mockMeter.On("Zones")
+	zonesCall.Run(func(mock.Arguments) {
+		collections.Add(1)
+		if failing.Load() {
+			zonesCall.ReturnArguments = mock.Arguments{[]EnergyZone(nil), assert.AnError}
+			return
+		}
+		zonesCall.ReturnArguments = mock.Arguments{[]EnergyZone{pkg}, nil}
+	})
+	mockMeter.On("PrimaryEnergyZone").Return(pkg, nil)
+
+	tr := CreateTestResources()
+	resourceInformer := &MockResourceInformer{}
+	resourceInformer.SetExpectations(t, tr)
+	resourceInformer.On("Refresh").Return(nil)
+
+	fakeClock := testingclock.NewFakeClock(time.Now())
+	monitor := NewPowerMonitor(
+		mockMeter,
+		WithLogger(slog.New(slog.DiscardHandler)),
+		WithClock(fakeClock),
+		WithInterval(time.Second),
+		WithMaxBackoff(5*time.Second),
+		WithResourceInformer(resourceInformer),
+	)
+	require.NoError(t, monitor.Init())
+	assert.Equal(t, time.Second, monitor.nextCollectionInterval())
+
+	// waitForCollection steps the clock to the next scheduled collection and
+	// waits for it to run
+	waitForCollection := func(t *testing.T, after time.Duration) {
+		t.Helper()
+		want := collections.Load() + 1
+		require.Eventually(t, fakeClock.HasWaiters, time.Second, time.Millisecond)
+		fakeClock.Step(after - time.Millisecond)
+		time.Sleep(5 * time.Millisecond)
+		require.Less(t, collections.Load(), want, "collection ran before the backoff interval elapsed")
+		fakeClock.Step(time.Millisecond)
+		require.Eventually(t, func() bool { return collections.Load() >= want }, time.Second, time.Millisecond)
+	}
+
+	monitor.collectionLoop()
+	t.Cleanup(func() {
+		monitor.collectionCancel()
+		monitor.collectionWg.Wait()
+	})
+	assert.Equal(t, int32(1), collections.Load())
+	assert.Equal(t, 1, monitor.ConsecutiveErrors())
+
+	// the interval doubles with every failed collection up to maxBackoff
+	for i, expected := range []time.Duration{2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second} {
+		waitForCollection(t, expected)
+		require.Eventually(t, func() bool { return monitor.ConsecutiveErrors() == i+2 }, time.Second, time.Millisecond)
+	}
+
+	// a successful collection resets the interval
+	failing.Store(false)
+	waitForCollection(t, 5*time.Second)
+	require.Eventually(t, func() bool { return monitor.ConsecutiveErrors() == 0 }, time.Second, time.Millisecond)
+	assert.Equal(t, time.Second, monitor.nextCollectionInterval())
+}
+
+func TestNextCollectionInterval(t *testing.T) {
+	tt := []struct {
+		name       string
+		interval   time.Duration
+		maxBackoff time.Duration
+		errors     int64
+		expected   time.Duration
+	}{
+		{"no errors", time.Second, time.Minute, 0, time.Second},
+		{"one error", time.Second, time.Minute, 1, 2 * time.Second},
+		{"doubles", time.Second, time.Minute, 4, 16 * time.Second},
+		{"capped", time.Second, time.Minute, 10, time.Minute},
+		{"many errors", time.Second, time.Minute, 1000, time.Minute},
+		{"backoff disabled", time.Second, 0, 5, time.Second},
+		{"max below interval", 10 * time.Second, 5 * time.Second, 5, 10 * time.Second},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			pm := &PowerMonitor{interval: tc.interval, maxBackoff: tc.maxBackoff}
+			pm.consecutiveErrors.Store(tc.errors)
+			assert.Equal(t, tc.expected, pm.nextCollectionInterval())
+		})
+	}
+}
+
 func assertDataChannelEmpty(t *testing.T, dataCh <-chan struct{}, timeout time.Duration) {
 	t.Helper()
 	select {
@@ -418,3 +518,144 @@ func assertDataUpdated(t *testing.T, dataCh <-chan struct{}, timeout time.Durati
 		t.Fatalf("No signal received within %s; %s", timeout, msg)
 	}
 }
+
+func TestCollectionTimeout(t *testing.T) {
+	zones := CreateTestZones()
+
+	// the meter blocks while slow is set until block is closed
+	var slow atomic.Bool
+	block := make(chan struct{})
+	mockMeter := &MockCPUPowerMeter{}
+	mockMeter.On("Zones").Return(zones, nil).Run(func(mock.Arguments) {
+		if slow.Load() {
+			<-block
+		}
+	})
+	mockMeter.On("PrimaryEnergyZone").Return(zones[0], nil)
+
+	tr := CreateTestResources()
+	resourceInformer := &MockResourceInformer{}
+	resourceInformer.SetExpectations(t, tr)
+	resourceInformer.On("Refresh").Return(nil)
+
+	fakeClock := testingclock.NewFakeClock(time.Now())
+	pm := NewPowerMonitor(
+		mockMeter,
+		WithLogger(slog.New(slog.DiscardHandler)),
+		WithClock(fakeClock),
+		WithResourceInformer(resourceInformer),
+		WithCollectionTimeout(time.Second),
+	)
+	require.NoError(t, pm.Init())
+
+	require.NoError(t, pm.refreshSnapshot())
+	old := pm.snapshot.Load()
+	require.NotNil(t, old)
+
+	slow.Store(true)
+	errCh := make(chan error, 1)
+	go func() { errCh <- pm.refreshSnapshot() }()
+
+	require.Eventually(t, fakeClock.HasWaiters, time.Second, time.Millisecond)
+	fakeClock.Step(time.Second)
+
+	select {
+	case err := <-errCh:
+		assert.ErrorContains(t, err, "timed out")
+	case <-time.After(time.Second):
+		t.Fatal("timed out collection must not wait for the slow meter")
+	}
+	assert.Same(t, old, pm.snapshot.Load(), "previous snapshot must be retained")
+
+	assert.ErrorContains(t, pm.refreshSnapshot(), "still in progress",
+		"a new collection must not wait for the abandoned one")
+
+	// the abandoned collection completes but is discarded, along with the
+	// read error it counted
+	core := zones[1].(*device.MockRaplZone)
+	core.OnEnergy(0, assert.AnError)
+	slow.Store(false)
+	close(block)
+	waitForCollection(t, pm)
+	assert.Same(t, old, pm.snapshot.Load(), "abandoned snapshot must be discarded")
+	assert.Empty(t, pm.readErrors, "abandoned collection must not change the monitor state")
+
+	core.OnEnergy(0, nil)
+	fakeClock.Step(time.Second)
+	require.NoError(t, pm.refreshSnapshot())
+	assert.NotSame(t, old, pm.snapshot.Load())
+}
+
+func TestCollectionTimeout_AfterNodeRead(t *testing.T) {
+	zones := CreateTestZones()
+	mockMeter := &MockCPUPowerMeter{}
+	mockMeter.On("Zones").Return(zones, nil)
+	mockMeter.On("PrimaryEnergyZone").Return(zones[0], nil)
+
+	// the informer blocks while slow is set until block is closed
+	var slow atomic.Bool
+	block := make(chan struct{})
+	tr := CreateTestResources()
+	resourceInformer := &MockResourceInformer{}
+	resourceInformer.SetExpectations(t, tr)
+	resourceInformer.On("Refresh").Return(nil).Run(func(mock.Arguments) {
+		if slow.Load() {
+			<-block
+		}
+	})
+
+	fakeClock := testingclock.NewFakeClock(time.Now())
+	pm := NewPowerMonitor(
+		mockMeter,
+		WithLogger(slog.New(slog.DiscardHandler)),
+		WithClock(fakeClock),
+		WithResourceInformer(resourceInformer),
+		WithCollectionTimeout(time.Second),
+	)
+	require.NoError(t, pm.Init())
+
+	require.NoError(t, pm.refreshSnapshot())
+	old := pm.snapshot.Load()
+
+	fakeClock.Step(time.Second)
+	slow.Store(true)
+	errCh := make(chan error, 1)
+	go func() { errCh <- pm.refreshSnapshot() }()
+
+	require.Eventually(t, fakeClock.HasWaiters, time.Second, time.Millisecond)
+	fakeClock.Step(time.Second)
+
+	select {
+	case err := <-errCh:
+		assert.ErrorContains(t, err, "timed out")
+	case <-time.After(time.Second):
+		t.Fatal("timed out collection must not wait for the slow informer")
+	}
+	// the node data read is published right away, with the previous workloads
+	partial := pm.snapshot.Load()
+	require.NotSame(t, old, partial, "node data of the timed out collection must be published")
+	assert.Equal(t, fakeClock.Now(), partial.Timestamp)
+	assert.NotEqual(t, old.Node.Timestamp, partial.Node.Timestamp, "partial snapshot must carry the new node data")
+	assert.Equal(t, len(old.Processes), len(partial.Processes), "partial snapshot must keep the previous workloads")
+	assert.Equal(t, len(old.Containers), len(partial.Containers))
+
+	// the informer was refreshed, so the collection is published once complete
+	slow.Store(false)
+	close(block)
+	waitForCollection(t, pm)
+	full := pm.snapshot.Load()
+	assert.NotSame(t, partial, full, "committed collection must be published")
+	assert.Equal(t, partial.Node.Timestamp, full.Node.Timestamp, "full snapshot must carry the same node data")
+}
+
+// waitForCollection waits until no collection is running
+func waitForCollection(t *testing.T, pm *PowerMonitor) {
+	t.Helper()
+	require.Eventually(t, func() bool {
+		if !pm.computeMu.TryLock() {
+			return false
+		}
+		pm.computeMu.Unlock()
+		return true
+	}, time.Second, time.Millisecond)
+}
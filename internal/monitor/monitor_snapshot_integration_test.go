@@ -10,6 +10,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/sustainable-computing-io/kepler/internal/device"
 	testingclock "k8s.io/utils/clock/testing"
 )
 
@@ -227,6 +228,9 @@ func TestIntegration_Monitor_Snapshot(t *testing.T) {
 	}
 	assert.Equal(t, snapshot2.Node.Zones[pkg].ActivePower, totalProcessPower,
 		"Sum of process power should equal node active power")
+	assert.NoError(t, VerifyConservation(snapshot2))
+	assert.Equal(t, 50*Joule, snapshot2.Node.Zones[pkg].EnergyDelta,
+		"Energy delta should be the energy consumed since the previous reading")
 
 	// === Collection 3: Continued operation ===
 	fakeClock.Step(5 * time.Second) // Advance time again
@@ -272,6 +276,7 @@ func TestIntegration_Monitor_Snapshot(t *testing.T) {
 		"Sum of process energy should equal accumulated process attribution (60J)")
 	assert.Equal(t, snapshot3.Node.Zones[pkg].ActivePower, totalProcessPower3,
 		"Sum of process power should equal node active power in third snapshot")
+	assert.NoError(t, VerifyConservation(snapshot3))
 
 	// === Verify terminated workload tracking is configured ===
 	t.Run("Terminated workload tracking configuration", func(t *testing.T) {
@@ -464,3 +469,55 @@ func TestIntegration_Monitor_Snapshot(t *testing.T) {
 		snapshot1.Node.Zones[pkg].EnergyTotal.Joules(),
 		snapshot3.Node.Zones[pkg].EnergyTotal.Joules())
 }
+
+// TestIntegration_Monitor_EnergyWraparound drives the monitor with a fake RAPL
+// meter whose counter is about to wrap and verifies the node energy delta
+// across the wrap is the energy actually consumed.
+func TestIntegration_Monitor_EnergyWraparound(t *testing.T) {
+	tr := CreateTestResources(
+		withNodeCpuUsage(0.5),
+		withNodeCpuTimeDelta(2000.0),
+	)
+
+	// counter reads 960J, then wraps to 20J: 60J consumed per read
+	cpuMeter, err := device.NewFakeCPUMeter([]string{"package"},
+		device.WithFakeMaxEnergy(1000*Joule),
+		device.WithFakeInitialEnergy(900*Joule),
+		device.WithFakeIncrement(60*Joule),
+	)
+	require.NoError(t, err)
+	zones, err := cpuMeter.Zones()
+	require.NoError(t, err)
+	pkg := zones[0]
+
+	resourceInformer := &MockResourceInformer{}
+	resourceInformer.SetExpectations(t, tr)
+	resourceInformer.On("Refresh").Return(nil).Times(2)
+
+	fakeClock := testingclock.NewFakeClock(time.Date(2025, 07, 10, 12, 0, 0, 0, time.UTC))
+	monitor := NewPowerMonitor(
+		cpuMeter,
+		WithResourceInformer(resourceInformer),
+		WithClock(fakeClock),
+		WithLogger(slog.Default().With("test", "energy-wraparound")),
+	)
+	require.NoError(t, monitor.Init())
+
+	snapshot1, err := monitor.Snapshot()
+	require.NoError(t, err)
+	usage1 := snapshot1.Node.Zones[pkg]
+	assert.Equal(t, 960*Joule, usage1.EnergyTotal)
+
+	fakeClock.Step(5 * time.Second)
+	snapshot2, err := monitor.Snapshot()
+	require.NoError(t, err)
+	usage2 := snapshot2.Node.Zones[pkg]
+
+	assert.Equal(t, 20*Joule, usage2.EnergyTotal, "counter must have wrapped")
+
+	delta := (usage2.ActiveEnergyTotal + usage2.IdleEnergyTotal) -
+		(usage1.ActiveEnergyTotal + usage1.IdleEnergyTotal)
+	assert.Equal(t, 60*Joule, delta, "energy delta across the wrap")
+	assert.Equal(t, 30*Joule, usage2.ActiveEnergyTotal-usage1.ActiveEnergyTotal)
+	assert.InDelta(t, 12.0, usage2.Power.Watts(), 1e-9, "60J over 5s")
+}
@@ -9,6 +9,7 @@ import (
 	"log"
 	"log/slog"
 	"os"
+	"reflect"
 	"testing"
 	"time"
 
@@ -16,6 +17,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/sustainable-computing-io/kepler/internal/device"
+	"github.com/sustainable-computing-io/kepler/internal/device/gpu"
 	"github.com/sustainable-computing-io/kepler/internal/resource"
 	testingclock "k8s.io/utils/clock/testing"
 )
@@ -171,6 +173,67 @@ func TestPowerMonitor_Snapshot(t *testing.T) {
 	assert.Equal(t, monitor.snapshot.Load(), snapshot)
 }
 
+func TestPowerMonitor_ShallowSnapshot(t *testing.T) {
+	zones := CreateTestZones()
+	mockMeter := &MockCPUPowerMeter{}
+	mockMeter.On("Zones").Return(zones, nil)
+	mockMeter.On("PrimaryEnergyZone").Return(zones[0], nil)
+
+	tr := CreateTestResources()
+	resourceInformer := &MockResourceInformer{}
+	resourceInformer.SetExpectations(t, tr)
+	resourceInformer.On("Refresh").Return(nil)
+
+	monitor := NewPowerMonitor(mockMeter, WithResourceInformer(resourceInformer))
+	require.NoError(t, monitor.Init())
+
+	snapshot, err := monitor.ShallowSnapshot()
+	require.NoError(t, err)
+
+	current := monitor.snapshot.Load()
+	assert.NotSame(t, current, snapshot)
+	assert.Equal(t, current, snapshot)
+	assert.NotSame(t, current.Node, snapshot.Node, "node must be deep copied")
+	assert.Equal(t, reflect.ValueOf(current.Processes).Pointer(), reflect.ValueOf(snapshot.Processes).Pointer(),
+		"processes must be shared with the published snapshot")
+	assert.True(t, monitor.exported.Load(), "shallow snapshots must also mark the snapshot as exported")
+}
+
+func TestPowerMonitor_LatestSnapshot(t *testing.T) {
+	fakeClock := testingclock.NewFakeClock(time.Now())
+	zones := CreateTestZones()
+	mockMeter := &MockCPUPowerMeter{}
+	mockMeter.On("Zones").Return(zones, nil)
+	mockMeter.On("PrimaryEnergyZone").Return(zones[0], nil)
+
+	tr := CreateTestResources()
+	resourceInformer := &MockResourceInformer{}
+	resourceInformer.SetExpectations(t, tr)
+	resourceInformer.On("Refresh").Return(nil)
+
+	monitor := NewPowerMonitor(mockMeter,
+		WithClock(fakeClock),
+		WithMaxStaleness(time.Second),
+		WithResourceInformer(resourceInformer))
+	require.NoError(t, monitor.Init())
+
+	_, _, err := monitor.LatestSnapshot()
+	assert.Error(t, err, "no snapshot before the first collection")
+
+	require.NoError(t, monitor.refreshSnapshot())
+	current := monitor.snapshot.Load()
+
+	// the snapshot is not refreshed however old it is
+	fakeClock.Step(time.Minute)
+	snapshot, age, err := monitor.LatestSnapshot()
+	require.NoError(t, err)
+	assert.NotSame(t, current, snapshot)
+	assert.Equal(t, current, snapshot)
+	assert.Equal(t, time.Minute, age, "age is measured by the monitor clock")
+	assert.Same(t, current, monitor.snapshot.Load())
+	assert.True(t, monitor.exported.Load())
+}
+
 func TestPowerMonitor_InitZones(t *testing.T) {
 	fakePowerMeter, err := device.NewFakeCPUMeter(nil)
 	require.NoError(t, err, "failed to create fake power meter")
@@ -1055,3 +1118,93 @@ func TestSnapshotFreshnessAndCloning(t *testing.T) {
 	resourceInformer.AssertExpectations(t)
 	mockMeter.AssertExpectations(t)
 }
+
+func TestNodeOnlyMode(t *testing.T) {
+	zones := CreateTestZones()
+	mockMeter := &MockCPUPowerMeter{}
+	mockMeter.On("Zones").Return(zones, nil)
+	mockMeter.On("PrimaryEnergyZone").Return(zones[0], nil)
+
+	gpuMeter := &MockGPUPowerMeter{}
+	gpuMeter.On("Vendor").Return(gpu.VendorNVIDIA)
+	gpuMeter.On("Devices").Return([]gpu.GPUDevice{{Index: 0, UUID: "GPU-0", Name: "NVIDIA A100", Vendor: gpu.VendorNVIDIA}})
+	gpuMeter.On("GetDevicePowerStats", 0).Return(gpu.GPUPowerStats{TotalPower: 100, IdlePower: 40, ActivePower: 60}, nil)
+	gpuMeter.On("GetTotalEnergy", 0).Return(device.Energy(500*Joule), nil)
+
+	// only node usage is read; workloads are never looked up
+	tr := CreateTestResources(createOnly(testNode))
+	resourceInformer := &MockResourceInformer{}
+	resourceInformer.SetExpectations(t, tr)
+	resourceInformer.On("Refresh").Return(nil)
+
+	fakeClock := testingclock.NewFakeClock(time.Now())
+	monitor := NewPowerMonitor(
+		mockMeter,
+		WithClock(fakeClock),
+		WithResourceInformer(resourceInformer),
+		WithGPUPowerMeters([]gpu.GPUPowerMeter{gpuMeter}),
+		WithMode(ModeNodeOnly),
+	)
+	require.NoError(t, monitor.Init())
+
+	for range 2 {
+		fakeClock.Step(time.Second)
+		require.NoError(t, monitor.refreshSnapshot())
+	}
+
+	snapshot := monitor.snapshot.Load()
+	require.NotNil(t, snapshot)
+	assert.Len(t, snapshot.Node.Zones, len(zones))
+	require.Len(t, snapshot.GPUStats, 1)
+	assert.Equal(t, "GPU-0", snapshot.GPUStats[0].UUID)
+	assert.Equal(t, 100.0, snapshot.GPUStats[0].TotalPower)
+
+	assert.Empty(t, snapshot.Processes)
+	assert.Empty(t, snapshot.Containers)
+	assert.Empty(t, snapshot.VirtualMachines)
+	assert.Empty(t, snapshot.Pods)
+
+	for _, method := range []string{"Processes", "Containers", "VirtualMachines", "Pods"} {
+		resourceInformer.AssertNotCalled(t, method)
+	}
+	gpuMeter.AssertNotCalled(t, "GetProcessPower")
+}
+
+// BenchmarkRefreshSnapshot compares the cost of a refresh in each monitor mode
+func BenchmarkRefreshSnapshot(b *testing.B) {
+	for _, mode := range []Mode{ModeFull, ModeNodeOnly} {
+		b.Run(string(mode), func(b *testing.B) {
+			zones := CreateTestZones()
+			mockMeter := &MockCPUPowerMeter{}
+			mockMeter.On("Zones").Return(zones, nil)
+			mockMeter.On("PrimaryEnergyZone").Return(zones[0], nil)
+
+			tr := CreateTestResources()
+			resourceInformer := &MockResourceInformer{}
+			resourceInformer.On("Node").Return(tr.Node)
+			resourceInformer.On("Processes").Return(tr.Processes)
+			resourceInformer.On("Containers").Return(tr.Containers)
+			resourceInformer.On("VirtualMachines").Return(tr.VirtualMachines)
+			resourceInformer.On("Pods").Return(tr.Pods)
+			resourceInformer.On("Refresh").Return(nil)
+
+			fakeClock := testingclock.NewFakeClock(time.Now())
+			monitor := NewPowerMonitor(
+				mockMeter,
+				WithLogger(slog.New(slog.DiscardHandler)),
+				WithClock(fakeClock),
+				WithResourceInformer(resourceInformer),
+				WithMode(mode),
+			)
+			require.NoError(b, monitor.Init())
+
+			b.ResetTimer()
+			for b.Loop() {
+				fakeClock.Step(time.Second)
+				if err := monitor.refreshSnapshot(); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -5,6 +5,8 @@ package monitor
 
 import (
 	"errors"
+
+	"github.com/sustainable-computing-io/kepler/internal/device"
 )
 
 func (pm *PowerMonitor) calculateNodePower(prevNode, newNode *Node) error {
@@ -14,6 +16,7 @@ func (pm *PowerMonitor) calculateNodePower(prevNode, newNode *Node) error {
 
 	now := pm.clock.Now()
 	newNode.Timestamp = now
+	newNode.PrimaryZone = pm.primaryZone
 
 	// get zones first, before locking for read
 	zones, err := pm.cpu.Zones()
@@ -22,8 +25,8 @@ func (pm *PowerMonitor) calculateNodePower(prevNode, newNode *Node) error {
 	}
 
 	nodeCPUTimeDelta := pm.resources.Node().ProcessTotalCPUTimeDelta
-	nodeCPUUsageRatio := pm.resources.Node().CPUUsageRatio
-	newNode.UsageRatio = nodeCPUUsageRatio
+	nodeCPUUsageRatio := pm.activeUsageRatio()
+	newNode.UsageRatio = pm.resources.Node().CPUUsageRatio
 
 	pm.logger.Debug("Calculating Node power",
 		"node.process-cpu.time", nodeCPUTimeDelta,
@@ -35,6 +38,7 @@ func (pm *PowerMonitor) calculateNodePower(prevNode, newNode *Node) error {
 	// Get the current energy
 
 	var retErr error
+	denied := false
 	for _, zone := range zones {
 		var deltaEnergy Energy
 		var power Power
@@ -53,8 +57,15 @@ func (pm *PowerMonitor) calculateNodePower(prevNode, newNode *Node) error {
 			absEnergy = energyReading
 
 			if energyErr != nil {
-				retErr = errors.Join(energyErr)
+				pm.recordReadError(cpuMeter, zone.Name())
 				pm.logger.Warn("Could not read energy for zone", "zone", zone.Name(), "index", zone.Index(), "error", energyErr)
+				if isPermissionDenied(energyErr) {
+					// not fatal: the snapshot is published without the zone
+					// so that the denied read is reported
+					denied = true
+					continue
+				}
+				retErr = errors.Join(energyErr)
 				continue
 			}
 
@@ -67,6 +78,7 @@ func (pm *PowerMonitor) calculateNodePower(prevNode, newNode *Node) error {
 			// power sensor
 			if powerErr != nil {
 				retErr = errors.Join(powerErr)
+				pm.recordReadError(cpuMeter, zone.Name())
 				pm.logger.Warn("Could not read power for zone", "zone", zone.Name(), "index", zone.Index(), "error", powerErr)
 				continue
 			}
@@ -86,7 +98,7 @@ func (pm *PowerMonitor) calculateNodePower(prevNode, newNode *Node) error {
 		}
 
 		// Calculate watts and joules diff if we have previous data for the zone
-		var activeEnergy, activeEnergyTotal, idleEnergyTotal Energy
+		var activeEnergy, idleEnergy, activeEnergyTotal, idleEnergyTotal Energy
 		var activePower, idlePower Power
 
 		if prevZone, ok := prevZones[zone]; ok {
@@ -132,13 +144,17 @@ func (pm *PowerMonitor) calculateNodePower(prevNode, newNode *Node) error {
 			// active = delta * cpuUsage
 			// idle = delta - active
 			activeEnergy = Energy(float64(deltaEnergy) * nodeCPUUsageRatio)
-			idleEnergy := deltaEnergy - activeEnergy
+			idleEnergy = deltaEnergy - activeEnergy
 
 			activeEnergyTotal = prevZone.ActiveEnergyTotal + activeEnergy
 			idleEnergyTotal = prevZone.IdleEnergyTotal + idleEnergy
 
 			activePower = Power(float64(power) * nodeCPUUsageRatio)
 			idlePower = power - activePower
+			if pm.powerSmoothingWindow > 0 {
+				activePower, idlePower = pm.smoothZonePower(zone, prevReadTime, now, activeEnergy, idleEnergy)
+				power = activePower + idlePower
+			}
 			pm.logger.Debug("Active and idle power/energy",
 				"active_power", activePower,
 				"idle_power", idlePower,
@@ -158,20 +174,54 @@ func (pm *PowerMonitor) calculateNodePower(prevNode, newNode *Node) error {
 
 		newNode.Zones[zone] = NodeUsage{
 			EnergyTotal: absEnergy,
+			EnergyDelta: deltaEnergy,
 
 			activeEnergy:      activeEnergy,
+			idleEnergy:        idleEnergy,
 			ActiveEnergyTotal: activeEnergyTotal,
 			IdleEnergyTotal:   idleEnergyTotal,
 
 			Power:       power,
 			ActivePower: activePower,
 			IdlePower:   idlePower,
+
+			PowerLimit: pm.zonePowerLimit(zone),
 		}
 	}
 
+	pm.raplPermissionDenied = denied
+	pm.readCoreZones(newNode, prevNode.CoreZones, timeDiff)
+
 	return retErr
 }
 
+// readCoreZones reads the per-core energy zones into node.CoreZones.
+// Power is derived from the energy delta since the previous reading. Read
+// errors are counted but not returned since per-core power is informational
+// and not used for attribution.
+func (pm *PowerMonitor) readCoreZones(node *Node, prev ZoneUsageMap, timeDiff float64) {
+	if len(pm.coreZones) == 0 {
+		return
+	}
+
+	node.CoreZones = make(ZoneUsageMap, len(pm.coreZones))
+	for _, zone := range pm.coreZones {
+		energy, err := zone.Energy()
+		if err != nil {
+			pm.recordReadError(cpuMeter, zone.Name())
+			pm.logger.Debug("Could not read energy for core", "core", zone.Index(), "error", err)
+			continue
+		}
+
+		usage := Usage{EnergyTotal: energy}
+		if prevUsage, ok := prev[zone]; ok && timeDiff > 0 {
+			delta := calculateEnergyDelta(energy, prevUsage.EnergyTotal, zone.MaxEnergy())
+			usage.Power = Power(float64(delta) / timeDiff)
+		}
+		node.CoreZones[zone] = usage
+	}
+}
+
 // Calculate joules difference handling wraparound
 func calculateEnergyDelta(current, previous, maxJoules Energy) Energy {
 	if current >= previous {
@@ -189,14 +239,16 @@ func calculateEnergyDelta(current, previous, maxJoules Energy) Energy {
 // firstNodeRead reads the energy for the first time
 func (pm *PowerMonitor) firstNodeRead(node *Node) error {
 	node.Timestamp = pm.clock.Now()
+	node.PrimaryZone = pm.primaryZone
 
 	zones, err := pm.cpu.Zones()
 	if err != nil {
 		return err
 	}
 
-	nodeCPUUsageRatio := pm.resources.Node().CPUUsageRatio
+	nodeCPUUsageRatio := pm.activeUsageRatio()
 	var retErr error
+	denied := false
 	for _, zone := range zones {
 
 		var energy Energy
@@ -211,8 +263,15 @@ func (pm *PowerMonitor) firstNodeRead(node *Node) error {
 		if isEnergySensor {
 			// energy sensor
 			if energyErr != nil {
-				retErr = errors.Join(energyErr)
+				pm.recordReadError(cpuMeter, zone.Name())
 				pm.logger.Warn("Could not read energy for zone", "zone", zone.Name(), "index", zone.Index(), "error", energyErr)
+				if isPermissionDenied(energyErr) {
+					// not fatal: the snapshot is published without the zone
+					// so that the denied read is reported
+					denied = true
+					continue
+				}
+				retErr = errors.Join(energyErr)
 				continue
 			}
 			energy = energyReading
@@ -224,6 +283,7 @@ func (pm *PowerMonitor) firstNodeRead(node *Node) error {
 			// power sensor
 			if powerErr != nil {
 				retErr = errors.Join(powerErr)
+				pm.recordReadError(cpuMeter, zone.Name())
 				pm.logger.Warn("Could not read power for zone", "zone", zone.Name(), "index", zone.Index(), "error", powerErr)
 				continue
 			}
@@ -242,16 +302,52 @@ func (pm *PowerMonitor) firstNodeRead(node *Node) error {
 		activeEnergy := Energy(float64(energy) * nodeCPUUsageRatio)
 		idleEnergy := energy - activeEnergy
 
+		// power zones split power the same way as subsequent reads so that
+		// active and idle power always sum to the zone power
+		activePower := Power(float64(power) * nodeCPUUsageRatio)
+		idlePower := power - activePower
+
 		node.Zones[zone] = NodeUsage{
 			EnergyTotal:       energy,
 			ActiveEnergyTotal: activeEnergy,
 			IdleEnergyTotal:   idleEnergy,
 			activeEnergy:      activeEnergy,
+			idleEnergy:        idleEnergy,
 			Power:             power, // Will be 0 for energy zones on first read
 			// Power can't be calculated for energy zones in the first read since we need Δt
 			// For power zones, we set it immediately
+			ActivePower: activePower,
+			IdlePower:   idlePower,
+
+			PowerLimit: pm.zonePowerLimit(zone),
 		}
 	}
 
+	pm.raplPermissionDenied = denied
+	pm.readCoreZones(node, nil, 0)
+
 	return retErr
 }
+
+// zonePowerLimit returns the power limit of zone or 0 if the zone does not
+// expose one or it cannot be read
+func (pm *PowerMonitor) zonePowerLimit(zone EnergyZone) Power {
+	limiter, ok := zone.(device.PowerLimiter)
+	if !ok {
+		return 0
+	}
+
+	limit, err := limiter.PowerLimit()
+	if err != nil {
+		pm.logger.Debug("Could not read power limit for zone", "zone", zone.Name(), "index", zone.Index(), "error", err)
+		return 0
+	}
+	return limit
+}
+
+// isPermissionDenied returns true if a zone read failed because Kepler is not
+// permitted to read the RAPL energy counters
+func isPermissionDenied(err error) bool {
+	var denied device.ErrRaplPermissionDenied
+	return errors.As(err, &denied)
+}
@@ -274,11 +274,268 @@ func TestNodeErrorHandling(t *testing.T) {
 		// Should have zone info for both
 		assert.NotContains(t, current.Node.Zones, pkg)
 		assert.Contains(t, current.Node.Zones, core)
+
+		// Each failed read must be counted
+		assert.Equal(t, uint64(2), pm.readErrors[MeterZone{Meter: "cpu", Zone: "package-0"}])
+		assert.NotContains(t, pm.readErrors, MeterZone{Meter: "cpu", Zone: "core-0"})
 	})
 
 	mockResourceInformer.AssertExpectations(t)
 }
 
+func TestMeterReadErrorsInSnapshot(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+
+	pkg := device.NewMockRaplZone(
+		"package-0",
+		0, "/sys/class/powercap/intel-rapl/intel-rapl:0", 200*Joule)
+	core := device.NewMockRaplZone(
+		"core-0", 0, "/sys/class/powercap/intel-rapl/intel-rapl:0/intel-rapl:0:0", 150*Joule)
+
+	mockCPUPowerMeter := &MockCPUPowerMeter{}
+	mockCPUPowerMeter.On("Zones").Return([]EnergyZone{pkg, core}, nil)
+	mockCPUPowerMeter.On("PrimaryEnergyZone").Return(pkg, nil)
+
+	mockClock := test_clock.NewFakeClock(time.Date(2025, 4, 14, 5, 40, 0, 0, time.UTC))
+
+	resInformer := &MockResourceInformer{}
+	resInformer.SetExpectations(t, CreateTestResources())
+	resInformer.On("Refresh").Return(nil)
+
+	pm := NewPowerMonitor(
+		mockCPUPowerMeter,
+		WithLogger(logger),
+		WithClock(mockClock),
+		WithResourceInformer(resInformer),
+	)
+	require.NoError(t, pm.Init())
+
+	pkg.Inc(10 * Joule)
+	core.Inc(5 * Joule)
+	require.NoError(t, pm.refreshSnapshot())
+	assert.Empty(t, pm.snapshot.Load().MeterReadErrors, "no read errors expected")
+
+	// failing zone read fails the refresh but is counted
+	mockClock.Step(time.Second)
+	core.OnEnergy(0, assert.AnError)
+	assert.Error(t, pm.refreshSnapshot())
+
+	// counts are carried over to the next successful snapshot
+	mockClock.Step(time.Second)
+	core.OnEnergy(20*Joule, nil)
+	require.NoError(t, pm.refreshSnapshot())
+
+	snapshot := pm.snapshot.Load()
+	assert.Equal(t, map[MeterZone]uint64{{Meter: "cpu", Zone: "core-0"}: 1}, snapshot.MeterReadErrors)
+
+	// snapshot holds a copy of the counters
+	pm.recordReadError("cpu", "core-0")
+	assert.Equal(t, uint64(1), snapshot.MeterReadErrors[MeterZone{Meter: "cpu", Zone: "core-0"}])
+}
+
+func TestRaplPermissionDeniedInSnapshot(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+
+	pkg := device.NewMockRaplZone(
+		"package-0",
+		0, "/sys/class/powercap/intel-rapl/intel-rapl:0", 200*Joule)
+
+	mockCPUPowerMeter := &MockCPUPowerMeter{}
+	mockCPUPowerMeter.On("Zones").Return([]EnergyZone{pkg}, nil)
+	mockCPUPowerMeter.On("PrimaryEnergyZone").Return(pkg, nil)
+
+	mockClock := test_clock.NewFakeClock(time.Date(2025, 4, 14, 5, 40, 0, 0, time.UTC))
+
+	resInformer := &MockResourceInformer{}
+	resInformer.SetExpectations(t, CreateTestResources())
+	resInformer.On("Refresh").Return(nil)
+
+	pm := NewPowerMonitor(
+		mockCPUPowerMeter,
+		WithLogger(logger),
+		WithClock(mockClock),
+		WithResourceInformer(resInformer),
+	)
+	require.NoError(t, pm.Init())
+
+	pkg.Inc(10 * Joule)
+	require.NoError(t, pm.refreshSnapshot())
+	assert.False(t, pm.snapshot.Load().RaplPermissionDenied)
+
+	// other read errors are not reported as permission denied
+	mockClock.Step(time.Second)
+	pkg.OnEnergy(0, assert.AnError)
+	assert.Error(t, pm.refreshSnapshot())
+	assert.False(t, pm.raplPermissionDenied)
+
+	mockClock.Step(time.Second)
+	denied := device.ErrRaplPermissionDenied{
+		Path: "/sys/class/powercap/intel-rapl/intel-rapl:0/energy_uj",
+		Err:  os.ErrPermission,
+	}
+	pkg.OnEnergy(0, denied)
+
+	// a denied read is not fatal: the snapshot is published without the zone
+	require.NoError(t, pm.refreshSnapshot())
+	snapshot := pm.snapshot.Load()
+	assert.True(t, snapshot.RaplPermissionDenied)
+	assert.NotContains(t, snapshot.Node.Zones, EnergyZone(pkg))
+	assert.Equal(t, map[MeterZone]uint64{{Meter: "cpu", Zone: "package-0"}: 2}, snapshot.MeterReadErrors)
+
+	// reset once the counters are readable again
+	mockClock.Step(time.Second)
+	pkg.OnEnergy(20*Joule, nil)
+	require.NoError(t, pm.refreshSnapshot())
+	assert.False(t, pm.snapshot.Load().RaplPermissionDenied)
+}
+
+// limitedZone is a RAPL zone that exposes a power limit
+type limitedZone struct {
+	*device.MockRaplZone
+	limit Power
+	err   error
+}
+
+func (z limitedZone) PowerLimit() (Power, error) {
+	return z.limit, z.err
+}
+
+func TestZonePowerLimit(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+
+	pkg := limitedZone{
+		MockRaplZone: device.NewMockRaplZone("package", 0, "/sys/class/powercap/intel-rapl/intel-rapl:0", 200*Joule),
+		limit:        100 * Watt,
+	}
+	dram := limitedZone{
+		MockRaplZone: device.NewMockRaplZone("dram", 0, "/sys/class/powercap/intel-rapl/intel-rapl:0/intel-rapl:0:0", 200*Joule),
+		err:          os.ErrNotExist,
+	}
+	core := device.NewMockRaplZone("core", 0, "/sys/class/powercap/intel-rapl/intel-rapl:0/intel-rapl:0:1", 200*Joule)
+	zones := []EnergyZone{pkg, dram, core}
+
+	mockCPUPowerMeter := &MockCPUPowerMeter{}
+	mockCPUPowerMeter.On("Zones").Return(zones, nil)
+	mockCPUPowerMeter.On("PrimaryEnergyZone").Return(pkg, nil)
+
+	mockClock := test_clock.NewFakeClock(time.Date(2025, 4, 14, 5, 40, 0, 0, time.UTC))
+
+	resInformer := &MockResourceInformer{}
+	resInformer.SetExpectations(t, CreateTestResources())
+	resInformer.On("Refresh").Return(nil)
+
+	pm := NewPowerMonitor(
+		mockCPUPowerMeter,
+		WithLogger(logger),
+		WithClock(mockClock),
+		WithResourceInformer(resInformer),
+	)
+	require.NoError(t, pm.Init())
+
+	for i := range 2 {
+		for _, z := range []*device.MockRaplZone{pkg.MockRaplZone, dram.MockRaplZone, core} {
+			z.Inc(10 * Joule)
+		}
+		require.NoError(t, pm.refreshSnapshot(), "refresh %d", i)
+
+		nodeZones := pm.snapshot.Load().Node.Zones
+		assert.Equal(t, 100*Watt, nodeZones[pkg].PowerLimit)
+		assert.Zero(t, nodeZones[dram].PowerLimit, "unreadable limits are unknown")
+		assert.Zero(t, nodeZones[core].PowerLimit, "zones without limits are unknown")
+
+		mockClock.Step(time.Second)
+	}
+}
+
+func TestPerSocketZonesPower(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+
+	// package zones of a dual-socket node share a name
+	pkg0 := device.NewMockRaplZone("package", 0, "/sys/class/powercap/intel-rapl/intel-rapl:0", 200*Joule)
+	pkg1 := device.NewMockRaplZone("package", 1, "/sys/class/powercap/intel-rapl/intel-rapl:1", 200*Joule)
+
+	mockCPUPowerMeter := &MockCPUPowerMeter{}
+	mockCPUPowerMeter.On("Zones").Return([]EnergyZone{pkg0, pkg1}, nil)
+
+	mockClock := test_clock.NewFakeClock(time.Date(2025, 4, 14, 5, 40, 0, 0, time.UTC))
+	resInformer := &MockResourceInformer{}
+	resInformer.On("Node").Return(&resource.Node{CPUUsageRatio: 0.5})
+
+	pm := NewPowerMonitor(
+		mockCPUPowerMeter,
+		WithLogger(logger),
+		WithClock(mockClock),
+		WithResourceInformer(resInformer),
+	)
+
+	prev := NewSnapshot()
+	require.NoError(t, pm.firstNodeRead(prev.Node))
+
+	mockClock.Step(time.Second)
+	pkg0.Inc(30 * Joule)
+	pkg1.Inc(50 * Joule)
+
+	current := NewSnapshot()
+	require.NoError(t, pm.calculateNodePower(prev.Node, current.Node))
+
+	require.Len(t, current.Node.Zones, 2, "socket zones must not collide")
+	assert.Equal(t, 30*Watt, current.Node.Zones[pkg0].Power)
+	assert.Equal(t, 50*Watt, current.Node.Zones[pkg1].Power)
+}
+
+func TestCoreZonesPower(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+
+	pkg := device.NewMockRaplZone(
+		"package", 0, "/sys/class/powercap/intel-rapl/intel-rapl:0", 200*Joule)
+	core0 := device.NewMockRaplZone("core", 0, "/sys/class/hwmon/hwmon2/energy1_input", 1000*Joule)
+	core1 := device.NewMockRaplZone("core", 1, "/sys/class/hwmon/hwmon2/energy2_input", 1000*Joule)
+
+	mockCPUPowerMeter := &MockCPUPowerMeter{}
+	mockCPUPowerMeter.On("Zones").Return([]EnergyZone{pkg}, nil)
+	mockCPUPowerMeter.On("PrimaryEnergyZone").Return(pkg, nil)
+
+	mockClock := test_clock.NewFakeClock(time.Date(2025, 4, 14, 5, 40, 0, 0, time.UTC))
+
+	resInformer := &MockResourceInformer{}
+	resInformer.SetExpectations(t, CreateTestResources())
+	resInformer.On("Refresh").Return(nil)
+
+	pm := NewPowerMonitor(
+		mockCPUPowerMeter,
+		WithLogger(logger),
+		WithClock(mockClock),
+		WithResourceInformer(resInformer),
+		WithCoreZones([]EnergyZone{core0, core1}),
+	)
+	require.NoError(t, pm.Init())
+
+	require.NoError(t, pm.refreshSnapshot())
+	node := pm.snapshot.Load().Node
+	require.Len(t, node.CoreZones, 2)
+	assert.Equal(t, Power(0), node.CoreZones[core0].Power, "no power on first read")
+	assert.NotContains(t, node.Zones, core0, "core zones must not be attributed")
+
+	mockClock.Step(2 * time.Second)
+	pkg.Inc(40 * Joule)
+	core0.Inc(10 * Joule)
+	core1.Inc(4 * Joule)
+	require.NoError(t, pm.refreshSnapshot())
+
+	node = pm.snapshot.Load().Node
+	assert.InDelta(t, 5.0, node.CoreZones[core0].Power.Watts(), 0.001)
+	assert.InDelta(t, 2.0, node.CoreZones[core1].Power.Watts(), 0.001)
+
+	// failing core read does not fail the refresh
+	mockClock.Step(2 * time.Second)
+	core1.OnEnergy(0, assert.AnError)
+	require.NoError(t, pm.refreshSnapshot())
+
+	snapshot := pm.snapshot.Load()
+	assert.Len(t, snapshot.Node.CoreZones, 1)
+	assert.Equal(t, uint64(1), snapshot.MeterReadErrors[MeterZone{Meter: "cpu", Zone: "core"}])
+}
+
 // TestCalculateEnergyDelta tests the CalculateEnergyDelta function directly
 func TestCalculateEnergyDelta(t *testing.T) {
 	testCases := []struct {
@@ -665,6 +922,81 @@ func TestNodeActiveEnergyTotalAccumulation(t *testing.T) {
 	mockResourceInformer.AssertExpectations(t)
 }
 
+// TestNodeActiveIdleConsistency verifies that the active and idle energy
+// counters of every zone sum to its energy counter, and the active and idle
+// power to its power, while the CPU usage ratio changes between reads
+func TestNodeActiveIdleConsistency(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+
+	pkg := device.NewMockRaplZone("package-0", 0, "/sys/class/powercap/intel-rapl/intel-rapl:0", 10000*Joule)
+	hwmon := device.NewMockPowerZone("hwmon0", 0, "/sys/class/hwmon/hwmon0")
+	zones := []EnergyZone{pkg, hwmon}
+
+	mockCPUPowerMeter := &MockCPUPowerMeter{}
+	mockCPUPowerMeter.On("Zones").Return(zones, nil)
+	mockCPUPowerMeter.On("PrimaryEnergyZone").Return(pkg, nil)
+
+	mockNode := &resource.Node{ProcessTotalCPUTimeDelta: 100.0}
+	mockResourceInformer := &MockResourceInformer{}
+	mockResourceInformer.On("Node").Return(mockNode)
+
+	mockClock := test_clock.NewFakeClock(time.Now())
+	pm := NewPowerMonitor(
+		mockCPUPowerMeter,
+		WithLogger(logger),
+		WithClock(mockClock),
+		WithResourceInformer(mockResourceInformer))
+	require.NoError(t, pm.Init())
+
+	assertConsistent := func(t *testing.T, node *Node) {
+		t.Helper()
+		for zone, usage := range node.Zones {
+			assert.Equal(t, usage.EnergyTotal, usage.ActiveEnergyTotal+usage.IdleEnergyTotal,
+				"zone %s: active + idle energy must equal total energy", zone.Name())
+			assert.Equal(t, usage.Power, usage.ActivePower+usage.IdlePower,
+				"zone %s: active + idle power must equal power", zone.Name())
+		}
+	}
+
+	mockNode.CPUUsageRatio = 0.3
+	pkg.Inc(123 * Joule)
+	hwmon.SetPower(40 * Watt)
+
+	prev := NewSnapshot()
+	require.NoError(t, pm.firstNodeRead(prev.Node))
+	assertConsistent(t, prev.Node)
+
+	steps := []struct {
+		usage  float64
+		energy Energy
+		power  Power
+	}{
+		{usage: 0.7, energy: 55 * Joule, power: 35 * Watt},
+		{usage: 0.0, energy: 17 * Joule, power: 12 * Watt},
+		{usage: 1.0, energy: 91 * Joule, power: 60 * Watt},
+		{usage: 0.45, energy: 33 * Joule, power: 27 * Watt},
+	}
+	for _, step := range steps {
+		mockClock.Step(3 * time.Second)
+		mockNode.CPUUsageRatio = step.usage
+		pkg.Inc(step.energy)
+		hwmon.SetPower(step.power)
+
+		current := NewSnapshot()
+		require.NoError(t, pm.calculateNodePower(prev.Node, current.Node))
+		assertConsistent(t, current.Node)
+
+		// each interval's energy is split entirely between the active and idle counters
+		for zone, usage := range current.Node.Zones {
+			prevUsage := prev.Node.Zones[zone]
+			assert.Equal(t, usage.EnergyDelta,
+				(usage.ActiveEnergyTotal-prevUsage.ActiveEnergyTotal)+(usage.IdleEnergyTotal-prevUsage.IdleEnergyTotal),
+				"zone %s: active and idle energy increments must sum to the energy delta", zone.Name())
+		}
+		prev = current
+	}
+}
+
 // TestPowerSensorCollection tests power sensor zones (like hwmon)
 func TestPowerSensorCollection(t *testing.T) {
 	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
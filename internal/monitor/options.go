@@ -18,11 +18,62 @@ type Opts struct {
 	clock                        clock.WithTicker
 	resources                    resource.Informer
 	gpuMeters                    []gpu.GPUPowerMeter
+	coreZones                    []EnergyZone
 	maxStaleness                 time.Duration
 	maxTerminated                int
 	minTerminatedEnergyThreshold Energy
+	pidMode                      PIDMode
+	mode                         Mode
+	maxBackoff                   time.Duration
+	resolveUsernames             bool
+	collectionTimeout            time.Duration
+	processEnergyBasis           EnergyBasis
+	gpuProcessEnergyBasis        EnergyBasis
+	gpuReliability               bool
+	gpuEnergyJitterTolerance     Energy
+	foldKernel                   bool
+	maxProcessAge                time.Duration
+	powerSmoothingWindow         time.Duration
 }
 
+// PIDMode selects which PID identifies a process in snapshots and metrics
+type PIDMode string
+
+const (
+	// PIDModeHost identifies processes by their PID in the host PID namespace
+	PIDModeHost PIDMode = "host"
+
+	// PIDModeNamespaced identifies container processes by their PID in the
+	// innermost PID namespace, qualified by the container
+	PIDModeNamespaced PIDMode = "namespaced"
+)
+
+// Mode selects which power is computed on each refresh
+type Mode string
+
+const (
+	// ModeFull computes node power and attributes it to processes, containers,
+	// VMs and pods
+	ModeFull Mode = "full"
+
+	// ModeNodeOnly only computes node zone and node GPU power; workload
+	// attribution is skipped entirely
+	ModeNodeOnly Mode = "node-only"
+)
+
+// EnergyBasis selects which share of the node power is attributed to workloads
+type EnergyBasis string
+
+const (
+	// EnergyBasisActive attributes only the active node power; idle power is
+	// left unattributed
+	EnergyBasisActive EnergyBasis = "active"
+
+	// EnergyBasisTotal attributes the total node power, distributing idle
+	// power by the same CPU time ratio as active power
+	EnergyBasisTotal EnergyBasis = "total"
+)
+
 // NewConfig returns a new Config with defaults set
 func DefaultOpts() Opts {
 	return Opts{
@@ -33,6 +84,11 @@ func DefaultOpts() Opts {
 		resources:                    nil,
 		maxTerminated:                500,
 		minTerminatedEnergyThreshold: 10 * Joule,
+		pidMode:                      PIDModeHost,
+		mode:                         ModeFull,
+		maxBackoff:                   5 * time.Minute,
+		processEnergyBasis:           EnergyBasisActive,
+		gpuProcessEnergyBasis:        EnergyBasisActive,
 	}
 }
 
@@ -74,6 +130,24 @@ func WithResourceInformer(r resource.Informer) OptionFn {
 	}
 }
 
+// WithMaxBackoff sets the longest interval between collections while
+// consecutive collections fail; 0 disables the backoff
+func WithMaxBackoff(d time.Duration) OptionFn {
+	return func(o *Opts) {
+		o.maxBackoff = d
+	}
+}
+
+// WithCollectionTimeout sets the time after which an in-progress collection
+// is reported as timed out. It is abandoned and the previous snapshot kept if
+// it is still reading the node power; otherwise the node power it read is
+// published right away. 0 disables the timeout
+func WithCollectionTimeout(d time.Duration) OptionFn {
+	return func(o *Opts) {
+		o.collectionTimeout = d
+	}
+}
+
 // WithMaxTerminated sets the maximum number of terminated workloads to keep in memory
 func WithMaxTerminated(max int) OptionFn {
 	return func(o *Opts) {
@@ -88,6 +162,91 @@ func WithMinTerminatedEnergyThreshold(threshold Energy) OptionFn {
 	}
 }
 
+// WithPIDMode sets the PID used to identify processes
+func WithPIDMode(mode PIDMode) OptionFn {
+	return func(o *Opts) {
+		o.pidMode = mode
+	}
+}
+
+// WithMode sets which power the PowerMonitor computes
+func WithMode(mode Mode) OptionFn {
+	return func(o *Opts) {
+		o.mode = mode
+	}
+}
+
+// WithProcessEnergyBasis sets which share of the node power is attributed to
+// processes and, through them, to containers, VMs and pods
+func WithProcessEnergyBasis(basis EnergyBasis) OptionFn {
+	return func(o *Opts) {
+		o.processEnergyBasis = basis
+	}
+}
+
+// WithGPUProcessEnergyBasis sets which share of the GPU power the GPU meters
+// attribute to processes. The meters are configured separately; the basis is
+// only used to report the attributable power of the devices of meters that
+// support idle attribution.
+func WithGPUProcessEnergyBasis(basis EnergyBasis) OptionFn {
+	return func(o *Opts) {
+		o.gpuProcessEnergyBasis = basis
+	}
+}
+
+// WithKernelAsProcess sets whether the kernel pseudo-process (PID 0) is
+// reported as a process or its CPU time is folded into node idle power
+func WithKernelAsProcess(enabled bool) OptionFn {
+	return func(o *Opts) {
+		o.foldKernel = !enabled
+	}
+}
+
+// WithMaxProcessAge sets the age after which processes are no longer reported
+// individually; their energy is only accumulated per zone. 0 reports all
+// processes.
+func WithMaxProcessAge(d time.Duration) OptionFn {
+	return func(o *Opts) {
+		o.maxProcessAge = d
+	}
+}
+
+// WithPowerSmoothingWindow sets the window over which the energy of each
+// interval is spread to smooth node zone power. Unlike filtering the power,
+// this keeps the integral of the power equal to the energy. 0 disables
+// smoothing.
+func WithPowerSmoothingWindow(d time.Duration) OptionFn {
+	return func(o *Opts) {
+		o.powerSmoothingWindow = d
+	}
+}
+
+// WithResolveUsernames enables resolving the UIDs of the user level to user names
+func WithResolveUsernames(enabled bool) OptionFn {
+	return func(o *Opts) {
+		o.resolveUsernames = enabled
+	}
+}
+
+// WithGPUReliabilityMetrics enables reading the fan speed and performance
+// state of GPU devices from meters that support it
+func WithGPUReliabilityMetrics(enabled bool) OptionFn {
+	return func(o *Opts) {
+		o.gpuReliability = enabled
+	}
+}
+
+// WithGPUEnergyJitterTolerance treats a GPU energy reading below the previous
+// one by at most tolerance as sampling jitter: the cumulative energy of the
+// device is held, i.e. the delta is zero, instead of following the counter
+// backwards. Larger backward moves are still treated as counter resets.
+// 0 disables the tolerance.
+func WithGPUEnergyJitterTolerance(tolerance Energy) OptionFn {
+	return func(o *Opts) {
+		o.gpuEnergyJitterTolerance = tolerance
+	}
+}
+
 // WithGPUPowerMeters sets the GPU power meters for the PowerMonitor.
 // Supports multiple GPU vendors (NVIDIA, AMD, Intel) simultaneously.
 func WithGPUPowerMeters(meters []gpu.GPUPowerMeter) OptionFn {
@@ -95,3 +254,12 @@ func WithGPUPowerMeters(meters []gpu.GPUPowerMeter) OptionFn {
 		o.gpuMeters = meters
 	}
 }
+
+// WithCoreZones sets the per-core energy zones for the PowerMonitor.
+// Per-core power is reported in addition to the CPU meter zones and is not
+// used for workload attribution.
+func WithCoreZones(zones []EnergyZone) OptionFn {
+	return func(o *Opts) {
+		o.coreZones = zones
+	}
+}
@@ -64,6 +64,17 @@ func (m *MockGPUPowerMeter) GetProcessInfo() ([]gpu.ProcessGPUInfo, error) {
 	return args.Get(0).([]gpu.ProcessGPUInfo), args.Error(1)
 }
 
+// MockGPUUtilizationMeter is a MockGPUPowerMeter that also reports
+// per-process utilization
+type MockGPUUtilizationMeter struct {
+	MockGPUPowerMeter
+}
+
+func (m *MockGPUUtilizationMeter) GetProcessUtilization() (map[uint32]gpu.ProcessUtilization, error) {
+	args := m.Called()
+	return args.Get(0).(map[uint32]gpu.ProcessUtilization), args.Error(1)
+}
+
 // TestWithMaxTerminated tests the WithMaxTerminated option function
 func TestWithMaxTerminated(t *testing.T) {
 	tests := []struct {
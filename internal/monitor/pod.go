@@ -4,6 +4,8 @@
 package monitor
 
 import (
+	"maps"
+
 	"github.com/sustainable-computing-io/kepler/internal/resource"
 )
 
@@ -13,19 +15,20 @@ func (pm *PowerMonitor) firstPodRead(snapshot *Snapshot) error {
 	pods := make(Pods, len(running))
 
 	zones := snapshot.Node.Zones
-	nodeCPUTimeDelta := pm.resources.Node().ProcessTotalCPUTimeDelta
+	nodeCPUTimeDelta := pm.workloadCPUTimeDelta()
 
 	for id, p := range running {
 		pod := newPod(p, zones)
 
 		// Calculate initial energy based on CPU ratio * nodeActiveEnergy
 		for zone, nodeZoneUsage := range zones {
-			if nodeZoneUsage.ActivePower == 0 || nodeZoneUsage.activeEnergy == 0 || nodeCPUTimeDelta == 0 {
+			energy, power := pm.attributable(nodeZoneUsage)
+			if power == 0 || energy == 0 || nodeCPUTimeDelta == 0 {
 				continue
 			}
 
 			cpuTimeRatio := p.CPUTimeDelta / nodeCPUTimeDelta
-			activeEnergy := Energy(cpuTimeRatio * float64(nodeZoneUsage.activeEnergy))
+			activeEnergy := Energy(cpuTimeRatio * float64(energy))
 
 			pod.Zones[zone] = Usage{
 				Power:       Power(0), // No power in first read - no delta time to calculate rate
@@ -43,6 +46,9 @@ func (pm *PowerMonitor) firstPodRead(snapshot *Snapshot) error {
 		if pod, ok := pods[container.PodID]; ok {
 			pod.GPUPower += container.GPUPower
 			pod.GPUEnergyTotal += container.GPUEnergyTotal
+			pod.GPUDevicePower = addGPUDevicePower(pod.GPUDevicePower, container.GPUDevicePower)
+			pod.GPUEncoderUtil += container.GPUEncoderUtil
+			pod.GPUDecoderUtil += container.GPUDecoderUtil
 		}
 	}
 
@@ -75,7 +81,9 @@ func (pm *PowerMonitor) calculatePodPower(prev, newSnapshot *Snapshot) error {
 		// Add to internal tracker (which will handle priority-based retention)
 		// NOTE: Each terminated pod is only added once since a pod cannot be terminated twice
 		pm.terminatedPodsTracker.Add(prevPod.Clone())
+		pm.terminatedPodsEnergy = addZoneEnergy(pm.terminatedPodsEnergy, prevPod.Zones)
 	}
+	newSnapshot.TerminatedPodsEnergy = maps.Clone(pm.terminatedPodsEnergy)
 
 	// Skip if no running pods
 	if len(pods.Running) == 0 {
@@ -83,8 +91,7 @@ func (pm *PowerMonitor) calculatePodPower(prev, newSnapshot *Snapshot) error {
 		return nil
 	}
 
-	node := pm.resources.Node()
-	nodeCPUTimeDelta := node.ProcessTotalCPUTimeDelta
+	nodeCPUTimeDelta := pm.workloadCPUTimeDelta()
 
 	pm.logger.Debug("Calculating pod power",
 		"node-cputime", nodeCPUTimeDelta,
@@ -103,14 +110,15 @@ func (pm *PowerMonitor) calculatePodPower(prev, newSnapshot *Snapshot) error {
 
 		// For each zone in the node, calculate pod's share
 		for zone, nodeZoneUsage := range newSnapshot.Node.Zones {
+			energy, power := pm.attributable(nodeZoneUsage)
 			// Skip zones with zero power to avoid division by zero
-			if nodeZoneUsage.Power == 0 || nodeZoneUsage.activeEnergy == 0 || nodeCPUTimeDelta == 0 {
+			if power == 0 || energy == 0 || nodeCPUTimeDelta == 0 {
 				continue
 			}
 
 			cpuTimeRatio := p.CPUTimeDelta / nodeCPUTimeDelta
 			// Calculate pod's share of this zone's power and energy
-			activeEnergy := Energy(float64(nodeZoneUsage.activeEnergy) * cpuTimeRatio)
+			activeEnergy := Energy(float64(energy) * cpuTimeRatio)
 			absoluteEnergy := activeEnergy
 
 			// If we have previous data for this pod and zone, add to absolute energy
@@ -121,7 +129,7 @@ func (pm *PowerMonitor) calculatePodPower(prev, newSnapshot *Snapshot) error {
 			}
 			pod.Zones[zone] = Usage{
 				EnergyTotal: absoluteEnergy,
-				Power:       Power(cpuTimeRatio * float64(nodeZoneUsage.ActivePower)),
+				Power:       Power(cpuTimeRatio * float64(power)),
 			}
 		}
 
@@ -136,6 +144,9 @@ func (pm *PowerMonitor) calculatePodPower(prev, newSnapshot *Snapshot) error {
 		if pod, ok := podMap[container.PodID]; ok {
 			pod.GPUPower += container.GPUPower
 			pod.GPUEnergyTotal += container.GPUEnergyTotal
+			pod.GPUDevicePower = addGPUDevicePower(pod.GPUDevicePower, container.GPUDevicePower)
+			pod.GPUEncoderUtil += container.GPUEncoderUtil
+			pod.GPUDecoderUtil += container.GPUDecoderUtil
 		}
 	}
 
@@ -236,6 +236,8 @@ func TestPodGPUPowerAggregation(t *testing.T) {
 				PodID:          "pod-1",
 				GPUPower:       80.0,
 				GPUEnergyTotal: 400 * Joule,
+				GPUEncoderUtil: 80,
+				GPUDecoderUtil: 10,
 				Zones:          make(ZoneUsageMap),
 			},
 			"container-2": &Container{
@@ -244,6 +246,7 @@ func TestPodGPUPowerAggregation(t *testing.T) {
 				PodID:          "pod-1",
 				GPUPower:       20.0,
 				GPUEnergyTotal: 100 * Joule,
+				GPUDecoderUtil: 15,
 				Zones:          make(ZoneUsageMap),
 			},
 			"container-3": &Container{
@@ -283,6 +286,10 @@ func TestPodGPUPowerAggregation(t *testing.T) {
 		// pod-1 should have 400 + 100 = 500J GPU energy
 		assert.Equal(t, 500*Joule, newSnapshot.Pods["pod-1"].GPUEnergyTotal)
 
+		// pod-1 encoder/decoder utilization is summed from its containers
+		assert.Equal(t, 80.0, newSnapshot.Pods["pod-1"].GPUEncoderUtil)
+		assert.Equal(t, 25.0, newSnapshot.Pods["pod-1"].GPUDecoderUtil)
+
 		// pod-2 should have 45W GPU power
 		assert.Equal(t, 45.0, newSnapshot.Pods["pod-2"].GPUPower)
 		// pod-2 should have 225J GPU energy
@@ -501,6 +508,48 @@ func TestTerminatedPodTracking(t *testing.T) {
 		assert.Contains(t, newSnapshot.Pods, "pod-2")
 	})
 
+	t.Run("terminated_pod_energy_survives_export", func(t *testing.T) {
+		mockMeter := &MockCPUPowerMeter{}
+		mockMeter.On("Zones").Return(zones, nil)
+		mockMeter.On("PrimaryEnergyZone").Return(zones[0], nil)
+		resInformer := &MockResourceInformer{}
+
+		monitor := &PowerMonitor{
+			logger:        logger,
+			cpu:           mockMeter,
+			clock:         fakeClock,
+			resources:     resInformer,
+			maxTerminated: 500,
+		}
+		require.NoError(t, monitor.Init())
+
+		prevSnapshot := NewSnapshot()
+		prevSnapshot.Node = createNodeSnapshot(zones, fakeClock.Now(), 0.5)
+		prevSnapshot.Pods["job-pod"] = &Pod{ID: "job-pod", Zones: make(ZoneUsageMap)}
+		for _, zone := range zones {
+			prevSnapshot.Pods["job-pod"].Zones[zone] = Usage{EnergyTotal: 25 * Joule}
+		}
+
+		// all containers of the pod exited; no running pods remain
+		monitor.exported.Store(true)
+		resInformer.On("Pods").Return(&resource.Pods{
+			Running: map[string]*resource.Pod{},
+			Terminated: map[string]*resource.Pod{
+				"job-pod": {ID: "job-pod"},
+			},
+		}).Once()
+
+		snapshot := NewSnapshot()
+		snapshot.Node = createNodeSnapshot(zones, fakeClock.Now().Add(time.Second), 0.5)
+		require.NoError(t, monitor.calculatePodPower(prevSnapshot, snapshot))
+
+		for _, zone := range zones {
+			assert.Equal(t, 25*Joule, snapshot.TerminatedPodsEnergy[zone.Name()])
+		}
+
+		resInformer.AssertExpectations(t)
+	})
+
 	t.Run("terminated_pod_cleanup_after_export", func(t *testing.T) {
 		mockMeter := &MockCPUPowerMeter{}
 		mockMeter.On("Zones").Return(zones, nil)
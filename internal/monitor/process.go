@@ -4,8 +4,10 @@
 package monitor
 
 import (
-	"fmt"
+	"strconv"
+	"time"
 
+	"github.com/sustainable-computing-io/kepler/internal/device/gpu"
 	"github.com/sustainable-computing-io/kepler/internal/resource"
 )
 
@@ -13,35 +15,9 @@ import (
 func (pm *PowerMonitor) firstProcessRead(snapshot *Snapshot) error {
 	// Collect GPU device stats on first read from all GPU meters
 	if len(pm.gpuMeters) > 0 {
-		var gpuStats []GPUDeviceStats
-		for _, meter := range pm.gpuMeters {
-			devices := meter.Devices()
-			for _, dev := range devices {
-				stats, err := meter.GetDevicePowerStats(dev.Index)
-				if err != nil {
-					pm.logger.Debug("Failed to get GPU device stats", "device", dev.Index, "error", err)
-					continue
-				}
-				energy, energyErr := meter.GetTotalEnergy(dev.Index)
-				if energyErr != nil {
-					pm.logger.Debug("Failed to get GPU energy", "device", dev.Index, "error", energyErr)
-					continue
-				}
-				gpuStats = append(gpuStats, GPUDeviceStats{
-					DeviceIndex: dev.Index,
-					UUID:        dev.UUID,
-					Name:        dev.Name,
-					Vendor:      string(dev.Vendor),
-					TotalPower:  stats.TotalPower,
-					IdlePower:   stats.IdlePower,
-					ActivePower: stats.ActivePower,
-					EnergyTotal: energy,
-				})
-			}
-		}
-		snapshot.GPUStats = gpuStats
-		pm.logger.Info("GPU stats collected on first read", "devices", len(gpuStats))
-		for _, s := range gpuStats {
+		snapshot.GPUStats = pm.readGPUDeviceStats(pm.gpuMeters)
+		pm.logger.Info("GPU stats collected on first read", "devices", len(snapshot.GPUStats))
+		for _, s := range snapshot.GPUStats {
 			pm.logger.Debug("GPU device stats", "device", s.DeviceIndex, "uuid", s.UUID, "total", s.TotalPower, "idle", s.IdlePower, "active", s.ActivePower)
 		}
 	}
@@ -50,23 +26,29 @@ func (pm *PowerMonitor) firstProcessRead(snapshot *Snapshot) error {
 	processes := make(Processes, len(running))
 
 	zones := snapshot.Node.Zones
-	nodeCPUTimeDelta := pm.resources.Node().ProcessTotalCPUTimeDelta
+	nodeCPUTimeDelta := pm.workloadCPUTimeDelta()
 
 	for _, proc := range running {
-		process := newProcess(proc, zones)
+		if pm.foldsKernel(proc) {
+			continue
+		}
+		process := newProcess(proc, zones, pm.pidMode)
+		process.Aged = pm.isAged(process, snapshot.Node.Timestamp)
 
 		// Calculate initial energy based on CPU ratio * nodeActiveEnergy
 		for zone, nodeZoneUsage := range zones {
-			if nodeZoneUsage.ActivePower == 0 || nodeZoneUsage.activeEnergy == 0 || nodeCPUTimeDelta == 0 {
+			energy, power := pm.attributable(nodeZoneUsage)
+			if power == 0 || energy == 0 || nodeCPUTimeDelta == 0 {
 				continue
 			}
 
 			cpuTimeRatio := proc.CPUTimeDelta / nodeCPUTimeDelta
-			activeEnergy := Energy(cpuTimeRatio * float64(nodeZoneUsage.activeEnergy))
+			activeEnergy := Energy(cpuTimeRatio * float64(energy))
 
 			process.Zones[zone] = Usage{
 				Power:       Power(0), // No power in first read - no delta time to calculate rate
 				EnergyTotal: activeEnergy,
+				EnergyDelta: activeEnergy,
 			}
 		}
 
@@ -80,13 +62,34 @@ func (pm *PowerMonitor) firstProcessRead(snapshot *Snapshot) error {
 	return nil
 }
 
-func newProcess(proc *resource.Process, zones NodeZoneUsageMap) *Process {
+// foldsKernel returns true if proc is the kernel pseudo-process and its CPU
+// time is folded into node idle power instead of being reported
+func (pm *PowerMonitor) foldsKernel(proc *resource.Process) bool {
+	return proc.PID == kernelPID && pm.foldKernel
+}
+
+// isAged returns true if the process is older than the max process age at now;
+// processes with an unknown start time are never aged
+func (pm *PowerMonitor) isAged(proc *Process, now time.Time) bool {
+	if pm.maxProcessAge <= 0 || proc.StartTime.IsZero() {
+		return false
+	}
+	return now.Sub(proc.StartTime) > pm.maxProcessAge
+}
+
+func newProcess(proc *resource.Process, zones NodeZoneUsageMap, pidMode PIDMode) *Process {
 	process := &Process{
 		PID:          proc.PID,
+		HostPID:      proc.PID,
 		Comm:         proc.Comm,
 		Exe:          proc.Exe,
 		Type:         proc.Type,
 		CPUTotalTime: proc.CPUTotalTime,
+		MemoryBytes:  proc.MemoryBytes,
+		Threads:      proc.Threads,
+		State:        proc.State,
+		UID:          proc.UID,
+		StartTime:    proc.StartTime,
 		Zones:        make(ZoneUsageMap, len(zones)),
 	}
 
@@ -98,6 +101,10 @@ func newProcess(proc *resource.Process, zones NodeZoneUsageMap) *Process {
 		}
 	}
 
+	if proc.PID == kernelPID && process.Comm == "" {
+		process.Comm = kernelComm
+	}
+
 	// Add the container ID if available
 	if proc.Container != nil {
 		process.ContainerID = proc.Container.ID
@@ -107,6 +114,12 @@ func newProcess(proc *resource.Process, zones NodeZoneUsageMap) *Process {
 	if proc.VirtualMachine != nil {
 		process.VirtualMachineID = proc.VirtualMachine.ID
 	}
+
+	// NamespacedPIDs lists the PID in each namespace, outermost first; it is
+	// only populated for container processes
+	if pidMode == PIDModeNamespaced && process.ContainerID != "" && len(proc.NamespacedPIDs) > 1 {
+		process.PID = proc.NamespacedPIDs[len(proc.NamespacedPIDs)-1]
+	}
 	return process
 }
 
@@ -120,13 +133,16 @@ func (pm *PowerMonitor) calculateProcessPower(prev, newSnapshot *Snapshot) error
 
 	// Get GPU power attribution from all GPU meters
 	gpuPowerByPID := make(map[uint32]float64)
+	var gpuDevicePowerByPID map[uint32]map[int]float64
+	var gpuSMUtilByPID, gpuEncUtilByPID, gpuDecUtilByPID map[uint32]float64
 	if len(pm.gpuMeters) > 0 {
-		var gpuStats []GPUDeviceStats
+		meters := make([]gpu.GPUPowerMeter, 0, len(pm.gpuMeters))
 		for _, meter := range pm.gpuMeters {
 			// Get process power from this meter
 			power, err := meter.GetProcessPower()
 			if err != nil {
 				pm.logger.Warn("Failed to get GPU process power", "vendor", meter.Vendor(), "error", err)
+				pm.recordReadError(gpuMeter, "processes")
 				continue
 			}
 			// Collect power from this meter. In practice, nodes have homogeneous GPUs
@@ -135,43 +151,24 @@ func (pm *PowerMonitor) calculateProcessPower(prev, newSnapshot *Snapshot) error
 			for pid, watts := range power {
 				gpuPowerByPID[pid] = watts
 			}
-
-			// Collect GPU device stats for debugging/monitoring
-			devices := meter.Devices()
-			for _, dev := range devices {
-				stats, err := meter.GetDevicePowerStats(dev.Index)
-				if err != nil {
-					pm.logger.Debug("Failed to get GPU device stats", "device", dev.Index, "error", err)
-					continue
-				}
-				energy, energyErr := meter.GetTotalEnergy(dev.Index)
-				if energyErr != nil {
-					pm.logger.Debug("Failed to get GPU energy", "device", dev.Index, "error", energyErr)
-					continue
-				}
-				gpuStats = append(gpuStats, GPUDeviceStats{
-					DeviceIndex: dev.Index,
-					UUID:        dev.UUID,
-					Name:        dev.Name,
-					Vendor:      string(dev.Vendor),
-					TotalPower:  stats.TotalPower,
-					IdlePower:   stats.IdlePower,
-					ActivePower: stats.ActivePower,
-					EnergyTotal: energy,
-				})
-			}
+			meters = append(meters, meter)
 		}
-		gpuStats = computeGPUActiveIdleEnergy(gpuStats, prev.GPUStats)
-		newSnapshot.GPUStats = gpuStats
+		pm.calculateGPUDeviceStats(prev, newSnapshot, meters)
+		gpuDevicePowerByPID = splitGPUDevicePower(gpuPowerByPID, pm.readGPUProcessDevices(meters))
+		gpuSMUtilByPID, gpuEncUtilByPID, gpuDecUtilByPID = pm.readGPUProcessUtilization(meters)
 		pm.logger.Debug("GPU process power", "gpu_processes", len(gpuPowerByPID))
 	}
 
 	procs := pm.resources.Processes()
+	gpuPowerByPID = translateGPUPIDs(gpuPowerByPID, procs.Running)
+	gpuDevicePowerByPID = translateGPUDevicePIDs(gpuDevicePowerByPID, procs.Running)
+	gpuSMUtilByPID = translateGPUPIDs(gpuSMUtilByPID, procs.Running)
+	gpuEncUtilByPID = translateGPUPIDs(gpuEncUtilByPID, procs.Running)
+	gpuDecUtilByPID = translateGPUPIDs(gpuDecUtilByPID, procs.Running)
 
 	pm.logger.Debug("Processing terminated processes", "terminated", len(procs.Terminated))
-	for pid := range procs.Terminated {
-		pidStr := fmt.Sprintf("%d", pid)
-		prevProcess, exists := prev.Processes[pidStr]
+	for _, proc := range procs.Terminated {
+		prevProcess, exists := prev.Processes[newProcess(proc, nil, pm.pidMode).StringID()]
 		if !exists {
 			continue
 		}
@@ -184,7 +181,7 @@ func (pm *PowerMonitor) calculateProcessPower(prev, newSnapshot *Snapshot) error
 	running := procs.Running
 
 	zones := newSnapshot.Node.Zones
-	nodeCPUTimeDelta := pm.resources.Node().ProcessTotalCPUTimeDelta
+	nodeCPUTimeDelta := pm.workloadCPUTimeDelta()
 	pm.logger.Debug("Calculating Process power",
 		"node.cpu.time", nodeCPUTimeDelta,
 		"running", len(running),
@@ -199,18 +196,23 @@ func (pm *PowerMonitor) calculateProcessPower(prev, newSnapshot *Snapshot) error
 	}
 
 	for _, proc := range running {
-		process := newProcess(proc, zones)
+		if pm.foldsKernel(proc) {
+			continue
+		}
+		process := newProcess(proc, zones, pm.pidMode)
+		process.Aged = pm.isAged(process, newSnapshot.Node.Timestamp)
 		pid := process.StringID() // to string
 
 		// For each zone in the node, calculate process's share
 		for zone, nodeZoneUsage := range zones {
-			if nodeZoneUsage.ActivePower == 0 || nodeZoneUsage.activeEnergy == 0 || nodeCPUTimeDelta == 0 {
+			energy, power := pm.attributable(nodeZoneUsage)
+			if power == 0 || energy == 0 || nodeCPUTimeDelta == 0 {
 				continue
 			}
 
 			cpuTimeRatio := proc.CPUTimeDelta / nodeCPUTimeDelta
 			// Calculate energy  for this interval
-			activeEnergy := Energy(cpuTimeRatio * float64(nodeZoneUsage.activeEnergy))
+			activeEnergy := Energy(cpuTimeRatio * float64(energy))
 
 			// Calculate absolute energy based on previous data
 			absoluteEnergy := activeEnergy
@@ -222,15 +224,20 @@ func (pm *PowerMonitor) calculateProcessPower(prev, newSnapshot *Snapshot) error
 
 			// Calculate process's share of this zone's power and energy
 			process.Zones[zone] = Usage{
-				Power:       Power(cpuTimeRatio * nodeZoneUsage.ActivePower.MicroWatts()),
+				Power:       Power(cpuTimeRatio * power.MicroWatts()),
 				EnergyTotal: absoluteEnergy,
+				EnergyDelta: activeEnergy,
 			}
 		}
 
 		// Add GPU power attribution if available
 		if gpuPower, hasGPU := gpuPowerByPID[uint32(proc.PID)]; hasGPU {
 			process.GPUPower = gpuPower
+			process.GPUDevicePower = gpuDevicePowerByPID[uint32(proc.PID)]
 		}
+		process.GPUSMUtil = gpuSMUtilByPID[uint32(proc.PID)]
+		process.GPUEncoderUtil = gpuEncUtilByPID[uint32(proc.PID)]
+		process.GPUDecoderUtil = gpuDecUtilByPID[uint32(proc.PID)]
 
 		// Accumulate GPU energy: energy = power × time
 		if prevProc, exists := prev.Processes[pid]; exists {
@@ -248,6 +255,10 @@ func (pm *PowerMonitor) calculateProcessPower(prev, newSnapshot *Snapshot) error
 
 	// Update the snapshot of running processes
 	newSnapshot.Processes = processMap
+	pm.countProcessChurn(prev.Processes, processMap)
+	newSnapshot.ProcessesStarted = pm.processesStarted
+	newSnapshot.ProcessesTerminated = pm.processesTerminated
+	newSnapshot.NegativeCPUTimeDeltas = pm.resources.Node().NegativeCPUTimeDeltas
 
 	// Populate terminated processes from tracker
 	newSnapshot.TerminatedProcesses = pm.terminatedProcessesTracker.Items()
@@ -259,6 +270,353 @@ func (pm *PowerMonitor) calculateProcessPower(prev, newSnapshot *Snapshot) error
 	return nil
 }
 
+// countProcessChurn accumulates the processes started and terminated between
+// the running processes of consecutive snapshots
+func (pm *PowerMonitor) countProcessChurn(prev, current Processes) {
+	for id := range current {
+		if _, exists := prev[id]; !exists {
+			pm.processesStarted++
+		}
+	}
+	for id := range prev {
+		if _, exists := current[id]; !exists {
+			pm.processesTerminated++
+		}
+	}
+}
+
+// readGPUDeviceStats reads the power and energy of every device of the given GPU meters
+func (pm *PowerMonitor) readGPUDeviceStats(meters []gpu.GPUPowerMeter) []GPUDeviceStats {
+	var gpuStats []GPUDeviceStats
+	for _, meter := range meters {
+		for _, dev := range meter.Devices() {
+			stats, err := meter.GetDevicePowerStats(dev.Index)
+			if err != nil {
+				pm.logger.Debug("Failed to get GPU device stats", "device", dev.Index, "error", err)
+				pm.recordReadError(gpuMeter, strconv.Itoa(dev.Index))
+				continue
+			}
+			// devices without a usable energy counter are still reported;
+			// their energy is integrated from power on subsequent reads
+			energy, energyErr := meter.GetTotalEnergy(dev.Index)
+			if energyErr != nil {
+				pm.logger.Debug("Failed to get GPU energy", "device", dev.Index, "error", energyErr)
+				pm.recordReadError(gpuMeter, strconv.Itoa(dev.Index))
+			}
+			attributable := stats.ActivePower
+			if _, ok := meter.(gpu.IdleAttributionConfigurable); ok && pm.gpuProcessEnergyBasis == EnergyBasisTotal {
+				attributable = stats.TotalPower
+			}
+			gpuStats = append(gpuStats, GPUDeviceStats{
+				DeviceIndex:       dev.Index,
+				UUID:              dev.UUID,
+				Name:              dev.Name,
+				Vendor:            string(dev.Vendor),
+				TotalPower:        stats.TotalPower,
+				IdlePower:         stats.IdlePower,
+				ActivePower:       stats.ActivePower,
+				AttributablePower: attributable,
+				Utilization:       stats.Utilization,
+				EnergyTotal:       energy,
+				Reliability:       pm.readGPUReliability(meter, dev.Index),
+				energyReadFailed:  energyErr != nil,
+			})
+		}
+	}
+	return gpuStats
+}
+
+// readGPUReliability reads the fan speed and performance state of a device
+// when GPU reliability metrics are enabled and the meter supports them
+func (pm *PowerMonitor) readGPUReliability(meter gpu.GPUPowerMeter, deviceIndex int) *GPUReliability {
+	if !pm.gpuReliability {
+		return nil
+	}
+	reader, ok := meter.(gpu.ReliabilityReader)
+	if !ok {
+		return nil
+	}
+
+	stats, err := reader.GetDeviceReliabilityStats(deviceIndex)
+	if err != nil {
+		pm.logger.Debug("Failed to get GPU reliability stats", "device", deviceIndex, "error", err)
+		return nil
+	}
+	return &stats
+}
+
+// calculateGPUDeviceStats reads the device stats of the given GPU meters into
+// newSnapshot and accumulates their energy from the previous snapshot
+func (pm *PowerMonitor) calculateGPUDeviceStats(prev, newSnapshot *Snapshot, meters []gpu.GPUPowerMeter) {
+	if len(pm.gpuMeters) == 0 {
+		return
+	}
+	gpuStats := pm.readGPUDeviceStats(meters)
+	timeDiff := newSnapshot.Node.Timestamp.Sub(prev.Node.Timestamp).Seconds()
+	gpuStats = integrateGPUPowerOnlyEnergy(gpuStats, prev.GPUStats, timeDiff)
+	gpuStats = pm.holdGPUEnergyJitter(gpuStats, prev.GPUStats)
+	gpuStats = computeGPUActiveIdleEnergy(gpuStats, prev.GPUStats)
+	newSnapshot.GPUStats = gpuStats
+}
+
+// readGPUProcessUtilization returns the SM, encoder and decoder utilization
+// per PID reported by the meters implementing gpu.ProcessUtilizationReader
+func (pm *PowerMonitor) readGPUProcessUtilization(meters []gpu.GPUPowerMeter) (sm, enc, dec map[uint32]float64) {
+	for _, meter := range meters {
+		reader, ok := meter.(gpu.ProcessUtilizationReader)
+		if !ok {
+			continue
+		}
+		utils, err := reader.GetProcessUtilization()
+		if err != nil {
+			pm.logger.Debug("Failed to get GPU process utilization", "vendor", meter.Vendor(), "error", err)
+			continue
+		}
+		for pid, pu := range utils {
+			if pu.ComputeUtil == 0 && pu.EncUtil == 0 && pu.DecUtil == 0 {
+				continue
+			}
+			if sm == nil {
+				sm = make(map[uint32]float64)
+				enc = make(map[uint32]float64)
+				dec = make(map[uint32]float64)
+			}
+			sm[pid] += float64(pu.ComputeUtil)
+			enc[pid] += float64(pu.EncUtil)
+			dec[pid] += float64(pu.DecUtil)
+		}
+	}
+	return sm, enc, dec
+}
+
+// readGPUProcessDevices returns the devices each GPU process runs on, keyed by
+// PID and device index, with the compute utilization of the process on the
+// device.
+func (pm *PowerMonitor) readGPUProcessDevices(meters []gpu.GPUPowerMeter) map[uint32]map[int]float64 {
+	var devices map[uint32]map[int]float64
+	for _, meter := range meters {
+		procs, err := meter.GetProcessInfo()
+		if err != nil {
+			pm.logger.Debug("Failed to get GPU process info", "vendor", meter.Vendor(), "error", err)
+			continue
+		}
+		for _, p := range procs {
+			if devices == nil {
+				devices = make(map[uint32]map[int]float64)
+			}
+			if devices[p.PID] == nil {
+				devices[p.PID] = make(map[int]float64)
+			}
+			devices[p.PID][p.DeviceIndex] += p.ComputeUtil
+		}
+	}
+	return devices
+}
+
+// splitGPUDevicePower splits the GPU power of each process among the devices
+// it runs on, in proportion to its compute utilization on each device, or
+// equally when it reports no utilization. Processes whose devices are unknown
+// are left out.
+func splitGPUDevicePower(gpuPowerByPID map[uint32]float64, devices map[uint32]map[int]float64) map[uint32]map[int]float64 {
+	var split map[uint32]map[int]float64
+	for pid, watts := range gpuPowerByPID {
+		utils := devices[pid]
+		if len(utils) == 0 {
+			continue
+		}
+		total := 0.0
+		for _, util := range utils {
+			total += util
+		}
+		if split == nil {
+			split = make(map[uint32]map[int]float64, len(gpuPowerByPID))
+		}
+		perDevice := make(map[int]float64, len(utils))
+		for idx, util := range utils {
+			if total > 0 {
+				perDevice[idx] = watts * util / total
+			} else {
+				perDevice[idx] = watts / float64(len(utils))
+			}
+		}
+		split[pid] = perDevice
+	}
+	return split
+}
+
+// translateGPUPIDs re-keys GPU process power by the PIDs tracked by the
+// resource layer. GPU drivers may report PIDs from a different PID namespace
+// than the one Kepler reads processes from (e.g. host PIDs for containerized
+// workloads), so readings whose PID is not tracked are matched against the
+// namespaced PIDs of running processes. Ambiguous matches are ignored.
+func translateGPUPIDs(gpuPowerByPID map[uint32]float64, running map[int]*resource.Process) map[uint32]float64 {
+	if len(gpuPowerByPID) == 0 {
+		return gpuPowerByPID
+	}
+
+	aliases := gpuPIDAliases(running)
+	if len(aliases) == 0 {
+		return gpuPowerByPID
+	}
+
+	translated := make(map[uint32]float64, len(gpuPowerByPID))
+	for pid, watts := range gpuPowerByPID {
+		translated[translateGPUPID(pid, running, aliases)] += watts
+	}
+	return translated
+}
+
+// translateGPUDevicePIDs re-keys the per-device GPU process power like
+// translateGPUPIDs
+func translateGPUDevicePIDs(devicePowerByPID map[uint32]map[int]float64, running map[int]*resource.Process) map[uint32]map[int]float64 {
+	if len(devicePowerByPID) == 0 {
+		return devicePowerByPID
+	}
+
+	aliases := gpuPIDAliases(running)
+	if len(aliases) == 0 {
+		return devicePowerByPID
+	}
+
+	translated := make(map[uint32]map[int]float64, len(devicePowerByPID))
+	for pid, perDevice := range devicePowerByPID {
+		target := translateGPUPID(pid, running, aliases)
+		if translated[target] == nil {
+			translated[target] = make(map[int]float64, len(perDevice))
+		}
+		for idx, watts := range perDevice {
+			translated[target][idx] += watts
+		}
+	}
+	return translated
+}
+
+// translateGPUPID returns the tracked PID of a PID reported by a GPU driver
+func translateGPUPID(pid uint32, running map[int]*resource.Process, aliases map[uint32]int) uint32 {
+	if _, tracked := running[int(pid)]; tracked {
+		return pid
+	}
+	if target, ok := aliases[pid]; ok && target >= 0 {
+		return uint32(target)
+	}
+	return pid
+}
+
+// gpuPIDAliases maps the namespaced PIDs of running processes to their PIDs;
+// namespaced PIDs shared by several processes map to -1.
+func gpuPIDAliases(running map[int]*resource.Process) map[uint32]int {
+	var aliases map[uint32]int
+	for _, proc := range running {
+		for _, nsPID := range proc.NamespacedPIDs {
+			if nsPID == proc.PID {
+				continue
+			}
+			if aliases == nil {
+				aliases = make(map[uint32]int)
+			}
+			if _, dup := aliases[uint32(nsPID)]; dup {
+				aliases[uint32(nsPID)] = -1 // ambiguous, e.g. PID 1 of many containers
+				continue
+			}
+			aliases[uint32(nsPID)] = proc.PID
+		}
+	}
+	return aliases
+}
+
+// gpuPowerOnlyReads is the number of consecutive failed or flat energy counter
+// reads after which a GPU device is treated as power-only
+const gpuPowerOnlyReads = 3
+
+// integrateGPUPowerOnlyEnergy derives the energy of devices without a usable
+// energy counter by integrating TotalPower over the interval. When the energy
+// read fails or the counter stays flat while the device draws power, only
+// that interval is integrated and the counter is used again on the next
+// usable read, offset by the integrated energy so that EnergyTotal remains
+// monotonic. Devices whose counter is unusable for gpuPowerOnlyReads
+// consecutive reads are treated as power-only from then on.
+func integrateGPUPowerOnlyEnergy(current, previous []GPUDeviceStats, timeDiff float64) []GPUDeviceStats {
+	if len(previous) == 0 || timeDiff <= 0 {
+		return current
+	}
+
+	prevByUUID := make(map[string]GPUDeviceStats, len(previous))
+	for _, s := range previous {
+		prevByUUID[s.UUID] = s
+	}
+
+	for i := range current {
+		prev, exists := prevByUUID[current[i].UUID]
+		if !exists {
+			continue
+		}
+
+		counter := current[i].EnergyTotal
+		interval := Energy(current[i].TotalPower * timeDiff * float64(Joule))
+		integrated := prev.EnergyTotal + interval
+		// EnergyTotal is the last counter read plus the offset, so the
+		// counter is flat when it matches the previous EnergyTotal
+		flat := counter+prev.energyOffset == prev.EnergyTotal && current[i].TotalPower > 0
+
+		switch {
+		case prev.powerOnly:
+			current[i].powerOnly = true
+			current[i].EnergyTotal = integrated
+
+		case current[i].energyReadFailed || flat:
+			current[i].unusableReads = prev.unusableReads + 1
+			current[i].powerOnly = current[i].unusableReads >= gpuPowerOnlyReads
+			current[i].EnergyTotal = integrated
+			current[i].energyOffset = prev.energyOffset + interval
+			if current[i].energyReadFailed {
+				current[i].failedReadEnergy = prev.failedReadEnergy + interval
+			}
+
+		case prev.energyReadFailed:
+			// the counter advanced over the failed reads too, whose energy was
+			// already integrated: use the counter unless it advanced by less
+			// than the integrated energy
+			current[i].EnergyTotal = max(integrated, counter+prev.energyOffset-prev.failedReadEnergy)
+			current[i].energyOffset = current[i].EnergyTotal - counter
+
+		default:
+			current[i].EnergyTotal = counter + prev.energyOffset
+			current[i].energyOffset = prev.energyOffset
+		}
+	}
+
+	return current
+}
+
+// holdGPUEnergyJitter holds the cumulative energy of devices whose counter
+// moved backwards by at most the jitter tolerance, e.g. a single sample of
+// firmware jitter, so that their energy delta is zero and the exported energy
+// stays monotonic. Larger backward moves are left as is and handled as
+// counter resets.
+func (pm *PowerMonitor) holdGPUEnergyJitter(current, previous []GPUDeviceStats) []GPUDeviceStats {
+	if pm.gpuEnergyJitterTolerance == 0 || len(previous) == 0 {
+		return current
+	}
+
+	prevByUUID := make(map[string]GPUDeviceStats, len(previous))
+	for _, s := range previous {
+		prevByUUID[s.UUID] = s
+	}
+
+	for i := range current {
+		prev, exists := prevByUUID[current[i].UUID]
+		if !exists || current[i].EnergyTotal >= prev.EnergyTotal {
+			continue
+		}
+		if backward := prev.EnergyTotal - current[i].EnergyTotal; backward <= pm.gpuEnergyJitterTolerance {
+			pm.logger.Debug("Holding GPU energy after a backward move within the jitter tolerance",
+				"device", current[i].DeviceIndex, "backward", backward, "tolerance", pm.gpuEnergyJitterTolerance)
+			current[i].EnergyTotal = prev.EnergyTotal
+		}
+	}
+	return current
+}
+
 // computeGPUActiveIdleEnergy splits cumulative GPU energy into active and idle
 // components using the instantaneous power ratio as the splitting factor.
 func computeGPUActiveIdleEnergy(current, previous []GPUDeviceStats) []GPUDeviceStats {
@@ -292,6 +650,7 @@ func computeGPUActiveIdleEnergy(current, previous []GPUDeviceStats) []GPUDeviceS
 		deltaActive := Energy(float64(deltaEnergy) * activeRatio)
 		deltaIdle := deltaEnergy - deltaActive
 
+		current[i].EnergyDelta = deltaEnergy
 		current[i].ActiveEnergyTotal = prev.ActiveEnergyTotal + deltaActive
 		current[i].IdleEnergyTotal = prev.IdleEnergyTotal + deltaIdle
 	}
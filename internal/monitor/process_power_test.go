@@ -12,6 +12,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/sustainable-computing-io/kepler/internal/device"
 	"github.com/sustainable-computing-io/kepler/internal/device/gpu"
 	"github.com/sustainable-computing-io/kepler/internal/resource"
 	testingclock "k8s.io/utils/clock/testing"
@@ -282,6 +283,99 @@ func TestProcessPowerCalculation(t *testing.T) {
 		resInformer.AssertExpectations(t)
 	})
 
+	t.Run("clamped CPU time decrease", func(t *testing.T) {
+		resInformer.ClearExpectations()
+
+		prevSnapshot := NewSnapshot()
+		prevSnapshot.Processes["123"] = &Process{
+			PID:          123,
+			Comm:         "test-proc",
+			CPUTotalTime: 10.0,
+			Zones:        make(ZoneUsageMap, len(zones)),
+		}
+		for _, zone := range zones {
+			prevSnapshot.Processes["123"].Zones[zone] = Usage{EnergyTotal: 25 * Joule}
+		}
+
+		newSnapshot := NewSnapshot()
+		newSnapshot.Node = createNodeSnapshot(zones, fakeClock.Now(), 0.5)
+
+		// CPU time went backwards; the informer clamps the delta to zero
+		procs := &resource.Processes{
+			Running: map[int]*resource.Process{
+				123: {
+					PID:          123,
+					Comm:         "test-proc",
+					CPUTotalTime: 5.0,
+					CPUTimeDelta: 0.0,
+				},
+			},
+			Terminated: map[int]*resource.Process{},
+		}
+
+		node := &resource.Node{ProcessTotalCPUTimeDelta: 10.0, NegativeCPUTimeDeltas: 1}
+		resInformer.On("Node").Return(node, nil).Maybe()
+		resInformer.On("Processes").Return(procs).Once()
+
+		err := monitor.calculateProcessPower(prevSnapshot, newSnapshot)
+		require.NoError(t, err)
+
+		proc := newSnapshot.Processes["123"]
+		require.NotNil(t, proc)
+		for _, zone := range zones {
+			usage := proc.Zones[zone]
+			assert.GreaterOrEqual(t, usage.Power, Power(0))
+			assert.Equal(t, 25*Joule, usage.EnergyTotal, "energy must not decrease")
+		}
+		assert.Equal(t, uint64(1), newSnapshot.NegativeCPUTimeDeltas)
+
+		resInformer.AssertExpectations(t)
+	})
+
+	t.Run("interval energy", func(t *testing.T) {
+		resInformer.ClearExpectations()
+
+		prevSnapshot := NewSnapshot()
+		prevSnapshot.Processes["123"] = &Process{
+			PID:   123,
+			Comm:  "test-proc",
+			Zones: make(ZoneUsageMap, len(zones)),
+		}
+		for _, zone := range zones {
+			prevSnapshot.Processes["123"].Zones[zone] = Usage{EnergyTotal: 25 * Joule}
+		}
+
+		newSnapshot := NewSnapshot()
+		newSnapshot.Node = createNodeSnapshot(zones, fakeClock.Now(), 0.5)
+
+		procs := &resource.Processes{
+			Running: map[int]*resource.Process{
+				123: {PID: 123, Comm: "test-proc", CPUTimeDelta: 4.0},
+			},
+			Terminated: map[int]*resource.Process{},
+		}
+
+		node := &resource.Node{ProcessTotalCPUTimeDelta: 10.0}
+		resInformer.On("Node").Return(node, nil).Maybe()
+		resInformer.On("Processes").Return(procs).Once()
+
+		err := monitor.calculateProcessPower(prevSnapshot, newSnapshot)
+		require.NoError(t, err)
+
+		proc := newSnapshot.Processes["123"]
+		require.NotNil(t, proc)
+		for _, zone := range zones {
+			nodeActive := newSnapshot.Node.Zones[zone].activeEnergy
+			expected := Energy(4.0 / 10.0 * float64(nodeActive))
+
+			usage := proc.Zones[zone]
+			assert.Equal(t, expected, usage.EnergyDelta, "interval energy is the CPU time ratio of the node active energy")
+			assert.Equal(t, 25*Joule+expected, usage.EnergyTotal)
+		}
+
+		resInformer.AssertExpectations(t)
+	})
+
 	t.Run("new zone missing in previous snapshot", func(t *testing.T) {
 		resInformer.ClearExpectations()
 
@@ -425,6 +519,84 @@ func TestProcessPowerConsistency(t *testing.T) {
 	mockMeter.AssertExpectations(t)
 }
 
+// fakeProc is a synthetic process listed by fakeProcessProvider
+type fakeProc struct {
+	pid     int
+	comm    string
+	cpuTime float64
+}
+
+func (p *fakeProc) PID() int                    { return p.pid }
+func (p *fakeProc) Comm() (string, error)       { return p.comm, nil }
+func (p *fakeProc) Executable() (string, error) { return "/bin/" + p.comm, nil }
+func (p *fakeProc) Cgroups() ([]resource.CGroup, error) {
+	return []resource.CGroup{{Path: "/system.slice"}}, nil
+}
+func (p *fakeProc) Environ() ([]string, error) { return nil, nil }
+func (p *fakeProc) CmdLine() ([]string, error) { return []string{"/bin/" + p.comm}, nil }
+func (p *fakeProc) CPUTime() (float64, error)  { return p.cpuTime, nil }
+
+// fakeProcessProvider feeds a synthetic process list to the resource informer
+// in place of /proc
+type fakeProcessProvider struct {
+	procs []resource.ProcInfo
+}
+
+func (f *fakeProcessProvider) AllProcs() ([]resource.ProcInfo, error) { return f.procs, nil }
+
+// fakeNodeStats reports a fixed node CPU usage and no processes
+type fakeNodeStats struct {
+	usage float64
+}
+
+func (f *fakeNodeStats) AllProcs() ([]resource.ProcInfo, error) { return nil, nil }
+func (f *fakeNodeStats) CPUUsageRatio() (float64, error)        { return f.usage, nil }
+
+func TestProcessPowerWithProcessProvider(t *testing.T) {
+	zones := CreateTestZones()
+	pkg := zones[0].(*device.MockRaplZone)
+	mockMeter := &MockCPUPowerMeter{}
+	mockMeter.On("Zones").Return(zones, nil)
+	mockMeter.On("PrimaryEnergyZone").Return(zones[0], nil)
+
+	fakeClock := testingclock.NewFakeClock(time.Now())
+	batch := &fakeProc{pid: 4001, comm: "batch", cpuTime: 10}
+	web := &fakeProc{pid: 4002, comm: "web", cpuTime: 10}
+
+	informer, err := resource.NewInformer(
+		resource.WithLogger(slog.New(slog.DiscardHandler)),
+		resource.WithClock(fakeClock),
+		resource.WithProcReader(&fakeNodeStats{usage: 0.5}),
+		resource.WithProcessProvider(&fakeProcessProvider{procs: []resource.ProcInfo{batch, web}}),
+	)
+	require.NoError(t, err)
+
+	pm := NewPowerMonitor(
+		mockMeter,
+		WithLogger(slog.New(slog.DiscardHandler)),
+		WithClock(fakeClock),
+		WithResourceInformer(informer),
+	)
+	require.NoError(t, pm.Init())
+	require.NoError(t, pm.refreshSnapshot())
+
+	fakeClock.Step(time.Second)
+	pkg.Inc(50 * Joule)
+	batch.cpuTime += 3
+	web.cpuTime += 1
+	require.NoError(t, pm.refreshSnapshot())
+
+	snapshot := pm.snapshot.Load()
+	require.Len(t, snapshot.Processes, 2)
+	assert.Equal(t, "batch", snapshot.Processes["4001"].Comm)
+	assert.Equal(t, "web", snapshot.Processes["4002"].Comm)
+
+	nodeActive := snapshot.Node.Zones[pkg].ActivePower
+	require.NotZero(t, nodeActive)
+	assert.InDelta(t, 0.75*nodeActive.Watts(), snapshot.Processes["4001"].Zones[pkg].Power.Watts(), 0.001)
+	assert.InDelta(t, 0.25*nodeActive.Watts(), snapshot.Processes["4002"].Zones[pkg].Power.Watts(), 0.001)
+}
+
 func TestTerminatedProcessTracking(t *testing.T) {
 	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
 	fakeClock := testingclock.NewFakeClock(time.Now())
@@ -968,6 +1140,9 @@ func TestProcessPowerWithGPU(t *testing.T) {
 			123: 50.5, // Process 123 uses 50.5W of GPU power
 		}
 		mockGPUMeter.On("GetProcessPower").Return(gpuProcessPower, nil)
+		mockGPUMeter.On("GetProcessInfo").Return([]gpu.ProcessGPUInfo{
+			{PID: 123, DeviceIndex: 0, ComputeUtil: 0.5},
+		}, nil)
 
 		resInformer := &MockResourceInformer{}
 
@@ -1000,6 +1175,7 @@ func TestProcessPowerWithGPU(t *testing.T) {
 		proc123, exists := newSnapshot.Processes["123"]
 		require.True(t, exists)
 		assert.Equal(t, 50.5, proc123.GPUPower)
+		assert.Equal(t, map[int]float64{0: 50.5}, proc123.GPUDevicePower)
 
 		// Verify GPU stats were collected
 		assert.Len(t, newSnapshot.GPUStats, 1)
@@ -1014,6 +1190,73 @@ func TestProcessPowerWithGPU(t *testing.T) {
 		assert.Equal(t, 0.0, proc456.GPUPower)
 	})
 
+	t.Run("calculateProcessPower_GPU_encoder_decoder_utilization", func(t *testing.T) {
+		logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+		fakeClock := testingclock.NewFakeClock(time.Now())
+
+		zones := CreateTestZones()
+		mockCPUMeter := &MockCPUPowerMeter{}
+		mockCPUMeter.On("Zones").Return(zones, nil)
+		mockCPUMeter.On("PrimaryEnergyZone").Return(zones[0], nil)
+
+		mockGPUMeter := new(MockGPUUtilizationMeter)
+		mockGPUMeter.On("Vendor").Return(gpu.VendorNVIDIA)
+		mockGPUMeter.On("Devices").Return([]gpu.GPUDevice{
+			{Index: 0, UUID: "GPU-1234", Name: "Test GPU", Vendor: gpu.VendorNVIDIA},
+		})
+		mockGPUMeter.On("GetDevicePowerStats", 0).Return(gpu.GPUPowerStats{
+			TotalPower:  150.5,
+			IdlePower:   25.0,
+			ActivePower: 125.5,
+		}, nil)
+		mockGPUMeter.On("GetTotalEnergy", 0).Return(500*Joule, nil)
+		mockGPUMeter.On("GetProcessPower").Return(map[uint32]float64{123: 50.5}, nil)
+		mockGPUMeter.On("GetProcessInfo").Return([]gpu.ProcessGPUInfo(nil), nil)
+		mockGPUMeter.On("GetProcessUtilization").Return(map[uint32]gpu.ProcessUtilization{
+			123: {PID: 123, ComputeUtil: 5, EncUtil: 70, DecUtil: 20},
+			456: {PID: 456, ComputeUtil: 10}, // no encoder or decoder activity
+		}, nil)
+
+		resInformer := &MockResourceInformer{}
+
+		monitor := &PowerMonitor{
+			logger:                       logger,
+			cpu:                          mockCPUMeter,
+			clock:                        fakeClock,
+			resources:                    resInformer,
+			maxTerminated:                500,
+			minTerminatedEnergyThreshold: 1 * Joule,
+			gpuMeters:                    []gpu.GPUPowerMeter{mockGPUMeter},
+		}
+
+		err := monitor.Init()
+		require.NoError(t, err)
+
+		tr := CreateTestResources(createOnly(testProcesses, testNode))
+		resInformer.SetExpectations(t, tr)
+
+		prevSnapshot := NewSnapshot()
+		prevSnapshot.Node = createNodeSnapshot(zones, fakeClock.Now(), 0.5)
+
+		newSnapshot := NewSnapshot()
+		newSnapshot.Node = createNodeSnapshot(zones, fakeClock.Now().Add(time.Second), 0.5)
+
+		err = monitor.calculateProcessPower(prevSnapshot, newSnapshot)
+		require.NoError(t, err)
+
+		proc123 := newSnapshot.Processes["123"]
+		require.NotNil(t, proc123)
+		assert.Equal(t, 5.0, proc123.GPUSMUtil)
+		assert.Equal(t, 70.0, proc123.GPUEncoderUtil)
+		assert.Equal(t, 20.0, proc123.GPUDecoderUtil)
+
+		proc456 := newSnapshot.Processes["456"]
+		require.NotNil(t, proc456)
+		assert.Equal(t, 10.0, proc456.GPUSMUtil)
+		assert.Zero(t, proc456.GPUEncoderUtil)
+		assert.Zero(t, proc456.GPUDecoderUtil)
+	})
+
 	t.Run("calculateProcessPower_GPU_energy_accumulation", func(t *testing.T) {
 		logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
 		fakeClock := testingclock.NewFakeClock(time.Now())
@@ -1041,6 +1284,7 @@ func TestProcessPowerWithGPU(t *testing.T) {
 			123: 50.5,
 		}
 		mockGPUMeter.On("GetProcessPower").Return(gpuProcessPower, nil)
+		mockGPUMeter.On("GetProcessInfo").Return([]gpu.ProcessGPUInfo(nil), nil)
 
 		resInformer := &MockResourceInformer{}
 
@@ -1110,6 +1354,7 @@ func TestProcessPowerWithGPU(t *testing.T) {
 		})
 		// GPU returns error
 		mockGPUMeter.On("GetProcessPower").Return(map[uint32]float64(nil), fmt.Errorf("GPU error"))
+		mockGPUMeter.On("GetProcessInfo").Return([]gpu.ProcessGPUInfo(nil), nil)
 
 		resInformer := &MockResourceInformer{}
 
@@ -1141,6 +1386,443 @@ func TestProcessPowerWithGPU(t *testing.T) {
 		// Verify processes are still calculated without GPU power (error should not fail)
 		assert.NotEmpty(t, newSnapshot.Processes)
 	})
+
+	t.Run("calculateProcessPower_GPU_host_PIDs", func(t *testing.T) {
+		logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+		fakeClock := testingclock.NewFakeClock(time.Now())
+
+		zones := CreateTestZones()
+		mockCPUMeter := &MockCPUPowerMeter{}
+		mockCPUMeter.On("Zones").Return(zones, nil)
+		mockCPUMeter.On("PrimaryEnergyZone").Return(zones[0], nil)
+
+		mockGPUMeter := new(MockGPUPowerMeter)
+		gpuDevices := []gpu.GPUDevice{
+			{Index: 0, UUID: "GPU-1234", Name: "Test GPU", Vendor: gpu.VendorNVIDIA},
+		}
+		mockGPUMeter.On("Vendor").Return(gpu.VendorNVIDIA)
+		mockGPUMeter.On("Devices").Return(gpuDevices)
+		mockGPUMeter.On("GetDevicePowerStats", 0).Return(gpu.GPUPowerStats{
+			TotalPower:  150.5,
+			IdlePower:   25.0,
+			ActivePower: 125.5,
+		}, nil)
+		mockGPUMeter.On("GetTotalEnergy", 0).Return(500*Joule, nil)
+
+		// GPU driver reports PIDs inside a PID namespace which differ from
+		// the host PIDs tracked by the resource layer
+		mockGPUMeter.On("GetProcessPower").Return(map[uint32]float64{
+			40456: 30.0, // namespaced PID of host PID 456 (container-2)
+			40001: 5.0,  // PID shared by two containers; cannot be resolved
+			789:   10.0, // PID tracked as is
+		}, nil)
+		mockGPUMeter.On("GetProcessInfo").Return([]gpu.ProcessGPUInfo{
+			{PID: 40456, DeviceIndex: 0},
+			{PID: 40001, DeviceIndex: 0},
+			{PID: 789, DeviceIndex: 0},
+		}, nil)
+
+		resInformer := &MockResourceInformer{}
+
+		monitor := &PowerMonitor{
+			logger:                       logger,
+			cpu:                          mockCPUMeter,
+			clock:                        fakeClock,
+			resources:                    resInformer,
+			maxTerminated:                500,
+			minTerminatedEnergyThreshold: 1 * Joule,
+			gpuMeters:                    []gpu.GPUPowerMeter{mockGPUMeter},
+		}
+
+		err := monitor.Init()
+		require.NoError(t, err)
+
+		tr := CreateTestResources(createOnly(testProcesses, testContainers, testNode))
+		tr.Processes.Running[456].NamespacedPIDs = []int{456, 40456}
+		tr.Processes.Running[123].NamespacedPIDs = []int{123, 40001}
+		tr.Processes.Running[1231].NamespacedPIDs = []int{1231, 40001}
+		resInformer.SetExpectations(t, tr)
+
+		prevSnapshot := NewSnapshot()
+		prevSnapshot.Node = createNodeSnapshot(zones, fakeClock.Now(), 0.5)
+
+		newSnapshot := NewSnapshot()
+		newSnapshot.Node = createNodeSnapshot(zones, fakeClock.Now().Add(time.Second), 0.5)
+
+		err = monitor.calculateProcessPower(prevSnapshot, newSnapshot)
+		require.NoError(t, err)
+
+		assert.Equal(t, 30.0, newSnapshot.Processes["456"].GPUPower)
+		assert.Equal(t, 10.0, newSnapshot.Processes["789"].GPUPower)
+		assert.Equal(t, 0.0, newSnapshot.Processes["123"].GPUPower)
+		assert.Equal(t, 0.0, newSnapshot.Processes["1231"].GPUPower)
+
+		err = monitor.calculateContainerPower(prevSnapshot, newSnapshot)
+		require.NoError(t, err)
+
+		assert.Equal(t, 30.0, newSnapshot.Containers["container-2"].GPUPower)
+		assert.Equal(t, map[int]float64{0: 30}, newSnapshot.Containers["container-2"].GPUDevicePower)
+		assert.Equal(t, 0.0, newSnapshot.Containers["container-1"].GPUPower)
+	})
+
+	t.Run("calculateProcessPower_GPU_power_only", func(t *testing.T) {
+		logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+		fakeClock := testingclock.NewFakeClock(time.Now())
+
+		zones := CreateTestZones()
+		mockCPUMeter := &MockCPUPowerMeter{}
+		mockCPUMeter.On("Zones").Return(zones, nil)
+		mockCPUMeter.On("PrimaryEnergyZone").Return(zones[0], nil)
+
+		// device reports instantaneous power but no energy counter
+		mockGPUMeter := new(MockGPUPowerMeter)
+		mockGPUMeter.On("Vendor").Return(gpu.VendorNVIDIA)
+		mockGPUMeter.On("Devices").Return([]gpu.GPUDevice{
+			{Index: 0, UUID: "GPU-1234", Name: "Test GPU", Vendor: gpu.VendorNVIDIA},
+		})
+		mockGPUMeter.On("GetDevicePowerStats", 0).Return(gpu.GPUPowerStats{
+			TotalPower:  100.0,
+			IdlePower:   25.0,
+			ActivePower: 75.0,
+		}, nil)
+		mockGPUMeter.On("GetTotalEnergy", 0).Return(Energy(0), fmt.Errorf("energy not supported"))
+		mockGPUMeter.On("GetProcessPower").Return(map[uint32]float64{}, nil)
+		mockGPUMeter.On("GetProcessInfo").Return([]gpu.ProcessGPUInfo(nil), nil)
+
+		resInformer := &MockResourceInformer{}
+
+		monitor := &PowerMonitor{
+			logger:                       logger,
+			cpu:                          mockCPUMeter,
+			clock:                        fakeClock,
+			resources:                    resInformer,
+			maxTerminated:                500,
+			minTerminatedEnergyThreshold: 1 * Joule,
+			gpuMeters:                    []gpu.GPUPowerMeter{mockGPUMeter},
+		}
+
+		err := monitor.Init()
+		require.NoError(t, err)
+
+		tr := CreateTestResources(createOnly(testProcesses, testNode))
+		resInformer.SetExpectations(t, tr)
+
+		prevSnapshot := NewSnapshot()
+		prevSnapshot.Node = createNodeSnapshot(zones, fakeClock.Now(), 0.5)
+		err = monitor.firstProcessRead(prevSnapshot)
+		require.NoError(t, err)
+
+		// device is reported despite the missing energy counter
+		require.Len(t, prevSnapshot.GPUStats, 1)
+		assert.Equal(t, 100.0, prevSnapshot.GPUStats[0].TotalPower)
+		assert.Equal(t, Energy(0), prevSnapshot.GPUStats[0].EnergyTotal)
+
+		newSnapshot := NewSnapshot()
+		newSnapshot.Node = createNodeSnapshot(zones, fakeClock.Now().Add(2*time.Second), 0.5)
+		err = monitor.calculateProcessPower(prevSnapshot, newSnapshot)
+		require.NoError(t, err)
+
+		// 100W over 2s = 200J, split 75/25 by the power ratio
+		require.Len(t, newSnapshot.GPUStats, 1)
+		stats := newSnapshot.GPUStats[0]
+		assert.Equal(t, 200*Joule, stats.EnergyTotal)
+		assert.Equal(t, 150*Joule, stats.ActiveEnergyTotal)
+		assert.Equal(t, 50*Joule, stats.IdleEnergyTotal)
+	})
+}
+
+func TestReadGPUReliability(t *testing.T) {
+	meter := gpu.NewFakeGPUMeter([]gpu.GPUDevice{{Index: 0, UUID: "GPU-0", Vendor: gpu.VendorNVIDIA}})
+	meter.SetFanSpeed(0, 35)
+	meter.SetPState(0, 8)
+
+	t.Run("enabled", func(t *testing.T) {
+		pm := &PowerMonitor{logger: slog.New(slog.DiscardHandler), gpuReliability: true}
+		stats := pm.readGPUDeviceStats([]gpu.GPUPowerMeter{meter})
+		require.Len(t, stats, 1)
+		require.NotNil(t, stats[0].Reliability)
+		assert.Equal(t, GPUReliability{FanSpeed: 35, PState: 8}, *stats[0].Reliability)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		pm := &PowerMonitor{logger: slog.New(slog.DiscardHandler)}
+		stats := pm.readGPUDeviceStats([]gpu.GPUPowerMeter{meter})
+		require.Len(t, stats, 1)
+		assert.Nil(t, stats[0].Reliability)
+	})
+}
+
+// idleAttributingGPUMeter is a GPU meter that supports idle attribution
+type idleAttributingGPUMeter struct {
+	*MockGPUPowerMeter
+}
+
+func (m idleAttributingGPUMeter) SetAttributeIdle(bool) {}
+
+func TestReadGPUAttributablePower(t *testing.T) {
+	newMeter := func() *MockGPUPowerMeter {
+		m := new(MockGPUPowerMeter)
+		m.On("Devices").Return([]gpu.GPUDevice{{Index: 0, UUID: "GPU-0", Vendor: gpu.VendorNVIDIA}})
+		m.On("Vendor").Return(gpu.VendorNVIDIA)
+		m.On("GetDevicePowerStats", 0).Return(gpu.GPUPowerStats{TotalPower: 100, IdlePower: 60, ActivePower: 40}, nil)
+		m.On("GetTotalEnergy", 0).Return(500*Joule, nil)
+		return m
+	}
+
+	tt := []struct {
+		name     string
+		basis    EnergyBasis
+		meter    gpu.GPUPowerMeter
+		expected float64
+	}{
+		{"active basis", EnergyBasisActive, idleAttributingGPUMeter{newMeter()}, 40},
+		{"total basis", EnergyBasisTotal, idleAttributingGPUMeter{newMeter()}, 100},
+		{"total basis without idle attribution", EnergyBasisTotal, newMeter(), 40},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			pm := &PowerMonitor{logger: slog.New(slog.DiscardHandler), gpuProcessEnergyBasis: tc.basis}
+			stats := pm.readGPUDeviceStats([]gpu.GPUPowerMeter{tc.meter})
+			require.Len(t, stats, 1)
+			assert.Equal(t, tc.expected, stats[0].AttributablePower)
+		})
+	}
+}
+
+func TestSplitGPUDevicePower(t *testing.T) {
+	power := map[uint32]float64{
+		1: 40, // on two devices, busier on device 1
+		2: 30, // on two idle devices
+		3: 10, // devices unknown
+	}
+	devices := map[uint32]map[int]float64{
+		1: {0: 0.25, 1: 0.75},
+		2: {0: 0, 1: 0},
+		4: {0: 0.5}, // no power
+	}
+
+	split := splitGPUDevicePower(power, devices)
+	assert.Equal(t, map[uint32]map[int]float64{
+		1: {0: 10, 1: 30},
+		2: {0: 15, 1: 15},
+	}, split)
+
+	assert.Nil(t, splitGPUDevicePower(power, nil))
+}
+
+func TestIntegrateGPUPowerOnlyEnergy(t *testing.T) {
+	t.Run("flat counter while drawing power", func(t *testing.T) {
+		prev := []GPUDeviceStats{
+			{UUID: "GPU-1234", TotalPower: 100.0, EnergyTotal: 500 * Joule},
+		}
+		current := []GPUDeviceStats{
+			{UUID: "GPU-1234", TotalPower: 100.0, EnergyTotal: 500 * Joule},
+		}
+
+		result := integrateGPUPowerOnlyEnergy(current, prev, 5)
+
+		// 100W * 5s = 500J integrated on top of the previous total
+		assert.Equal(t, 1000*Joule, result[0].EnergyTotal)
+		// a single flat read does not make the device power-only
+		assert.False(t, result[0].powerOnly)
+	})
+
+	t.Run("counter is used again after a flat or failed read", func(t *testing.T) {
+		reads := []struct {
+			name     string
+			stats    GPUDeviceStats
+			expected Energy
+		}{
+			{"normal", GPUDeviceStats{TotalPower: 100, EnergyTotal: 1000 * Joule}, 1000 * Joule},
+			// flat while drawing 100W over 5s: 500J integrated
+			{"flat", GPUDeviceStats{TotalPower: 100, EnergyTotal: 1000 * Joule}, 1500 * Joule},
+			// the counter advances 400J again, on top of the integrated energy
+			{"normal", GPUDeviceStats{TotalPower: 80, EnergyTotal: 1400 * Joule}, 1900 * Joule},
+			// failed read while drawing 60W over 5s: 300J integrated
+			{"failed", GPUDeviceStats{TotalPower: 60, energyReadFailed: true}, 2200 * Joule},
+			// the counter advanced 800J over both intervals, which replaces
+			// the 300J integrated over the failed one
+			{"recovered", GPUDeviceStats{TotalPower: 60, EnergyTotal: 2200 * Joule}, 2700 * Joule},
+			{"normal", GPUDeviceStats{TotalPower: 60, EnergyTotal: 2500 * Joule}, 3000 * Joule},
+		}
+
+		var prev []GPUDeviceStats
+		for i, r := range reads {
+			r.stats.UUID = "GPU-1234"
+			current := []GPUDeviceStats{r.stats}
+			if i == 0 {
+				prev = current
+				continue
+			}
+
+			prev = integrateGPUPowerOnlyEnergy(current, prev, 5)
+			assert.Equal(t, r.expected, prev[0].EnergyTotal, "read %d (%s)", i, r.name)
+			assert.False(t, prev[0].powerOnly, "read %d (%s)", i, r.name)
+		}
+	})
+
+	t.Run("power only after consecutive flat or failed reads", func(t *testing.T) {
+		prev := []GPUDeviceStats{{UUID: "GPU-1234", TotalPower: 100, EnergyTotal: 1000 * Joule}}
+		reads := []GPUDeviceStats{
+			{UUID: "GPU-1234", TotalPower: 100, EnergyTotal: 1000 * Joule},
+			{UUID: "GPU-1234", TotalPower: 100, energyReadFailed: true},
+			{UUID: "GPU-1234", TotalPower: 100, EnergyTotal: 1000 * Joule},
+		}
+		for i, r := range reads {
+			prev = integrateGPUPowerOnlyEnergy([]GPUDeviceStats{r}, prev, 1)
+			assert.Equal(t, i == gpuPowerOnlyReads-1, prev[0].powerOnly, "read %d", i)
+		}
+		assert.Equal(t, 1300*Joule, prev[0].EnergyTotal)
+
+		// once power-only, an advancing counter is no longer used
+		prev = integrateGPUPowerOnlyEnergy([]GPUDeviceStats{
+			{UUID: "GPU-1234", TotalPower: 100, EnergyTotal: 5000 * Joule},
+		}, prev, 1)
+		assert.Equal(t, 1400*Joule, prev[0].EnergyTotal)
+		assert.True(t, prev[0].powerOnly)
+	})
+
+	t.Run("power only stays integrated", func(t *testing.T) {
+		prev := []GPUDeviceStats{
+			{UUID: "GPU-1234", TotalPower: 100.0, EnergyTotal: 1000 * Joule, powerOnly: true},
+		}
+		current := []GPUDeviceStats{
+			// counter starts moving again; it must not replace the integrated total
+			{UUID: "GPU-1234", TotalPower: 50.0, EnergyTotal: 600 * Joule},
+		}
+
+		result := integrateGPUPowerOnlyEnergy(current, prev, 2)
+
+		assert.Equal(t, 1100*Joule, result[0].EnergyTotal)
+		assert.True(t, result[0].powerOnly)
+	})
+
+	t.Run("advancing counter is kept", func(t *testing.T) {
+		prev := []GPUDeviceStats{
+			{UUID: "GPU-1234", TotalPower: 100.0, EnergyTotal: 500 * Joule},
+		}
+		current := []GPUDeviceStats{
+			{UUID: "GPU-1234", TotalPower: 100.0, EnergyTotal: 900 * Joule},
+		}
+
+		result := integrateGPUPowerOnlyEnergy(current, prev, 5)
+
+		assert.Equal(t, 900*Joule, result[0].EnergyTotal)
+		assert.False(t, result[0].powerOnly)
+	})
+
+	t.Run("flat counter while idle at zero power", func(t *testing.T) {
+		prev := []GPUDeviceStats{
+			{UUID: "GPU-1234", EnergyTotal: 500 * Joule},
+		}
+		current := []GPUDeviceStats{
+			{UUID: "GPU-1234", EnergyTotal: 500 * Joule},
+		}
+
+		result := integrateGPUPowerOnlyEnergy(current, prev, 5)
+
+		assert.Equal(t, 500*Joule, result[0].EnergyTotal)
+		assert.False(t, result[0].powerOnly)
+	})
+
+	t.Run("elapsed time between snapshots in seconds", func(t *testing.T) {
+		mockGPUMeter := &MockGPUPowerMeter{}
+		mockGPUMeter.On("Devices").Return([]gpu.GPUDevice{{Index: 0, UUID: "GPU-1234"}})
+		mockGPUMeter.On("GetDevicePowerStats", 0).Return(gpu.GPUPowerStats{TotalPower: 80.0}, nil)
+		mockGPUMeter.On("GetTotalEnergy", 0).Return(Energy(0), assert.AnError)
+
+		monitor := &PowerMonitor{
+			logger:    slog.New(slog.DiscardHandler),
+			gpuMeters: []gpu.GPUPowerMeter{mockGPUMeter},
+		}
+
+		now := time.Now()
+		prev := NewSnapshot()
+		prev.Node = &Node{Timestamp: now}
+		prev.GPUStats = []GPUDeviceStats{{UUID: "GPU-1234", TotalPower: 80.0, EnergyTotal: 100 * Joule, powerOnly: true}}
+
+		snapshot := NewSnapshot()
+		snapshot.Node = &Node{Timestamp: now.Add(2500 * time.Millisecond)}
+		monitor.calculateGPUDeviceStats(prev, snapshot, monitor.gpuMeters)
+
+		// 80W over 2.5s adds 200J; the node timestamps must be converted to
+		// seconds, not reinterpreted as a duration
+		require.Len(t, snapshot.GPUStats, 1)
+		assert.Equal(t, 300*Joule, snapshot.GPUStats[0].EnergyTotal)
+	})
+}
+
+func TestGPUEnergyJitterTolerance(t *testing.T) {
+	meter := gpu.NewFakeGPUMeter([]gpu.GPUDevice{{Index: 0, UUID: "GPU-0", Vendor: gpu.VendorNVIDIA}})
+	meter.SetPower(0, 100*Watt)
+
+	now := time.Now()
+	prev := NewSnapshot()
+	prev.Node = &Node{Timestamp: now}
+	prev.GPUStats = []GPUDeviceStats{{
+		UUID: "GPU-0", TotalPower: 100, ActivePower: 100,
+		EnergyTotal: 1000 * Joule, ActiveEnergyTotal: 1000 * Joule,
+	}}
+
+	read := func(tolerance, energy Energy) GPUDeviceStats {
+		t.Helper()
+		pm := &PowerMonitor{
+			logger:                   slog.New(slog.DiscardHandler),
+			gpuMeters:                []gpu.GPUPowerMeter{meter},
+			gpuEnergyJitterTolerance: tolerance,
+		}
+		meter.SetEnergy(0, energy)
+
+		snapshot := NewSnapshot()
+		snapshot.Node = &Node{Timestamp: now.Add(time.Second)}
+		pm.calculateGPUDeviceStats(prev, snapshot, pm.gpuMeters)
+		require.Len(t, snapshot.GPUStats, 1)
+		return snapshot.GPUStats[0]
+	}
+
+	t.Run("small backward jump within tolerance", func(t *testing.T) {
+		stats := read(Joule, 1000*Joule-250*device.MilliJoule)
+		assert.Zero(t, stats.EnergyDelta, "jitter must not count as a wraparound")
+		assert.Equal(t, 1000*Joule, stats.EnergyTotal, "cumulative energy must not go backwards")
+		assert.Equal(t, 1000*Joule, stats.ActiveEnergyTotal)
+	})
+
+	t.Run("backward jump beyond tolerance is a reset", func(t *testing.T) {
+		stats := read(Joule, 10*Joule)
+		assert.Zero(t, stats.EnergyDelta)
+		assert.Equal(t, 10*Joule, stats.EnergyTotal, "the counter follows the device after a reset")
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		stats := read(0, 1000*Joule-250*device.MilliJoule)
+		assert.Zero(t, stats.EnergyDelta)
+		assert.Equal(t, 1000*Joule-250*device.MilliJoule, stats.EnergyTotal)
+	})
+
+	t.Run("forward move", func(t *testing.T) {
+		stats := read(Joule, 1100*Joule)
+		assert.Equal(t, 100*Joule, stats.EnergyDelta)
+		assert.Equal(t, 1100*Joule, stats.EnergyTotal)
+	})
+}
+
+func TestComputeGPUActiveIdleEnergy_UtilizationSplit(t *testing.T) {
+	// stats split by the meter at 25% utilization, without an idle baseline
+	split := gpu.GPUPowerStats{TotalPower: 200, ActivePower: 200, Utilization: 25, UtilizationKnown: true}.SplitByUtilization()
+	prev := []GPUDeviceStats{{UUID: "GPU-0", EnergyTotal: 1000 * Joule}}
+	current := []GPUDeviceStats{{
+		UUID:        "GPU-0",
+		TotalPower:  split.TotalPower,
+		IdlePower:   split.IdlePower,
+		ActivePower: split.ActivePower,
+		Utilization: split.Utilization,
+		EnergyTotal: 1400 * Joule,
+	}}
+
+	result := computeGPUActiveIdleEnergy(current, prev)
+	assert.Equal(t, 100*Joule, result[0].ActiveEnergyTotal)
+	assert.Equal(t, 300*Joule, result[0].IdleEnergyTotal)
+	assert.Equal(t, result[0].EnergyDelta, result[0].ActiveEnergyTotal+result[0].IdleEnergyTotal)
 }
 
 func TestComputeGPUActiveIdleEnergy(t *testing.T) {
@@ -1234,3 +1916,322 @@ func TestComputeGPUActiveIdleEnergy(t *testing.T) {
 		assert.Equal(t, 500*Joule, result[0].IdleEnergyTotal)
 	})
 }
+
+func TestProcessPIDMode(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+	fakeClock := testingclock.NewFakeClock(time.Now())
+	zones := CreateTestZones()
+
+	container := &resource.Container{ID: "abc", Name: "app", Runtime: resource.ContainerDRuntime}
+
+	// host PID 4242 is PID 7 inside the container's PID namespace
+	containerProc := func(delta float64) *resource.Process {
+		return &resource.Process{
+			PID: 4242, Comm: "app", Exe: "/app", Type: resource.ContainerProcess,
+			Container: container, NamespacedPIDs: []int{4242, 7},
+			CPUTimeDelta: delta,
+		}
+	}
+	hostProc := func(delta float64) *resource.Process {
+		return &resource.Process{PID: 100, Comm: "sshd", Exe: "/usr/sbin/sshd", CPUTimeDelta: delta}
+	}
+
+	tt := []struct {
+		name        string
+		mode        PIDMode
+		expectedKey string
+		expectedPID int
+	}{
+		{name: "host", mode: PIDModeHost, expectedKey: "4242", expectedPID: 4242},
+		{name: "namespaced", mode: PIDModeNamespaced, expectedKey: "abc/7", expectedPID: 7},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			mockMeter := &MockCPUPowerMeter{}
+			mockMeter.On("Zones").Return(zones, nil)
+			mockMeter.On("PrimaryEnergyZone").Return(zones[0], nil)
+			resInformer := &MockResourceInformer{}
+
+			monitor := &PowerMonitor{
+				logger:        logger,
+				cpu:           mockMeter,
+				clock:         fakeClock,
+				resources:     resInformer,
+				maxTerminated: 500,
+				pidMode:       tc.mode,
+			}
+			require.NoError(t, monitor.Init())
+
+			tr := CreateTestResources(createOnly(testNode))
+			resInformer.On("Node").Return(tr.Node, nil).Maybe()
+
+			// first interval: both processes running
+			resInformer.On("Processes").Return(&resource.Processes{
+				Running:    map[int]*resource.Process{4242: containerProc(30), 100: hostProc(20)},
+				Terminated: map[int]*resource.Process{},
+			}).Once()
+
+			snapshot1 := NewSnapshot()
+			snapshot1.Node = createNodeSnapshot(zones, fakeClock.Now(), 0.5)
+			require.NoError(t, monitor.calculateProcessPower(NewSnapshot(), snapshot1))
+
+			require.Contains(t, snapshot1.Processes, tc.expectedKey)
+			proc := snapshot1.Processes[tc.expectedKey]
+			assert.Equal(t, tc.expectedPID, proc.PID)
+			assert.Equal(t, 4242, proc.HostPID)
+			assert.Equal(t, "abc", proc.ContainerID)
+
+			// host processes are always identified by their host PID
+			require.Contains(t, snapshot1.Processes, "100")
+			assert.Equal(t, 100, snapshot1.Processes["100"].PID)
+
+			// second interval: the energy of the container process accumulates
+			// under the same key
+			resInformer.On("Processes").Return(&resource.Processes{
+				Running:    map[int]*resource.Process{4242: containerProc(30), 100: hostProc(20)},
+				Terminated: map[int]*resource.Process{},
+			}).Once()
+
+			snapshot2 := NewSnapshot()
+			snapshot2.Node = createNodeSnapshot(zones, fakeClock.Now().Add(time.Second), 0.5)
+			require.NoError(t, monitor.calculateProcessPower(snapshot1, snapshot2))
+
+			require.Contains(t, snapshot2.Processes, tc.expectedKey)
+			for _, zone := range zones {
+				assert.Greater(t, snapshot2.Processes[tc.expectedKey].Zones[zone].EnergyTotal,
+					proc.Zones[zone].EnergyTotal, "energy should accumulate for zone %s", zone.Name())
+			}
+
+			// third interval: the container process terminates and is tracked
+			// under the same key
+			resInformer.On("Processes").Return(&resource.Processes{
+				Running:    map[int]*resource.Process{100: hostProc(20)},
+				Terminated: map[int]*resource.Process{4242: containerProc(0)},
+			}).Once()
+
+			snapshot3 := NewSnapshot()
+			snapshot3.Node = createNodeSnapshot(zones, fakeClock.Now().Add(2*time.Second), 0.5)
+			require.NoError(t, monitor.calculateProcessPower(snapshot2, snapshot3))
+
+			terminated := monitor.terminatedProcessesTracker.Items()
+			require.Contains(t, terminated, tc.expectedKey)
+			assert.Equal(t, tc.expectedPID, terminated[tc.expectedKey].PID)
+		})
+	}
+}
+
+func TestKernelAsProcess(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+
+	tt := []struct {
+		name            string
+		kernelAsProcess bool
+		activePower     Power
+		kernelPower     Power
+		appPower        Power
+	}{
+		// 50% CPU usage of 100W; the kernel has 40% of the process CPU time
+		{name: "reported as process", kernelAsProcess: true, activePower: 50 * Watt, kernelPower: 20 * Watt, appPower: 30 * Watt},
+		// the kernel share of the active power is folded into idle
+		{name: "folded into idle", kernelAsProcess: false, activePower: 30 * Watt, appPower: 30 * Watt},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeClock := testingclock.NewFakeClock(time.Now())
+			pkg := device.NewMockRaplZone("package", 0, "/sys/class/powercap/intel-rapl/intel-rapl:0", 1000*Joule)
+			zones := []EnergyZone{pkg}
+
+			mockMeter := &MockCPUPowerMeter{}
+			mockMeter.On("Zones").Return(zones, nil)
+			mockMeter.On("PrimaryEnergyZone").Return(pkg, nil)
+
+			resInformer := &MockResourceInformer{}
+			resInformer.On("Node").Return(&resource.Node{
+				CPUUsageRatio:            0.5,
+				ProcessTotalCPUTimeDelta: 100,
+			})
+			resInformer.On("Processes").Return(&resource.Processes{
+				Running: map[int]*resource.Process{
+					0:   {PID: 0, CPUTimeDelta: 40},
+					100: {PID: 100, Comm: "app", CPUTimeDelta: 60},
+				},
+				Terminated: map[int]*resource.Process{},
+			})
+
+			pm := NewPowerMonitor(mockMeter,
+				WithLogger(logger),
+				WithClock(fakeClock),
+				WithResourceInformer(resInformer),
+				WithKernelAsProcess(tc.kernelAsProcess),
+			)
+			require.NoError(t, pm.Init())
+
+			prev := NewSnapshot()
+			require.NoError(t, pm.firstNodeRead(prev.Node))
+
+			fakeClock.Step(time.Second)
+			pkg.Inc(100 * Joule)
+			current := NewSnapshot()
+			require.NoError(t, pm.calculateNodePower(prev.Node, current.Node))
+			require.NoError(t, pm.calculateProcessPower(prev, current))
+
+			node := current.Node.Zones[pkg]
+			assert.InDelta(t, tc.activePower.Watts(), node.ActivePower.Watts(), 1e-6)
+			assert.InDelta(t, 100, (node.ActivePower + node.IdlePower).Watts(), 1e-6)
+			assert.Equal(t, 0.5, current.Node.UsageRatio, "the reported CPU usage is unchanged")
+
+			require.Contains(t, current.Processes, "100")
+			assert.InDelta(t, tc.appPower.Watts(), current.Processes["100"].Zones[pkg].Power.Watts(), 1e-6)
+
+			kernel, reported := current.Processes["0"]
+			assert.Equal(t, tc.kernelAsProcess, reported)
+			if reported {
+				assert.Equal(t, "kernel", kernel.Comm)
+				assert.InDelta(t, tc.kernelPower.Watts(), kernel.Zones[pkg].Power.Watts(), 1e-6)
+			}
+
+			assert.NoError(t, VerifyConservation(current))
+		})
+	}
+}
+
+func TestMaxProcessAge(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+	fakeClock := testingclock.NewFakeClock(time.Now())
+	pkg := device.NewMockRaplZone("package", 0, "/sys/class/powercap/intel-rapl/intel-rapl:0", 1000*Joule)
+	zones := []EnergyZone{pkg}
+
+	mockMeter := &MockCPUPowerMeter{}
+	mockMeter.On("Zones").Return(zones, nil)
+	mockMeter.On("PrimaryEnergyZone").Return(pkg, nil)
+
+	started := fakeClock.Now()
+	resInformer := &MockResourceInformer{}
+	resInformer.On("Node").Return(&resource.Node{
+		CPUUsageRatio:            0.5,
+		ProcessTotalCPUTimeDelta: 100,
+	})
+	resInformer.On("Processes").Return(&resource.Processes{
+		Running: map[int]*resource.Process{
+			// long-lived system process
+			100: {PID: 100, Comm: "systemd", CPUTimeDelta: 40, StartTime: started.Add(-2 * time.Hour)},
+			// becomes older than the max age during the second interval
+			200: {PID: 200, Comm: "daemon", CPUTimeDelta: 30, StartTime: started.Add(-time.Hour + 1500*time.Millisecond)},
+			// recent workload
+			300: {PID: 300, Comm: "app", CPUTimeDelta: 20, StartTime: started.Add(-time.Minute)},
+			// unknown start time
+			400: {PID: 400, Comm: "unknown", CPUTimeDelta: 10},
+		},
+		Terminated: map[int]*resource.Process{},
+	})
+
+	pm := NewPowerMonitor(mockMeter,
+		WithLogger(logger),
+		WithClock(fakeClock),
+		WithResourceInformer(resInformer),
+		WithMaxProcessAge(time.Hour),
+	)
+	require.NoError(t, pm.Init())
+
+	prev := NewSnapshot()
+	require.NoError(t, pm.firstNodeRead(prev.Node))
+
+	fakeClock.Step(time.Second)
+	pkg.Inc(100 * Joule)
+	current := NewSnapshot()
+	require.NoError(t, pm.calculateNodePower(prev.Node, current.Node))
+	require.NoError(t, pm.calculateProcessPower(prev, current))
+	pm.accumulateAgedProcessesEnergy(prev, current)
+
+	assert.True(t, current.Processes["100"].Aged)
+	assert.False(t, current.Processes["200"].Aged)
+	assert.False(t, current.Processes["300"].Aged)
+	assert.False(t, current.Processes["400"].Aged, "processes with unknown start time are never aged")
+	assert.Equal(t, current.Processes["100"].Zones[pkg].EnergyTotal, pm.agedProcessesEnergy["package"])
+
+	fakeClock.Step(time.Second)
+	pkg.Inc(100 * Joule)
+	next := NewSnapshot()
+	require.NoError(t, pm.calculateNodePower(current.Node, next.Node))
+	require.NoError(t, pm.calculateProcessPower(current, next))
+	pm.accumulateAgedProcessesEnergy(current, next)
+
+	assert.True(t, next.Processes["100"].Aged)
+	assert.True(t, next.Processes["200"].Aged)
+	assert.False(t, next.Processes["300"].Aged)
+
+	// only the energy consumed while aged is aggregated
+	daemonDelta := next.Processes["200"].Zones[pkg].EnergyTotal - current.Processes["200"].Zones[pkg].EnergyTotal
+	assert.Equal(t, next.Processes["100"].Zones[pkg].EnergyTotal+daemonDelta, pm.agedProcessesEnergy["package"])
+
+	// aged processes are still attributed power
+	assert.NoError(t, VerifyConservation(next))
+}
+
+func TestProcessChurn(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+	fakeClock := testingclock.NewFakeClock(time.Now())
+	zones := CreateTestZones()
+
+	mockMeter := &MockCPUPowerMeter{}
+	mockMeter.On("Zones").Return(zones, nil)
+	mockMeter.On("PrimaryEnergyZone").Return(zones[0], nil)
+	resInformer := &MockResourceInformer{}
+
+	monitor := &PowerMonitor{
+		logger:        logger,
+		cpu:           mockMeter,
+		clock:         fakeClock,
+		resources:     resInformer,
+		maxTerminated: 500,
+	}
+	require.NoError(t, monitor.Init())
+
+	tr := CreateTestResources(createOnly(testNode))
+	resInformer.On("Node").Return(tr.Node, nil).Maybe()
+
+	running := func(pids ...int) map[int]*resource.Process {
+		procs := make(map[int]*resource.Process, len(pids))
+		for _, pid := range pids {
+			procs[pid] = &resource.Process{PID: pid, Comm: "proc", CPUTimeDelta: 10}
+		}
+		return procs
+	}
+
+	// first snapshot: 3 processes running
+	prev := NewSnapshot()
+	prev.Node = createNodeSnapshot(zones, fakeClock.Now(), 0.5)
+	for pid := range running(1, 2, 3) {
+		p := &Process{PID: pid, Zones: make(ZoneUsageMap)}
+		prev.Processes[p.StringID()] = p
+	}
+
+	// second snapshot: 1 terminated, 2 and 3 keep running, 4 and 5 started
+	resInformer.On("Processes").Return(&resource.Processes{
+		Running:    running(2, 3, 4, 5),
+		Terminated: running(1),
+	}).Once()
+
+	snapshot := NewSnapshot()
+	snapshot.Node = createNodeSnapshot(zones, fakeClock.Now().Add(time.Second), 0.5)
+	require.NoError(t, monitor.calculateProcessPower(prev, snapshot))
+
+	assert.Equal(t, uint64(2), snapshot.ProcessesStarted)
+	assert.Equal(t, uint64(1), snapshot.ProcessesTerminated)
+
+	// third snapshot: counts accumulate; 2 and 4 terminated, 6 started
+	resInformer.On("Processes").Return(&resource.Processes{
+		Running:    running(3, 5, 6),
+		Terminated: running(2, 4),
+	}).Once()
+
+	next := NewSnapshot()
+	next.Node = createNodeSnapshot(zones, fakeClock.Now().Add(2*time.Second), 0.5)
+	require.NoError(t, monitor.calculateProcessPower(snapshot, next))
+
+	assert.Equal(t, uint64(3), next.ProcessesStarted)
+	assert.Equal(t, uint64(3), next.ProcessesTerminated)
+	assert.Equal(t, next.ProcessesStarted, next.Clone().ProcessesStarted)
+}
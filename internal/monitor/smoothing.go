@@ -0,0 +1,146 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package monitor
+
+import (
+	"slices"
+	"time"
+)
+
+// energySlice is the energy of one interval, released at a constant rate
+// over the smoothing window starting at the beginning of that interval
+type energySlice struct {
+	start, end time.Time
+	rate       float64 // µJ per second, i.e. µW
+}
+
+// energySmoother smooths power without altering energy. Instead of filtering
+// the power, it spreads the energy of each interval evenly over a window and
+// reports as power the energy released in each interval. Unlike an EWMA, the
+// integral of the smoothed power equals the measured energy, minus the energy
+// still pending release, which is fully released one window after the last
+// interval.
+type energySmoother struct {
+	window     time.Duration
+	pending    []energySlice
+	releasedTo time.Time // end of the last interval
+}
+
+func newEnergySmoother(window time.Duration) *energySmoother {
+	return &energySmoother{window: window}
+}
+
+// clone returns a copy of the smoother that does not share its pending energy
+func (s *energySmoother) clone() *energySmoother {
+	c := *s
+	c.pending = slices.Clone(s.pending)
+	return &c
+}
+
+// Update adds the energy measured over the interval (from, to] and returns
+// the smoothed power of that interval
+func (s *energySmoother) Update(from, to time.Time, delta Energy) Power {
+	elapsed := to.Sub(from)
+	if elapsed <= 0 {
+		return 0
+	}
+	if s.window <= elapsed {
+		// nothing to smooth over, e.g. a window shorter than the interval;
+		// release the pending energy along with the interval's energy
+		released := float64(delta) + s.pendingEnergy()
+		s.pending = s.pending[:0]
+		s.releasedTo = to
+		return Power(released / elapsed.Seconds())
+	}
+
+	if delta > 0 {
+		s.pending = append(s.pending, energySlice{
+			start: from,
+			end:   from.Add(s.window),
+			rate:  float64(delta) / s.window.Seconds(),
+		})
+	}
+
+	// release the energy of a gap since the previous interval too, so that
+	// none is lost
+	if !s.releasedTo.IsZero() && s.releasedTo.Before(from) {
+		from = s.releasedTo
+	}
+	released := s.release(from, to)
+	return Power(released / elapsed.Seconds())
+}
+
+// release returns the energy released over (from, to] and drops the slices
+// that are fully released
+func (s *energySmoother) release(from, to time.Time) float64 {
+	released := 0.0
+	remaining := s.pending[:0]
+	for _, slice := range s.pending {
+		start, end := maxTime(slice.start, from), minTime(slice.end, to)
+		if end.After(start) {
+			released += slice.rate * end.Sub(start).Seconds()
+		}
+		if slice.end.After(to) {
+			remaining = append(remaining, slice)
+		}
+	}
+	s.pending = remaining
+	s.releasedTo = to
+	return released
+}
+
+// Pending returns the measured energy not yet released as smoothed power
+func (s *energySmoother) Pending() Energy {
+	return Energy(s.pendingEnergy())
+}
+
+func (s *energySmoother) pendingEnergy() float64 {
+	pending := 0.0
+	for _, slice := range s.pending {
+		pending += slice.rate * slice.end.Sub(maxTime(slice.start, s.releasedTo)).Seconds()
+	}
+	return pending
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
+
+// zoneSmoother smooths the active and idle power of a node zone separately so
+// that both match their energy
+type zoneSmoother struct {
+	active, idle *energySmoother
+}
+
+func (z *zoneSmoother) clone() *zoneSmoother {
+	return &zoneSmoother{active: z.active.clone(), idle: z.idle.clone()}
+}
+
+// smoothZonePower returns the smoothed active and idle power of a zone over
+// the interval (from, to]
+func (pm *PowerMonitor) smoothZonePower(zone EnergyZone, from, to time.Time, activeEnergy, idleEnergy Energy) (Power, Power) {
+	if pm.powerSmoothers == nil {
+		pm.powerSmoothers = make(map[EnergyZone]*zoneSmoother)
+	}
+
+	s, ok := pm.powerSmoothers[zone]
+	if !ok {
+		s = &zoneSmoother{
+			active: newEnergySmoother(pm.powerSmoothingWindow),
+			idle:   newEnergySmoother(pm.powerSmoothingWindow),
+		}
+		pm.powerSmoothers[zone] = s
+	}
+	return s.active.Update(from, to, activeEnergy), s.idle.Update(from, to, idleEnergy)
+}
@@ -0,0 +1,138 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package monitor
+
+import (
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	test_clock "k8s.io/utils/clock/testing"
+
+	"github.com/sustainable-computing-io/kepler/internal/device"
+)
+
+func TestEnergySmoother(t *testing.T) {
+	start := time.Date(2025, 4, 14, 5, 40, 0, 0, time.UTC)
+
+	t.Run("integral of smoothed power equals energy", func(t *testing.T) {
+		s := newEnergySmoother(5 * time.Second)
+
+		// a bursty load with uneven intervals
+		deltas := []Energy{
+			100 * Joule, 0, 0, 300 * Joule, 20 * Joule,
+			0, 50 * Joule, 50 * Joule, 0, 400 * Joule,
+		}
+		steps := []time.Duration{
+			time.Second, 2 * time.Second, time.Second, 500 * time.Millisecond, time.Second,
+			1500 * time.Millisecond, time.Second, time.Second, 3 * time.Second, time.Second,
+		}
+
+		now := start
+		var energy Energy
+		integral := 0.0 // µJ
+		for i, delta := range deltas {
+			next := now.Add(steps[i])
+			power := s.Update(now, next, delta)
+			integral += float64(power) * steps[i].Seconds()
+			energy += delta
+			now = next
+
+			assert.InDelta(t, float64(energy), integral+float64(s.Pending()), 1,
+				"energy is either released as power or pending after interval %d", i)
+		}
+		assert.Positive(t, s.Pending(), "the last intervals are not fully released")
+
+		// one window after the last interval all energy has been released
+		for range 5 {
+			next := now.Add(time.Second)
+			integral += float64(s.Update(now, next, 0)) * time.Second.Seconds()
+			now = next
+		}
+		assert.Zero(t, s.Pending())
+		assert.InDelta(t, float64(energy), integral, 1)
+	})
+
+	t.Run("constant load is unchanged", func(t *testing.T) {
+		s := newEnergySmoother(3 * time.Second)
+
+		now := start
+		var power Power
+		for range 5 {
+			power = s.Update(now, now.Add(time.Second), 60*Joule)
+			now = now.Add(time.Second)
+		}
+		assert.InDelta(t, (60 * Watt).MicroWatts(), power.MicroWatts(), 1e-6)
+	})
+
+	t.Run("spike is spread over the window", func(t *testing.T) {
+		s := newEnergySmoother(4 * time.Second)
+
+		now := start
+		for range 4 {
+			var delta Energy
+			if now.Equal(start) {
+				delta = 400 * Joule
+			}
+			power := s.Update(now, now.Add(time.Second), delta)
+			assert.InDelta(t, (100 * Watt).MicroWatts(), power.MicroWatts(), 1e-6)
+			now = now.Add(time.Second)
+		}
+		assert.Zero(t, s.Update(now, now.Add(time.Second), 0))
+	})
+
+	t.Run("window not longer than the interval", func(t *testing.T) {
+		s := newEnergySmoother(time.Second)
+		power := s.Update(start, start.Add(2*time.Second), 100*Joule)
+		assert.InDelta(t, (50 * Watt).MicroWatts(), power.MicroWatts(), 1e-6)
+		assert.Zero(t, s.Pending())
+	})
+}
+
+func TestNodePowerSmoothing(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError}))
+
+	pkg := device.NewMockRaplZone("package", 0, "/sys/class/powercap/intel-rapl/intel-rapl:0", 1000*Joule)
+	mockCPUPowerMeter := &MockCPUPowerMeter{}
+	mockCPUPowerMeter.On("Zones").Return([]EnergyZone{pkg}, nil)
+	mockCPUPowerMeter.On("PrimaryEnergyZone").Return(pkg, nil)
+
+	mockClock := test_clock.NewFakeClock(time.Date(2025, 4, 14, 5, 40, 0, 0, time.UTC))
+
+	resInformer := &MockResourceInformer{}
+	resInformer.SetExpectations(t, CreateTestResources())
+	resInformer.On("Refresh").Return(nil)
+
+	pm := NewPowerMonitor(
+		mockCPUPowerMeter,
+		WithLogger(logger),
+		WithClock(mockClock),
+		WithResourceInformer(resInformer),
+		WithPowerSmoothingWindow(3*time.Second),
+	)
+	require.NoError(t, pm.Init())
+	require.NoError(t, pm.refreshSnapshot())
+
+	// a 90 J burst followed by idle intervals
+	integral := 0.0
+	var energy Energy
+	for i, delta := range []Energy{90 * Joule, 0, 0, 0} {
+		mockClock.Step(time.Second)
+		pkg.Inc(delta)
+		energy += delta
+		require.NoError(t, pm.refreshSnapshot(), "refresh %d", i)
+
+		usage := pm.snapshot.Load().Node.Zones[pkg]
+		assert.Equal(t, delta, usage.EnergyDelta, "energy is not smoothed")
+		assert.InDelta(t, usage.Power.MicroWatts(), (usage.ActivePower + usage.IdlePower).MicroWatts(), 1e-6)
+		integral += usage.Power.MicroWatts()
+	}
+
+	assert.InDelta(t, float64(energy), integral, 1, "integral of node power equals its energy")
+	assert.InDelta(t, 0.0, pm.snapshot.Load().Node.Zones[pkg].Power.MicroWatts(), 1e-6,
+		"the burst is released over the window")
+}
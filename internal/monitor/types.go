@@ -9,13 +9,15 @@ import (
 	"time"
 
 	"github.com/sustainable-computing-io/kepler/internal/device"
+	"github.com/sustainable-computing-io/kepler/internal/device/gpu"
 	"github.com/sustainable-computing-io/kepler/internal/resource"
 )
 
 type (
-	Energy     = device.Energy
-	Power      = device.Power
-	EnergyZone = device.EnergyZone
+	Energy         = device.Energy
+	Power          = device.Power
+	EnergyZone     = device.EnergyZone
+	GPUReliability = gpu.ReliabilityStats
 )
 
 const (
@@ -26,6 +28,7 @@ const (
 // NodeUsage contains energy consumption data of a node. This is different to Usage in that it has idle/active split
 type NodeUsage struct {
 	EnergyTotal Energy // Cumulative joules counter
+	EnergyDelta Energy // Energy consumed since the previous reading
 	Power       Power  // Current power in watts
 
 	// Split of Delta Energy between Active and Idle
@@ -35,8 +38,11 @@ type NodeUsage struct {
 	IdleEnergyTotal Energy // Cumulative energy counter for idle workloads
 	IdlePower       Power  // portion of the total power that allocated to node idling
 
+	PowerLimit Power // Power limit of the zone, 0 if the zone has no known limit
+
 	// NOTE: activeEnergy is an internal variable that is used to calculate Resource's energy
 	activeEnergy Energy // Energy used by the Resource running
+	idleEnergy   Energy // Energy spent idling since the previous reading
 }
 
 // Usage contains energy consumption data of workloads (Process, Container, VM)
@@ -44,6 +50,10 @@ type NodeUsage struct {
 type Usage struct {
 	EnergyTotal Energy // Cumulative joules counter
 	Power       Power  // Current power in watts
+
+	// EnergyDelta is the energy attributed in the latest interval; it is only
+	// set for processes
+	EnergyDelta Energy
 }
 
 // ZoneUsageMap maps energy zones to basic usage data (absolute energy and power).
@@ -56,9 +66,11 @@ type ZoneUsageMap map[EnergyZone]Usage
 type NodeZoneUsageMap map[EnergyZone]NodeUsage
 
 type Node struct {
-	Timestamp  time.Time        // Timestamp of the last measurement
-	UsageRatio float64          // ratio of usage
-	Zones      NodeZoneUsageMap // Map of zones to usage
+	Timestamp   time.Time        // Timestamp of the last measurement
+	UsageRatio  float64          // ratio of usage
+	Zones       NodeZoneUsageMap // Map of zones to usage
+	PrimaryZone EnergyZone       // zone that best represents the total CPU power; nil if unknown
+	CoreZones   ZoneUsageMap     // per-core energy and power; nil if per-core energy is unavailable
 }
 
 func (n *Node) Clone() *Node {
@@ -68,18 +80,30 @@ func (n *Node) Clone() *Node {
 	ret := *n
 	ret.Zones = make(NodeZoneUsageMap, len(n.Zones))
 	maps.Copy(ret.Zones, n.Zones)
+	ret.CoreZones = maps.Clone(n.CoreZones)
 	return &ret
 }
 
 // Process represents the power consumption of a process
 type Process struct {
-	PID  int
-	Comm string
-	Exe  string
+	PID     int // PID identifying the process; see PIDMode
+	HostPID int // PID in the host PID namespace
+	Comm    string
+	Exe     string
 
 	Type resource.ProcessType
 
 	CPUTotalTime float64 // CPU time in seconds
+	MemoryBytes  uint64  // resident set size in bytes
+	Threads      int     // number of threads
+	State        string  // kernel process state (R, S, D, Z, ...)
+	UID          int     // real user ID owning the process; -1 if unknown
+
+	StartTime time.Time // when the process started; zero if unknown
+
+	// Aged is set for processes older than the max process age, which are
+	// only reported in the aggregate energy of aged processes
+	Aged bool
 
 	Zones ZoneUsageMap
 
@@ -87,6 +111,16 @@ type Process struct {
 	GPUPower       float64
 	GPUEnergyTotal Energy // Cumulative GPU energy in microjoules
 
+	// GPUDevicePower is GPUPower split by GPU device index; nil when the
+	// devices the process runs on are unknown
+	GPUDevicePower map[int]float64
+
+	// GPU SM, encoder and decoder utilization in percent, summed across
+	// devices. Only set by GPU meters reporting per-process utilization.
+	GPUSMUtil      float64
+	GPUEncoderUtil float64
+	GPUDecoderUtil float64
+
 	ContainerID      string // empty if not a container
 	VirtualMachineID string // empty if not a virtual machine
 }
@@ -99,6 +133,7 @@ func (p *Process) Clone() *Process {
 	ret := *p
 	ret.Zones = make(ZoneUsageMap, len(p.Zones))
 	maps.Copy(ret.Zones, p.Zones)
+	ret.GPUDevicePower = maps.Clone(p.GPUDevicePower)
 	return &ret
 }
 
@@ -107,8 +142,13 @@ func (p *Process) ZoneUsage() ZoneUsageMap {
 	return p.Zones
 }
 
-// StringID implements the Resource interface
+// StringID implements the Resource interface. A process identified by a PID
+// from a nested PID namespace is qualified by its container since such PIDs
+// are only unique within their namespace.
 func (p *Process) StringID() string {
+	if p.HostPID != 0 && p.PID != p.HostPID && p.ContainerID != "" {
+		return p.ContainerID + "/" + strconv.Itoa(p.PID)
+	}
 	return strconv.Itoa(p.PID)
 }
 
@@ -122,6 +162,7 @@ type Container struct {
 	Runtime ContainerRuntime // Container runtime
 
 	CPUTotalTime float64 // CPU time in seconds
+	MemoryBytes  uint64  // resident set size of the container's processes in bytes
 
 	Zones ZoneUsageMap
 
@@ -129,6 +170,13 @@ type Container struct {
 	GPUPower       float64
 	GPUEnergyTotal Energy // Cumulative GPU energy, aggregated from processes
 
+	// GPUDevicePower is GPUPower split by GPU device index, aggregated from processes
+	GPUDevicePower map[int]float64
+
+	// GPU encoder and decoder utilization in percent, aggregated from processes
+	GPUEncoderUtil float64
+	GPUDecoderUtil float64
+
 	// pod id is empty if the container is not a pod
 	PodID string
 }
@@ -141,6 +189,7 @@ func (c *Container) Clone() *Container {
 	ret := *c
 	ret.Zones = make(ZoneUsageMap, len(c.Zones))
 	maps.Copy(ret.Zones, c.Zones)
+	ret.GPUDevicePower = maps.Clone(c.GPUDevicePower)
 	return &ret
 }
 
@@ -202,6 +251,13 @@ type Pod struct {
 	// GPU power attribution (in Watts). Aggregated from container-level GPU power.
 	GPUPower       float64
 	GPUEnergyTotal Energy // Cumulative GPU energy, aggregated from containers
+
+	// GPUDevicePower is GPUPower split by GPU device index, aggregated from containers
+	GPUDevicePower map[int]float64
+
+	// GPU encoder and decoder utilization in percent, aggregated from containers
+	GPUEncoderUtil float64
+	GPUDecoderUtil float64
 }
 
 func (p *Pod) Clone() *Pod {
@@ -212,6 +268,7 @@ func (p *Pod) Clone() *Pod {
 	ret := *p
 	ret.Zones = make(ZoneUsageMap, len(p.Zones))
 	maps.Copy(ret.Zones, p.Zones)
+	ret.GPUDevicePower = maps.Clone(p.GPUDevicePower)
 	return &ret
 }
 
@@ -245,9 +302,34 @@ type GPUDeviceStats struct {
 	TotalPower        float64 // Current total power in Watts
 	IdlePower         float64 // Detected idle power in Watts
 	ActivePower       float64 // Active power (Total - Idle) in Watts
+	AttributablePower float64 // Power split among processes: ActivePower, or TotalPower with the total basis
+	Utilization       float64 // Device SM utilization in percent (0-100); 0 when unavailable
 	EnergyTotal       Energy  // Cumulative GPU energy from hardware counter
+	EnergyDelta       Energy  // GPU energy consumed since the previous reading
 	ActiveEnergyTotal Energy  // Cumulative active GPU energy (split from EnergyTotal using power ratio)
 	IdleEnergyTotal   Energy  // Cumulative idle GPU energy (split from EnergyTotal using power ratio)
+
+	// Reliability holds the fan speed and performance state of the device;
+	// nil unless GPU reliability metrics are collected
+	Reliability *GPUReliability
+
+	powerOnly        bool   // EnergyTotal is integrated from TotalPower (no usable energy counter)
+	energyReadFailed bool   // the energy counter could not be read
+	energyOffset     Energy // energy integrated from TotalPower on top of the energy counter
+	failedReadEnergy Energy // energy integrated over consecutive failed energy counter reads
+	unusableReads    int    // consecutive failed or flat energy counter reads
+}
+
+// meter types used to identify the source of read errors
+const (
+	cpuMeter = "cpu"
+	gpuMeter = "gpu"
+)
+
+// MeterZone identifies a zone (or device) of a power meter
+type MeterZone struct {
+	Meter string // type of meter: cpu, gpu
+	Zone  string // zone name for cpu meters, device index for gpu meters
 }
 
 // Snapshot encapsulates power monitoring data
@@ -264,10 +346,46 @@ type Snapshot struct {
 	VirtualMachines           VirtualMachines // VM power data, keyed by container ID
 	TerminatedVirtualMachines VirtualMachines // Terminated VMs with highest energy consumption
 	Pods                      Pods            // Pod power data, keyed by pod ID
+	Users                     Users           // User power data, keyed by UID
 	TerminatedPods            Pods            // Terminated pods with highest energy consumption
 
+	// Cumulative energy, keyed by zone name, of all containers and pods that
+	// terminated since start. Unlike TerminatedContainers and TerminatedPods,
+	// these are never cleared nor limited by the terminated workload trackers.
+	TerminatedContainersEnergy map[string]Energy
+	TerminatedPodsEnergy       map[string]Energy
+
+	// AgedProcessesEnergy is the cumulative energy, keyed by zone name, that
+	// processes consumed while older than the max process age
+	AgedProcessesEnergy map[string]Energy
+
 	// GPU power statistics for debugging/monitoring (optional, nil if no GPU)
 	GPUStats []GPUDeviceStats
+
+	// AttributedEnergy is the cumulative energy attributed to the workloads of
+	// each level since start, e.g. to compare the energy of containers with
+	// that of all processes
+	AttributedEnergy map[LevelZone]Energy
+
+	// MeterReadErrors is the cumulative count of failed meter reads since start
+	MeterReadErrors map[MeterZone]uint64
+
+	// RaplPermissionDenied is set when a RAPL energy counter could not be
+	// read in this snapshot because Kepler is not permitted to read it
+	RaplPermissionDenied bool
+
+	// ProcessEnergyBasis is the share of the node power attributed to the
+	// processes of this snapshot; empty is EnergyBasisActive
+	ProcessEnergyBasis EnergyBasis
+
+	// Cumulative number of processes that appeared in or disappeared from
+	// the running processes between consecutive snapshots since start
+	ProcessesStarted    uint64
+	ProcessesTerminated uint64
+
+	// NegativeCPUTimeDeltas is the cumulative number of process CPU time
+	// decreases that were clamped to zero since start
+	NegativeCPUTimeDeltas uint64
 }
 
 // NewSnapshot creates a new Snapshot instance
@@ -285,6 +403,7 @@ func NewSnapshot() *Snapshot {
 		TerminatedVirtualMachines: make(VirtualMachines),
 		Pods:                      make(Pods),
 		TerminatedPods:            make(Pods),
+		Users:                     make(Users),
 	}
 }
 
@@ -300,6 +419,12 @@ func (s *Snapshot) Clone() *Snapshot {
 		TerminatedVirtualMachines: make(VirtualMachines, len(s.TerminatedVirtualMachines)),
 		Pods:                      make(Pods, len(s.Pods)),
 		TerminatedPods:            make(Pods, len(s.TerminatedPods)),
+		Users:                     make(Users, len(s.Users)),
+		ProcessesStarted:          s.ProcessesStarted,
+		ProcessesTerminated:       s.ProcessesTerminated,
+		NegativeCPUTimeDeltas:     s.NegativeCPUTimeDeltas,
+		RaplPermissionDenied:      s.RaplPermissionDenied,
+		ProcessEnergyBasis:        s.ProcessEnergyBasis,
 	}
 
 	// Deep copy the processes map
@@ -339,11 +464,39 @@ func (s *Snapshot) Clone() *Snapshot {
 		clone.TerminatedPods[id] = src.Clone()
 	}
 
-	// Copy GPU stats (slice of value types, so shallow copy is sufficient)
+	for id, src := range s.Users {
+		clone.Users[id] = src.Clone()
+	}
+
+	// Copy GPU stats; only the reliability stats are referenced by pointer
 	if len(s.GPUStats) > 0 {
 		clone.GPUStats = make([]GPUDeviceStats, len(s.GPUStats))
 		copy(clone.GPUStats, s.GPUStats)
+		for i, stats := range clone.GPUStats {
+			if stats.Reliability != nil {
+				r := *stats.Reliability
+				clone.GPUStats[i].Reliability = &r
+			}
+		}
 	}
 
+	clone.AttributedEnergy = maps.Clone(s.AttributedEnergy)
+	clone.MeterReadErrors = maps.Clone(s.MeterReadErrors)
+	clone.TerminatedContainersEnergy = maps.Clone(s.TerminatedContainersEnergy)
+	clone.AgedProcessesEnergy = maps.Clone(s.AgedProcessesEnergy)
+	clone.TerminatedPodsEnergy = maps.Clone(s.TerminatedPodsEnergy)
+
 	return clone
 }
+
+// ShallowClone returns a copy of the snapshot that shares the workload maps,
+// GPU stats and error counters with s; only the Node is deep copied. It is
+// meant for read-only consumers of snapshots published by the monitor, which
+// are never modified once published, and avoids the cost of copying every
+// process on large nodes. Callers must not modify the shared maps or the
+// workloads they contain.
+func (s *Snapshot) ShallowClone() *Snapshot {
+	clone := *s
+	clone.Node = s.Node.Clone()
+	return &clone
+}
@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package monitor
+
+import (
+	"maps"
+	"strconv"
+)
+
+// User represents the power consumption of all running processes of a user
+type User struct {
+	UID  int    // real user ID
+	Name string // user name; empty unless user names are resolved
+
+	// Zones holds the summed power of the user's running processes; energy
+	// is not tracked at the user level
+	Zones ZoneUsageMap
+
+	GPUPower float64 // summed GPU power of the user's running processes in watts
+}
+
+type Users = map[string]*User
+
+func (u *User) Clone() *User {
+	if u == nil {
+		return nil
+	}
+
+	ret := *u
+	ret.Zones = make(ZoneUsageMap, len(u.Zones))
+	maps.Copy(ret.Zones, u.Zones)
+	return &ret
+}
+
+// calculateUserPower aggregates the power of the running processes per user.
+// Processes whose owner could not be read are skipped.
+func (pm *PowerMonitor) calculateUserPower(newSnapshot *Snapshot) {
+	users := make(Users)
+
+	for _, proc := range newSnapshot.Processes {
+		if proc.UID < 0 {
+			continue
+		}
+
+		id := strconv.Itoa(proc.UID)
+		u, exists := users[id]
+		if !exists {
+			u = &User{
+				UID:   proc.UID,
+				Name:  pm.userName(proc.UID),
+				Zones: make(ZoneUsageMap, len(proc.Zones)),
+			}
+			users[id] = u
+		}
+
+		for zone, usage := range proc.Zones {
+			zu := u.Zones[zone]
+			zu.Power += usage.Power
+			u.Zones[zone] = zu
+		}
+		u.GPUPower += proc.GPUPower
+	}
+
+	newSnapshot.Users = users
+}
+
+// userName returns the name of the user with the given UID if user names are
+// resolved and the user is known; lookups are cached including failed ones
+func (pm *PowerMonitor) userName(uid int) string {
+	if !pm.resolveUsernames {
+		return ""
+	}
+
+	if name, cached := pm.userNames[uid]; cached {
+		return name
+	}
+
+	name := ""
+	if u, err := pm.lookupUser(strconv.Itoa(uid)); err == nil {
+		name = u.Username
+	} else {
+		pm.logger.Debug("Failed to resolve user name", "uid", uid, "error", err)
+	}
+
+	if pm.userNames == nil {
+		pm.userNames = make(map[int]string)
+	}
+	pm.userNames[uid] = name
+	return name
+}
@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package monitor
+
+import (
+	"log/slog"
+	"os/user"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/sustainable-computing-io/kepler/internal/device"
+	testingclock "k8s.io/utils/clock/testing"
+)
+
+func TestUserPower(t *testing.T) {
+	pkg := device.NewMockRaplZone("package-0", 0, "/sys/class/powercap/intel-rapl/intel-rapl:0", 1000*Joule)
+	zones := []EnergyZone{pkg}
+
+	mockMeter := &MockCPUPowerMeter{}
+	mockMeter.On("Zones").Return(zones, nil)
+	mockMeter.On("PrimaryEnergyZone").Return(pkg, nil)
+
+	// processes 123 (30%) and 456 (20%) belong to uid 1000, 789 (15%) to
+	// root and 1001 (20%) to uid 107; the owner of 1231 and 1002 is unknown
+	tr := CreateTestResources()
+	running := tr.Processes.Running
+	running[123].UID = 1000
+	running[456].UID = 1000
+	running[789].UID = 0
+	running[1001].UID = 107
+	running[1231].UID = -1
+	running[1002].UID = -1
+
+	resourceInformer := &MockResourceInformer{}
+	resourceInformer.SetExpectations(t, tr)
+	resourceInformer.On("Refresh").Return(nil)
+
+	fakeClock := testingclock.NewFakeClock(time.Now())
+	pm := NewPowerMonitor(
+		mockMeter,
+		WithLogger(slog.New(slog.DiscardHandler)),
+		WithClock(fakeClock),
+		WithResourceInformer(resourceInformer),
+		WithResolveUsernames(true),
+	)
+	lookups := 0
+	pm.lookupUser = func(uid string) (*user.User, error) {
+		lookups++
+		if uid == "1000" {
+			return &user.User{Uid: uid, Username: "alice"}, nil
+		}
+		return nil, user.UnknownUserIdError(107)
+	}
+	require.NoError(t, pm.Init())
+
+	pkg.Inc(100 * Joule)
+	require.NoError(t, pm.refreshSnapshot())
+	fakeClock.Step(time.Second)
+	pkg.Inc(50 * Joule)
+	require.NoError(t, pm.refreshSnapshot())
+
+	snapshot := pm.snapshot.Load()
+	require.Len(t, snapshot.Users, 3, "processes with an unknown owner must be skipped")
+
+	active := snapshot.Node.Zones[pkg].ActivePower.Watts()
+	require.NotZero(t, active)
+
+	for uid, expected := range map[string]struct {
+		name  string
+		share float64
+	}{
+		"1000": {"alice", 0.50},
+		"0":    {"", 0.15},
+		"107":  {"", 0.20},
+	} {
+		u := snapshot.Users[uid]
+		require.NotNil(t, u, "user %s", uid)
+		assert.Equal(t, expected.name, u.Name, "user %s", uid)
+		assert.InDelta(t, expected.share*active, u.Zones[pkg].Power.Watts(), 0.001, "user %s", uid)
+	}
+
+	assert.Equal(t, 3, lookups, "user names must be looked up once per user")
+}
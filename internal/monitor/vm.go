@@ -13,19 +13,20 @@ func (pm *PowerMonitor) firstVMRead(snapshot *Snapshot) error {
 	vms := make(VirtualMachines, len(running))
 
 	zones := snapshot.Node.Zones
-	nodeCPUTimeDelta := pm.resources.Node().ProcessTotalCPUTimeDelta
+	nodeCPUTimeDelta := pm.workloadCPUTimeDelta()
 
 	for id, vm := range running {
 		vmInstance := newVM(vm, zones)
 
 		// Calculate initial energy based on CPU ratio * nodeActiveEnergy
 		for zone, nodeZoneUsage := range zones {
-			if nodeZoneUsage.ActivePower == 0 || nodeZoneUsage.activeEnergy == 0 || nodeCPUTimeDelta == 0 {
+			energy, power := pm.attributable(nodeZoneUsage)
+			if power == 0 || energy == 0 || nodeCPUTimeDelta == 0 {
 				continue
 			}
 
 			cpuTimeRatio := vm.CPUTimeDelta / nodeCPUTimeDelta
-			activeEnergy := Energy(cpuTimeRatio * float64(nodeZoneUsage.activeEnergy))
+			activeEnergy := Energy(cpuTimeRatio * float64(energy))
 
 			vmInstance.Zones[zone] = Usage{
 				Power:       Power(0), // No power in first read - no delta time to calculate rate
@@ -65,7 +66,7 @@ func (pm *PowerMonitor) calculateVMPower(prev, newSnapshot *Snapshot) error {
 		pm.terminatedVMsTracker.Add(prevVM.Clone())
 	}
 
-	nodeCPUTimeDelta := pm.resources.Node().ProcessTotalCPUTimeDelta
+	nodeCPUTimeDelta := pm.workloadCPUTimeDelta()
 	pm.logger.Debug("Calculating VM power",
 		"node.cpu.time", nodeCPUTimeDelta,
 		"running", len(vms.Running),
@@ -80,8 +81,9 @@ func (pm *PowerMonitor) calculateVMPower(prev, newSnapshot *Snapshot) error {
 
 		// For each zone in the node, calculate VM's share
 		for zone, nodeZoneUsage := range newSnapshot.Node.Zones {
+			energy, power := pm.attributable(nodeZoneUsage)
 			// Skip zones with zero power to avoid division by zero
-			if nodeZoneUsage.ActivePower == 0 || nodeZoneUsage.activeEnergy == 0 || nodeCPUTimeDelta == 0 {
+			if power == 0 || energy == 0 || nodeCPUTimeDelta == 0 {
 				continue
 			}
 
@@ -89,7 +91,7 @@ func (pm *PowerMonitor) calculateVMPower(prev, newSnapshot *Snapshot) error {
 			cpuTimeRatio := vm.CPUTimeDelta / nodeCPUTimeDelta
 
 			// Calculate energy delta for this interval
-			activeEnergy := Energy(cpuTimeRatio * float64(nodeZoneUsage.activeEnergy))
+			activeEnergy := Energy(cpuTimeRatio * float64(energy))
 
 			// Calculate absolute energy based on previous data
 			absoluteEnergy := activeEnergy
@@ -100,7 +102,7 @@ func (pm *PowerMonitor) calculateVMPower(prev, newSnapshot *Snapshot) error {
 			}
 
 			newVMInstance.Zones[zone] = Usage{
-				Power:       Power(cpuTimeRatio * nodeZoneUsage.ActivePower.MicroWatts()),
+				Power:       Power(cpuTimeRatio * power.MicroWatts()),
 				EnergyTotal: absoluteEnergy,
 			}
 		}
@@ -30,8 +30,28 @@ type PowerReader struct {
 	once sync.Once
 }
 
+// PowerReaderOption is a functional option for configuring the PowerReader
+type PowerReaderOption func(*powerReaderOpts)
+
+type powerReaderOpts struct {
+	maxConcurrentRequests int
+}
+
+// WithMaxConcurrentRequests caps the number of in-flight HTTP requests to the
+// BMC; values <= 0 leave the number of requests unbounded
+func WithMaxConcurrentRequests(n int) PowerReaderOption {
+	return func(o *powerReaderOpts) {
+		o.maxConcurrentRequests = n
+	}
+}
+
 // NewPowerReader creates a new PowerReader with the given client
-func NewPowerReader(bmc *redfishcfg.BMCDetail, httpTimeout time.Duration, logger *slog.Logger) *PowerReader {
+func NewPowerReader(bmc *redfishcfg.BMCDetail, httpTimeout time.Duration, logger *slog.Logger, opts ...PowerReaderOption) *PowerReader {
+	o := powerReaderOpts{}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
 	// Configure HTTP client with timeout and TLS configuration
 	httpClient := &http.Client{
 		Timeout: httpTimeout,
@@ -43,6 +63,10 @@ func NewPowerReader(bmc *redfishcfg.BMCDetail, httpTimeout time.Duration, logger
 		}
 	}
 
+	if o.maxConcurrentRequests > 0 {
+		httpClient.Transport = newLimitedTransport(httpClient.Transport, o.maxConcurrentRequests)
+	}
+
 	// Create gofish client configuration
 	cfg := gofish.ClientConfig{
 		Endpoint:   bmc.Endpoint,
@@ -30,6 +30,7 @@ type Service struct {
 	// Simplified caching for staleness support
 	mu            sync.RWMutex  // Protects cached readings
 	cachedReading *PowerReading // Last reading from all chassis
+	pollFailed    bool          // the most recent poll of the BMC failed
 
 	unavailable bool // unavailable indicates the service failed to initialize
 }
@@ -52,9 +53,7 @@ func WithStaleness(staleness time.Duration) OptionFn {
 
 // NewService creates a new Redfish service
 func NewService(cfg config.Redfish, logger *slog.Logger, opts ...OptionFn) (*Service, error) {
-	// Log experimental feature warning
 	logger = logger.With(slog.String("service", "experimental.redfish"))
-	logger.Warn("Using EXPERIMENTAL Redfish power monitoring feature", "feature", "redfish")
 
 	// NodeName is already resolved in config processing
 	nodeName := cfg.NodeName
@@ -84,7 +83,8 @@ func NewService(cfg config.Redfish, logger *slog.Logger, opts ...OptionFn) (*Ser
 	logger.Info("BMC configuration loaded", "node_name", nodeName, "bmc_id", bmcID, "endpoint", bmcDetail.Endpoint)
 
 	// Create power reader with BMC configuration
-	reader := NewPowerReader(bmcDetail, cfg.HTTPTimeout, logger)
+	reader := NewPowerReader(bmcDetail, cfg.HTTPTimeout, logger,
+		WithMaxConcurrentRequests(cfg.MaxConcurrentRequests))
 
 	service := &Service{
 		logger:      logger,
@@ -186,6 +186,20 @@ func (s *Service) IsAvailable() bool {
 	return !s.unavailable
 }
 
+// LastReading returns the time of the last successful poll of the BMC, zero
+// if no poll succeeded yet, and whether the most recent poll failed, in which
+// case the last reading is stale
+func (s *Service) LastReading() (time.Time, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var polled time.Time
+	if s.cachedReading != nil {
+		polled = s.cachedReading.Timestamp
+	}
+	return polled, s.pollFailed
+}
+
 // isFresh checks if the cached reading is still within the staleness threshold
 func (s *Service) isFresh() bool {
 	s.mu.RLock()
@@ -226,6 +240,9 @@ func (s *Service) Power() (*PowerReading, error) {
 	// Need fresh data - collect from BMC
 	readings, err := s.powerReader.ReadAll()
 	if err != nil {
+		s.mu.Lock()
+		s.pollFailed = true
+		s.mu.Unlock()
 		return nil, fmt.Errorf("failed to collect power readings: %w", err)
 	}
 
@@ -238,6 +255,7 @@ func (s *Service) Power() (*PowerReading, error) {
 	// Update the cache with the new reading
 	s.mu.Lock()
 	s.cachedReading = newReading.Clone() // Clone for safe storage
+	s.pollFailed = false
 	s.mu.Unlock()
 
 	s.logger.Debug("Collected and cached fresh chassis power readings",
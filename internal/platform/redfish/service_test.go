@@ -524,6 +524,53 @@ func TestServiceStalenessCache(t *testing.T) {
 	assert.Equal(t, 300.0*device.Watt, readings4.Chassis[0].Readings[0].Power) // Cached new value
 }
 
+func TestServiceLastReading(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	config := testutil.ServerConfig{
+		Username:   "admin",
+		Password:   "secret",
+		PowerWatts: 200.0,
+	}
+	server := testutil.NewServer(config)
+	defer server.Close()
+
+	service := createTestService(t, server, logger)
+	service.staleness = 0 // poll the BMC on every call
+
+	require.NoError(t, service.Init())
+	defer func() {
+		require.NoError(t, service.Shutdown())
+	}()
+
+	polled, failed := service.LastReading()
+	assert.True(t, polled.IsZero(), "no poll yet")
+	assert.False(t, failed)
+
+	before := time.Now()
+	reading, err := service.Power()
+	require.NoError(t, err)
+	polled, failed = service.LastReading()
+	assert.Equal(t, reading.Timestamp, polled)
+	assert.False(t, polled.Before(before))
+	assert.False(t, failed)
+
+	// a failed poll keeps the last reading time and marks it stale
+	server.SetError(testutil.ErrorInternalServer)
+	_, err = service.Power()
+	require.Error(t, err)
+	stalePolled, failed := service.LastReading()
+	assert.Equal(t, polled, stalePolled)
+	assert.True(t, failed)
+
+	server.SetError(testutil.ErrorNone)
+	_, err = service.Power()
+	require.NoError(t, err)
+	newPolled, failed := service.LastReading()
+	assert.False(t, newPolled.Before(polled))
+	assert.False(t, failed)
+}
+
 func TestServiceShutdownIdempotent(t *testing.T) {
 	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
 	scenario := testutil.TestScenario{
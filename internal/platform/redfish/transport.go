@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package redfish
+
+import (
+	"net/http"
+)
+
+// limitedTransport is an http.RoundTripper that caps the number of in-flight
+// requests to protect fragile BMCs from being overwhelmed
+type limitedTransport struct {
+	base http.RoundTripper
+	sem  chan struct{}
+}
+
+// newLimitedTransport wraps base so that at most maxInFlight requests are
+// in flight at any time. A nil base uses http.DefaultTransport.
+func newLimitedTransport(base http.RoundTripper, maxInFlight int) *limitedTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &limitedTransport{
+		base: base,
+		sem:  make(chan struct{}, maxInFlight),
+	}
+}
+
+// RoundTrip waits for a free slot (or for the request context to be done)
+// before delegating to the base transport
+func (t *limitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	select {
+	case t.sem <- struct{}{}:
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
+	defer func() { <-t.sem }()
+
+	return t.base.RoundTrip(req)
+}
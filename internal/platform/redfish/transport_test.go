@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package redfish
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingTransport is a fake http.RoundTripper that records the maximum
+// number of requests in flight at the same time
+type countingTransport struct {
+	delay    time.Duration
+	inFlight atomic.Int32
+	maxSeen  atomic.Int32
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	n := t.inFlight.Add(1)
+	defer t.inFlight.Add(-1)
+
+	for {
+		seen := t.maxSeen.Load()
+		if n <= seen || t.maxSeen.CompareAndSwap(seen, n) {
+			break
+		}
+	}
+
+	time.Sleep(t.delay)
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader("{}")),
+		Request:    req,
+	}, nil
+}
+
+func TestLimitedTransport(t *testing.T) {
+	t.Run("in-flight requests never exceed the limit", func(t *testing.T) {
+		const limit = 3
+		fake := &countingTransport{delay: 10 * time.Millisecond}
+		client := &http.Client{Transport: newLimitedTransport(fake, limit)}
+
+		var wg sync.WaitGroup
+		for range 20 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				resp, err := client.Get("http://bmc.example.com/redfish/v1")
+				if assert.NoError(t, err) {
+					_ = resp.Body.Close()
+				}
+			}()
+		}
+		wg.Wait()
+
+		assert.LessOrEqual(t, fake.maxSeen.Load(), int32(limit))
+		assert.Positive(t, fake.maxSeen.Load())
+		assert.Zero(t, fake.inFlight.Load())
+	})
+
+	t.Run("waiting request honours context cancellation", func(t *testing.T) {
+		fake := &countingTransport{delay: 200 * time.Millisecond}
+		transport := newLimitedTransport(fake, 1)
+
+		// occupy the only slot
+		busy, err := http.NewRequest(http.MethodGet, "http://bmc.example.com/redfish/v1", nil)
+		require.NoError(t, err)
+		go func() {
+			resp, err := transport.RoundTrip(busy)
+			if err == nil {
+				_ = resp.Body.Close()
+			}
+		}()
+		time.Sleep(20 * time.Millisecond)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://bmc.example.com/redfish/v1", nil)
+		require.NoError(t, err)
+
+		_, err = transport.RoundTrip(req)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
@@ -39,7 +39,7 @@ var containerPatterns = map[*regexp.Regexp]ContainerRuntime{
 }
 
 // containerInfoFromProc detects if a process is running in a container and extracts container info
-func containerInfoFromProc(proc procInfo) (*Container, error) {
+func containerInfoFromProc(proc ProcInfo) (*Container, error) {
 	cgroups, err := proc.Cgroups()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get process cgroups: %w", err)
@@ -62,6 +62,7 @@ func containerInfoFromProc(proc procInfo) (*Container, error) {
 
 	c := &Container{
 		ID:      ctnrID,
+		RawID:   rawContainerID(paths, ctnrID),
 		Runtime: runtime,
 	}
 
@@ -140,6 +141,45 @@ func containerInfoFromCgroupPaths(paths []string) (ContainerRuntime, string) {
 	return UnknownRuntime, "" // No match found
 }
 
+// normalizeContainerID returns the canonical form of a container ID found in
+// a cgroup path
+func normalizeContainerID(id string, format ContainerIDFormat) string {
+	id = strings.ToLower(id)
+	if format == ContainerIDShort && len(id) > shortContainerIDLen {
+		return id[:shortContainerIDLen]
+	}
+	return id
+}
+
+// applyIDFormat sets the ID of the container to the canonical form of its
+// full ID; the full ID is kept to look up the pod of the container
+func (c *Container) applyIDFormat(format ContainerIDFormat) {
+	if c == nil {
+		return
+	}
+	full := c.podLookupID()
+	c.ID = normalizeContainerID(full, format)
+	c.fullID = ""
+	if c.ID != full {
+		c.fullID = full
+	}
+}
+
+// rawContainerID returns the element of the cgroup paths the container ID was
+// found in, including any runtime prefix and suffix (e.g. docker-<id>.scope)
+func rawContainerID(paths []string, id string) string {
+	for _, path := range paths {
+		elems := strings.Split(path, "/")
+		// the deepest element is the one the ID was extracted from
+		for i := len(elems) - 1; i >= 0; i-- {
+			if strings.Contains(elems[i], id) {
+				return elems[i]
+			}
+		}
+	}
+	return id
+}
+
 // containerNameFromEnv extracts container metadata from environment variables
 func containerNameFromEnv(env []string) string {
 	for _, e := range env {
@@ -81,6 +81,67 @@ func TestContainerInfoFromCgroups(t *testing.T) {
 	}
 }
 
+func TestContainerIDNormalization(t *testing.T) {
+	const id = "99f3a16ea25b7724cb56a4f0c0df1113ad9474fbf5545bead97fd5c7f61c13f4"
+
+	tt := []struct {
+		name  string
+		path  string
+		rawID string
+	}{{
+		name:  "docker scope",
+		path:  "0::/system.slice/docker-" + id + ".scope",
+		rawID: "docker-" + id + ".scope",
+	}, {
+		name:  "cri-containerd scope",
+		path:  "0::/kubepods.slice/kubepods-besteffort.slice/kubepods-besteffort-podeab5a334_93fe_48a8_b139_9e8079c1f163.slice/cri-containerd-" + id + ".scope",
+		rawID: "cri-containerd-" + id + ".scope",
+	}, {
+		name:  "cri-containerd systemd",
+		path:  "/system.slice/containerd.service/kubepods-burstable-poda3b200c9_db51_40b4_9d2d_53f8fdf80d7f.slice:cri-containerd:" + id,
+		rawID: "kubepods-burstable-poda3b200c9_db51_40b4_9d2d_53f8fdf80d7f.slice:cri-containerd:" + id,
+	}, {
+		name:  "crio scope",
+		path:  "0::/kubepods.slice/kubepods-burstable.slice/kubepods-burstable-pod2c9f8a79_5391_454b_88cb_86190881cb96.slice/crio-" + id + ".scope",
+		rawID: "crio-" + id + ".scope",
+	}, {
+		name:  "libpod scope",
+		path:  "0::/user.slice/user-1000.slice/user@1000.service/user.slice/libpod-" + id + ".scope",
+		rawID: "libpod-" + id + ".scope",
+	}, {
+		name:  "raw 64 hex",
+		path:  "kubelet/kubepods/besteffort/podbdd4097d-6795-404e-9bd8-6a1383386198/" + id,
+		rawID: id,
+	}}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			mockProc := &MockProcInfo{}
+			mockProc.On("Cgroups").Return([]CGroup{{Path: tc.path}}, nil)
+			mockProc.On("Environ").Return([]string{}, nil)
+			mockProc.On("CmdLine").Return([]string{}, nil)
+
+			container, err := containerInfoFromProc(mockProc)
+			require.NoError(t, err)
+			require.NotNil(t, container)
+			assert.Equal(t, id, container.ID)
+			assert.Equal(t, tc.rawID, container.RawID)
+
+			container.applyIDFormat(ContainerIDShort)
+			assert.Equal(t, "99f3a16ea25b", container.ID)
+			assert.Equal(t, tc.rawID, container.RawID, "raw ID is kept")
+			assert.Equal(t, id, container.podLookupID())
+
+			// formats are applied to the full ID
+			container.applyIDFormat(ContainerIDShort)
+			assert.Equal(t, "99f3a16ea25b", container.ID)
+			container.applyIDFormat(ContainerIDFull)
+			assert.Equal(t, id, container.ID)
+			assert.Equal(t, id, container.podLookupID())
+		})
+	}
+}
+
 func TestContainerIDFromPathWithCgroup(t *testing.T) {
 	type expect struct {
 		id      string
@@ -330,7 +391,7 @@ func TestContainerInfoFromProc(t *testing.T) {
 	for _, tc := range tt {
 		t.Run(tc.name, func(t *testing.T) {
 			mockProc := &MockProcInfo{}
-			cgroups := []cGroup{{Path: tc.cgroupsPath}}
+			cgroups := []CGroup{{Path: tc.cgroupsPath}}
 			mockProc.On("Cgroups").Return(cgroups, nil)
 			mockProc.On("Environ").Return(tc.environ, tc.environError)
 			mockProc.On("CmdLine").Return(tc.cmdline, tc.cmdlineError)
@@ -19,6 +19,10 @@ import (
 type Node struct {
 	ProcessTotalCPUTimeDelta float64 // sum of all process CPU time deltas
 	CPUUsageRatio            float64
+
+	// NegativeCPUTimeDeltas is the cumulative number of process CPU time
+	// decreases that were clamped to a zero CPU time delta
+	NegativeCPUTimeDeltas uint64
 }
 
 // Processes represents sets of running and terminated processes
@@ -69,6 +73,7 @@ type Informer interface {
 type resourceInformer struct {
 	logger *slog.Logger
 	fs     allProcReader
+	procs  ProcessProvider
 	clock  clock.Clock
 
 	node *Node
@@ -91,6 +96,20 @@ type resourceInformer struct {
 	pods        *Pods
 
 	lastScanTime time.Time // Time of the last full scan
+
+	// process discovery
+	procScanInterval time.Duration // minimum time between /proc enumerations
+	lastProcScan     time.Time     // time of the last /proc enumeration
+	scannedProcs     []ProcInfo    // processes found by the last /proc enumeration
+
+	// nodeOnly skips workload tracking and only refreshes node CPU usage
+	nodeOnly bool
+
+	// containerIDFormat is the canonical form of container IDs
+	containerIDFormat ContainerIDFormat
+
+	// negativeCPUTimeDeltas counts the process CPU time decreases clamped to 0
+	negativeCPUTimeDeltas uint64
 }
 
 var _ Informer = (*resourceInformer)(nil)
@@ -114,11 +133,21 @@ func NewInformer(opts ...OptionFn) (*resourceInformer, error) {
 		return nil, errors.New("no procfs reader specified")
 	}
 
+	if opt.procs == nil {
+		opt.procs = opt.procReader
+	}
+
 	return &resourceInformer{
 		logger: opt.logger.With("service", "resource-informer"),
 		fs:     opt.procReader,
+		procs:  opt.procs,
 		clock:  opt.clock,
 
+		procScanInterval: opt.processScanInterval,
+		nodeOnly:         opt.nodeOnly,
+
+		containerIDFormat: opt.containerIDFormat,
+
 		node: &Node{},
 
 		procCache: make(map[int]*Process),
@@ -153,8 +182,8 @@ func (ri *resourceInformer) Name() string {
 }
 
 func (ri *resourceInformer) Init() error {
-	// ensure we can access procfs
-	_, err := ri.fs.AllProcs()
+	// ensure we can access procfs or the process provider
+	_, err := ri.procs.AllProcs()
 	if err != nil {
 		return fmt.Errorf("failed to access procfs: %w", err)
 	}
@@ -165,7 +194,7 @@ func (ri *resourceInformer) Init() error {
 
 // refreshProcesses refreshes the process cache and returns the procs for containers and VMs
 func (ri *resourceInformer) refreshProcesses() ([]*Process, []*Process, error) {
-	procs, err := ri.fs.AllProcs()
+	procs, err := ri.listProcs()
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to get processes: %w", err)
 	}
@@ -220,6 +249,26 @@ func (ri *resourceInformer) refreshProcesses() ([]*Process, []*Process, error) {
 	return containerProcs, vmProcs, refreshErrs
 }
 
+// listProcs returns the processes to be refreshed. /proc is enumerated at most
+// once every process scan interval; in between, the processes discovered by the
+// last scan are reused. Processes that exited since are dropped when reading
+// their stats fails, and new processes are picked up by the next scan.
+func (ri *resourceInformer) listProcs() ([]ProcInfo, error) {
+	now := ri.clock.Now()
+	if ri.procScanInterval > 0 && !ri.lastProcScan.IsZero() && now.Sub(ri.lastProcScan) < ri.procScanInterval {
+		return ri.scannedProcs, nil
+	}
+
+	procs, err := ri.procs.AllProcs()
+	if err != nil {
+		return nil, err
+	}
+
+	ri.scannedProcs = procs
+	ri.lastProcScan = now
+	return procs, nil
+}
+
 func (ri *resourceInformer) refreshContainers(containerProcs []*Process) error {
 	containersRunning := make(map[string]*Container)
 
@@ -282,7 +331,7 @@ func (ri *resourceInformer) refreshPods() error {
 	var refreshErrs error
 
 	for _, container := range ri.containers.Running {
-		cntrInfo, found, err := ri.podInformer.LookupByContainerID(container.ID)
+		cntrInfo, found, err := ri.podInformer.LookupByContainerID(container.podLookupID())
 		if err != nil {
 			ri.logger.Debug("Failed to get pod for container", "container", container.ID, "error", err)
 			refreshErrs = errors.Join(refreshErrs, fmt.Errorf("failed to get pod for container: %w", err))
@@ -340,6 +389,7 @@ func (ri *resourceInformer) refreshNode() error {
 
 	ri.node.ProcessTotalCPUTimeDelta = procCPUDeltaTotal
 	ri.node.CPUUsageRatio = usage
+	ri.node.NegativeCPUTimeDeltas = ri.negativeCPUTimeDeltas
 
 	return nil
 }
@@ -349,6 +399,13 @@ func (ri *resourceInformer) refreshNode() error {
 func (ri *resourceInformer) Refresh() error {
 	started := ri.clock.Now()
 
+	if ri.nodeOnly {
+		err := ri.refreshNode()
+		ri.lastScanTime = ri.clock.Now()
+		ri.logger.Debug("Node information collected", "duration", ri.lastScanTime.Sub(started))
+		return err
+	}
+
 	// Refresh workloads in dependency order:
 	// processes -> {
 	//   -> containers -> pod
@@ -449,11 +506,13 @@ func (ri *resourceInformer) updateVMCache(proc *Process) *VirtualMachine {
 }
 
 // updateProcessCache updates the process cache with the latest information and returns the updated process
-func (ri *resourceInformer) updateProcessCache(proc procInfo) (*Process, error) {
+func (ri *resourceInformer) updateProcessCache(proc ProcInfo) (*Process, error) {
 	pid := proc.PID()
 
 	if cached, exists := ri.procCache[pid]; exists {
 		err := populateProcessFields(cached, proc)
+		ri.clampCPUTimeDelta(cached)
+		cached.Container.applyIDFormat(ri.containerIDFormat)
 		return cached, err
 	}
 
@@ -461,11 +520,26 @@ func (ri *resourceInformer) updateProcessCache(proc procInfo) (*Process, error)
 	if err != nil {
 		return nil, err
 	}
+	newProc.Container.applyIDFormat(ri.containerIDFormat)
 
 	ri.procCache[pid] = newProc
 	return newProc, nil
 }
 
+// clampCPUTimeDelta clamps the CPU time delta of a process whose cumulative CPU
+// time decreased, e.g. due to an undetected PID reuse, to 0 so that it is not
+// attributed negative power
+func (ri *resourceInformer) clampCPUTimeDelta(p *Process) {
+	if p.CPUTimeDelta >= 0 {
+		return
+	}
+
+	ri.logger.Debug("Process CPU time decreased, clamping delta to 0",
+		"pid", p.PID, "comm", p.Comm, "delta", p.CPUTimeDelta)
+	ri.negativeCPUTimeDeltas++
+	p.CPUTimeDelta = 0
+}
+
 func (ri *resourceInformer) updateContainerCache(proc *Process, resetCPUTime bool) *Container {
 	c := proc.Container
 	if c == nil {
@@ -480,10 +554,12 @@ func (ri *resourceInformer) updateContainerCache(proc *Process, resetCPUTime boo
 
 	if resetCPUTime {
 		cached.CPUTimeDelta = 0
+		cached.MemoryBytes = 0
 	}
 
 	cached.CPUTimeDelta += proc.CPUTimeDelta
 	cached.CPUTotalTime += proc.CPUTimeDelta
+	cached.MemoryBytes += proc.MemoryBytes
 
 	return cached
 }
@@ -509,14 +585,20 @@ func (ri *resourceInformer) updatePodCache(container *Container, resetCPUTime bo
 	return cached
 }
 
-func populateProcessFields(p *Process, proc procInfo) error {
-	cpuTotalTime, err := proc.CPUTime()
+func populateProcessFields(p *Process, proc ProcInfo) error {
+	stats, err := processStats(proc)
 	if err != nil {
 		return err
 	}
 
-	p.CPUTimeDelta = cpuTotalTime - p.CPUTotalTime
-	p.CPUTotalTime = cpuTotalTime
+	p.CPUTimeDelta = stats.CPUTime - p.CPUTotalTime
+	p.CPUTotalTime = stats.CPUTime
+
+	// memory, threads and state change independently of CPU usage, so they
+	// are refreshed even for idle processes
+	p.MemoryBytes = stats.MemoryBytes
+	p.Threads = stats.Threads
+	p.State = stats.State
 
 	// ignore already processed processes with close to 0 CPU time usage
 	if newProc := p.Comm == ""; !newProc && p.CPUTimeDelta <= 1e-12 {
@@ -546,18 +628,91 @@ func populateProcessFields(p *Process, proc procInfo) error {
 		p.Type = info.Type
 		p.Container = info.Container
 		p.VirtualMachine = info.VM
+		p.NamespacedPIDs = namespacedPIDs(proc, p.Type)
+		p.UID = processUID(proc)
+		p.StartTime = stats.StartTime
+		if p.StartTime.IsZero() {
+			p.StartTime = processStartTime(proc)
+		}
 	}
 
 	return nil
 }
 
+// processStats returns the CPU time of a process along with its memory, thread
+// count, state and start time where the process reports them from a single
+// read; otherwise only the CPU time is read
+func processStats(proc ProcInfo) (procStats, error) {
+	if reader, ok := proc.(statsReader); ok {
+		return reader.Stats()
+	}
+
+	cpuTime, err := proc.CPUTime()
+	if err != nil {
+		return procStats{}, err
+	}
+	return procStats{CPUTime: cpuTime}, nil
+}
+
+// processUID returns the real user ID owning a process or -1 if it can not be
+// read. Errors are ignored since the UID is only used to aggregate per user.
+func processUID(proc ProcInfo) int {
+	reader, ok := proc.(uidReader)
+	if !ok {
+		return -1
+	}
+
+	uid, err := reader.UID()
+	if err != nil {
+		return -1
+	}
+	return uid
+}
+
+// processStartTime returns when a process started or the zero time if it can
+// not be read. Errors are ignored since the start time is only used to filter
+// processes by age.
+func processStartTime(proc ProcInfo) time.Time {
+	reader, ok := proc.(startTimeReader)
+	if !ok {
+		return time.Time{}
+	}
+
+	started, err := reader.StartTime()
+	if err != nil {
+		return time.Time{}
+	}
+	return started
+}
+
+// namespacedPIDs returns the PIDs of a container process across PID namespaces
+// so that PIDs reported by devices from a different namespace (e.g. GPU drivers
+// reporting host PIDs) can be mapped back to the process. Errors are ignored
+// since the translation is best effort.
+func namespacedPIDs(proc ProcInfo, typ ProcessType) []int {
+	if typ != ContainerProcess {
+		return nil
+	}
+
+	reader, ok := proc.(nsPIDReader)
+	if !ok {
+		return nil
+	}
+
+	pids, err := reader.NamespacedPIDs()
+	if err != nil || len(pids) < 2 {
+		return nil
+	}
+	return pids
+}
+
 type ProcessTypeInfo struct {
 	Type      ProcessType
 	Container *Container
 	VM        *VirtualMachine
 }
 
-func computeTypeInfoFromProc(proc procInfo) (*ProcessTypeInfo, error) {
+func computeTypeInfoFromProc(proc ProcInfo) (*ProcessTypeInfo, error) {
 	// detect process type in parallel
 	type result struct {
 		container *Container
@@ -601,7 +756,7 @@ func computeTypeInfoFromProc(proc procInfo) (*ProcessTypeInfo, error) {
 }
 
 // newProcess creates a new Process with static information filled in
-func newProcess(proc procInfo) (*Process, error) {
+func newProcess(proc ProcInfo) (*Process, error) {
 	p := &Process{
 		PID: proc.PID(),
 	}
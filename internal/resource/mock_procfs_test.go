@@ -7,12 +7,13 @@ import (
 	"context"
 	"math/rand"
 	"strings"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/sustainable-computing-io/kepler/internal/k8s/pod"
 )
 
-// MockProcInfo is a mock implementation of procInfo for testing
+// MockProcInfo is a mock implementation of ProcInfo for testing
 type MockProcInfo struct {
 	mock.Mock
 }
@@ -32,9 +33,9 @@ func (m *MockProcInfo) Executable() (string, error) {
 	return args.String(0), args.Error(1)
 }
 
-func (m *MockProcInfo) Cgroups() ([]cGroup, error) {
+func (m *MockProcInfo) Cgroups() ([]CGroup, error) {
 	args := m.Called()
-	return args.Get(0).([]cGroup), args.Error(1)
+	return args.Get(0).([]CGroup), args.Error(1)
 }
 
 func (m *MockProcInfo) Environ() ([]string, error) {
@@ -52,14 +53,54 @@ func (m *MockProcInfo) CPUTime() (float64, error) {
 	return args.Get(0).(float64), args.Error(1)
 }
 
+// MockNSProcInfo is a MockProcInfo that also reports namespaced PIDs
+type MockNSProcInfo struct {
+	MockProcInfo
+}
+
+func (m *MockNSProcInfo) NamespacedPIDs() ([]int, error) {
+	args := m.Called()
+	return args.Get(0).([]int), args.Error(1)
+}
+
+// MockStatsProcInfo is a MockProcInfo that also reports process stats
+type MockStatsProcInfo struct {
+	MockProcInfo
+}
+
+func (m *MockStatsProcInfo) Stats() (procStats, error) {
+	args := m.Called()
+	return args.Get(0).(procStats), args.Error(1)
+}
+
+// MockUIDProcInfo is a MockProcInfo that also reports the owning user
+type MockUIDProcInfo struct {
+	MockProcInfo
+}
+
+func (m *MockUIDProcInfo) UID() (int, error) {
+	args := m.Called()
+	return args.Int(0), args.Error(1)
+}
+
+// MockStartTimeProcInfo is a MockProcInfo that also reports its start time
+type MockStartTimeProcInfo struct {
+	MockProcInfo
+}
+
+func (m *MockStartTimeProcInfo) StartTime() (time.Time, error) {
+	args := m.Called()
+	return args.Get(0).(time.Time), args.Error(1)
+}
+
 // MockProcReader is a mock implementation of procInformer for testing
 type MockProcReader struct {
 	mock.Mock
 }
 
-func (m *MockProcReader) AllProcs() ([]procInfo, error) {
+func (m *MockProcReader) AllProcs() ([]ProcInfo, error) {
 	args := m.Called()
-	return args.Get(0).([]procInfo), args.Error(1)
+	return args.Get(0).([]ProcInfo), args.Error(1)
 }
 
 func (m *MockProcReader) CPUUsageRatio() (float64, error) {
@@ -6,6 +6,7 @@ package resource
 import (
 	"log/slog"
 	"os"
+	"time"
 
 	"github.com/sustainable-computing-io/kepler/internal/k8s/pod"
 	"k8s.io/utils/clock"
@@ -17,7 +18,12 @@ type Options struct {
 	clock       clock.Clock
 	procFSPath  string
 	procReader  allProcReader
+	procs       ProcessProvider
 	podInformer pod.Informer
+
+	processScanInterval time.Duration
+	nodeOnly            bool
+	containerIDFormat   ContainerIDFormat
 }
 
 // OptionFn is a function that configures the Options
@@ -37,6 +43,14 @@ func WithProcReader(r allProcReader) OptionFn {
 	}
 }
 
+// WithProcessProvider sets the source of the running processes; by default
+// processes are listed from procfs. Node CPU usage is still read from procfs.
+func WithProcessProvider(p ProcessProvider) OptionFn {
+	return func(o *Options) {
+		o.procs = p
+	}
+}
+
 // WithPodInformer sets the pod informer
 func WithPodInformer(pi pod.Informer) OptionFn {
 	return func(o *Options) {
@@ -58,11 +72,36 @@ func WithClock(c clock.Clock) OptionFn {
 	}
 }
 
+// WithProcessScanInterval sets how often /proc is enumerated to discover processes;
+// 0 enumerates /proc on every refresh
+func WithProcessScanInterval(d time.Duration) OptionFn {
+	return func(o *Options) {
+		o.processScanInterval = d
+	}
+}
+
+// WithNodeOnly restricts Refresh to node CPU usage; processes, containers,
+// VMs and pods are not tracked
+func WithNodeOnly(nodeOnly bool) OptionFn {
+	return func(o *Options) {
+		o.nodeOnly = nodeOnly
+	}
+}
+
+// WithContainerIDFormat sets the canonical form of container IDs, which is
+// used as container identity and metric label
+func WithContainerIDFormat(format ContainerIDFormat) OptionFn {
+	return func(o *Options) {
+		o.containerIDFormat = format
+	}
+}
+
 // defaultOptions returns the default options
 func defaultOptions() *Options {
 	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
 	return &Options{
-		logger: logger,
-		clock:  &clock.RealClock{},
+		logger:            logger,
+		clock:             &clock.RealClock{},
+		containerIDFormat: ContainerIDFull,
 	}
 }
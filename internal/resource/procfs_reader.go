@@ -5,33 +5,85 @@ package resource
 
 import (
 	"fmt"
+	"math"
+	"time"
 
 	"github.com/prometheus/procfs"
 )
 
-// cGroup holds only required cgroup info about the process
-type cGroup struct {
+// CGroup holds only required cgroup info about the process
+type CGroup struct {
 	Path string // used to detect if a process is running in a container
 }
 
-// procInfo is an interface that wraps the necessary methods from procfs.Proc to be used by the resource service
-type procInfo interface {
+// ProcInfo is an interface that wraps the necessary methods from procfs.Proc to be used by the resource service.
+// Implementations may also implement NamespacedPIDs() ([]int, error),
+// UID() (int, error) and StartTime() (time.Time, error) to report the
+// namespaced PIDs, the owner and the start time of a process, and
+// Stats() (procStats, error) to report its CPU time together with its memory,
+// threads, state and start time from a single read, which is then used instead
+// of CPUTime.
+type ProcInfo interface {
 	PID() int
 	Comm() (string, error)
 	Executable() (string, error)
-	Cgroups() ([]cGroup, error)
+	Cgroups() ([]CGroup, error)
 	Environ() ([]string, error)
 	CmdLine() ([]string, error)
 	CPUTime() (float64, error)
 }
 
+// nsPIDReader is implemented by ProcInfo implementations that can report the
+// PIDs of a process across nested PID namespaces
+type nsPIDReader interface {
+	NamespacedPIDs() ([]int, error)
+}
+
+// uidReader is implemented by ProcInfo implementations that can report the
+// user owning a process
+type uidReader interface {
+	UID() (int, error)
+}
+
+// startTimeReader is implemented by ProcInfo implementations that can report
+// when a process started
+type startTimeReader interface {
+	StartTime() (time.Time, error)
+}
+
+// procStats holds point-in-time statistics of a process
+type procStats struct {
+	CPUTime     float64   // user and system time in seconds
+	MemoryBytes uint64    // resident set size in bytes
+	Threads     int       // number of threads
+	State       string    // kernel process state (R, S, D, Z, ...)
+	StartTime   time.Time // when the process started; zero if unknown
+}
+
+// statsReader is implemented by ProcInfo implementations that can report
+// point-in-time statistics of a process
+type statsReader interface {
+	Stats() (procStats, error)
+}
+
 // procWrapper implements ProcInfo by wrapping procfs.Proc. This is needed because the procfs.Proc
 // does not implement PID() as a method
 type procWrapper struct {
 	proc procfs.Proc
+
+	// bootTime is the boot time in seconds since the epoch, which the start
+	// time of the process is relative to; 0 if it is read on demand
+	bootTime uint64
 }
 
-var _ procInfo = (*procWrapper)(nil)
+var (
+	_ ProcInfo    = (*procWrapper)(nil)
+	_ nsPIDReader = (*procWrapper)(nil)
+	_ statsReader = (*procWrapper)(nil)
+	_ uidReader   = (*procWrapper)(nil)
+
+	_ startTimeReader = (*procWrapper)(nil)
+)
 
 func (p *procWrapper) PID() int {
 	return p.proc.PID
@@ -45,15 +97,15 @@ func (p *procWrapper) Executable() (string, error) {
 	return p.proc.Executable()
 }
 
-func (p *procWrapper) Cgroups() ([]cGroup, error) {
+func (p *procWrapper) Cgroups() ([]CGroup, error) {
 	cgroupsData, err := p.proc.Cgroups()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get process cgroups: %w", err)
 	}
 
-	cgroups := make([]cGroup, len(cgroupsData))
+	cgroups := make([]CGroup, len(cgroupsData))
 	for i, cg := range cgroupsData {
-		cgroups[i] = cGroup{
+		cgroups[i] = CGroup{
 			Path: cg.Path,
 		}
 	}
@@ -68,6 +120,54 @@ func (p *procWrapper) CmdLine() ([]string, error) {
 	return p.proc.CmdLine()
 }
 
+func (p *procWrapper) NamespacedPIDs() ([]int, error) {
+	status, err := p.proc.NewStatus()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get process status: %w", err)
+	}
+
+	pids := make([]int, len(status.NSpids))
+	for i, pid := range status.NSpids {
+		pids[i] = int(pid)
+	}
+	return pids, nil
+}
+
+// UID returns the real user ID of the process from /proc/<pid>/status
+func (p *procWrapper) UID() (int, error) {
+	status, err := p.proc.NewStatus()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get process status: %w", err)
+	}
+	return int(status.UIDs[0]), nil
+}
+
+// StartTime returns the time the process started from /proc/<pid>/stat and
+// the boot time in /proc/stat
+func (p *procWrapper) StartTime() (time.Time, error) {
+	st, err := p.proc.Stat()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return p.startTime(st)
+}
+
+// startTime returns the start time of the process in st, reading the boot
+// time from /proc/stat unless it is known
+func (p *procWrapper) startTime(st procfs.ProcStat) (time.Time, error) {
+	var secs float64
+	if p.bootTime > 0 {
+		secs = float64(p.bootTime) + float64(st.Starttime)/userHZ
+	} else {
+		var err error
+		if secs, err = st.StartTime(); err != nil {
+			return time.Time{}, err
+		}
+	}
+	whole, frac := math.Modf(secs)
+	return time.Unix(int64(whole), int64(frac*float64(time.Second))), nil
+}
+
 // userHZ is the number of clock ticks per second
 // hardcoded just like in procfs
 const userHZ = 100
@@ -81,24 +181,58 @@ func (p *procWrapper) CPUTime() (float64, error) {
 	return float64(st.STime+st.UTime) / userHZ, nil
 }
 
+// Stats returns the CPU time, resident set size, thread count, state and start
+// time of the process from a single read of /proc/<pid>/stat. The start time is
+// left zero if the boot time is not known.
+func (p *procWrapper) Stats() (procStats, error) {
+	st, err := p.proc.Stat()
+	if err != nil {
+		return procStats{}, err
+	}
+
+	stats := procStats{
+		CPUTime:     float64(st.STime+st.UTime) / userHZ,
+		MemoryBytes: uint64(st.ResidentMemory()),
+		Threads:     st.NumThreads,
+		State:       st.State,
+	}
+	if p.bootTime > 0 {
+		stats.StartTime, _ = p.startTime(st)
+	}
+	return stats, nil
+}
+
 // WrapProc wraps a procfs.Proc in a ProcInfo interface
-func WrapProc(proc procfs.Proc) procInfo {
+func WrapProc(proc procfs.Proc) ProcInfo {
 	return &procWrapper{proc: proc}
 }
 
+// ProcessProvider lists the running processes tracked by the resource informer.
+// The procfs reader is the default provider; embedders can supply their own,
+// e.g. to feed a process list collected with eBPF.
+type ProcessProvider interface {
+	// AllProcs returns a list of all running processes
+	AllProcs() ([]ProcInfo, error)
+}
+
 // Update the allProcReader interface to return our wrapped interface
 type allProcReader interface {
-	// AllProcs returns a list of all running processes
-	AllProcs() ([]procInfo, error)
+	ProcessProvider
 
 	// CPUUsageRatio returns the CPU usage ratio
 	CPUUsageRatio() (float64, error)
 }
 
+var _ ProcessProvider = (*procFSReader)(nil)
+
 // procFSReader is the default implementation of ProcReader using procfs
 type procFSReader struct {
 	fs       procfs.FS
 	prevStat procfs.CPUStat
+
+	// bootTime is read once, on the first AllProcs, to derive the start time
+	// of processes without reading /proc/stat for each of them
+	bootTime uint64
 }
 
 // CPUUsageRatio returns the CPU usage ratio as
@@ -141,15 +275,21 @@ func (r *procFSReader) CPUUsageRatio() (float64, error) {
 }
 
 // AllProcs returns a list of all running processes
-func (r *procFSReader) AllProcs() ([]procInfo, error) {
+func (r *procFSReader) AllProcs() ([]ProcInfo, error) {
 	procs, err := r.fs.AllProcs()
 	if err != nil {
 		return nil, err
 	}
 
-	ret := make([]procInfo, len(procs))
+	if r.bootTime == 0 {
+		if stat, err := r.fs.Stat(); err == nil {
+			r.bootTime = stat.BootTime
+		}
+	}
+
+	ret := make([]ProcInfo, len(procs))
 	for i, proc := range procs {
-		ret[i] = WrapProc(proc)
+		ret[i] = &procWrapper{proc: proc, bootTime: r.bootTime}
 	}
 	return ret, nil
 }
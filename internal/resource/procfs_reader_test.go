@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"os"
 	"testing"
 	"time"
 
@@ -23,7 +24,7 @@ func TestNewProcess(t *testing.T) {
 		mockProc.On("PID").Return(12345)
 		mockProc.On("Comm").Return("test-process", nil)
 		mockProc.On("Executable").Return("/usr/bin/test", nil)
-		mockProc.On("Cgroups").Return([]cGroup{{Path: "/system.slice/test.service"}}, nil)
+		mockProc.On("Cgroups").Return([]CGroup{{Path: "/system.slice/test.service"}}, nil)
 		mockProc.On("Environ").Return([]string{}, nil).Maybe()
 		mockProc.On("CmdLine").Return([]string{"/bin/bash"}, nil).Maybe()
 		mockProc.On("CPUTime").Return(float64(10.5), nil).Once()
@@ -78,7 +79,7 @@ func TestNewProcess(t *testing.T) {
 		mockProc.On("Comm").Return("test-process", nil)
 		mockProc.On("Executable").Return("/usr/bin/test", nil)
 		mockProc.On("CmdLine").Return([]string{"/usr/bin/test", "this", "out"}, nil).Maybe()
-		mockProc.On("Cgroups").Return([]cGroup{}, errors.New("cgroups error"))
+		mockProc.On("Cgroups").Return([]CGroup{}, errors.New("cgroups error"))
 		mockProc.On("CPUTime").Return(float64(10.5), nil).Once()
 
 		process, err := newProcess(mockProc)
@@ -98,7 +99,7 @@ func TestNewProcess(t *testing.T) {
 		mockProc.On("CPUTime").Return(float64(10.5), nil)
 
 		ctrID := "316de3e24617ffce955b712c990dd057e7088fc9720e578cb18d874aac72deb0"
-		mockProc.On("Cgroups").Return([]cGroup{{Path: fmt.Sprintf("/sys/fs/cgroup/system.slice/docker-%s.scope", ctrID)}}, nil)
+		mockProc.On("Cgroups").Return([]CGroup{{Path: fmt.Sprintf("/sys/fs/cgroup/system.slice/docker-%s.scope", ctrID)}}, nil)
 		mockProc.On("Environ").Return([]string{"CONTAINER_NAME=test-container"}, nil)
 
 		process, err := newProcess(mockProc)
@@ -123,7 +124,7 @@ func TestResourceInformer(t *testing.T) {
 		mockProc.On("PID").Return(12345)
 		mockProc.On("Comm").Return("test-process", nil)
 		mockProc.On("Executable").Return("/usr/bin/test", nil)
-		mockProc.On("Cgroups").Return([]cGroup{{Path: "/system.slice/test.service"}}, nil)
+		mockProc.On("Cgroups").Return([]CGroup{{Path: "/system.slice/test.service"}}, nil)
 		mockProc.On("Environ").Return([]string{}, nil).Maybe()
 		mockProc.On("CmdLine").Return([]string{"/bin/bash"}, nil)
 		mockProc.On("CPUTime").Return(float64(10.5), nil).Once()
@@ -140,12 +141,12 @@ func TestResourceInformer(t *testing.T) {
 		require.NotNil(t, informer)
 
 		// Initialize
-		mockProcFS.On("AllProcs").Return([]procInfo{mockProc}, nil).Once() // first
+		mockProcFS.On("AllProcs").Return([]ProcInfo{mockProc}, nil).Once() // first
 		err = informer.Init()
 		require.NoError(t, err)
 
 		// First refresh
-		mockProcFS.On("AllProcs").Return([]procInfo{mockProc}, nil).Once() // first
+		mockProcFS.On("AllProcs").Return([]ProcInfo{mockProc}, nil).Once() // first
 		mockProcFS.On("CPUUsageRatio").Return(float64(0.25), nil).Once()
 		err = informer.Refresh()
 		require.NoError(t, err)
@@ -174,7 +175,7 @@ func TestResourceInformer(t *testing.T) {
 
 		// For second Refresh - same process with increased CPU time
 		mockProc.On("CPUTime").Return(float64(15.0), nil).Once()
-		mockProcFS.On("AllProcs").Return([]procInfo{mockProc}, nil).Once()
+		mockProcFS.On("AllProcs").Return([]ProcInfo{mockProc}, nil).Once()
 		mockProcFS.On("CPUUsageRatio").Return(float64(0.35), nil).Once()
 
 		err = informer.Refresh()
@@ -195,6 +196,46 @@ func TestResourceInformer(t *testing.T) {
 		mockProc.AssertExpectations(t)
 	})
 
+	t.Run("Decreasing CPU time is clamped", func(t *testing.T) {
+		mockProc := &MockProcInfo{}
+		mockProc.On("PID").Return(12345)
+		mockProc.On("Comm").Return("test-process", nil)
+		mockProc.On("Executable").Return("/usr/bin/test", nil)
+		mockProc.On("Cgroups").Return([]CGroup{{Path: "/system.slice/test.service"}}, nil)
+		mockProc.On("Environ").Return([]string{}, nil).Maybe()
+		mockProc.On("CmdLine").Return([]string{"/bin/bash"}, nil)
+		mockProc.On("CPUTime").Return(float64(10.0), nil).Once()
+
+		mockProcFS := &MockProcReader{}
+		informer, err := NewInformer(WithProcReader(mockProcFS))
+		require.NoError(t, err)
+
+		mockProcFS.On("AllProcs").Return([]ProcInfo{mockProc}, nil).Once()
+		require.NoError(t, informer.Init())
+
+		mockProcFS.On("AllProcs").Return([]ProcInfo{mockProc}, nil).Once()
+		mockProcFS.On("CPUUsageRatio").Return(float64(0.25), nil).Once()
+		require.NoError(t, informer.Refresh())
+		assert.Equal(t, uint64(0), informer.Node().NegativeCPUTimeDeltas)
+
+		// CPU time goes backwards on the next refresh
+		mockProc.On("CPUTime").Return(float64(5.0), nil).Once()
+		mockProcFS.On("AllProcs").Return([]ProcInfo{mockProc}, nil).Once()
+		mockProcFS.On("CPUUsageRatio").Return(float64(0.25), nil).Once()
+		require.NoError(t, informer.Refresh())
+
+		proc := informer.Processes().Running[12345]
+		assert.Equal(t, float64(5.0), proc.CPUTotalTime)
+		assert.Equal(t, float64(0), proc.CPUTimeDelta)
+
+		node := informer.Node()
+		assert.Equal(t, float64(0), node.ProcessTotalCPUTimeDelta)
+		assert.Equal(t, uint64(1), node.NegativeCPUTimeDeltas)
+
+		mockProcFS.AssertExpectations(t)
+		mockProc.AssertExpectations(t)
+	})
+
 	t.Run("Process termination", func(t *testing.T) {
 		mockInformer := &MockProcReader{}
 		fakeClock := testclock.NewFakeClock(time.Now())
@@ -204,7 +245,7 @@ func TestResourceInformer(t *testing.T) {
 		mockProc1.On("PID").Return(1001)
 		mockProc1.On("Comm").Return("process-1", nil)
 		mockProc1.On("Executable").Return("/bin/process1", nil)
-		mockProc1.On("Cgroups").Return([]cGroup{{Path: "/system.slice/process1.service"}}, nil)
+		mockProc1.On("Cgroups").Return([]CGroup{{Path: "/system.slice/process1.service"}}, nil)
 		mockProc1.On("CPUTime").Return(float64(5.0), nil).Once()
 		mockProc1.On("Environ").Return([]string{}, nil).Maybe()
 		mockProc1.On("CmdLine").Return([]string{"/bin/process1"}, nil).Maybe()
@@ -213,16 +254,16 @@ func TestResourceInformer(t *testing.T) {
 		mockProc2.On("PID").Return(1002)
 		mockProc2.On("Comm").Return("process-2", nil)
 		mockProc2.On("Executable").Return("/bin/process2", nil)
-		mockProc2.On("Cgroups").Return([]cGroup{{Path: "/system.slice/process2.service"}}, nil)
+		mockProc2.On("Cgroups").Return([]CGroup{{Path: "/system.slice/process2.service"}}, nil)
 		mockProc2.On("CPUTime").Return(float64(10.0), nil).Once()
 		mockProc2.On("Environ").Return([]string{}, nil).Maybe()
 		mockProc2.On("CmdLine").Return([]string{"/bin/process2"}, nil).Maybe()
 
 		// For Init
-		mockInformer.On("AllProcs").Return([]procInfo{mockProc1, mockProc2}, nil).Once()
+		mockInformer.On("AllProcs").Return([]ProcInfo{mockProc1, mockProc2}, nil).Once()
 
 		// For first Refresh
-		mockInformer.On("AllProcs").Return([]procInfo{mockProc1, mockProc2}, nil).Once()
+		mockInformer.On("AllProcs").Return([]ProcInfo{mockProc1, mockProc2}, nil).Once()
 		mockInformer.On("CPUUsageRatio").Return(float64(0.1), nil).Once()
 
 		informer, err := NewInformer(
@@ -250,7 +291,7 @@ func TestResourceInformer(t *testing.T) {
 
 		// Second refresh - process 2 is gone
 		mockProc1.On("CPUTime").Return(float64(7.5), nil)
-		mockInformer.On("AllProcs").Return([]procInfo{mockProc1}, nil).Once()
+		mockInformer.On("AllProcs").Return([]ProcInfo{mockProc1}, nil).Once()
 		mockInformer.On("CPUUsageRatio").Return(float64(0.15), nil).Once()
 
 		// Second refresh
@@ -294,7 +335,7 @@ func TestResourceInformer(t *testing.T) {
 		}, nil)
 
 		ctnrID, cgPath := mockContainerIDAndPath(PodmanRuntime)
-		mockProc.On("Cgroups").Return([]cGroup{{Path: cgPath}}, nil).Once()
+		mockProc.On("Cgroups").Return([]CGroup{{Path: cgPath}}, nil).Once()
 
 		mockProc.On("CPUTime").Return(float64(3.0), nil).Once()
 
@@ -305,12 +346,12 @@ func TestResourceInformer(t *testing.T) {
 		require.NoError(t, err)
 
 		// Initialize
-		mockInformer.On("AllProcs").Return([]procInfo{mockProc}, nil).Once()
+		mockInformer.On("AllProcs").Return([]ProcInfo{mockProc}, nil).Once()
 		err = informer.Init()
 		require.NoError(t, err)
 
 		// First refresh
-		mockInformer.On("AllProcs").Return([]procInfo{mockProc}, nil).Once()
+		mockInformer.On("AllProcs").Return([]ProcInfo{mockProc}, nil).Once()
 		mockInformer.On("CPUUsageRatio").Return(float64(0.3), nil).Once()
 		err = informer.Refresh()
 		require.NoError(t, err)
@@ -340,7 +381,7 @@ func TestResourceInformer(t *testing.T) {
 
 		// For second Refresh - increased CPU time
 		mockProc.On("CPUTime").Return(float64(5.0), nil).Once()
-		mockInformer.On("AllProcs").Return([]procInfo{mockProc}, nil).Once()
+		mockInformer.On("AllProcs").Return([]ProcInfo{mockProc}, nil).Once()
 		mockInformer.On("CPUUsageRatio").Return(float64(0.45), nil).Once()
 
 		// Second refresh
@@ -373,15 +414,15 @@ func TestResourceInformer(t *testing.T) {
 		mockProc.On("Executable").Return("/bin/container-app", nil)
 		mockProc.On("CmdLine").Return([]string{"/bin/container-app", "-with", "args"}, nil)
 		cntrID, cgroupPath := mockContainerIDAndPath(PodmanRuntime)
-		mockProc.On("Cgroups").Return([]cGroup{{Path: cgroupPath}}, nil)
+		mockProc.On("Cgroups").Return([]CGroup{{Path: cgroupPath}}, nil)
 		mockProc.On("Environ").Return([]string{"CONTAINER_NAME=test-container"}, nil)
 		mockProc.On("CPUTime").Return(float64(8.0), nil)
 
 		// For Init
-		mockInformer.On("AllProcs").Return([]procInfo{mockProc}, nil).Once()
+		mockInformer.On("AllProcs").Return([]ProcInfo{mockProc}, nil).Once()
 
 		// For first Refresh
-		mockInformer.On("AllProcs").Return([]procInfo{mockProc}, nil).Once()
+		mockInformer.On("AllProcs").Return([]ProcInfo{mockProc}, nil).Once()
 		mockInformer.On("CPUUsageRatio").Return(float64(0.0), nil).Once()
 
 		informer, err := NewInformer(
@@ -404,7 +445,7 @@ func TestResourceInformer(t *testing.T) {
 		assert.Contains(t, containers.Running, cntrID)
 
 		// Second refresh - container process is gone
-		mockInformer.On("AllProcs").Return([]procInfo{}, nil).Once()
+		mockInformer.On("AllProcs").Return([]ProcInfo{}, nil).Once()
 		mockInformer.On("CPUUsageRatio").Return(float64(0.3), nil).Once()
 
 		// Move clock forward
@@ -436,10 +477,10 @@ func TestResourceInformer(t *testing.T) {
 		fakeClock := testclock.NewFakeClock(time.Now())
 
 		// For Init
-		mockInformer.On("AllProcs").Return([]procInfo{}, nil).Once()
+		mockInformer.On("AllProcs").Return([]ProcInfo{}, nil).Once()
 
 		// For Refresh - return error from AllProcs but still need CPUUsageRatio for refreshNode
-		mockInformer.On("AllProcs").Return([]procInfo{}, errors.New("procfs error")).Once()
+		mockInformer.On("AllProcs").Return([]ProcInfo{}, errors.New("procfs error")).Once()
 		mockInformer.On("CPUUsageRatio").Return(0.5, nil).Once()
 
 		informer, err := NewInformer(
@@ -461,6 +502,30 @@ func TestResourceInformer(t *testing.T) {
 	})
 }
 
+func TestRefresh_NodeOnly(t *testing.T) {
+	mockProcFS := &MockProcReader{}
+	informer, err := NewInformer(
+		WithProcReader(mockProcFS),
+		WithClock(testclock.NewFakeClock(time.Now())),
+		WithNodeOnly(true),
+	)
+	require.NoError(t, err)
+
+	// processes are never enumerated in node-only mode
+	mockProcFS.On("CPUUsageRatio").Return(0.4, nil).Once()
+	require.NoError(t, informer.Refresh())
+
+	assert.Equal(t, 0.4, informer.Node().CPUUsageRatio)
+	assert.Zero(t, informer.Node().ProcessTotalCPUTimeDelta)
+	assert.Empty(t, informer.Processes().Running)
+	assert.Empty(t, informer.Containers().Running)
+	assert.Empty(t, informer.VirtualMachines().Running)
+	assert.Empty(t, informer.Pods().Running)
+
+	mockProcFS.AssertNotCalled(t, "AllProcs")
+	mockProcFS.AssertExpectations(t)
+}
+
 func TestRefresh_PodInformer(t *testing.T) {
 	t.Run("Uses podInformer successfully", func(t *testing.T) {
 		mockProc := &MockProcInfo{}
@@ -469,12 +534,12 @@ func TestRefresh_PodInformer(t *testing.T) {
 		mockProc.On("CmdLine").Return([]string{"/usr/bin/test", "--arg1"}, nil).Once()
 		mockProc.On("Executable").Return("/usr/bin/test", nil)
 		containerID, cgPath := mockContainerIDAndPath(DockerRuntime)
-		mockProc.On("Cgroups").Return([]cGroup{{Path: cgPath}}, nil)
+		mockProc.On("Cgroups").Return([]CGroup{{Path: cgPath}}, nil)
 		mockProc.On("CPUTime").Return(10.0, nil).Once()
 		mockProc.On("Environ").Return([]string{"CONTAINER_NAME=my-container"}, nil)
 
 		mockProcFS := &MockProcReader{}
-		mockProcFS.On("AllProcs").Return([]procInfo{mockProc}, nil).Twice()
+		mockProcFS.On("AllProcs").Return([]ProcInfo{mockProc}, nil).Twice()
 		mockProcFS.On("CPUUsageRatio").Return(0.5, nil).Once()
 
 		mockPodInformer := new(mockPodInformer)
@@ -502,6 +567,50 @@ func TestRefresh_PodInformer(t *testing.T) {
 		mockProcFS.AssertExpectations(t)
 		mockProc.AssertExpectations(t)
 	})
+	t.Run("Looks up pods by full ID with short container IDs", func(t *testing.T) {
+		mockProc := &MockProcInfo{}
+		mockProc.On("PID").Return(123)
+		mockProc.On("Comm").Return("test-process", nil)
+		mockProc.On("CmdLine").Return([]string{"/usr/bin/test", "--arg1"}, nil).Once()
+		mockProc.On("Executable").Return("/usr/bin/test", nil)
+		containerID, cgPath := mockContainerIDAndPath(DockerRuntime)
+		mockProc.On("Cgroups").Return([]CGroup{{Path: cgPath}}, nil)
+		mockProc.On("CPUTime").Return(10.0, nil).Once()
+		mockProc.On("Environ").Return([]string{"CONTAINER_NAME=my-container"}, nil)
+
+		mockProcFS := &MockProcReader{}
+		mockProcFS.On("AllProcs").Return([]ProcInfo{mockProc}, nil).Twice()
+		mockProcFS.On("CPUUsageRatio").Return(0.5, nil).Once()
+
+		mockPodInformer := new(mockPodInformer)
+		mockPodInformer.On("LookupByContainerID", containerID).Return(
+			&pod.ContainerInfo{
+				PodID:         "pod123",
+				PodName:       "mypod",
+				Namespace:     "default",
+				ContainerName: "my-container",
+			}, true, nil,
+		)
+
+		informer, err := NewInformer(
+			WithProcReader(mockProcFS),
+			WithPodInformer(mockPodInformer),
+			WithContainerIDFormat(ContainerIDShort),
+		)
+		require.NoError(t, err)
+		require.NoError(t, informer.Init())
+		require.NoError(t, informer.Refresh())
+
+		containers := informer.Containers()
+		require.Len(t, containers.Running, 1)
+		ctnr := containers.Running[containerID[:12]]
+		require.NotNil(t, ctnr)
+		assert.Equal(t, containerID[:12], ctnr.ID)
+		require.NotNil(t, ctnr.Pod)
+		assert.Equal(t, "mypod", ctnr.Pod.Name)
+
+		mockPodInformer.AssertExpectations(t)
+	})
 	t.Run("podInformer returns ErrNoPod", func(t *testing.T) {
 		mockProc := &MockProcInfo{}
 		mockProc.On("PID").Return(456)
@@ -512,10 +621,10 @@ func TestRefresh_PodInformer(t *testing.T) {
 		mockProc.On("CmdLine").Return([]string{"/usr/bin/container-exec"}, nil).Once()
 
 		containerID, cgPath := mockContainerIDAndPath(DockerRuntime)
-		mockProc.On("Cgroups").Return([]cGroup{{Path: cgPath}}, nil)
+		mockProc.On("Cgroups").Return([]CGroup{{Path: cgPath}}, nil)
 
 		mockProcFS := &MockProcReader{}
-		mockProcFS.On("AllProcs").Return([]procInfo{mockProc}, nil).Twice()
+		mockProcFS.On("AllProcs").Return([]ProcInfo{mockProc}, nil).Twice()
 		mockProcFS.On("CPUUsageRatio").Return(0.5, nil).Once()
 
 		mockPodInformer := new(mockPodInformer)
@@ -551,10 +660,10 @@ func TestRefresh_PodInformer(t *testing.T) {
 		mockProc.On("CmdLine").Return([]string{"/usr/bin/container-exec"}, nil).Once()
 
 		containerID, cgPath := mockContainerIDAndPath(DockerRuntime)
-		mockProc.On("Cgroups").Return([]cGroup{{Path: cgPath}}, nil)
+		mockProc.On("Cgroups").Return([]CGroup{{Path: cgPath}}, nil)
 
 		mockProcFS := &MockProcReader{}
-		mockProcFS.On("AllProcs").Return([]procInfo{mockProc}, nil).Twice()
+		mockProcFS.On("AllProcs").Return([]ProcInfo{mockProc}, nil).Twice()
 		mockProcFS.On("CPUUsageRatio").Return(0.5, nil).Once()
 
 		podError := errors.New("general error")
@@ -596,10 +705,10 @@ func TestLookupByContainerID_UpdatesContainerName(t *testing.T) {
 
 		// Create container with Docker runtime
 		containerID, cgPath := mockContainerIDAndPath(DockerRuntime)
-		mockProc.On("Cgroups").Return([]cGroup{{Path: cgPath}}, nil)
+		mockProc.On("Cgroups").Return([]CGroup{{Path: cgPath}}, nil)
 
 		mockProcFS := &MockProcReader{}
-		mockProcFS.On("AllProcs").Return([]procInfo{mockProc}, nil).Twice()
+		mockProcFS.On("AllProcs").Return([]ProcInfo{mockProc}, nil).Twice()
 		mockProcFS.On("CPUUsageRatio").Return(0.4, nil).Once()
 
 		// Mock pod informer that returns container name from pod info
@@ -666,10 +775,10 @@ func TestLookupByContainerID_UpdatesContainerName(t *testing.T) {
 		mockProc.On("CmdLine").Return([]string{"/usr/bin/nginx", "-g", "daemon off;"}, nil)
 
 		containerID, cgPath := mockContainerIDAndPath(ContainerDRuntime)
-		mockProc.On("Cgroups").Return([]cGroup{{Path: cgPath}}, nil)
+		mockProc.On("Cgroups").Return([]CGroup{{Path: cgPath}}, nil)
 
 		mockProcFS := &MockProcReader{}
-		mockProcFS.On("AllProcs").Return([]procInfo{mockProc}, nil).Twice()
+		mockProcFS.On("AllProcs").Return([]ProcInfo{mockProc}, nil).Twice()
 		mockProcFS.On("CPUUsageRatio").Return(0.2, nil).Once()
 
 		// Pod informer returns different name than environment
@@ -750,6 +859,14 @@ func TestProcWrapper(t *testing.T) {
 	cpuTime, err := wrapper.CPUTime()
 	require.NoError(t, err)
 	assert.Greater(t, cpuTime, float64(0))
+
+	stats, err := wrapper.(statsReader).Stats()
+	require.NoError(t, err)
+	assert.Equal(t, cpuTime, stats.CPUTime)
+	assert.Equal(t, uint64(426889*os.Getpagesize()), stats.MemoryBytes) // rss pages from the stat fixture
+	assert.Equal(t, 23, stats.Threads)
+	assert.Equal(t, "S", stats.State)
+	assert.True(t, stats.StartTime.IsZero(), "the start time needs the boot time")
 }
 
 // Test for the procfs fixture to ensure the test fixture directory is available
@@ -762,6 +879,16 @@ func TestProcFSReader(t *testing.T) {
 	procs, err := informer.AllProcs()
 	require.NoError(t, err)
 	assert.Len(t, procs, 6) // 1 regular, 4 containers, 1 vm
+
+	// the start time is derived from the boot time read once by the reader
+	for _, proc := range procs {
+		stats, err := proc.(statsReader).Stats()
+		require.NoError(t, err)
+		started, err := proc.(startTimeReader).StartTime()
+		require.NoError(t, err)
+		assert.Equal(t, started, stats.StartTime)
+		assert.False(t, stats.StartTime.IsZero())
+	}
 }
 
 // Test for the procfs fixture to ensure the test fixture directory is available
@@ -834,16 +961,16 @@ func TestProcessUpdateAfterRefresh(t *testing.T) {
 	mockProc.On("PID").Return(1001)
 	mockProc.On("Comm").Return("process-initial", nil).Once()
 	mockProc.On("Executable").Return("/bin/process-initial", nil).Once()
-	mockProc.On("Cgroups").Return([]cGroup{{Path: "/system.slice/process.service"}}, nil).Once()
+	mockProc.On("Cgroups").Return([]CGroup{{Path: "/system.slice/process.service"}}, nil).Once()
 	mockProc.On("CPUTime").Return(procCPUTime, nil).Once()
 	mockProc.On("Environ").Return([]string{}, nil).Maybe()
 	mockProc.On("CmdLine").Return([]string{"/bin/process-initial"}, nil).Once()
 
 	// For Init
-	mockInformer.On("AllProcs").Return([]procInfo{mockProc}, nil).Once()
+	mockInformer.On("AllProcs").Return([]ProcInfo{mockProc}, nil).Once()
 
 	// For first Refresh
-	mockInformer.On("AllProcs").Return([]procInfo{mockProc}, nil).Once()
+	mockInformer.On("AllProcs").Return([]ProcInfo{mockProc}, nil).Once()
 	mockInformer.On("CPUUsageRatio").Return(float64(0.0), nil).Once()
 
 	informer, err := NewInformer(
@@ -872,11 +999,11 @@ func TestProcessUpdateAfterRefresh(t *testing.T) {
 	// Second refresh - process has changed comm and executable, with significant CPU time
 	mockProc.On("Comm").Return("process-updated", nil).Once()
 	mockProc.On("CmdLine").Return([]string{"/bin/process-updated"}, nil).Once()
-	mockProc.On("Cgroups").Return([]cGroup{{Path: "/system.slice/process.service"}}, nil).Once()
+	mockProc.On("Cgroups").Return([]CGroup{{Path: "/system.slice/process.service"}}, nil).Once()
 	mockProc.On("Executable").Return("/bin/process-updated", nil).Once()
 	mockProc.On("CPUTime").Return(float64(7.0), nil).Once() // 2.0 delta
 
-	mockInformer.On("AllProcs").Return([]procInfo{mockProc}, nil).Once()
+	mockInformer.On("AllProcs").Return([]ProcInfo{mockProc}, nil).Once()
 	mockInformer.On("CPUUsageRatio").Return(0.3, nil).Once()
 
 	// Second refresh
@@ -892,7 +1019,7 @@ func TestProcessUpdateAfterRefresh(t *testing.T) {
 
 	// Third refresh - process changes again but with negligible CPU time delta
 	mockProc.On("CPUTime").Return(float64(7.0000000000001), nil).Once() // Very small delta (1e-13)
-	mockInformer.On("AllProcs").Return([]procInfo{mockProc}, nil).Once()
+	mockInformer.On("AllProcs").Return([]ProcInfo{mockProc}, nil).Once()
 	mockInformer.On("CPUUsageRatio").Return(0.3, nil).Once()
 	// Third refresh
 	err = informer.Refresh()
@@ -920,16 +1047,16 @@ func TestZeroCPUTimeProcess(t *testing.T) {
 	mockProc.On("PID").Return(1001).Times(5) // Called multiple times
 	mockProc.On("Comm").Return("zero-cpu-process", nil).Once()
 	mockProc.On("Executable").Return("/bin/zero-cpu-process", nil).Once()
-	mockProc.On("Cgroups").Return([]cGroup{{Path: "/system.slice/process.service"}}, nil).Once()
+	mockProc.On("Cgroups").Return([]CGroup{{Path: "/system.slice/process.service"}}, nil).Once()
 	mockProc.On("CPUTime").Return(float64(0.0), nil).Once()
 	mockProc.On("Environ").Return([]string{}, nil).Maybe()
 	mockProc.On("CmdLine").Return([]string{"/bin/zero-cpu-process"}, nil).Maybe()
 
 	// For Init
-	mockProcFS.On("AllProcs").Return([]procInfo{mockProc}, nil).Once()
+	mockProcFS.On("AllProcs").Return([]ProcInfo{mockProc}, nil).Once()
 
 	// For first Refresh
-	mockProcFS.On("AllProcs").Return([]procInfo{mockProc}, nil).Once()
+	mockProcFS.On("AllProcs").Return([]ProcInfo{mockProc}, nil).Once()
 	mockProcFS.On("CPUUsageRatio").Return(float64(0.0), nil).Once()
 
 	informer, err := NewInformer(
@@ -955,7 +1082,7 @@ func TestZeroCPUTimeProcess(t *testing.T) {
 	// Second refresh - process with close to 0 CPU delta and should not update process fields
 	mockProc.On("CPUTime").Return(float64(1e-14), nil).Once() // Still zero
 
-	mockProcFS.On("AllProcs").Return([]procInfo{mockProc}, nil).Once()
+	mockProcFS.On("AllProcs").Return([]ProcInfo{mockProc}, nil).Once()
 	mockProcFS.On("CPUUsageRatio").Return(float64(0.5), nil).Once()
 	// Second refresh
 	err = informer.Refresh()
@@ -974,6 +1101,108 @@ func TestZeroCPUTimeProcess(t *testing.T) {
 	mockProc.AssertExpectations(t)
 }
 
+func TestProcessScanInterval(t *testing.T) {
+	mockProcFS := &MockProcReader{}
+	fakeClock := testclock.NewFakeClock(time.Now())
+
+	newMockProc := func(pid int, comm string) *MockProcInfo {
+		p := &MockProcInfo{}
+		p.On("PID").Return(pid)
+		p.On("Comm").Return(comm, nil).Maybe()
+		p.On("Executable").Return("/bin/"+comm, nil).Maybe()
+		p.On("Cgroups").Return([]CGroup{{Path: "/system.slice/process.service"}}, nil).Maybe()
+		p.On("Environ").Return([]string{}, nil).Maybe()
+		p.On("CmdLine").Return([]string{"/bin/" + comm}, nil).Maybe()
+		return p
+	}
+
+	proc1 := newMockProc(1001, "proc-1")
+	proc2 := newMockProc(1002, "proc-2")
+	proc3 := newMockProc(1003, "proc-3")
+
+	mockProcFS.On("CPUUsageRatio").Return(0.5, nil)
+
+	informer, err := NewInformer(
+		WithProcReader(mockProcFS),
+		WithClock(fakeClock),
+		WithProcessScanInterval(30*time.Second),
+	)
+	require.NoError(t, err)
+
+	// first refresh scans /proc
+	mockProcFS.On("AllProcs").Return([]ProcInfo{proc1, proc2}, nil).Once()
+	proc1.On("CPUTime").Return(1.0, nil).Once()
+	proc2.On("CPUTime").Return(1.0, nil).Once()
+	require.NoError(t, informer.Refresh())
+	assert.Len(t, informer.Processes().Running, 2)
+
+	// second refresh within the scan interval reuses the discovered processes;
+	// proc2 exited and proc3 spawned in the meantime
+	fakeClock.Step(10 * time.Second)
+	proc1.On("CPUTime").Return(3.0, nil).Once()
+	proc2.On("CPUTime").Return(0.0, os.ErrNotExist).Once()
+	require.NoError(t, informer.Refresh())
+
+	processes := informer.Processes()
+	assert.Len(t, processes.Running, 1)
+	assert.Equal(t, 2.0, processes.Running[1001].CPUTimeDelta, "power must still be computed between scans")
+	assert.Contains(t, processes.Terminated, 1002, "exited process must be terminated between scans")
+	assert.NotContains(t, processes.Running, 1003, "new process is only discovered by the next scan")
+
+	// once the scan interval has elapsed, /proc is scanned again
+	fakeClock.Step(20 * time.Second)
+	mockProcFS.On("AllProcs").Return([]ProcInfo{proc1, proc3}, nil).Once()
+	proc1.On("CPUTime").Return(4.0, nil).Once()
+	proc3.On("CPUTime").Return(1.0, nil).Once()
+	require.NoError(t, informer.Refresh())
+
+	processes = informer.Processes()
+	assert.Len(t, processes.Running, 2)
+	assert.Contains(t, processes.Running, 1003)
+	assert.Empty(t, processes.Terminated)
+
+	mockProcFS.AssertExpectations(t)
+	proc1.AssertExpectations(t)
+	proc2.AssertExpectations(t)
+	proc3.AssertExpectations(t)
+}
+
+func TestProcessProvider(t *testing.T) {
+	mockProcFS := &MockProcReader{}
+	mockProcFS.On("CPUUsageRatio").Return(0.5, nil)
+
+	proc := &MockProcInfo{}
+	proc.On("PID").Return(2001)
+	proc.On("CPUTime").Return(2.0, nil)
+	proc.On("Comm").Return("ebpf-proc", nil)
+	proc.On("Executable").Return("/bin/ebpf-proc", nil)
+	proc.On("Cgroups").Return([]CGroup{{Path: "/system.slice/ebpf.service"}}, nil)
+	proc.On("Environ").Return([]string{}, nil).Maybe()
+	proc.On("CmdLine").Return([]string{"/bin/ebpf-proc"}, nil).Maybe()
+
+	provider := &MockProcReader{}
+	provider.On("AllProcs").Return([]ProcInfo{proc}, nil).Twice() // Once for Init, once for Refresh
+
+	informer, err := NewInformer(
+		WithProcReader(mockProcFS),
+		WithProcessProvider(provider),
+	)
+	require.NoError(t, err)
+	require.NoError(t, informer.Init())
+	require.NoError(t, informer.Refresh())
+
+	running := informer.Processes().Running
+	require.Contains(t, running, 2001)
+	assert.Equal(t, "ebpf-proc", running[2001].Comm)
+	assert.Equal(t, RegularProcess, running[2001].Type)
+	assert.Equal(t, 0.5, informer.Node().CPUUsageRatio, "node CPU usage must still be read from procfs")
+
+	// processes must only be listed from the provider
+	mockProcFS.AssertNotCalled(t, "AllProcs")
+	mockProcFS.AssertExpectations(t)
+	provider.AssertExpectations(t)
+}
+
 func TestProcFSReaderCPUUsageRatio(t *testing.T) {
 	t.Run("First call returns zero usage", func(t *testing.T) {
 		// Create a mock reader with no previous stats
@@ -1071,7 +1300,7 @@ func TestResourceInformerCreation(t *testing.T) {
 func TestResourceInformer_InitRefreshErr(t *testing.T) {
 	t.Run("Init with failing procfs access", func(t *testing.T) {
 		mockProcFS := &MockProcReader{}
-		mockProcFS.On("AllProcs").Return([]procInfo(nil), errors.New("procfs access denied"))
+		mockProcFS.On("AllProcs").Return([]ProcInfo(nil), errors.New("procfs access denied"))
 
 		informer, err := NewInformer(WithProcReader(mockProcFS))
 		require.NoError(t, err)
@@ -1085,7 +1314,7 @@ func TestResourceInformer_InitRefreshErr(t *testing.T) {
 
 	t.Run("refreshNode with CPUUsageRatio error", func(t *testing.T) {
 		mockProcFS := &MockProcReader{}
-		mockProcFS.On("AllProcs").Return([]procInfo{}, nil).Twice() // Once for Init, once for Refresh
+		mockProcFS.On("AllProcs").Return([]ProcInfo{}, nil).Twice() // Once for Init, once for Refresh
 		mockProcFS.On("CPUUsageRatio").Return(0.0, errors.New("cpu stat error"))
 
 		informer, err := NewInformer(WithProcReader(mockProcFS))
@@ -1137,7 +1366,7 @@ func TestProcWrapperErrors(t *testing.T) {
 		mockProc := &MockProcInfo{}
 
 		// Mock Cgroups to return error
-		mockProc.On("Cgroups").Return([]cGroup(nil), errors.New("cgroup read error"))
+		mockProc.On("Cgroups").Return([]CGroup(nil), errors.New("cgroup read error"))
 
 		cgroups, err := mockProc.Cgroups()
 		assert.Error(t, err)
@@ -1162,6 +1391,172 @@ func TestProcWrapperErrors(t *testing.T) {
 	})
 }
 
+func TestNamespacedPIDs(t *testing.T) {
+	t.Run("container process", func(t *testing.T) {
+		mockProc := &MockNSProcInfo{}
+		mockProc.On("NamespacedPIDs").Return([]int{40456, 7}, nil).Once()
+
+		assert.Equal(t, []int{40456, 7}, namespacedPIDs(mockProc, ContainerProcess))
+		mockProc.AssertExpectations(t)
+	})
+
+	t.Run("single namespace", func(t *testing.T) {
+		mockProc := &MockNSProcInfo{}
+		mockProc.On("NamespacedPIDs").Return([]int{40456}, nil).Once()
+
+		assert.Nil(t, namespacedPIDs(mockProc, ContainerProcess))
+		mockProc.AssertExpectations(t)
+	})
+
+	t.Run("read error", func(t *testing.T) {
+		mockProc := &MockNSProcInfo{}
+		mockProc.On("NamespacedPIDs").Return([]int(nil), errors.New("status read error")).Once()
+
+		assert.Nil(t, namespacedPIDs(mockProc, ContainerProcess))
+		mockProc.AssertExpectations(t)
+	})
+
+	t.Run("non container process", func(t *testing.T) {
+		mockProc := &MockNSProcInfo{}
+		assert.Nil(t, namespacedPIDs(mockProc, RegularProcess))
+		mockProc.AssertNotCalled(t, "NamespacedPIDs")
+	})
+
+	t.Run("reader without namespace support", func(t *testing.T) {
+		assert.Nil(t, namespacedPIDs(&MockProcInfo{}, ContainerProcess))
+	})
+}
+
+func TestProcessUID(t *testing.T) {
+	t.Run("uid reported", func(t *testing.T) {
+		mockProc := &MockUIDProcInfo{}
+		mockProc.On("UID").Return(1000, nil).Once()
+
+		assert.Equal(t, 1000, processUID(mockProc))
+		mockProc.AssertExpectations(t)
+	})
+
+	t.Run("read error", func(t *testing.T) {
+		mockProc := &MockUIDProcInfo{}
+		mockProc.On("UID").Return(0, errors.New("status read error")).Once()
+
+		assert.Equal(t, -1, processUID(mockProc))
+	})
+
+	t.Run("reader without uid support", func(t *testing.T) {
+		assert.Equal(t, -1, processUID(&MockProcInfo{}))
+	})
+}
+
+func TestProcessStartTime(t *testing.T) {
+	started := time.Date(2025, 4, 14, 5, 40, 0, 0, time.UTC)
+
+	t.Run("start time reported", func(t *testing.T) {
+		mockProc := &MockStartTimeProcInfo{}
+		mockProc.On("StartTime").Return(started, nil).Once()
+
+		assert.Equal(t, started, processStartTime(mockProc))
+		mockProc.AssertExpectations(t)
+	})
+
+	t.Run("read error", func(t *testing.T) {
+		mockProc := &MockStartTimeProcInfo{}
+		mockProc.On("StartTime").Return(time.Time{}, errors.New("stat read error")).Once()
+
+		assert.True(t, processStartTime(mockProc).IsZero())
+	})
+
+	t.Run("reader without start time support", func(t *testing.T) {
+		assert.True(t, processStartTime(&MockProcInfo{}).IsZero())
+	})
+}
+
+func TestProcessStats(t *testing.T) {
+	t.Run("stats reported", func(t *testing.T) {
+		mockProc := &MockStatsProcInfo{}
+		mockProc.On("Stats").Return(procStats{CPUTime: 3, MemoryBytes: 64 << 20, Threads: 12, State: "R"}, nil).Once()
+
+		stats, err := processStats(mockProc)
+		require.NoError(t, err)
+		assert.Equal(t, procStats{CPUTime: 3, MemoryBytes: 64 << 20, Threads: 12, State: "R"}, stats)
+		mockProc.AssertExpectations(t)
+		mockProc.AssertNotCalled(t, "CPUTime")
+	})
+
+	t.Run("process exited", func(t *testing.T) {
+		mockProc := &MockStatsProcInfo{}
+		mockProc.On("Stats").Return(procStats{}, &os.PathError{Op: "open", Path: "/proc/42/stat", Err: os.ErrNotExist}).Once()
+
+		_, err := processStats(mockProc)
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("read error", func(t *testing.T) {
+		mockProc := &MockStatsProcInfo{}
+		mockProc.On("Stats").Return(procStats{}, errors.New("stat read error")).Once()
+
+		_, err := processStats(mockProc)
+		assert.ErrorContains(t, err, "stat read error")
+	})
+
+	t.Run("reader without stats support", func(t *testing.T) {
+		mockProc := &MockProcInfo{}
+		mockProc.On("CPUTime").Return(float64(5), nil).Once()
+
+		stats, err := processStats(mockProc)
+		require.NoError(t, err)
+		assert.Equal(t, procStats{CPUTime: 5}, stats)
+	})
+}
+
+func TestRefreshStats(t *testing.T) {
+	containerID, cgroupPath := mockContainerIDAndPath(DockerRuntime)
+
+	newContainerProc := func(pid int, memory uint64) *MockStatsProcInfo {
+		p := &MockStatsProcInfo{}
+		p.On("PID").Return(pid).Maybe()
+		p.On("Comm").Return(fmt.Sprintf("proc-%d", pid), nil).Maybe()
+		p.On("Executable").Return("/usr/bin/app", nil).Maybe()
+		p.On("Cgroups").Return([]CGroup{{Path: cgroupPath}}, nil).Maybe()
+		p.On("Environ").Return([]string{}, nil).Maybe()
+		p.On("CmdLine").Return([]string{"/usr/bin/app"}, nil).Maybe()
+		p.On("Stats").Return(procStats{CPUTime: float64(pid), MemoryBytes: memory, Threads: pid / 100, State: "S"}, nil).Maybe()
+		return p
+	}
+	proc1 := newContainerProc(100, 100<<20)
+	proc2 := newContainerProc(200, 50<<20)
+
+	// exits before its stats are read
+	exited := &MockStatsProcInfo{}
+	exited.On("PID").Return(300).Maybe()
+	exited.On("Stats").Return(procStats{}, &os.PathError{Op: "open", Path: "/proc/300/stat", Err: os.ErrNotExist}).Maybe()
+
+	mockProcFS := &MockProcReader{}
+	mockProcFS.On("AllProcs").Return([]ProcInfo{proc1, proc2, exited}, nil)
+	mockProcFS.On("CPUUsageRatio").Return(float64(0.5), nil)
+
+	informer, err := NewInformer(WithProcReader(mockProcFS), WithClock(testclock.NewFakeClock(time.Now())))
+	require.NoError(t, err)
+	require.NoError(t, informer.Init())
+	require.NoError(t, informer.Refresh())
+
+	procs := informer.Processes()
+	require.Len(t, procs.Running, 2)
+	assert.Equal(t, uint64(100<<20), procs.Running[100].MemoryBytes)
+	assert.Equal(t, uint64(50<<20), procs.Running[200].MemoryBytes)
+	assert.Equal(t, 1, procs.Running[100].Threads)
+	assert.Equal(t, 2, procs.Running[200].Threads)
+	assert.Equal(t, "S", procs.Running[200].State)
+
+	containers := informer.Containers()
+	require.Contains(t, containers.Running, containerID)
+	assert.Equal(t, uint64(150<<20), containers.Running[containerID].MemoryBytes)
+
+	// memory is not accumulated across refreshes
+	require.NoError(t, informer.Refresh())
+	assert.Equal(t, uint64(150<<20), informer.Containers().Running[containerID].MemoryBytes)
+}
+
 func TestRefreshConcurrency(t *testing.T) {
 	// container for pod dependency testing
 	mockProc1 := &MockProcInfo{}
@@ -1171,7 +1566,7 @@ func TestRefreshConcurrency(t *testing.T) {
 	mockProc1.On("CmdLine").Return([]string{"/bin/container-app"}, nil)
 	mockProc1.On("Environ").Return([]string{"CONTAINER_NAME=test-container"}, nil)
 	ctnrID, cgPath := mockContainerIDAndPath(PodmanRuntime)
-	mockProc1.On("Cgroups").Return([]cGroup{{Path: cgPath}}, nil)
+	mockProc1.On("Cgroups").Return([]CGroup{{Path: cgPath}}, nil)
 	mockProc1.On("CPUTime").Return(float64(3.0), nil)
 
 	// VM process
@@ -1185,7 +1580,7 @@ func TestRefreshConcurrency(t *testing.T) {
 		"-name", "test-vm",
 	}, nil)
 	mockProc2.On("Environ").Return([]string{}, nil).Maybe()
-	mockProc2.On("Cgroups").Return([]cGroup{{Path: "/system.slice/libvirt.service"}}, nil)
+	mockProc2.On("Cgroups").Return([]CGroup{{Path: "/system.slice/libvirt.service"}}, nil)
 	mockProc2.On("CPUTime").Return(float64(2.0), nil)
 
 	// Regular process
@@ -1193,14 +1588,14 @@ func TestRefreshConcurrency(t *testing.T) {
 	mockProc3.On("PID").Return(1001)
 	mockProc3.On("Comm").Return("regular-proc", nil)
 	mockProc3.On("Executable").Return("/bin/regular", nil)
-	mockProc3.On("Cgroups").Return([]cGroup{{Path: "/system.slice/regular.service"}}, nil)
+	mockProc3.On("Cgroups").Return([]CGroup{{Path: "/system.slice/regular.service"}}, nil)
 	mockProc3.On("CPUTime").Return(float64(1.0), nil)
 	mockProc3.On("Environ").Return([]string{}, nil).Maybe()
 	mockProc3.On("CmdLine").Return([]string{"/bin/regular"}, nil).Maybe()
 
 	mockInformer := &MockProcReader{}
-	mockInformer.On("AllProcs").Return([]procInfo{}, nil).Once()
-	mockInformer.On("AllProcs").Return([]procInfo{mockProc1, mockProc2, mockProc3}, nil).Once()
+	mockInformer.On("AllProcs").Return([]ProcInfo{}, nil).Once()
+	mockInformer.On("AllProcs").Return([]ProcInfo{mockProc1, mockProc2, mockProc3}, nil).Once()
 	mockInformer.On("CPUUsageRatio").Return(float64(0.1), nil).Once()
 
 	// Mock pod informer to test pod dependency on containers
@@ -1264,3 +1659,78 @@ func TestRefreshConcurrency(t *testing.T) {
 	mockProc2.AssertExpectations(t)
 	mockProc3.AssertExpectations(t)
 }
+
+// benchProc is a lightweight ProcInfo used by benchmarks where testify mocks
+// would dominate the measurements
+type benchProc struct {
+	pid     int
+	cpuTime float64
+}
+
+func (p *benchProc) PID() int                    { return p.pid }
+func (p *benchProc) Comm() (string, error)       { return "bench", nil }
+func (p *benchProc) Executable() (string, error) { return "/bin/bench", nil }
+func (p *benchProc) Cgroups() ([]CGroup, error) {
+	return []CGroup{{Path: "/system.slice/bench.service"}}, nil
+}
+func (p *benchProc) Environ() ([]string, error) { return nil, nil }
+func (p *benchProc) CmdLine() ([]string, error) { return []string{"/bin/bench"}, nil }
+func (p *benchProc) CPUTime() (float64, error) {
+	p.cpuTime += 0.1
+	return p.cpuTime, nil
+}
+
+// benchProcReader simulates /proc enumeration cost by allocating a new handle
+// per process on every AllProcs call and counts the enumerations
+type benchProcReader struct {
+	procs []*benchProc
+	scans int
+}
+
+func (r *benchProcReader) AllProcs() ([]ProcInfo, error) {
+	r.scans++
+	procs := make([]ProcInfo, len(r.procs))
+	for i, p := range r.procs {
+		procs[i] = p
+	}
+	return procs, nil
+}
+
+func (r *benchProcReader) CPUUsageRatio() (float64, error) {
+	return 0.5, nil
+}
+
+func BenchmarkRefreshProcessScanInterval(b *testing.B) {
+	const (
+		numProcs = 5000
+		interval = 5 * time.Second
+	)
+
+	for _, scanInterval := range []time.Duration{0, 30 * time.Second} {
+		b.Run(fmt.Sprintf("scan-interval=%s", scanInterval), func(b *testing.B) {
+			reader := &benchProcReader{procs: make([]*benchProc, numProcs)}
+			for i := range reader.procs {
+				reader.procs[i] = &benchProc{pid: i + 1}
+			}
+			fakeClock := testclock.NewFakeClock(time.Now())
+
+			informer, err := NewInformer(
+				WithProcReader(reader),
+				WithClock(fakeClock),
+				WithLogger(slog.New(slog.DiscardHandler)),
+				WithProcessScanInterval(scanInterval),
+			)
+			require.NoError(b, err)
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for b.Loop() {
+				if err := informer.Refresh(); err != nil {
+					b.Fatal(err)
+				}
+				fakeClock.Step(interval)
+			}
+			b.ReportMetric(float64(reader.scans)/float64(b.N), "scans/op")
+		})
+	}
+}
@@ -3,6 +3,8 @@
 
 package resource
 
+import "time"
+
 type ProcessType string
 
 const (
@@ -22,22 +24,42 @@ type Process struct {
 	Container      *Container
 	VirtualMachine *VirtualMachine
 
+	// NamespacedPIDs holds the PIDs of the process in each PID namespace it
+	// belongs to, as reported by the NSpid field of /proc/<pid>/status
+	// (outermost first). Only populated for container processes.
+	NamespacedPIDs []int
+
+	// UID is the real user ID owning the process; -1 if unknown
+	UID int
+
+	// StartTime is when the process started; zero if unknown
+	StartTime time.Time
+
 	// Dynamic
 	CPUTotalTime float64 // total cpu time used by the process
 	CPUTimeDelta float64 // cpu time used by the process since last refresh
+	MemoryBytes  uint64  // resident set size of the process
+	Threads      int     // number of threads of the process
+	State        string  // kernel process state (R, S, D, Z, ...)
 }
 
 // Container represents metadata about a container
 type Container struct {
-	ID      string
+	ID      string // canonical container ID, see ContainerIDFormat
+	RawID   string // cgroup path element the ID was found in, e.g. docker-<id>.scope
 	Name    string
 	Runtime ContainerRuntime
 
+	// fullID is the full container ID used to look up the pod of the
+	// container; empty if it is the same as ID
+	fullID string
+
 	Pod *Pod
 
 	// Resource usage tracking
 	CPUTotalTime float64 // total cpu time used by the container so far
 	CPUTimeDelta float64 // cpu time used by the container since last refresh
+	MemoryBytes  uint64  // sum of the resident set size of the container's processes
 }
 
 type ContainerRuntime string
@@ -51,6 +73,29 @@ const (
 	KubePodsRuntime   ContainerRuntime = "kubernetes"
 )
 
+// ContainerIDFormat selects the canonical form of container IDs
+type ContainerIDFormat string
+
+const (
+	// ContainerIDFull uses the full 64 character container ID
+	ContainerIDFull ContainerIDFormat = "full"
+
+	// ContainerIDShort uses the 12 character short container ID as shown by
+	// docker, crictl and podman
+	ContainerIDShort ContainerIDFormat = "short"
+)
+
+// shortContainerIDLen is the length of a short container ID
+const shortContainerIDLen = 12
+
+// podLookupID returns the container ID to look up the pod of the container with
+func (c *Container) podLookupID() string {
+	if c.fullID != "" {
+		return c.fullID
+	}
+	return c.ID
+}
+
 // Clone creates a deep copy of a Container
 func (c *Container) Clone() *Container {
 	if c == nil {
@@ -59,8 +104,10 @@ func (c *Container) Clone() *Container {
 
 	clone := &Container{
 		ID:      c.ID,
+		RawID:   c.RawID,
 		Name:    c.Name,
 		Runtime: c.Runtime,
+		fullID:  c.fullID,
 	}
 
 	return clone
@@ -23,7 +23,7 @@ var (
 )
 
 // vmInfoFromProc detects if a process is a VM process and extracts VM info
-func vmInfoFromProc(proc procInfo) (*VirtualMachine, error) {
+func vmInfoFromProc(proc ProcInfo) (*VirtualMachine, error) {
 	// Check command line for VM processes
 	cmdline, err := proc.CmdLine()
 	if err != nil {
@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/sustainable-computing-io/kepler/internal/service"
+)
+
+// configInspector exposes the effective configuration at /debug/config
+type configInspector struct {
+	api APIService
+	cfg fmt.Stringer
+}
+
+var (
+	_ service.Service     = (*configInspector)(nil)
+	_ service.Initializer = (*configInspector)(nil)
+)
+
+// NewConfigInspector creates a service serving cfg at /debug/config. cfg must
+// already have its secrets redacted as it is served as is.
+func NewConfigInspector(api APIService, cfg fmt.Stringer) *configInspector {
+	return &configInspector{
+		api: api,
+		cfg: cfg,
+	}
+}
+
+func (ci *configInspector) Name() string {
+	return "config-inspector"
+}
+
+func (ci *configInspector) Init() error {
+	return ci.api.Register("/debug/config", "config", "Effective configuration (secrets redacted)", ci.handler())
+}
+
+func (ci *configInspector) handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", http.MethodGet)
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "application/yaml; charset=utf-8")
+		_, _ = w.Write([]byte(ci.cfg.String()))
+	})
+}
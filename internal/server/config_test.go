@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/sustainable-computing-io/kepler/config"
+	"k8s.io/utils/ptr"
+)
+
+func TestConfigInspectorInit(t *testing.T) {
+	api := &MockAPIService{}
+	ci := NewConfigInspector(api, config.DefaultConfig())
+
+	api.On("Register", "/debug/config", "config", "Effective configuration (secrets redacted)", mock.Anything).Return(nil)
+
+	assert.NoError(t, ci.Init())
+	assert.Equal(t, "config-inspector", ci.Name())
+	api.AssertExpectations(t)
+}
+
+func TestConfigInspectorHandler(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Kube.Config = "/home/user/.kube/secret-config"
+	cfg.Experimental = &config.Experimental{
+		Platform: config.Platform{
+			Redfish: config.Redfish{
+				Enabled:    ptr.To(true),
+				ConfigFile: "/etc/kepler/bmc-credentials.yaml",
+			},
+		},
+	}
+
+	ci := NewConfigInspector(&MockAPIService{}, cfg.Redacted())
+
+	t.Run("serves redacted config", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		ci.handler().ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/debug/config", nil))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "application/yaml; charset=utf-8", rr.Header().Get("Content-Type"))
+
+		body := rr.Body.String()
+		assert.Contains(t, body, "metricsLevel")
+		assert.NotContains(t, body, "secret-config")
+		assert.NotContains(t, body, "bmc-credentials")
+	})
+
+	t.Run("rejects non GET requests", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		ci.handler().ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/debug/config", nil))
+
+		assert.Equal(t, http.StatusMethodNotAllowed, rr.Code)
+	})
+}
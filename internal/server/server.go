@@ -24,6 +24,7 @@ type APIService interface {
 type APIServer struct {
 	// input
 	logger      *slog.Logger
+	name        string
 	listenAddrs []string
 
 	// http
@@ -37,6 +38,7 @@ var _ APIService = (*APIServer)(nil)
 
 type Opts struct {
 	logger      *slog.Logger
+	name        string
 	listenAddrs []string
 	webCfgPath  string
 }
@@ -51,6 +53,14 @@ func WithLogger(logger *slog.Logger) OptionFn {
 	}
 }
 
+// WithName sets the service name of the APIServer, used to tell apart
+// multiple servers
+func WithName(name string) OptionFn {
+	return func(o *Opts) {
+		o.name = name
+	}
+}
+
 // WithListenAddress sets the listening addresses for the APIServer
 func WithListenAddress(addr []string) OptionFn {
 	return func(o *Opts) {
@@ -68,6 +78,7 @@ func WithWebConfig(path string) OptionFn {
 func DefaultOpts() Opts {
 	return Opts{
 		logger:      slog.Default(),
+		name:        "api-server",
 		listenAddrs: []string{":28282"}, // Default HTTP Port
 		webCfgPath:  "",                 // Not present by default
 	}
@@ -85,7 +96,8 @@ func NewAPIServer(applyOpts ...OptionFn) *APIServer {
 		Handler: mux,
 	}
 	apiServer := &APIServer{
-		logger:      opts.logger.With("service", "api-server"),
+		logger:      opts.logger.With("service", opts.name),
+		name:        opts.name,
 		listenAddrs: opts.listenAddrs,
 		mux:         mux,
 		server:      server,
@@ -96,7 +108,7 @@ func NewAPIServer(applyOpts ...OptionFn) *APIServer {
 }
 
 func (s *APIServer) Name() string {
-	return "api-server"
+	return s.name
 }
 
 func (s *APIServer) Init() error {
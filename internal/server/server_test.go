@@ -70,6 +70,12 @@ func TestNewAPIServer(t *testing.T) {
 			WithListenAddress([]string{":9090"}),
 		},
 		serviceName: "api-server",
+	}, {
+		name: "with custom name",
+		opts: []OptionFn{
+			WithName("pprof-server"),
+		},
+		serviceName: "pprof-server",
 	}}
 
 	for _, tt := range tt {
@@ -0,0 +1,156 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"cmp"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/sustainable-computing-io/kepler/internal/monitor"
+	"github.com/sustainable-computing-io/kepler/internal/service"
+)
+
+// snapshotProvider provides the latest power monitor snapshot
+type snapshotProvider interface {
+	Snapshot() (*monitor.Snapshot, error)
+}
+
+// zoneInspector exposes the raw zone energy readings of the latest snapshot
+// at /debug/zones
+type zoneInspector struct {
+	api APIService
+	pm  snapshotProvider
+}
+
+var (
+	_ service.Service     = (*zoneInspector)(nil)
+	_ service.Initializer = (*zoneInspector)(nil)
+)
+
+// cpuZoneReading is the raw reading of a CPU energy zone
+type cpuZoneReading struct {
+	Name              string  `json:"name"`
+	Index             int     `json:"index"`
+	Path              string  `json:"path"`
+	EnergyTotal       float64 `json:"energyTotalJoules"`
+	EnergyDelta       float64 `json:"energyDeltaJoules"`
+	ActiveEnergyTotal float64 `json:"activeEnergyTotalJoules"`
+	IdleEnergyTotal   float64 `json:"idleEnergyTotalJoules"`
+	Power             float64 `json:"powerWatts"`
+	ActivePower       float64 `json:"activePowerWatts"`
+	IdlePower         float64 `json:"idlePowerWatts"`
+	MaxEnergy         float64 `json:"maxEnergyJoules"`
+}
+
+// gpuZoneReading is the raw reading of a GPU device
+type gpuZoneReading struct {
+	Index             int     `json:"index"`
+	UUID              string  `json:"uuid"`
+	Name              string  `json:"name"`
+	Vendor            string  `json:"vendor"`
+	EnergyTotal       float64 `json:"energyTotalJoules"`
+	EnergyDelta       float64 `json:"energyDeltaJoules"`
+	ActiveEnergyTotal float64 `json:"activeEnergyTotalJoules"`
+	IdleEnergyTotal   float64 `json:"idleEnergyTotalJoules"`
+	Power             float64 `json:"powerWatts"`
+	ActivePower       float64 `json:"activePowerWatts"`
+	IdlePower         float64 `json:"idlePowerWatts"`
+}
+
+// zoneReadings is the response of /debug/zones
+type zoneReadings struct {
+	Timestamp time.Time        `json:"timestamp"`
+	CPU       []cpuZoneReading `json:"cpu"`
+	GPU       []gpuZoneReading `json:"gpu"`
+}
+
+// NewZoneInspector creates a service serving the raw zone readings of the
+// latest snapshot of pm at /debug/zones
+func NewZoneInspector(api APIService, pm snapshotProvider) *zoneInspector {
+	return &zoneInspector{
+		api: api,
+		pm:  pm,
+	}
+}
+
+func (zi *zoneInspector) Name() string {
+	return "zone-inspector"
+}
+
+func (zi *zoneInspector) Init() error {
+	return zi.api.Register("/debug/zones", "zones", "Raw CPU and GPU zone energy readings", zi.handler())
+}
+
+func (zi *zoneInspector) handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", http.MethodGet)
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+
+		snapshot, err := zi.pm.Snapshot()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(readingsOf(snapshot))
+	})
+}
+
+// readingsOf returns the zone readings of snapshot sorted by zone name and index
+func readingsOf(snapshot *monitor.Snapshot) zoneReadings {
+	ret := zoneReadings{
+		Timestamp: snapshot.Timestamp,
+		CPU:       []cpuZoneReading{},
+		GPU:       []gpuZoneReading{},
+	}
+
+	if snapshot.Node != nil {
+		for zone, usage := range snapshot.Node.Zones {
+			ret.CPU = append(ret.CPU, cpuZoneReading{
+				Name:              zone.Name(),
+				Index:             zone.Index(),
+				Path:              zone.Path(),
+				EnergyTotal:       usage.EnergyTotal.Joules(),
+				EnergyDelta:       usage.EnergyDelta.Joules(),
+				ActiveEnergyTotal: usage.ActiveEnergyTotal.Joules(),
+				IdleEnergyTotal:   usage.IdleEnergyTotal.Joules(),
+				Power:             usage.Power.Watts(),
+				ActivePower:       usage.ActivePower.Watts(),
+				IdlePower:         usage.IdlePower.Watts(),
+				MaxEnergy:         zone.MaxEnergy().Joules(),
+			})
+		}
+	}
+	slices.SortFunc(ret.CPU, func(a, b cpuZoneReading) int {
+		return cmp.Or(cmp.Compare(a.Name, b.Name), cmp.Compare(a.Index, b.Index))
+	})
+
+	for _, dev := range snapshot.GPUStats {
+		ret.GPU = append(ret.GPU, gpuZoneReading{
+			Index:             dev.DeviceIndex,
+			UUID:              dev.UUID,
+			Name:              dev.Name,
+			Vendor:            dev.Vendor,
+			EnergyTotal:       dev.EnergyTotal.Joules(),
+			EnergyDelta:       dev.EnergyDelta.Joules(),
+			ActiveEnergyTotal: dev.ActiveEnergyTotal.Joules(),
+			IdleEnergyTotal:   dev.IdleEnergyTotal.Joules(),
+			Power:             dev.TotalPower,
+			ActivePower:       dev.ActivePower,
+			IdlePower:         dev.IdlePower,
+		})
+	}
+	slices.SortFunc(ret.GPU, func(a, b gpuZoneReading) int {
+		return cmp.Compare(a.Index, b.Index)
+	})
+
+	return ret
+}
@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/sustainable-computing-io/kepler/internal/device"
+	"github.com/sustainable-computing-io/kepler/internal/monitor"
+)
+
+// stubSnapshotProvider returns a fixed snapshot or error
+type stubSnapshotProvider struct {
+	snapshot *monitor.Snapshot
+	err      error
+}
+
+func (s *stubSnapshotProvider) Snapshot() (*monitor.Snapshot, error) {
+	return s.snapshot, s.err
+}
+
+func TestZoneInspectorInit(t *testing.T) {
+	api := &MockAPIService{}
+	zi := NewZoneInspector(api, &stubSnapshotProvider{})
+
+	api.On("Register", "/debug/zones", "zones", "Raw CPU and GPU zone energy readings", mock.Anything).Return(nil)
+
+	assert.NoError(t, zi.Init())
+	assert.Equal(t, "zone-inspector", zi.Name())
+	api.AssertExpectations(t)
+}
+
+func TestZoneInspectorHandler(t *testing.T) {
+	pkg := device.NewMockRaplZone("package", 0, "/sys/class/powercap/intel-rapl/intel-rapl:0", 262143*device.Joule)
+	dram := device.NewMockRaplZone("dram", 0, "/sys/class/powercap/intel-rapl/intel-rapl:0:1", 65535*device.Joule)
+
+	now := time.Date(2025, 7, 10, 12, 0, 0, 0, time.UTC)
+	snapshot := monitor.NewSnapshot()
+	snapshot.Timestamp = now
+	snapshot.Node.Zones = monitor.NodeZoneUsageMap{
+		pkg: {
+			EnergyTotal:       1000 * device.Joule,
+			EnergyDelta:       50 * device.Joule,
+			ActiveEnergyTotal: 600 * device.Joule,
+			IdleEnergyTotal:   400 * device.Joule,
+			Power:             10 * device.Watt,
+			ActivePower:       6 * device.Watt,
+			IdlePower:         4 * device.Watt,
+		},
+		dram: {EnergyTotal: 200 * device.Joule, EnergyDelta: 5 * device.Joule, Power: 1 * device.Watt},
+	}
+	snapshot.GPUStats = []monitor.GPUDeviceStats{{
+		DeviceIndex:       0,
+		UUID:              "GPU-1234",
+		Name:              "NVIDIA A100",
+		Vendor:            "nvidia",
+		TotalPower:        150,
+		IdlePower:         50,
+		ActivePower:       100,
+		EnergyTotal:       3000 * device.Joule,
+		EnergyDelta:       750 * device.Joule,
+		ActiveEnergyTotal: 2000 * device.Joule,
+		IdleEnergyTotal:   1000 * device.Joule,
+	}}
+
+	t.Run("serves zone readings", func(t *testing.T) {
+		zi := NewZoneInspector(&MockAPIService{}, &stubSnapshotProvider{snapshot: snapshot})
+
+		rr := httptest.NewRecorder()
+		zi.handler().ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/debug/zones", nil))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+
+		var got zoneReadings
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &got))
+
+		assert.True(t, now.Equal(got.Timestamp))
+		require.Len(t, got.CPU, 2)
+		// sorted by zone name
+		assert.Equal(t, "dram", got.CPU[0].Name)
+		assert.Equal(t, cpuZoneReading{
+			Name:              "package",
+			Index:             0,
+			Path:              "/sys/class/powercap/intel-rapl/intel-rapl:0",
+			EnergyTotal:       1000,
+			EnergyDelta:       50,
+			ActiveEnergyTotal: 600,
+			IdleEnergyTotal:   400,
+			Power:             10,
+			ActivePower:       6,
+			IdlePower:         4,
+			MaxEnergy:         262143,
+		}, got.CPU[1])
+
+		require.Len(t, got.GPU, 1)
+		assert.Equal(t, gpuZoneReading{
+			Index:             0,
+			UUID:              "GPU-1234",
+			Name:              "NVIDIA A100",
+			Vendor:            "nvidia",
+			EnergyTotal:       3000,
+			EnergyDelta:       750,
+			ActiveEnergyTotal: 2000,
+			IdleEnergyTotal:   1000,
+			Power:             150,
+			ActivePower:       100,
+			IdlePower:         50,
+		}, got.GPU[0])
+	})
+
+	t.Run("no GPUs", func(t *testing.T) {
+		zi := NewZoneInspector(&MockAPIService{}, &stubSnapshotProvider{snapshot: monitor.NewSnapshot()})
+
+		rr := httptest.NewRecorder()
+		zi.handler().ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/debug/zones", nil))
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.JSONEq(t, `{"timestamp":"0001-01-01T00:00:00Z","cpu":[],"gpu":[]}`, rr.Body.String())
+	})
+
+	t.Run("snapshot error", func(t *testing.T) {
+		zi := NewZoneInspector(&MockAPIService{}, &stubSnapshotProvider{err: errors.New("monitor not ready")})
+
+		rr := httptest.NewRecorder()
+		zi.handler().ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/debug/zones", nil))
+
+		assert.Equal(t, http.StatusServiceUnavailable, rr.Code)
+		assert.Contains(t, rr.Body.String(), "monitor not ready")
+	})
+
+	t.Run("rejects non GET requests", func(t *testing.T) {
+		zi := NewZoneInspector(&MockAPIService{}, &stubSnapshotProvider{snapshot: snapshot})
+
+		rr := httptest.NewRecorder()
+		zi.handler().ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/debug/zones", nil))
+
+		assert.Equal(t, http.StatusMethodNotAllowed, rr.Code)
+	})
+}
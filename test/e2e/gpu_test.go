@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: 2025 The Kepler Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package e2e
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/sustainable-computing-io/kepler/test/common"
+)
+
+// fakeGPUPower is the power of the fake GPU configured by writeFakeGPUConfig
+const fakeGPUPower = 40.0
+
+// writeFakeGPUConfig writes the e2e config with a fake GPU meter attributing
+// its power to pid
+func writeFakeGPUConfig(t *testing.T, pid int) string {
+	t.Helper()
+
+	base, err := os.ReadFile(testConfig.configFile)
+	require.NoError(t, err, "e2e config file is required")
+
+	cfg := fmt.Sprintf(`%s
+dev:
+  fake-gpu-meter:
+    enabled: true
+    devices: 1
+    power: %v
+    pids: [%d]
+`, base, fakeGPUPower, pid)
+
+	path := filepath.Join(t.TempDir(), "kepler-fake-gpu.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(cfg), 0o600))
+	return path
+}
+
+// TestFakeGPUProcessAttribution verifies that the GPU power reported by the
+// fake GPU meter is attributed to the known process it is seeded with
+func TestFakeGPUProcessAttribution(t *testing.T) {
+	requireE2EPrerequisites(t)
+
+	sleeper := exec.Command("sleep", "300")
+	require.NoError(t, sleeper.Start())
+	t.Cleanup(func() {
+		_ = sleeper.Process.Kill()
+		_ = sleeper.Wait()
+	})
+	pid := sleeper.Process.Pid
+
+	kepler := startKepler(t, withLogOutput(os.Stderr), withConfigFile(writeFakeGPUConfig(t, pid)))
+	scraper := common.NewMetricsScraper(kepler.MetricsURL())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	pidStr := strconv.Itoa(pid)
+	var gpuWatts float64
+	err := common.WaitForCondition(ctx, 1*time.Second, func() bool {
+		metrics, err := scraper.ScrapeMetric("kepler_process_gpu_watts")
+		if err != nil {
+			return false
+		}
+		for _, m := range metrics {
+			if m.Labels["pid"] == pidStr && m.Labels["state"] == "running" {
+				gpuWatts = m.Value
+				return true
+			}
+		}
+		return false
+	})
+	require.NoError(t, err, "kepler_process_gpu_watts should be reported for PID %d", pid)
+
+	assert.InDelta(t, fakeGPUPower, gpuWatts, 0.01,
+		"the only GPU process should be attributed all fake GPU power")
+
+	nodeMetrics, err := scraper.ScrapeMetric("kepler_node_gpu_watts")
+	require.NoError(t, err)
+	require.NotEmpty(t, nodeMetrics)
+	assert.InDelta(t, fakeGPUPower, nodeMetrics[0].Value, 0.01)
+}
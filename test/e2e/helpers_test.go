@@ -95,6 +95,11 @@ func withLogOutput(w io.Writer) keplerOption {
 	return func(k *KeplerInstance) { k.logOutput = w }
 }
 
+// withConfigFile sets the config file Kepler is started with
+func withConfigFile(path string) keplerOption {
+	return func(k *KeplerInstance) { k.configPath = path }
+}
+
 // startKepler starts Kepler and registers cleanup
 func startKepler(t *testing.T, opts ...keplerOption) *KeplerInstance {
 	t.Helper()